@@ -14,23 +14,24 @@ import (
 // newCosmosAnteHandler creates the default ante handler for Cosmos transactions
 func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHandler {
 	feemarketParams := options.FeeMarketKeeper.GetParams(ctx)
+	// the msg filter is read on every tx so that governance can update it without an upgrade
+	msgFilter := options.EvmKeeper.GetParams(ctx).MsgFilter
 	var txFeeChecker ante.TxFeeChecker
 	if options.DynamicFeeChecker {
 		txFeeChecker = evmante.NewDynamicFeeChecker(&feemarketParams)
 	}
 
 	return sdk.ChainAnteDecorators(
-		cosmosante.NewRejectMessagesDecorator(), // reject MsgEthereumTxs
+		cosmosante.NewRejectMessagesDecorator(msgFilter.BlockedMsgTypeURLs...), // reject MsgEthereumTxs and blocked msgs
 		cosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+			authzDisabledMsgTypes(msgFilter)...,
 		),
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewMinGasPriceDecorator(&feemarketParams),
+		cosmosante.NewMinGasPriceDecorator(&feemarketParams, msgFilter.MinGasPriceOverrides...),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, txFeeChecker),
 		// SetPubKeyDecorator must be called before all signature verification decorators
@@ -43,3 +44,15 @@ func newCosmosAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHandl
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper, &feemarketParams),
 	)
 }
+
+// authzDisabledMsgTypes returns the Msg types that cannot be granted or executed
+// within authz. Besides the ones disabled by default, the msgs blocked by the
+// filter are disabled so that they cannot be executed through an authz.MsgExec.
+func authzDisabledMsgTypes(msgFilter evmtypes.MsgFilter) []string {
+	disabledMsgTypes := []string{
+		sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+		sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+	}
+	disabledMsgTypes = append(disabledMsgTypes, msgFilter.BlockedMsgTypeURLs...)
+	return append(disabledMsgTypes, msgFilter.BlockedAuthzMsgTypeURLs...)
+}
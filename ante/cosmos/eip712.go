@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -86,11 +87,12 @@ func (svd LegacyEip712SigVerificationDecorator) AnteHandle(ctx sdk.Context,
 		return ctx, err
 	}
 
-	// EIP712 allows just one signature
+	// EIP712 allows just one signer, whose key can be a LegacyAminoPubKey multisig
+	// with one signature per member in its multisig signature data
 	if len(sigs) != 1 {
 		return ctx, errorsmod.Wrapf(
 			errortypes.ErrTooManySignatures,
-			"invalid number of signers (%d);  EIP712 signatures allows just one signature",
+			"invalid number of signers (%d);  EIP712 signatures allows just one signer",
 			len(sigs),
 		)
 	}
@@ -100,7 +102,7 @@ func (svd LegacyEip712SigVerificationDecorator) AnteHandle(ctx sdk.Context,
 		return ctx, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid number of signers;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
-	// EIP712 has just one signature, avoid looping here and only read index 0
+	// EIP712 has just one signer, avoid looping here and only read index 0
 	i := 0
 	sig := sigs[i]
 
@@ -151,7 +153,8 @@ func (svd LegacyEip712SigVerificationDecorator) AnteHandle(ctx sdk.Context,
 }
 
 // VerifySignature verifies a transaction signature contained in SignatureData abstracting over different signing modes
-// and single vs multi-signatures.
+// and single vs multi-signatures. Single signatures are carried by the ExtensionOptionsWeb3Tx extension, while each
+// signature of a LegacyAminoPubKey multisig is carried by the multisig signature data.
 func VerifySignature(
 	pubKey cryptotypes.PubKey,
 	signerData authsigning.SignerData,
@@ -172,64 +175,7 @@ func VerifySignature(
 		// @contract: this code is reached only when Msg has Web3Tx extension (so this custom Ante handler flow),
 		// and the signature is SIGN_MODE_LEGACY_AMINO_JSON which is supported for EIP712 for now
 
-		msgs := tx.GetMsgs()
-		if len(msgs) == 0 {
-			return errorsmod.Wrap(errortypes.ErrNoSignatures, "tx doesn't contain any msgs to verify signature")
-		}
-
-		txBytes := legacytx.StdSignBytes(
-			signerData.ChainID,
-			signerData.AccountNumber,
-			signerData.Sequence,
-			tx.GetTimeoutHeight(),
-			legacytx.StdFee{
-				Amount: tx.GetFee(),
-				Gas:    tx.GetGas(),
-			},
-			msgs, tx.GetMemo(),
-		)
-
-		signerChainID, err := strconv.ParseUint(signerData.ChainID, 10, 64)
-		if err != nil {
-			return errorsmod.Wrapf(err, "failed to parse chain-id: %s", signerData.ChainID)
-		}
-
-		txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
-		if !ok {
-			return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesn't contain any extensions")
-		}
-		opts := txWithExtensions.GetExtensionOptions()
-		if len(opts) != 1 {
-			return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesn't contain expected amount of extension options")
-		}
-
-		extOpt, ok := opts[0].GetCachedValue().(*eip712.ExtensionOptionsWeb3Tx)
-		if !ok {
-			return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "unknown extension option")
-		}
-
-		if extOpt.TypedDataChainID != signerChainID {
-			return errorsmod.Wrap(errortypes.ErrInvalidChainID, "invalid chain-id")
-		}
-
-		if len(extOpt.FeePayer) == 0 {
-			return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "no feePayer on ExtensionOptionsWeb3Tx")
-		}
-		feePayer, err := sdk.AccAddressFromBech32(extOpt.FeePayer)
-		if err != nil {
-			return errorsmod.Wrap(err, "failed to parse feePayer from ExtensionOptionsWeb3Tx")
-		}
-
-		feeDelegation := &eip712.FeeDelegationOptions{
-			FeePayer: feePayer,
-		}
-
-		typedData, err := eip712.LegacyWrapTxToTypedData(evmCodec, extOpt.TypedDataChainID, msgs[0], txBytes, feeDelegation)
-		if err != nil {
-			return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
-		}
-
-		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		sigHash, extOpt, feePayer, err := legacyTypedDataHash(signerData, tx)
 		if err != nil {
 			return err
 		}
@@ -275,7 +221,167 @@ func VerifySignature(
 		}
 
 		return nil
+	case *signing.MultiSignatureData:
+		multisigPubKey, ok := pubKey.(*multisig.LegacyAminoPubKey)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "expected %T for multisig EIP712 signature, got %T", &multisig.LegacyAminoPubKey{}, pubKey)
+		}
+
+		sigHash, extOpt, feePayer, err := legacyTypedDataHash(signerData, tx)
+		if err != nil {
+			return err
+		}
+
+		// Note: the signatures of the multisig members are carried by the multisig signature data
+		if len(extOpt.FeePayerSig) != 0 {
+			return errorsmod.Wrap(errortypes.ErrTooManySignatures, "invalid feePayerSig value; EIP712 multisig must have the ExtensionOptionsWeb3Tx signature empty")
+		}
+
+		multisigAddr := sdk.AccAddress(multisigPubKey.Address())
+		if !feePayer.Equals(multisigAddr) {
+			return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "feePayer %s is different from multisig address %s", feePayer, multisigAddr)
+		}
+
+		return verifyMultisigEIP712Signature(multisigPubKey, data, sigHash)
 	default:
 		return errorsmod.Wrapf(errortypes.ErrTooManySignatures, "unexpected SignatureData %T", sigData)
 	}
 }
+
+// legacyTypedDataHash returns the hash of the legacy EIP-712 typed data of the transaction, along with
+// its ExtensionOptionsWeb3Tx extension and fee payer. The fee payer, and fee granter if any, are part
+// of the typed data.
+func legacyTypedDataHash(
+	signerData authsigning.SignerData,
+	tx authsigning.Tx,
+) ([]byte, *eip712.ExtensionOptionsWeb3Tx, sdk.AccAddress, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil, nil, errorsmod.Wrap(errortypes.ErrNoSignatures, "tx doesn't contain any msgs to verify signature")
+	}
+
+	feeGranter := sdk.AccAddress(tx.FeeGranter())
+
+	stdFee := legacytx.StdFee{
+		Amount: tx.GetFee(),
+		Gas:    tx.GetGas(),
+	}
+	if !feeGranter.Empty() {
+		stdFee.Granter = feeGranter.String()
+	}
+
+	txBytes := legacytx.StdSignBytes(
+		signerData.ChainID,
+		signerData.AccountNumber,
+		signerData.Sequence,
+		tx.GetTimeoutHeight(),
+		stdFee,
+		msgs, tx.GetMemo(),
+	)
+
+	signerChainID, err := strconv.ParseUint(signerData.ChainID, 10, 64)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrapf(err, "failed to parse chain-id: %s", signerData.ChainID)
+	}
+
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil, nil, errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesn't contain any extensions")
+	}
+	opts := txWithExtensions.GetExtensionOptions()
+	if len(opts) != 1 {
+		return nil, nil, nil, errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesn't contain expected amount of extension options")
+	}
+
+	extOpt, ok := opts[0].GetCachedValue().(*eip712.ExtensionOptionsWeb3Tx)
+	if !ok {
+		return nil, nil, nil, errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "unknown extension option")
+	}
+
+	if extOpt.TypedDataChainID != signerChainID {
+		return nil, nil, nil, errorsmod.Wrap(errortypes.ErrInvalidChainID, "invalid chain-id")
+	}
+
+	if len(extOpt.FeePayer) == 0 {
+		return nil, nil, nil, errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "no feePayer on ExtensionOptionsWeb3Tx")
+	}
+	feePayer, err := sdk.AccAddressFromBech32(extOpt.FeePayer)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "failed to parse feePayer from ExtensionOptionsWeb3Tx")
+	}
+
+	if !feePayer.Equals(sdk.AccAddress(tx.FeePayer())) {
+		return nil, nil, nil, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "feePayer %s is different from transaction fee payer %s", feePayer, sdk.AccAddress(tx.FeePayer()))
+	}
+
+	feeDelegation := &eip712.FeeDelegationOptions{
+		FeePayer:   feePayer,
+		FeeGranter: feeGranter,
+	}
+
+	typedData, err := eip712.LegacyWrapTxToTypedData(evmCodec, extOpt.TypedDataChainID, msgs[0], txBytes, feeDelegation)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return sigHash, extOpt, feePayer, nil
+}
+
+// verifyMultisigEIP712Signature verifies that the multisig signature data holds a valid EIP-712 signature
+// of the typed data hash for at least threshold of the multisig ethsecp256k1 keys.
+func verifyMultisigEIP712Signature(
+	pubKey *multisig.LegacyAminoPubKey,
+	data *signing.MultiSignatureData,
+	sigHash []byte,
+) error {
+	pubKeys := pubKey.GetPubKeys()
+	bitArray := data.BitArray
+	sigs := data.Signatures
+
+	if bitArray == nil || bitArray.Count() != len(pubKeys) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "bit array size doesn't match the %d multisig keys", len(pubKeys))
+	}
+
+	if bitArray.NumTrueBitsBefore(bitArray.Count()) != len(sigs) {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "number of signatures doesn't match the bit array")
+	}
+
+	if len(sigs) < int(pubKey.Threshold) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "not enough signatures; expected at least %d, got %d", pubKey.Threshold, len(sigs))
+	}
+
+	sigIndex := 0
+	for i, memberPubKey := range pubKeys {
+		if !bitArray.GetIndex(i) {
+			continue
+		}
+
+		memberSig, ok := sigs[sigIndex].(*signing.SingleSignatureData)
+		if !ok || memberSig.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return errorsmod.Wrapf(errortypes.ErrNotSupported, "unexpected multisig SignatureData %T at index %d: nested multisigs and sign modes other than SIGN_MODE_LEGACY_AMINO_JSON are not supported", sigs[sigIndex], i)
+		}
+
+		if _, ok := memberPubKey.(*ethsecp256k1.PubKey); !ok {
+			return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "multisig key %d is not an %s key", i, ethsecp256k1.KeyType)
+		}
+
+		memberSigBytes := memberSig.Signature
+		if len(memberSigBytes) != ethcrypto.SignatureLength {
+			return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "signature length of multisig key %d doesn't match typical [R||S||V] signature 65 bytes", i)
+		}
+
+		// VerifySignature of ethsecp256k1 accepts 64 byte signature [R||S]
+		if !secp256k1.VerifySignature(memberPubKey.Bytes(), sigHash, memberSigBytes[:ethcrypto.RecoveryIDOffset]) {
+			return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "unable to verify signature of EIP712 typed data for multisig key %d", i)
+		}
+
+		sigIndex++
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package cosmos_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/ante/cosmos"
+	"github.com/zenanetwork/zena/crypto/ethsecp256k1"
+	"github.com/zenanetwork/zena/encoding"
+	"github.com/zenanetwork/zena/ethereum/eip712"
+	"github.com/zenanetwork/zena/testutil/constants"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestLegacyEIP712VerifySignature(t *testing.T) {
+	evmChainID := constants.ExampleChainID.EVMChainID
+	encodingCfg := encoding.MakeConfig(evmChainID)
+	txCfg := encodingCfg.TxConfig
+	// the legacy typed data is derived from the Amino JSON of the messages
+	banktypes.RegisterLegacyAminoCodec(encodingCfg.Amino)
+
+	registry := codectypes.NewInterfaceRegistry()
+	eip712.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	var err error
+	privKeys := make([]*ethsecp256k1.PrivKey, 3)
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range privKeys {
+		privKeys[i], err = ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+	multisigPubKey := multisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	granter := sdk.AccAddress(pubKeys[2].Address())
+	fees := sdk.NewCoins(sdk.NewInt64Coin(constants.ExampleAttoDenom, 1000))
+	signerData := authsigning.SignerData{
+		ChainID:       "9001",
+		AccountNumber: 4,
+		Sequence:      2,
+	}
+
+	// buildTx returns a tx sent by the signer with the given pubKey and its legacy EIP-712 typed data hash
+	buildTx := func(t *testing.T, pubKey cryptotypes.PubKey, feeGranter sdk.AccAddress) (authtx.ExtensionOptionsTxBuilder, []byte) {
+		t.Helper()
+
+		signer := sdk.AccAddress(pubKey.Address())
+		msg := banktypes.NewMsgSend(signer, sdk.AccAddress(pubKeys[1].Address()), fees)
+
+		txBuilder, ok := txCfg.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+		require.True(t, ok)
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(fees)
+		txBuilder.SetGasLimit(200000)
+		txBuilder.SetFeeGranter(feeGranter)
+
+		stdFee := legacytx.StdFee{Amount: fees, Gas: 200000}
+		if !feeGranter.Empty() {
+			stdFee.Granter = feeGranter.String()
+		}
+		txBytes := legacytx.StdSignBytes(
+			signerData.ChainID, signerData.AccountNumber, signerData.Sequence, 0,
+			stdFee, []sdk.Msg{msg}, "",
+		)
+		typedData, err := eip712.LegacyWrapTxToTypedData(cdc, 9001, msg, txBytes, &eip712.FeeDelegationOptions{
+			FeePayer:   signer,
+			FeeGranter: feeGranter,
+		})
+		require.NoError(t, err)
+
+		if !feeGranter.Empty() {
+			require.Contains(t, typedData.Types["Fee"], apitypes.Type{Name: "feeGranter", Type: "string"})
+		}
+
+		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+
+		return txBuilder, sigHash
+	}
+
+	// setWeb3Extension sets the ExtensionOptionsWeb3Tx extension of the tx
+	setWeb3Extension := func(t *testing.T, txBuilder authtx.ExtensionOptionsTxBuilder, pubKey cryptotypes.PubKey, feePayerSig []byte) {
+		t.Helper()
+
+		option, err := codectypes.NewAnyWithValue(&eip712.ExtensionOptionsWeb3Tx{
+			TypedDataChainID: 9001,
+			FeePayer:         sdk.AccAddress(pubKey.Address()).String(),
+			FeePayerSig:      feePayerSig,
+		})
+		require.NoError(t, err)
+		txBuilder.SetExtensionOptions(option)
+	}
+
+	// multisigData returns the multisig signature data of the given member signatures
+	multisigData := func(sigs map[int][]byte) *signing.MultiSignatureData {
+		data := multisigtypes.NewMultisig(len(pubKeys))
+		for i := range pubKeys {
+			sig, ok := sigs[i]
+			if !ok {
+				continue
+			}
+			data.BitArray.SetIndex(i, true)
+			data.Signatures = append(data.Signatures, &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: sig,
+			})
+		}
+		return data
+	}
+
+	sign := func(t *testing.T, i int, sigHash []byte) []byte {
+		t.Helper()
+		sig, err := privKeys[i].Sign(sigHash)
+		require.NoError(t, err)
+		return sig
+	}
+
+	testCases := []struct {
+		name   string
+		run    func(t *testing.T) error
+		expErr string
+	}{
+		{
+			name: "pass - single signer with fee granter",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, pubKeys[0], granter)
+				setWeb3Extension(t, txBuilder, pubKeys[0], sign(t, 0, sigHash))
+
+				sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+				return cosmos.VerifySignature(pubKeys[0], signerData, sigData, txBuilder.GetTx())
+			},
+		},
+		{
+			name: "fail - single signer with fee granter signing the typed data without granter",
+			run: func(t *testing.T) error {
+				_, sigHash := buildTx(t, pubKeys[0], nil)
+				txBuilder, _ := buildTx(t, pubKeys[0], granter)
+				setWeb3Extension(t, txBuilder, pubKeys[0], sign(t, 0, sigHash))
+
+				sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+				return cosmos.VerifySignature(pubKeys[0], signerData, sigData, txBuilder.GetTx())
+			},
+			expErr: "is different from transaction pubkey",
+		},
+		{
+			name: "pass - 2-of-3 multisig with fee granter",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, multisigPubKey, granter)
+				setWeb3Extension(t, txBuilder, multisigPubKey, nil)
+
+				sigData := multisigData(map[int][]byte{0: sign(t, 0, sigHash), 2: sign(t, 2, sigHash)})
+				return cosmos.VerifySignature(multisigPubKey, signerData, sigData, txBuilder.GetTx())
+			},
+		},
+		{
+			name: "fail - multisig below threshold",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, multisigPubKey, nil)
+				setWeb3Extension(t, txBuilder, multisigPubKey, nil)
+
+				sigData := multisigData(map[int][]byte{1: sign(t, 1, sigHash)})
+				return cosmos.VerifySignature(multisigPubKey, signerData, sigData, txBuilder.GetTx())
+			},
+			expErr: "not enough signatures",
+		},
+		{
+			name: "fail - multisig signature from another member key",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, multisigPubKey, nil)
+				setWeb3Extension(t, txBuilder, multisigPubKey, nil)
+
+				sigData := multisigData(map[int][]byte{0: sign(t, 0, sigHash), 1: sign(t, 2, sigHash)})
+				return cosmos.VerifySignature(multisigPubKey, signerData, sigData, txBuilder.GetTx())
+			},
+			expErr: "unable to verify signature of EIP712 typed data for multisig key 1",
+		},
+		{
+			name: "fail - multisig with extension signature",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, multisigPubKey, nil)
+				setWeb3Extension(t, txBuilder, multisigPubKey, sign(t, 0, sigHash))
+
+				sigData := multisigData(map[int][]byte{0: sign(t, 0, sigHash), 1: sign(t, 1, sigHash)})
+				return cosmos.VerifySignature(multisigPubKey, signerData, sigData, txBuilder.GetTx())
+			},
+			expErr: "EIP712 multisig must have the ExtensionOptionsWeb3Tx signature empty",
+		},
+		{
+			name: "fail - fee payer different from the multisig",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, multisigPubKey, nil)
+				setWeb3Extension(t, txBuilder, pubKeys[0], nil)
+
+				sigData := multisigData(map[int][]byte{0: sign(t, 0, sigHash), 1: sign(t, 1, sigHash)})
+				return cosmos.VerifySignature(multisigPubKey, signerData, sigData, txBuilder.GetTx())
+			},
+			expErr: "is different from transaction fee payer",
+		},
+		{
+			name: "fail - multisig data with a single signer key",
+			run: func(t *testing.T) error {
+				txBuilder, sigHash := buildTx(t, pubKeys[0], nil)
+				setWeb3Extension(t, txBuilder, pubKeys[0], nil)
+
+				sigData := multisigData(map[int][]byte{0: sign(t, 0, sigHash), 1: sign(t, 1, sigHash)})
+				return cosmos.VerifySignature(pubKeys[0], signerData, sigData, txBuilder.GetTx())
+			},
+			expErr: "for multisig EIP712 signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.run(t)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
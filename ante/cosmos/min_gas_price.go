@@ -13,21 +13,29 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // MinGasPriceDecorator will check if the transaction's fee is at least as large
 // as the MinGasPrices param. If fee is too low, decorator returns error and tx
 // is rejected. This applies for both CheckTx and DeliverTx
 // If fee is high enough, then call next AnteHandler
+//
+// The min gas price of msg types with an override is replaced by the override,
+// and the highest min gas price among the tx msgs is required.
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
-	feemarketParams *feemarkettypes.Params
+	feemarketParams      *feemarkettypes.Params
+	minGasPriceOverrides []evmtypes.MinGasPriceOverride
 }
 
 // NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
 // Cosmos transactions.
-func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params) MinGasPriceDecorator {
-	return MinGasPriceDecorator{feemarketParams}
+func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params, minGasPriceOverrides ...evmtypes.MinGasPriceOverride) MinGasPriceDecorator {
+	return MinGasPriceDecorator{
+		feemarketParams:      feemarketParams,
+		minGasPriceOverrides: minGasPriceOverrides,
+	}
 }
 
 func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -36,7 +44,10 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feemarketParams.MinGasPrice
+	minGasPrice, err := mpd.getMinGasPrice(tx.GetMsgs(), 1)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s", err.Error())
+	}
 
 	feeCoins := feeTx.GetFee()
 	evmDenom := evmtypes.GetEVMCoinDenom()
@@ -93,3 +104,55 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 	return next(ctx, tx, simulate)
 }
+
+// getMinGasPrice returns the highest min gas price required by the msgs, using
+// the min gas price override of the msg type if any, or the global min gas price
+// otherwise. The msgs wrapped in an authz.MsgExec are also accounted for.
+func (mpd MinGasPriceDecorator) getMinGasPrice(msgs []sdk.Msg, nestedLvl int) (math.LegacyDec, error) {
+	minGasPrice := mpd.feemarketParams.MinGasPrice
+	if len(mpd.minGasPriceOverrides) == 0 {
+		return minGasPrice, nil
+	}
+	if nestedLvl >= maxNestedMsgs {
+		return math.LegacyDec{}, fmt.Errorf("found more nested msgs than permitted; got: %d, expected: <%d", nestedLvl, maxNestedMsgs)
+	}
+
+	var txMinGasPrice math.LegacyDec
+	for _, msg := range msgs {
+		msgMinGasPrice := minGasPrice
+		if override, found := mpd.getMinGasPriceOverride(sdk.MsgTypeURL(msg)); found {
+			msgMinGasPrice = override
+		}
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return math.LegacyDec{}, err
+			}
+			innerMinGasPrice, err := mpd.getMinGasPrice(innerMsgs, nestedLvl+1)
+			if err != nil {
+				return math.LegacyDec{}, err
+			}
+			msgMinGasPrice = math.LegacyMaxDec(msgMinGasPrice, innerMinGasPrice)
+		}
+
+		if txMinGasPrice.IsNil() || msgMinGasPrice.GT(txMinGasPrice) {
+			txMinGasPrice = msgMinGasPrice
+		}
+	}
+
+	if txMinGasPrice.IsNil() {
+		return minGasPrice, nil
+	}
+	return txMinGasPrice, nil
+}
+
+// getMinGasPriceOverride returns the min gas price override of the msg type, if any.
+func (mpd MinGasPriceDecorator) getMinGasPriceOverride(msgTypeURL string) (math.LegacyDec, bool) {
+	for _, override := range mpd.minGasPriceOverrides {
+		if override.MsgTypeURL == msgTypeURL {
+			return override.MinGasPrice, true
+		}
+	}
+	return math.LegacyDec{}, false
+}
@@ -1,6 +1,8 @@
 package cosmos
 
 import (
+	"slices"
+
 	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -10,16 +12,23 @@ import (
 )
 
 // RejectMessagesDecorator prevents invalid msg types from being executed.
-type RejectMessagesDecorator struct{}
+type RejectMessagesDecorator struct {
+	// blockedMsgTypes is the type urls of the msgs to reject.
+	blockedMsgTypes []string
+}
 
-// NewRejectMessagesDecorator creates a new RejectMessagesDecorator.
-func NewRejectMessagesDecorator() sdk.AnteDecorator {
-	return RejectMessagesDecorator{}
+// NewRejectMessagesDecorator creates a new RejectMessagesDecorator that, besides
+// the MsgEthereumTx, rejects the given msg types.
+func NewRejectMessagesDecorator(blockedMsgTypes ...string) sdk.AnteDecorator {
+	return RejectMessagesDecorator{
+		blockedMsgTypes: blockedMsgTypes,
+	}
 }
 
 // AnteHandle rejects messages that requires ethereum-specific authentication.
 // For example `MsgEthereumTx` requires fee to be deducted in the antehandler in
-// order to perform the refund.
+// order to perform the refund. It also rejects the blocked msg types, which are
+// usually disabled through governance as an emergency circuit breaker.
 func (rmd RejectMessagesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	for _, msg := range tx.GetMsgs() {
 		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
@@ -28,6 +37,10 @@ func (rmd RejectMessagesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				"MsgEthereumTx needs to be contained within a tx with 'ExtensionOptionsEthereumTx' option",
 			)
 		}
+
+		if url := sdk.MsgTypeURL(msg); slices.Contains(rmd.blockedMsgTypes, url) {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "found disabled msg type: %s", url)
+		}
 	}
 	return next(ctx, tx, simulate)
 }
@@ -15,6 +15,7 @@ func newMonoEVMAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHand
 			options.AccountKeeper,
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
+			options.FeegrantKeeper,
 			options.MaxTxGasWanted,
 			&evmParams,
 			&feemarketParams,
@@ -17,6 +17,8 @@ import (
 
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+// If the fees are paid by a fee granter, the balance is only checked against
+// the transaction value.
 // This method will fail if:
 // - from address is NOT an EOA
 // - account balance is lower than the transaction cost
@@ -27,6 +29,7 @@ func VerifyAccountBalance(
 	account *statedb.Account,
 	from common.Address,
 	ethTx *ethtypes.Transaction,
+	feeGranter sdk.AccAddress,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.HasCodeHash() {
@@ -46,6 +49,16 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	if !feeGranter.Empty() {
+		if account.Balance.ToBig().Cmp(ethTx.Value()) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"failed to check sender balance: sender balance < tx value (%s < %s)", account.Balance, ethTx.Value(),
+			)
+		}
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
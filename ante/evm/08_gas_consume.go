@@ -14,6 +14,7 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // UpdateCumulativeGasWanted updates the cumulative gas wanted
@@ -36,7 +37,56 @@ func UpdateCumulativeGasWanted(
 	return cumulativeGasWanted
 }
 
-// ConsumeFeesAndEmitEvent deduces fees from sender and emits the event
+// VerifyFeeGranter checks that the fee granter set on the Cosmos tx wrapping
+// the Ethereum transaction is committed by the sender. The Cosmos tx isn't
+// covered by the Ethereum signature, so the granter must be an address of the
+// access list of the signed transaction. Otherwise, anyone relaying the
+// transaction could attach or swap the granter.
+func VerifyFeeGranter(feeGranter sdktypes.AccAddress, ethTx *ethtypes.Transaction) error {
+	if feeGranter.Empty() {
+		return nil
+	}
+
+	granter := common.BytesToAddress(feeGranter)
+	for _, tuple := range ethTx.AccessList() {
+		if tuple.Address == granter {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		errortypes.ErrUnauthorized,
+		"fee granter %s is not in the access list of the signed transaction", feeGranter,
+	)
+}
+
+// UseFeeGrant returns the account paying the transaction fees. If a fee granter
+// is set, the fees are deducted from the allowance given by the granter to the
+// sender and the granter is returned. Otherwise, the sender pays the fees.
+func UseFeeGrant(
+	ctx sdktypes.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	feeGranter sdktypes.AccAddress,
+	from sdktypes.AccAddress,
+	fees sdktypes.Coins,
+	msgs []sdktypes.Msg,
+) (sdktypes.AccAddress, error) {
+	if feeGranter.Empty() {
+		return from, nil
+	}
+
+	if feegrantKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	if err := feegrantKeeper.UseGrantedFees(ctx, feeGranter, from, fees, msgs); err != nil {
+		return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+	}
+
+	return feeGranter, nil
+}
+
+// ConsumeFeesAndEmitEvent deduces fees from the fee payer and emits the event
 func ConsumeFeesAndEmitEvent(
 	ctx sdktypes.Context,
 	evmKeeper anteinterfaces.EVMKeeper,
@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 const AcceptedTxType = 0 |
@@ -33,6 +34,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
 	evmParams       *evmtypes.Params
 	feemarketParams *feemarkettypes.Params
@@ -44,10 +46,14 @@ type MonoDecorator struct {
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
 // decorators using the returned DecoratorUtils
+//
+// The feegrant keeper is optional. If it's nil, EVM transactions with a fee
+// granter are rejected.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 	evmParams *evmtypes.Params,
 	feemarketParams *feemarkettypes.Params,
@@ -56,6 +62,7 @@ func NewEVMMonoDecorator(
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 		evmParams:       evmParams,
 		feemarketParams: feemarketParams,
@@ -174,6 +181,18 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
 
+	// The fee granter is set on the Cosmos tx wrapping the EVM transaction.
+	// It's read from the tx instead of txFeeInfo because the latter is not
+	// available on ReCheckTx.
+	var feeGranter sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feeGranter = feeTx.FeeGranter()
+	}
+
+	if err := VerifyFeeGranter(feeGranter, ethTx); err != nil {
+		return ctx, err
+	}
+
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
@@ -186,6 +205,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		account,
 		fromAddr,
 		ethTx,
+		feeGranter,
 	); err != nil {
 		return ctx, err
 	}
@@ -217,16 +237,37 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	// store the allowance of the fee granter (if any) before the fees are deducted
+	// from it, so that the fees of the leftover gas are given back to it
+	if err := md.evmKeeper.SetTransientFeeAllowance(ctx, feeGranter, from); err != nil {
+		return ctx, err
+	}
+
+	feePayer, err := UseFeeGrant(
+		ctx,
+		md.feegrantKeeper,
+		feeGranter,
+		from,
+		msgFees,
+		msgs,
+	)
+	if err != nil {
+		return ctx, err
+	}
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
 		msgFees,
-		from,
+		feePayer,
 	)
 	if err != nil {
 		return ctx, err
 	}
 
+	// store the fee granter (if any) so that the leftover gas is refunded to it
+	md.evmKeeper.SetTransientFeePayer(ctx, feeGranter)
+
 	gasWanted := UpdateCumulativeGasWanted(
 		ctx,
 		gas,
@@ -2,6 +2,7 @@ package evm_test
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -32,6 +33,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -62,7 +64,12 @@ func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address)
 	return uint256.NewInt(0)
 }
 
-func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
+func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context)                  {}
+func (k *ExtendedEVMKeeper) SetTransientFeePayer(_ sdk.Context, _ sdk.AccAddress) {}
+
+func (k *ExtendedEVMKeeper) SetTransientFeeAllowance(_ sdk.Context, _, _ sdk.AccAddress) error {
+	return nil
+}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
@@ -116,6 +123,19 @@ func (m MockAccountKeeper) TryAddUnorderedNonce(_ sdk.Context, _ []byte, _ time.
 func (m MockAccountKeeper) UnorderedTransactionsEnabled() bool { return false }
 func (m MockAccountKeeper) AddressCodec() address.Codec        { return nil }
 
+// only methods called by EVMMonoDecorator
+type MockFeegrantKeeper struct {
+	Granter sdk.AccAddress
+	Grantee sdk.AccAddress
+}
+
+func (m MockFeegrantKeeper) UseGrantedFees(_ context.Context, granter, grantee sdk.AccAddress, _ sdk.Coins, _ []sdk.Msg) error {
+	if !granter.Equals(m.Granter) || !grantee.Equals(m.Grantee) {
+		return errors.New("fee-grant not found")
+	}
+	return nil
+}
+
 func signMsgEthereumTx(t *testing.T, privKey *ethsecp256k1.PrivKey, args *evmsdktypes.EvmTxArgs) *evmsdktypes.MsgEthereumTx {
 	t.Helper()
 	msg := evmsdktypes.NewTx(args)
@@ -219,7 +239,7 @@ func TestMonoDecorator(t *testing.T) {
 			feeMarketKeeper := MockFeeMarketKeeper{}
 			params := keeper.GetParams(sdk.Context{})
 			feemarketParams := feeMarketKeeper.GetParams(sdk.Context{})
-			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, 0, &params, &feemarketParams)
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, nil, 0, &params, &feemarketParams)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
 
@@ -237,3 +257,140 @@ func TestMonoDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestMonoDecoratorFeeGranter(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	cfg := encoding.MakeConfig(chainID)
+	granter := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	validKeeper := func(grantee sdk.AccAddress) authante.FeegrantKeeper {
+		return MockFeegrantKeeper{Granter: granter, Grantee: grantee}
+	}
+
+	testCases := []struct {
+		name           string
+		feeGranter     sdk.AccAddress
+		signedGranter  sdk.AccAddress
+		amount         *big.Int
+		feegrantKeeper func(grantee sdk.AccAddress) authante.FeegrantKeeper
+		expErr         string
+	}{
+		{
+			"fail - sender can't pay the fees",
+			nil,
+			nil,
+			nil,
+			validKeeper,
+			"insufficient funds",
+		},
+		{
+			"fail - fee granter not signed by the sender",
+			granter,
+			nil,
+			nil,
+			validKeeper,
+			"is not in the access list of the signed transaction",
+		},
+		{
+			"fail - fee granter swapped",
+			granter,
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			nil,
+			validKeeper,
+			"is not in the access list of the signed transaction",
+		},
+		{
+			"fail - fee grants are not enabled",
+			granter,
+			granter,
+			nil,
+			func(_ sdk.AccAddress) authante.FeegrantKeeper {
+				return nil
+			},
+			"fee grants are not enabled",
+		},
+		{
+			"fail - no allowance from the fee granter",
+			granter,
+			granter,
+			nil,
+			func(_ sdk.AccAddress) authante.FeegrantKeeper {
+				return MockFeegrantKeeper{}
+			},
+			"fee-grant not found",
+		},
+		{
+			"fail - sender can't pay the value",
+			granter,
+			granter,
+			big.NewInt(2e18),
+			validKeeper,
+			"sender balance < tx value",
+		},
+		{
+			"success - fees paid by the fee granter",
+			granter,
+			granter,
+			big.NewInt(1e18),
+			validKeeper,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmsdktypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			chainConfig := evmsdktypes.DefaultChainConfig(evmsdktypes.DefaultEVMChainID)
+			err := evmsdktypes.SetChainConfig(chainConfig)
+			require.NoError(t, err)
+			coinInfo := evmsdktypes.EvmCoinInfo{
+				Denom:         evmsdktypes.DefaultEVMExtendedDenom,
+				ExtendedDenom: evmsdktypes.DefaultEVMExtendedDenom,
+				DisplayDenom:  evmsdktypes.DefaultEVMDisplayDenom,
+				Decimals:      18,
+			}
+			err = configurator.
+				WithExtendedEips(evmsdktypes.DefaultCosmosEVMActivators).
+				WithEVMCoinInfo(coinInfo).
+				Configure()
+			require.NoError(t, err)
+			privKey, _ := ethsecp256k1.GenerateKey()
+			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
+			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+			feeMarketKeeper := MockFeeMarketKeeper{}
+			params := keeper.GetParams(sdk.Context{})
+			feemarketParams := feeMarketKeeper.GetParams(sdk.Context{})
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, tc.feegrantKeeper(cosmosAddr), 0, &params, &feemarketParams)
+			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
+
+			// the tx cost (10 eth) is higher than the sender balance (1 eth)
+			accesses := ethtypes.AccessList{}
+			if !tc.signedGranter.Empty() {
+				accesses = append(accesses, ethtypes.AccessTuple{Address: common.BytesToAddress(tc.signedGranter)})
+			}
+			args := &evmsdktypes.EvmTxArgs{
+				Nonce:     0,
+				GasLimit:  100000,
+				GasFeeCap: big.NewInt(1e14),
+				GasTipCap: big.NewInt(1e14),
+				Amount:    tc.amount,
+				Input:     []byte("test"),
+				Accesses:  &accesses,
+			}
+			msg := signMsgEthereumTx(t, privKey, args)
+			tx, err := utiltx.PrepareEthTx(cfg.TxConfig, nil, msg)
+			require.NoError(t, err)
+			txBuilder, err := cfg.TxConfig.WrapTxBuilder(tx)
+			require.NoError(t, err)
+			txBuilder.SetFeeGranter(tc.feeGranter)
+
+			_, err = monoDec.AnteHandle(ctx, txBuilder.GetTx(), true, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ type EVMKeeper interface {
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	SetTransientFeePayer(ctx sdk.Context, feePayer sdk.AccAddress)
+	SetTransientFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
-	Msg_ConvertCoin_FullMethodName      = "/cosmos.evm.erc20.v1.Msg/ConvertCoin"
-	Msg_UpdateParams_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName        = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
+	Msg_ConvertCoin_FullMethodName         = "/cosmos.evm.erc20.v1.Msg/ConvertCoin"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName       = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_DeregisterTokenPair_FullMethodName = "/cosmos.evm.erc20.v1.Msg/DeregisterTokenPair"
+	Msg_MigrateBalance_FullMethodName      = "/cosmos.evm.erc20.v1.Msg/MigrateBalance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +50,14 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// DeregisterTokenPair defines a governance operation for retiring a token
+	// pair. It disables the conversions, optionally migrates the outstanding
+	// balances and removes the pair, its precompile and allowances. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error)
+	// MigrateBalance converts the Cosmos coins of a native ERC20 token pair
+	// deregistered with the balance migration back to ERC20 tokens
+	MigrateBalance(ctx context.Context, in *MsgMigrateBalance, opts ...grpc.CallOption) (*MsgMigrateBalanceResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +113,24 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error) {
+	out := new(MsgDeregisterTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterTokenPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateBalance(ctx context.Context, in *MsgMigrateBalance, opts ...grpc.CallOption) (*MsgMigrateBalanceResponse, error) {
+	out := new(MsgMigrateBalanceResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -125,6 +153,14 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// DeregisterTokenPair defines a governance operation for retiring a token
+	// pair. It disables the conversions, optionally migrates the outstanding
+	// balances and removes the pair, its precompile and allowances. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error)
+	// MigrateBalance converts the Cosmos coins of a native ERC20 token pair
+	// deregistered with the balance migration back to ERC20 tokens
+	MigrateBalance(context.Context, *MsgMigrateBalance) (*MsgMigrateBalanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -147,6 +183,12 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTokenPair not implemented")
+}
+func (UnimplementedMsgServer) MigrateBalance(context.Context, *MsgMigrateBalance) (*MsgMigrateBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateBalance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +292,42 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeregisterTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterTokenPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, req.(*MsgDeregisterTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateBalance(ctx, req.(*MsgMigrateBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +355,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "DeregisterTokenPair",
+			Handler:    _Msg_DeregisterTokenPair_Handler,
+		},
+		{
+			MethodName: "MigrateBalance",
+			Handler:    _Msg_MigrateBalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
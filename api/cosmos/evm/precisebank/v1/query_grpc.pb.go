@@ -19,20 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Remainder_FullMethodName         = "/cosmos.evm.precisebank.v1.Query/Remainder"
-	Query_FractionalBalance_FullMethodName = "/cosmos.evm.precisebank.v1.Query/FractionalBalance"
+	Query_Params_FullMethodName             = "/cosmos.evm.precisebank.v1.Query/Params"
+	Query_Remainder_FullMethodName          = "/cosmos.evm.precisebank.v1.Query/Remainder"
+	Query_FractionalBalance_FullMethodName  = "/cosmos.evm.precisebank.v1.Query/FractionalBalance"
+	Query_FractionalBalances_FullMethodName = "/cosmos.evm.precisebank.v1.Query/FractionalBalances"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of x/precisebank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
 	// FractionalBalance returns only the fractional balance of an address. This
 	// does not include any integer balance.
 	FractionalBalance(ctx context.Context, in *QueryFractionalBalanceRequest, opts ...grpc.CallOption) (*QueryFractionalBalanceResponse, error)
+	// FractionalBalances returns all non-zero fractional balances of an extended
+	// denom.
+	FractionalBalances(ctx context.Context, in *QueryFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryFractionalBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -43,6 +50,15 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
 	out := new(QueryRemainderResponse)
 	err := c.cc.Invoke(ctx, Query_Remainder_FullMethodName, in, out, opts...)
@@ -61,16 +77,30 @@ func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractional
 	return out, nil
 }
 
+func (c *queryClient) FractionalBalances(ctx context.Context, in *QueryFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryFractionalBalancesResponse, error) {
+	out := new(QueryFractionalBalancesResponse)
+	err := c.cc.Invoke(ctx, Query_FractionalBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
+	// Params queries the parameters of x/precisebank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
 	// FractionalBalance returns only the fractional balance of an address. This
 	// does not include any integer balance.
 	FractionalBalance(context.Context, *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error)
+	// FractionalBalances returns all non-zero fractional balances of an extended
+	// denom.
+	FractionalBalances(context.Context, *QueryFractionalBalancesRequest) (*QueryFractionalBalancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -78,12 +108,18 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
 }
 func (UnimplementedQueryServer) FractionalBalance(context.Context, *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalance not implemented")
 }
+func (UnimplementedQueryServer) FractionalBalances(context.Context, *QueryFractionalBalancesRequest) (*QueryFractionalBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -97,6 +133,24 @@ func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRemainderRequest)
 	if err := dec(in); err != nil {
@@ -133,6 +187,24 @@ func _Query_FractionalBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FractionalBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFractionalBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FractionalBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FractionalBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FractionalBalances(ctx, req.(*QueryFractionalBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +212,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.precisebank.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "Remainder",
 			Handler:    _Query_Remainder_Handler,
@@ -148,6 +224,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FractionalBalance",
 			Handler:    _Query_FractionalBalance_Handler,
 		},
+		{
+			MethodName: "FractionalBalances",
+			Handler:    _Query_FractionalBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/precisebank/v1/query.proto",
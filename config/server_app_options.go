@@ -7,6 +7,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
+	evmmempool "github.com/zenanetwork/zena/mempool"
 	"github.com/zenanetwork/zena/mempool/txpool/legacypool"
 	srvflags "github.com/zenanetwork/zena/server/flags"
 
@@ -139,10 +140,43 @@ func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *leg
 	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)); lifetime != 0 {
 		legacyConfig.Lifetime = lifetime
 	}
+	if journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal)); journal != "" {
+		if !filepath.IsAbs(journal) {
+			journal = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), journal)
+		}
+		legacyConfig.Journal = journal
+	}
+	if rejournal := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal)); rejournal != 0 {
+		legacyConfig.Rejournal = rejournal
+	}
 
 	return &legacyConfig
 }
 
+// GetCosmosTxPolicy reads the block space policy of the Cosmos transactions from appOpts.
+// The lane message types, if any, are reserved a fraction of the block gas in a single lane.
+func GetCosmosTxPolicy(appOpts servertypes.AppOptions, logger log.Logger) evmmempool.CosmosTxPolicy {
+	if appOpts == nil {
+		logger.Error("app options is nil, using no cosmos tx policy")
+		return evmmempool.CosmosTxPolicy{}
+	}
+
+	policy := evmmempool.CosmosTxPolicy{
+		MaxBlockGasFraction: cast.ToFloat64(appOpts.Get(srvflags.EVMMempoolCosmosMaxBlockGasFraction)),
+		MaxTxsPerSender:     cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosMaxTxsPerSender)),
+		MaxGasPerSender:     cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosMaxGasPerSender)),
+	}
+	if msgTypes := cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolLaneMsgTypes)); len(msgTypes) > 0 {
+		policy.Lanes = []evmmempool.LaneConfig{{
+			Name:             "reserved",
+			MsgTypeURLs:      msgTypes,
+			BlockGasFraction: cast.ToFloat64(appOpts.Get(srvflags.EVMMempoolLaneBlockGasFraction)),
+		}}
+	}
+
+	return policy
+}
+
 func GetCosmosPoolMaxTx(appOpts servertypes.AppOptions, logger log.Logger) int {
 	if appOpts == nil {
 		logger.Error("app options is nil, using default cosmos pool max tx of -1 (no-op)")
@@ -10,6 +10,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	evmmempool "github.com/zenanetwork/zena/mempool"
+	srvflags "github.com/zenanetwork/zena/server/flags"
+
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
@@ -230,6 +233,113 @@ func TestGetMinGasPrices(t *testing.T) {
 	}
 }
 
+func TestGetLegacyPoolConfigJournal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		setupFn  func() servertypes.AppOptions
+		expected string
+	}{
+		{
+			name: "journal disabled by default",
+			setupFn: func() servertypes.AppOptions {
+				return newMockAppOptions()
+			},
+			expected: "",
+		},
+		{
+			name: "relative journal path is resolved against the home",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/home/zenad")
+				opts.Set(srvflags.EVMMempoolJournal, "data/evm_mempool.rlp")
+				return opts
+			},
+			expected: "/home/zenad/data/evm_mempool.rlp",
+		},
+		{
+			name: "absolute journal path",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/home/zenad")
+				opts.Set(srvflags.EVMMempoolJournal, "/var/lib/evm_mempool.rlp")
+				return opts
+			},
+			expected: "/var/lib/evm_mempool.rlp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			legacyConfig := GetLegacyPoolConfig(tc.setupFn(), log.NewNopLogger())
+			require.Equal(t, tc.expected, legacyConfig.Journal)
+		})
+	}
+}
+
+func TestGetCosmosTxPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		setupFn  func() servertypes.AppOptions
+		expected evmmempool.CosmosTxPolicy
+	}{
+		{
+			name: "missing flags returns empty policy",
+			setupFn: func() servertypes.AppOptions {
+				return newMockAppOptions()
+			},
+			expected: evmmempool.CosmosTxPolicy{},
+		},
+		{
+			name: "limits without lane",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMMempoolCosmosMaxBlockGasFraction, 0.5)
+				opts.Set(srvflags.EVMMempoolCosmosMaxTxsPerSender, uint64(10))
+				opts.Set(srvflags.EVMMempoolCosmosMaxGasPerSender, uint64(1_000_000))
+				opts.Set(srvflags.EVMMempoolLaneBlockGasFraction, 0.2)
+				return opts
+			},
+			expected: evmmempool.CosmosTxPolicy{
+				MaxBlockGasFraction: 0.5,
+				MaxTxsPerSender:     10,
+				MaxGasPerSender:     1_000_000,
+			},
+		},
+		{
+			name: "lane message types",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMMempoolLaneMsgTypes, []string{"/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket"})
+				opts.Set(srvflags.EVMMempoolLaneBlockGasFraction, 0.2)
+				return opts
+			},
+			expected: evmmempool.CosmosTxPolicy{
+				Lanes: []evmmempool.LaneConfig{{
+					Name:             "reserved",
+					MsgTypeURLs:      []string{"/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket"},
+					BlockGasFraction: 0.2,
+				}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := GetCosmosTxPolicy(tc.setupFn(), log.NewNopLogger())
+			require.Equal(t, tc.expected, policy)
+			require.NoError(t, policy.Validate())
+		})
+	}
+}
+
 func createGenesisWithMaxGas(t *testing.T, maxGas int64) string {
 	t.Helper()
 	tempDir := t.TempDir()
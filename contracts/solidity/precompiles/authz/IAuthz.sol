@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantData describes an authorization given by a granter to a grantee.
+struct GrantData {
+    /// @dev The address of the account that gave the authorization
+    address granter;
+    /// @dev The address of the account allowed to execute messages on behalf of the granter
+    address grantee;
+    /// @dev The type URL of the authorized message (e.g. "/cosmos.staking.v1beta1.MsgDelegate")
+    string msgType;
+    /// @dev Unix timestamp at which the authorization expires (zero if it never expires)
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK authz module.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted.
+    /// @param granter The address of the account that gave the authorization
+    /// @param grantee The address of the account that received the authorization
+    /// @param msgType The type URL of the authorized message
+    /// @param expiration The unix timestamp at which the authorization expires (zero if it never expires)
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgType,
+        int64 expiration
+    );
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter The address of the account that gave the authorization
+    /// @param grantee The address of the account whose authorization was revoked
+    /// @param msgType The type URL of the revoked message
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgType
+    );
+
+    /// @dev Emitted when a grantee executes messages on behalf of granters.
+    /// @param grantee The address of the account that executed the messages
+    /// @param msgTypes The type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypes);
+
+    /// @dev Grants a generic authorization to the grantee to execute messages of the given
+    /// type on behalf of the granter. Only whitelisted message types can be granted.
+    /// @param granter The address of the account giving the authorization. Must be the msg.sender
+    /// @param grantee The address of the account receiving the authorization
+    /// @param msgType The type URL of the message to authorize
+    /// @param expiration The unix timestamp at which the authorization expires. Use zero for no expiration
+    /// @return success Whether or not the authorization was granted successfully
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgType,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes an existing authorization from the grantee.
+    /// @param granter The address of the account that gave the authorization. Must be the msg.sender
+    /// @param grantee The address of the account whose authorization is revoked
+    /// @param msgType The type URL of the message to revoke
+    /// @return success Whether or not the authorization was revoked successfully
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgType
+    ) external returns (bool success);
+
+    /// @dev Executes messages on behalf of their signers using the authorizations given to the grantee.
+    /// Each message is the proto3 JSON encoding of a Cosmos SDK message including its "@type" field.
+    /// Only whitelisted message types can be executed.
+    /// @param grantee The address of the account executing the messages. Must be the msg.sender
+    /// @param msgs The JSON encoded messages to execute
+    /// @return success Whether or not the messages were executed successfully
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bool success);
+
+    /// @dev Queries the authorizations given by a granter to a grantee.
+    /// @param granter The address of the account that gave the authorizations
+    /// @param grantee The address of the account that received the authorizations
+    /// @param msgType The type URL of the message to filter by. Use an empty string to return all grants
+    /// @param pagination The pagination options
+    /// @return grants The matching authorizations
+    /// @return pageResponse The pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgType,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries all the authorizations given by a granter.
+    /// @param granter The address of the account that gave the authorizations
+    /// @param pagination The pagination options
+    /// @return grants The authorizations given by the granter
+    /// @return pageResponse The pagination response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries all the authorizations received by a grantee.
+    /// @param grantee The address of the account that received the authorizations
+    /// @param pagination The pagination options
+    /// @return grants The authorizations received by the grantee
+    /// @return pageResponse The pagination response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
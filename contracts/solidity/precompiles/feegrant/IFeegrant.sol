@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev AllowanceData describes a fee allowance given by a granter to a grantee.
+struct AllowanceData {
+    /// @dev The address of the account paying the fees
+    address granter;
+    /// @dev The address of the account whose fees are paid
+    address grantee;
+    /// @dev The type URL of the allowance (e.g. "/cosmos.feegrant.v1beta1.BasicAllowance")
+    string allowanceType;
+    /// @dev The maximum amount of fees that can be paid (empty if there is no limit)
+    Coin[] spendLimit;
+    /// @dev Unix timestamp at which the allowance expires (zero if it never expires)
+    int64 expiration;
+    /// @dev Duration of the period in seconds (periodic allowances only)
+    int64 period;
+    /// @dev The maximum amount of fees that can be paid in a period (periodic allowances only)
+    Coin[] periodSpendLimit;
+    /// @dev The amount of fees that can still be paid in the current period (periodic allowances only)
+    Coin[] periodCanSpend;
+    /// @dev Unix timestamp at which the current period resets (periodic allowances only)
+    int64 periodReset;
+    /// @dev The type URLs of the messages whose fees can be paid (empty if all messages are allowed)
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK feegrant module.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted.
+    /// @param granter The address of the account paying the fees
+    /// @param grantee The address of the account whose fees are paid
+    /// @param allowanceType The type URL of the granted allowance
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        string allowanceType
+    );
+
+    /// @dev Emitted when a fee allowance is revoked.
+    /// @param granter The address of the account that gave the allowance
+    /// @param grantee The address of the account whose allowance was revoked
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Grants a basic fee allowance to the grantee.
+    /// @param granter The address of the account paying the fees. Must be the msg.sender
+    /// @param grantee The address of the account whose fees are paid
+    /// @param spendLimit The maximum amount of fees that can be paid. Use an empty array for no limit
+    /// @param expiration The unix timestamp at which the allowance expires. Use zero for no expiration
+    /// @return success Whether or not the allowance was granted successfully
+    function grantBasicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a periodic fee allowance to the grantee. The amount of fees that can be
+    /// paid is reset to the period spend limit at the beginning of each period.
+    /// @param granter The address of the account paying the fees. Must be the msg.sender
+    /// @param grantee The address of the account whose fees are paid
+    /// @param spendLimit The maximum amount of fees that can be paid. Use an empty array for no limit
+    /// @param expiration The unix timestamp at which the allowance expires. Use zero for no expiration
+    /// @param period The duration of the period in seconds
+    /// @param periodSpendLimit The maximum amount of fees that can be paid in a period
+    /// @return success Whether or not the allowance was granted successfully
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev Grants a basic fee allowance to the grantee that can only be used to pay the fees
+    /// of the given message types.
+    /// @param granter The address of the account paying the fees. Must be the msg.sender
+    /// @param grantee The address of the account whose fees are paid
+    /// @param spendLimit The maximum amount of fees that can be paid. Use an empty array for no limit
+    /// @param expiration The unix timestamp at which the allowance expires. Use zero for no expiration
+    /// @param allowedMessages The type URLs of the messages whose fees can be paid
+    /// @return success Whether or not the allowance was granted successfully
+    function grantAllowedMsgAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev Revokes an existing fee allowance from the grantee.
+    /// @param granter The address of the account that gave the allowance. Must be the msg.sender
+    /// @param grantee The address of the account whose allowance is revoked
+    /// @return success Whether or not the allowance was revoked successfully
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Queries the fee allowance given by a granter to a grantee.
+    /// @param granter The address of the account that gave the allowance
+    /// @param grantee The address of the account that received the allowance
+    /// @return allowance The fee allowance. The granter is the zero address if there is no allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (AllowanceData memory allowance);
+
+    /// @dev Queries all the fee allowances received by a grantee.
+    /// @param grantee The address of the account that received the allowances
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances received by the grantee
+    /// @return pageResponse The pagination response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            AllowanceData[] memory allowances,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Queries all the fee allowances given by a granter.
+    /// @param granter The address of the account that gave the allowances
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances given by the granter
+    /// @return pageResponse The pagination response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            AllowanceData[] memory allowances,
+            PageResponse memory pageResponse
+        );
+}
@@ -73,6 +73,31 @@ interface ICS20I {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev Transfer defines a method for performing an IBC v2 transfer addressed by client ID.
+    /// The packet is sent over the transfer port without requiring a v1 channel.
+    /// @param sourceClient the client ID by which the packet will be sent
+    /// @param encoding the encoding of the packet payload. Supported values are
+    /// "application/json", "application/x-protobuf" and "application/x-solidity-abi".
+    /// Defaults to "application/json" when empty
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the address of the receiver on the counterparty chain
+    /// @param timeoutTimestamp the timeout timestamp in absolute seconds since unix epoch.
+    /// It must be set since IBC v2 packets don't support height based timeouts
+    /// @param memo optional memo
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transfer(
+        string memory sourceClient,
+        string memory encoding,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
     /// @dev denoms Defines a method for returning all denoms.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denoms(
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IVesting contract's address.
+address constant VESTING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The IVesting contract's instance.
+IVesting constant VESTING_CONTRACT = IVesting(VESTING_PRECOMPILE_ADDRESS);
+
+/// @dev Period defines a length of time and amount of coins that will vest.
+struct Period {
+    /// @dev Period duration in seconds
+    int64 length;
+    /// @dev Amount of coins released at the end of the period
+    Coin[] amount;
+}
+
+/// @dev VestingAccount describes the vesting schedule of an account.
+struct VestingAccount {
+    /// @dev The vesting account type: "continuous", "delayed", "periodic", "permanent_locked" or "clawback"
+    string accountType;
+    /// @dev Unix timestamp at which vesting starts
+    int64 startTime;
+    /// @dev Unix timestamp at which vesting ends (zero for permanently locked accounts)
+    int64 endTime;
+    /// @dev Coins initially locked in the account
+    Coin[] originalVesting;
+    /// @dev Vested coins that are currently delegated
+    Coin[] delegatedFree;
+    /// @dev Vesting coins that are currently delegated
+    Coin[] delegatedVesting;
+    /// @dev Vesting periods (only populated for periodic and clawback vesting accounts)
+    Period[] periods;
+}
+
+/// @author Evmos Team
+/// @title Vesting Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK vesting accounts.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000803
+interface IVesting {
+    /// @dev Emitted when a continuous or delayed vesting account is created.
+    /// @param funder The address of the account that funded the vesting account
+    /// @param vestingAddress The address of the created vesting account
+    /// @param amount The coins locked in the vesting account
+    /// @param endTime The unix timestamp at which vesting ends
+    /// @param delayed Whether the coins vest all at once at the end time
+    event CreateVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        Coin[] amount,
+        int64 endTime,
+        bool delayed
+    );
+
+    /// @dev Emitted when a periodic vesting account is created.
+    /// @param funder The address of the account that funded the vesting account
+    /// @param vestingAddress The address of the created vesting account
+    /// @param startTime The unix timestamp at which vesting starts
+    /// @param periods The vesting periods
+    event CreatePeriodicVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        int64 startTime,
+        Period[] periods
+    );
+
+    /// @dev Emitted when a permanently locked account is created.
+    /// @param funder The address of the account that funded the locked account
+    /// @param vestingAddress The address of the created locked account
+    /// @param amount The coins locked in the account
+    event CreatePermanentLockedAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        Coin[] amount
+    );
+
+    /// @dev Emitted when a clawback vesting account is created.
+    /// @param funder The address of the account that funded the vesting account
+    /// @param vestingAddress The address of the created vesting account
+    /// @param startTime The unix timestamp at which vesting starts
+    /// @param periods The vesting periods
+    event CreateClawbackVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        int64 startTime,
+        Period[] periods
+    );
+
+    /// @dev Emitted when vesting periods are added to a clawback vesting account.
+    /// @param funder The address of the funder of the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param periods The vesting periods appended to the schedule
+    event FundVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        Period[] periods
+    );
+
+    /// @dev Emitted when the unvested coins of a clawback vesting account are reclaimed.
+    /// @param funder The address of the funder of the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param dest The address that received the unvested coins
+    /// @param coins The unvested coins that were reclaimed
+    event Clawback(
+        address indexed funder,
+        address indexed vestingAddress,
+        address dest,
+        Coin[] coins
+    );
+
+    /// @dev Creates a new continuous or delayed vesting account funded by the funder.
+    /// The vesting starts at the current block time.
+    /// @param funder The address of the account funding the vesting account. Must be the msg.sender
+    /// @param vestingAddress The address of the vesting account to create. Must not exist yet
+    /// @param amount The coins to lock in the vesting account
+    /// @param endTime The unix timestamp at which vesting ends
+    /// @param delayed If true, all coins vest at the end time instead of linearly
+    /// @return success Whether or not the vesting account was created successfully
+    function createVestingAccount(
+        address funder,
+        address vestingAddress,
+        Coin[] calldata amount,
+        int64 endTime,
+        bool delayed
+    ) external returns (bool success);
+
+    /// @dev Creates a new periodic vesting account funded by the funder.
+    /// The total amount of the periods is transferred from the funder.
+    /// @param funder The address of the account funding the vesting account. Must be the msg.sender
+    /// @param vestingAddress The address of the vesting account to create. Must not exist yet
+    /// @param startTime The unix timestamp at which vesting starts
+    /// @param periods The vesting periods
+    /// @return success Whether or not the vesting account was created successfully
+    function createPeriodicVestingAccount(
+        address funder,
+        address vestingAddress,
+        int64 startTime,
+        Period[] calldata periods
+    ) external returns (bool success);
+
+    /// @dev Creates a new account whose coins are locked permanently. The coins can
+    /// only be used for staking and governance.
+    /// @param funder The address of the account funding the locked account. Must be the msg.sender
+    /// @param vestingAddress The address of the locked account to create. Must not exist yet
+    /// @param amount The coins to lock in the account
+    /// @return success Whether or not the locked account was created successfully
+    function createPermanentLockedAccount(
+        address funder,
+        address vestingAddress,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev Creates a new clawback vesting account funded by the funder. The coins vest
+    /// at the end of each period, and the funder can reclaim the coins that haven't vested yet.
+    /// The unvested coins of a clawback vesting account can't be delegated.
+    /// @param funder The address of the account funding the vesting account. Must be the msg.sender
+    /// @param vestingAddress The address of the vesting account to create. Must not exist yet
+    /// @param startTime The unix timestamp at which vesting starts
+    /// @param periods The vesting periods
+    /// @return success Whether or not the vesting account was created successfully
+    function createClawbackVestingAccount(
+        address funder,
+        address vestingAddress,
+        int64 startTime,
+        Period[] calldata periods
+    ) external returns (bool success);
+
+    /// @dev Appends vesting periods to the schedule of a clawback vesting account and
+    /// transfers their total amount from the funder. If the schedule already ended, the
+    /// new periods start at the current block time.
+    /// @param funder The funder of the vesting account. Must be the msg.sender
+    /// @param vestingAddress The address of the clawback vesting account
+    /// @param periods The vesting periods to append
+    /// @return success Whether or not the vesting account was funded successfully
+    function fundVestingAccount(
+        address funder,
+        address vestingAddress,
+        Period[] calldata periods
+    ) external returns (bool success);
+
+    /// @dev Reclaims the coins of a clawback vesting account that haven't vested yet.
+    /// The vesting periods that haven't ended are removed from the schedule.
+    /// @param funder The funder of the vesting account. Must be the msg.sender
+    /// @param vestingAddress The address of the clawback vesting account
+    /// @param dest The address receiving the unvested coins
+    /// @return coins The unvested coins that were reclaimed
+    function clawback(
+        address funder,
+        address vestingAddress,
+        address dest
+    ) external returns (Coin[] memory coins);
+
+    /// @dev Queries the locked, unlocked, vested and unvested balances of a vesting account
+    /// at the current block time.
+    /// @param vestingAddress The address of the vesting account
+    /// @return locked The coins that cannot be transferred
+    /// @return unlocked The coins that can be transferred
+    /// @return vested The coins that have vested
+    /// @return unvested The coins that are still vesting
+    function balances(
+        address vestingAddress
+    )
+        external
+        view
+        returns (
+            Coin[] memory locked,
+            Coin[] memory unlocked,
+            Coin[] memory vested,
+            Coin[] memory unvested
+        );
+
+    /// @dev Queries the vesting schedule of a vesting account.
+    /// @param vestingAddress The address of the vesting account
+    /// @return account The vesting account schedule
+    function getVestingAccount(
+        address vestingAddress
+    ) external view returns (VestingAccount memory account);
+}
@@ -216,14 +216,17 @@ func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
 
 // Verifies the signature as an EIP-712 signature by first converting the message payload
 // to EIP-712 object bytes, then performing ECDSA verification on the hash. This is to support
-// signing a Cosmos payload using EIP-712.
+// signing a Cosmos payload using EIP-712. The payload is converted with each of the supported
+// encodings: the JSON-derived, the protobuf-typed and the legacy EIP-712 encodings.
 func (pubKey PubKey) verifySignatureAsEIP712(msg, sig []byte) bool {
 	eip712Bytes, err := eip712.GetEIP712BytesForMsg(msg)
-	if err != nil {
-		return false
+	if err == nil && pubKey.verifySignatureECDSA(eip712Bytes, sig) {
+		return true
 	}
 
-	if pubKey.verifySignatureECDSA(eip712Bytes, sig) {
+	// Try verifying the signature using the protobuf-typed EIP-712 encoding
+	protoEIP712Bytes, err := eip712.GetProtoEIP712BytesForMsg(msg)
+	if err == nil && pubKey.verifySignatureECDSA(protoEIP712Bytes, sig) {
 		return true
 	}
 
@@ -0,0 +1,117 @@
+package eips
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// MaxOpcodeGasMultiplier bounds the constant gas of an overridden opcode to
+	// the range [defaultGas / MaxOpcodeGasMultiplier, defaultGas * MaxOpcodeGasMultiplier].
+	MaxOpcodeGasMultiplier = 10
+
+	// opcodeGasEIPBase is the first EIP number reserved for the opcode constant gas
+	// activators. It is far above the Ethereum and custom EIP numbers.
+	opcodeGasEIPBase = 1 << 24
+	// opcodeGasBits is the number of bits of the constant gas values that can be set,
+	// enough for the highest default constant gas times MaxOpcodeGasMultiplier.
+	opcodeGasBits = 20
+	// opcodeGasEIPsPerOpcode is the size of the EIP range reserved for each opcode:
+	// one activator starting the override, one per bit of the value and one
+	// activator ending the override.
+	opcodeGasEIPsPerOpcode = 32
+	// opcodeGasEndSlot is the slot of the activator ending the override.
+	opcodeGasEndSlot = opcodeGasBits + 1
+
+	// opcodeGasPending marks the constant gas of an opcode whose override is being
+	// applied, so that the opcodes without constant gas in the jump table of the
+	// active fork are left untouched.
+	opcodeGasPending = uint64(1) << 63
+)
+
+// OpcodeGasActivators returns the activators used to override the constant gas of
+// the opcodes. The jump table cannot be modified once the EVM is built, so the
+// overrides are applied as extra EIPs of the VM config: for every opcode, one
+// activator starts the override, one activator per bit sets the bit of the value
+// and one activator ends the override. The activators hold no state, so EVMs
+// with different overrides can be built concurrently.
+func OpcodeGasActivators() map[int]func(*vm.JumpTable) {
+	activators := make(map[int]func(*vm.JumpTable))
+	for i := 0; i < 256; i++ {
+		op := vm.OpCode(i)
+
+		activators[opcodeGasEIP(op, 0)] = func(jt *vm.JumpTable) {
+			if jt[op].GetConstantGas() != 0 {
+				jt[op].SetConstantGas(opcodeGasPending)
+			}
+		}
+		for bit := 0; bit < opcodeGasBits; bit++ {
+			activators[opcodeGasEIP(op, bit+1)] = func(jt *vm.JumpTable) {
+				if gas := jt[op].GetConstantGas(); gas&opcodeGasPending != 0 {
+					jt[op].SetConstantGas(gas | 1<<bit)
+				}
+			}
+		}
+		activators[opcodeGasEIP(op, opcodeGasEndSlot)] = func(jt *vm.JumpTable) {
+			if gas := jt[op].GetConstantGas(); gas&opcodeGasPending != 0 {
+				jt[op].SetConstantGas(gas &^ opcodeGasPending)
+			}
+		}
+	}
+	return activators
+}
+
+// OpcodeGasEIPs returns the extra EIPs that set the constant gas of the opcode to
+// the given value, in the order they must be enabled. The constant gas of an
+// opcode without constant gas in the jump table of the active fork is not set.
+func OpcodeGasEIPs(op vm.OpCode, gas uint64) []int {
+	eips := []int{opcodeGasEIP(op, 0)}
+	for bit := 0; bit < opcodeGasBits; bit++ {
+		if gas&(1<<bit) != 0 {
+			eips = append(eips, opcodeGasEIP(op, bit+1))
+		}
+	}
+	return append(eips, opcodeGasEIP(op, opcodeGasEndSlot))
+}
+
+// IsOpcodeGasEIP returns true if the EIP number is reserved for the opcode
+// constant gas activators.
+func IsOpcodeGasEIP(eip int) bool {
+	return eip >= opcodeGasEIPBase && eip < opcodeGasEIPBase+256*opcodeGasEIPsPerOpcode
+}
+
+// ActivateableEIPs returns the EIPs that can be set as extra EIPs, leaving out the
+// range reserved for the opcode constant gas activators.
+func ActivateableEIPs() []string {
+	var activateable []string
+	for _, eip := range vm.ActivateableEips() {
+		if num, err := strconv.Atoi(eip); err == nil && IsOpcodeGasEIP(num) {
+			continue
+		}
+		activateable = append(activateable, eip)
+	}
+	return activateable
+}
+
+// ValidateOpcodeGasOverride checks that the opcode has constant gas in the jump
+// table of the active fork and that the overridden constant gas is within the
+// bounded multiplier range of it.
+func ValidateOpcodeGasOverride(jt *vm.JumpTable, op vm.OpCode, gas uint64) error {
+	defaultGas := jt[op].GetConstantGas()
+	if defaultGas == 0 {
+		return fmt.Errorf("opcode %s has no constant gas to override", op)
+	}
+
+	minGas := (defaultGas + MaxOpcodeGasMultiplier - 1) / MaxOpcodeGasMultiplier
+	maxGas := min(defaultGas*MaxOpcodeGasMultiplier, 1<<opcodeGasBits-1)
+	if gas < minGas || gas > maxGas {
+		return fmt.Errorf("constant gas %d of opcode %s is out of the range [%d, %d]", gas, op, minGas, maxGas)
+	}
+	return nil
+}
+
+func opcodeGasEIP(op vm.OpCode, slot int) int {
+	return opcodeGasEIPBase + int(op)*opcodeGasEIPsPerOpcode + slot
+}
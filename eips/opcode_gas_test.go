@@ -0,0 +1,97 @@
+package eips_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/eips"
+)
+
+func TestOpcodeGasEIPs(t *testing.T) {
+	require.NoError(t, vm.ExtendActivators(eips.OpcodeGasActivators()))
+
+	overrides := map[vm.OpCode]uint64{
+		vm.ADD:    1,
+		vm.CREATE: 320000,
+		vm.PUSH0:  1,
+	}
+
+	testCases := []struct {
+		name     string
+		rules    params.Rules
+		expPush0 uint64
+	}{
+		{"cancun", params.Rules{IsCancun: true}, 1},
+		// PUSH0 is not defined before Shanghai, so its override is skipped
+		{"london", params.Rules{IsLondon: true}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jt, err := vm.LookupInstructionSet(tc.rules)
+			require.NoError(t, err)
+			defaultGas := jt[vm.MUL].GetConstantGas()
+
+			// enable the extra EIPs on the jump table like the interpreter does
+			for op, gas := range overrides {
+				for _, eip := range eips.OpcodeGasEIPs(op, gas) {
+					require.True(t, eips.IsOpcodeGasEIP(eip))
+					require.NoError(t, vm.EnableEIP(eip, &jt))
+				}
+			}
+
+			require.Equal(t, uint64(1), jt[vm.ADD].GetConstantGas())
+			require.Equal(t, uint64(320000), jt[vm.CREATE].GetConstantGas())
+			require.Equal(t, tc.expPush0, jt[vm.PUSH0].GetConstantGas())
+			// other opcodes are not modified
+			require.Equal(t, defaultGas, jt[vm.MUL].GetConstantGas())
+		})
+	}
+}
+
+func TestValidateOpcodeGasOverride(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rules       params.Rules
+		op          vm.OpCode
+		gas         uint64
+		errContains string
+	}{
+		{"valid", params.Rules{IsPrague: true}, vm.CREATE, 320000, ""},
+		{"no constant gas", params.Rules{IsPrague: true}, vm.STOP, 10, "has no constant gas"},
+		{"not defined in the fork", params.Rules{IsLondon: true}, vm.PUSH0, 1, "has no constant gas"},
+		{"above the multiplier range", params.Rules{IsPrague: true}, vm.ADD, 31, "out of the range [1, 30]"},
+		{"above the multiplier range of CREATE", params.Rules{IsPrague: true}, vm.CREATE, 320001, "out of the range [3200, 320000]"},
+		{"below the multiplier range", params.Rules{IsPrague: true}, vm.CREATE, 3199, "out of the range [3200, 320000]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jt, err := vm.LookupInstructionSet(tc.rules)
+			require.NoError(t, err)
+
+			err = eips.ValidateOpcodeGasOverride(&jt, tc.op, tc.gas)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestActivateableEIPs(t *testing.T) {
+	if !vm.ValidEip(1 << 24) {
+		require.NoError(t, vm.ExtendActivators(eips.OpcodeGasActivators()))
+	}
+
+	activateable := eips.ActivateableEIPs()
+	require.Contains(t, activateable, "3855")
+	require.Less(t, len(activateable), 100)
+	for _, eip := range activateable {
+		require.NotEqual(t, "16777216", eip)
+	}
+}
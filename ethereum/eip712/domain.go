@@ -17,3 +17,14 @@ func createEIP712Domain(chainID uint64) apitypes.TypedDataDomain {
 
 	return domain
 }
+
+// eip712DomainTypes returns the type definition of the typed data domain.
+func eip712DomainTypes() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "string"},
+		{Name: "salt", Type: "string"},
+	}
+}
@@ -23,6 +23,8 @@ import (
 
 type FeeDelegationOptions struct {
 	FeePayer sdk.AccAddress
+	// FeeGranter is the optional fee grant account paying the fees on behalf of the fee payer
+	FeeGranter sdk.AccAddress
 }
 
 const (
@@ -63,14 +65,28 @@ func LegacyWrapTxToTypedData(
 			return apitypes.TypedData{}, errorsmod.Wrap(errortypes.ErrInvalidType, "cannot parse fee from tx data")
 		}
 
+		// the fee payer and granter of the Amino fee are replaced by the delegation options
+		delete(feeInfo, "payer")
+		delete(feeInfo, "granter")
+
 		feeInfo["feePayer"] = feeDelegation.FeePayer.String()
 
 		// also patching msgTypes to include feePayer
-		msgTypes["Fee"] = []apitypes.Type{
+		feeTypes := []apitypes.Type{
 			{Name: "feePayer", Type: "string"},
-			{Name: "amount", Type: "Coin[]"},
-			{Name: "gas", Type: "string"},
 		}
+
+		// the granter is only included when set to keep the typed data of
+		// transactions without a fee grant unchanged
+		if !feeDelegation.FeeGranter.Empty() {
+			feeInfo["feeGranter"] = feeDelegation.FeeGranter.String()
+			feeTypes = append(feeTypes, apitypes.Type{Name: "feeGranter", Type: "string"})
+		}
+
+		msgTypes["Fee"] = append(feeTypes,
+			apitypes.Type{Name: "amount", Type: "Coin[]"},
+			apitypes.Type{Name: "gas", Type: "string"},
+		)
 	}
 
 	typedData := apitypes.TypedData{
@@ -161,8 +161,10 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 	signerInfo := authInfo.SignerInfos[0]
 
 	stdFee := &legacytx.StdFee{
-		Amount: authInfo.Fee.Amount,
-		Gas:    authInfo.Fee.GasLimit,
+		Amount:  authInfo.Fee.Amount,
+		Gas:     authInfo.Fee.GasLimit,
+		Payer:   authInfo.Fee.Payer,
+		Granter: authInfo.Fee.Granter,
 	}
 
 	// WrapTxToTypedData expects the payload as an Amino Sign Doc
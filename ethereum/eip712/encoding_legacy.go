@@ -95,6 +95,14 @@ func legacyDecodeAminoSignDoc(signDocBytes []byte, eip155ChainID uint64) (apityp
 		FeePayer: feePayer,
 	}
 
+	if fees.Granter != "" {
+		feeGranter, err := sdk.AccAddressFromBech32(fees.Granter)
+		if err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("invalid fee granter: %w", err)
+		}
+		feeDelegation.FeeGranter = feeGranter
+	}
+
 	typedData, err := LegacyWrapTxToTypedData(
 		protoCodec,
 		eip155ChainID,
@@ -174,6 +182,14 @@ func legacyDecodeProtobufSignDoc(signDocBytes []byte, eip155ChainID uint64) (api
 		FeePayer: feePayer,
 	}
 
+	if authInfo.Fee.Granter != "" {
+		feeGranter, err := sdk.AccAddressFromBech32(authInfo.Fee.Granter)
+		if err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("invalid fee granter: %w", err)
+		}
+		feeDelegation.FeeGranter = feeGranter
+	}
+
 	// WrapTxToTypedData expects the payload as an Amino Sign Doc
 	signBytes := legacytx.StdSignBytes(
 		signDoc.ChainId,
@@ -0,0 +1,289 @@
+package eip712
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// TxPrimaryType is the EIP-712 primary type of transactions signed
+// with the protobuf-typed encoding.
+const TxPrimaryType = txField
+
+// protoTxPayload defines the transaction fields that are signed with the
+// protobuf-typed EIP-712 encoding.
+type protoTxPayload struct {
+	accountNumber uint64
+	chainID       string
+	fee           txTypes.Fee
+	memo          string
+	msgs          []*codectypes.Any
+	sequence      uint64
+	timeoutHeight uint64
+
+	timeoutTimestamp *time.Time
+	unordered        bool
+}
+
+// aminoSignDocExtensions defines the fields of the Amino JSON sign doc that are
+// not part of legacytx.StdSignDoc.
+type aminoSignDocExtensions struct {
+	TimeoutTimestamp *time.Time `json:"timeout_timestamp"`
+	Unordered        bool       `json:"unordered"`
+}
+
+// GetProtoEIP712BytesForMsg returns the EIP-712 object bytes for the given SignDoc bytes
+// using the protobuf-typed encoding. See GetProtoEIP712TypedDataForMsg for more.
+func GetProtoEIP712BytesForMsg(signDocBytes []byte) ([]byte, error) {
+	typedData, err := GetProtoEIP712TypedDataForMsg(signDocBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("could not get EIP-712 object bytes: %w", err)
+	}
+
+	return []byte(rawData), nil
+}
+
+// GetProtoEIP712TypedDataForMsg returns the protobuf-typed EIP-712 TypedData representation
+// for either Amino or Protobuf encoded signature doc bytes. Unlike GetEIP712TypedDataForMsg,
+// the types are derived from the protobuf descriptors of the messages instead of their
+// flattened JSON, so the schema of each message is deterministic and nested Any fields are
+// typed with the packed message.
+func GetProtoEIP712TypedDataForMsg(signDocBytes []byte) (apitypes.TypedData, error) {
+	// Ensure codecs have been initialized
+	if err := validateCodecInit(); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	payload, errAmino := decodeAminoProtoPayload(signDocBytes)
+	if errAmino == nil {
+		return wrapProtoPayloadToTypedData(eip155ChainID, payload)
+	}
+
+	payload, errProtobuf := decodeProtobufProtoPayload(signDocBytes)
+	if errProtobuf == nil {
+		return wrapProtoPayloadToTypedData(eip155ChainID, payload)
+	}
+
+	return apitypes.TypedData{}, fmt.Errorf("could not decode sign doc as either Amino or Protobuf.\n amino: %v\n protobuf: %v", errAmino, errProtobuf)
+}
+
+// GetProtoEIP712Types returns the protobuf-typed EIP-712 types of a transaction carrying a
+// single message with the given type URL, along with the EIP-712 type name of the message.
+// Any fields of the message are typed as an unresolved google.protobuf.Any, since their
+// types depend on the packed message: these are replaced by the types returned for the
+// packed message type URL, prefixed with "Any".
+func GetProtoEIP712Types(typeURL string) (apitypes.Types, string, error) {
+	// Ensure codecs have been initialized
+	if err := validateCodecInit(); err != nil {
+		return nil, "", err
+	}
+
+	typedData, err := wrapProtoPayloadToTypedData(eip155ChainID, protoTxPayload{
+		msgs: []*codectypes.Any{{TypeUrl: typeURL}},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The message is the value of the Any set as the msg0 field of the transaction
+	anyType, err := protoFieldType(typedData.Types, txField, msgFieldForIndex(0))
+	if err != nil {
+		return nil, "", err
+	}
+	msgType, err := protoFieldType(typedData.Types, anyType, "value")
+	if err != nil {
+		return nil, "", err
+	}
+
+	return typedData.Types, msgType, nil
+}
+
+// protoFieldType returns the type of the named field of the given EIP-712 type.
+func protoFieldType(types apitypes.Types, typeName, fieldName string) (string, error) {
+	for _, field := range types[typeName] {
+		if field.Name == fieldName {
+			return field.Type, nil
+		}
+	}
+
+	return "", fmt.Errorf("missing field %s in EIP-712 type %s", fieldName, typeName)
+}
+
+// wrapProtoPayloadToTypedData returns the protobuf-typed EIP-712 TypedData for the given
+// transaction payload. Messages are set as the msg0...msgN fields of the transaction, each
+// typed as the Any wrapping it.
+func wrapProtoPayloadToTypedData(chainID uint64, payload protoTxPayload) (apitypes.TypedData, error) {
+	if len(payload.msgs) == 0 {
+		return apitypes.TypedData{}, errors.New("unable to build EIP-712 payload: transaction does contain any messages")
+	}
+
+	builder := newProtoTypesBuilder(protoCodec.InterfaceRegistry())
+
+	feeBz, err := payload.fee.Marshal()
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	feeType, feeValue, err := builder.decodeMessage(string(feeFullName), feeBz, 1)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	txTypesList := []apitypes.Type{
+		{Name: "account_number", Type: ethUint64},
+		{Name: "chain_id", Type: ethString},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: ethString},
+	}
+	message := apitypes.TypedDataMessage{
+		"account_number":    fmt.Sprint(payload.accountNumber),
+		"chain_id":          payload.chainID,
+		"fee":               feeValue,
+		"memo":              payload.memo,
+		"sequence":          fmt.Sprint(payload.sequence),
+		"timeout_height":    fmt.Sprint(payload.timeoutHeight),
+		"timeout_timestamp": "",
+		"unordered":         payload.unordered,
+	}
+	if payload.timeoutTimestamp != nil {
+		message["timeout_timestamp"] = payload.timeoutTimestamp.UTC().Format(time.RFC3339Nano)
+	}
+
+	for i, msg := range payload.msgs {
+		msgType, msgValue, err := builder.encodeAnyBytes(msg.TypeUrl, msg.Value, 0)
+		if err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("could not convert message %d to EIP712 representation: %w", i, err)
+		}
+
+		field := msgFieldForIndex(i)
+		txTypesList = append(txTypesList, apitypes.Type{Name: field, Type: msgType})
+		message[field] = msgValue
+	}
+
+	txTypesList = append(txTypesList,
+		apitypes.Type{Name: "sequence", Type: ethUint64},
+		apitypes.Type{Name: "timeout_height", Type: ethUint64},
+		apitypes.Type{Name: "timeout_timestamp", Type: ethString},
+		apitypes.Type{Name: "unordered", Type: ethBool},
+	)
+
+	types := builder.types
+	types["EIP712Domain"] = eip712DomainTypes()
+	types[txField] = txTypesList
+
+	return apitypes.TypedData{
+		Types:       types,
+		PrimaryType: TxPrimaryType,
+		Domain:      createEIP712Domain(chainID),
+		Message:     message,
+	}, nil
+}
+
+// decodeAminoProtoPayload attempts to decode the provided sign doc (bytes) as an Amino
+// payload and returns the transaction fields signed with the protobuf-typed encoding.
+func decodeAminoProtoPayload(signDocBytes []byte) (protoTxPayload, error) {
+	var aminoDoc legacytx.StdSignDoc
+	if err := aminoCodec.UnmarshalJSON(signDocBytes, &aminoDoc); err != nil {
+		return protoTxPayload{}, err
+	}
+
+	var fees legacytx.StdFee
+	if err := aminoCodec.UnmarshalJSON(aminoDoc.Fee, &fees); err != nil {
+		return protoTxPayload{}, err
+	}
+
+	// The timeout timestamp and unordered flag are dropped by legacytx.StdSignDoc
+	var extensions aminoSignDocExtensions
+	if err := json.Unmarshal(signDocBytes, &extensions); err != nil {
+		return protoTxPayload{}, err
+	}
+
+	msgs := make([]*codectypes.Any, len(aminoDoc.Msgs))
+	for i, jsonMsg := range aminoDoc.Msgs {
+		var m sdk.Msg
+		if err := aminoCodec.UnmarshalJSON(jsonMsg, &m); err != nil {
+			return protoTxPayload{}, fmt.Errorf("failed to unmarshal sign doc message: %w", err)
+		}
+
+		msgAny, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return protoTxPayload{}, err
+		}
+		msgs[i] = msgAny
+	}
+
+	return protoTxPayload{
+		accountNumber: aminoDoc.AccountNumber,
+		chainID:       aminoDoc.ChainID,
+		fee: txTypes.Fee{
+			Amount:   fees.Amount,
+			GasLimit: fees.Gas,
+			Payer:    fees.Payer,
+			Granter:  fees.Granter,
+		},
+		memo:          aminoDoc.Memo,
+		msgs:          msgs,
+		sequence:      aminoDoc.Sequence,
+		timeoutHeight: aminoDoc.TimeoutHeight,
+
+		timeoutTimestamp: extensions.TimeoutTimestamp,
+		unordered:        extensions.Unordered,
+	}, nil
+}
+
+// decodeProtobufProtoPayload attempts to decode the provided sign doc (bytes) as a Protobuf
+// payload and returns the transaction fields signed with the protobuf-typed encoding.
+func decodeProtobufProtoPayload(signDocBytes []byte) (protoTxPayload, error) {
+	signDoc := &txTypes.SignDoc{}
+	if err := signDoc.Unmarshal(signDocBytes); err != nil {
+		return protoTxPayload{}, err
+	}
+
+	authInfo := &txTypes.AuthInfo{}
+	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
+		return protoTxPayload{}, err
+	}
+
+	body := &txTypes.TxBody{}
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return protoTxPayload{}, err
+	}
+
+	if len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
+		return protoTxPayload{}, errors.New("body contains unsupported fields: ExtensionOptions or NonCriticalExtensionOptions")
+	}
+
+	if len(authInfo.SignerInfos) != 1 {
+		return protoTxPayload{}, fmt.Errorf("invalid number of signer infos provided, expected 1 got %v", len(authInfo.SignerInfos))
+	}
+
+	if authInfo.Fee == nil {
+		return protoTxPayload{}, errors.New("auth info does not contain a fee")
+	}
+
+	return protoTxPayload{
+		accountNumber: signDoc.AccountNumber,
+		chainID:       signDoc.ChainId,
+		fee:           *authInfo.Fee,
+		memo:          body.Memo,
+		msgs:          body.Messages,
+		sequence:      authInfo.SignerInfos[0].Sequence,
+		timeoutHeight: body.TimeoutHeight,
+
+		timeoutTimestamp: body.TimeoutTimestamp,
+		unordered:        body.Unordered,
+	}, nil
+}
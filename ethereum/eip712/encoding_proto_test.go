@@ -0,0 +1,301 @@
+package eip712_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zenanetwork/zena/crypto/ethsecp256k1"
+	"github.com/zenanetwork/zena/encoding"
+	"github.com/zenanetwork/zena/ethereum/eip712"
+	"github.com/zenanetwork/zena/testutil/constants"
+	utiltx "github.com/zenanetwork/zena/testutil/tx"
+
+	govv1api "cosmossdk.io/api/cosmos/gov/v1"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// protoSignDoc returns the SIGN_MODE_DIRECT sign doc bytes of a transaction with the given messages.
+func protoSignDoc(t *testing.T, timeoutHeight uint64, msgs ...sdk.Msg) []byte {
+	t.Helper()
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = msgAny
+	}
+
+	return protoSignDocForBody(t, txtypes.TxBody{Messages: anys, Memo: "memo", TimeoutHeight: timeoutHeight})
+}
+
+// protoSignDocForBody returns the SIGN_MODE_DIRECT sign doc bytes of a transaction with the given body.
+func protoSignDocForBody(t *testing.T, body txtypes.TxBody) []byte {
+	t.Helper()
+
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+
+	authInfo := txtypes.AuthInfo{
+		SignerInfos: []*txtypes.SignerInfo{{Sequence: 7}},
+		Fee: &txtypes.Fee{
+			Amount:   sdk.NewCoins(sdk.NewCoin(constants.ExampleAttoDenom, math.NewInt(2000))),
+			GasLimit: 200000,
+			Granter:  feePayerAddress,
+		},
+	}
+	authInfoBz, err := authInfo.Marshal()
+	require.NoError(t, err)
+
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+		ChainId:       constants.ExampleChainID.ChainID,
+		AccountNumber: 3,
+	}
+	bz, err := signDoc.Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+func TestGetProtoEIP712TypedDataForMsg(t *testing.T) {
+	encoding.MakeConfig(chainID)
+
+	from := utiltx.GenerateAddress().Bytes()
+	to := utiltx.GenerateAddress().Bytes()
+	coins := sdk.NewCoins(sdk.NewCoin(constants.ExampleAttoDenom, math.NewInt(1)))
+
+	send := banktypes.NewMsgSend(from, to, coins)
+	vote := govtypesv1.NewMsgVote(from, 5, govtypesv1.OptionYes, "")
+	exec := authz.NewMsgExec(from, []sdk.Msg{send})
+	mixedExec := authz.NewMsgExec(from, []sdk.Msg{send, vote})
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr string
+	}{
+		{
+			name: "pass - messages of different types and nested Any",
+			msgs: []sdk.Msg{send, vote, &exec},
+		},
+		{
+			name:   "fail - repeated Any packing different types",
+			msgs:   []sdk.Msg{&mixedExec},
+			expErr: "repeated field elements have different types",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signDoc := protoSignDoc(t, 100, tc.msgs...)
+
+			typedData, err := eip712.GetProtoEIP712TypedDataForMsg(signDoc)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, eip712.TxPrimaryType, typedData.PrimaryType)
+			require.Equal(t, "100", typedData.Message["timeout_height"])
+			require.Equal(t, "7", typedData.Message["sequence"])
+			require.Equal(t, "", typedData.Message["timeout_timestamp"])
+			require.Equal(t, false, typedData.Message["unordered"])
+
+			fee := typedData.Message["fee"].(map[string]interface{})
+			require.Equal(t, feePayerAddress, fee["granter"])
+			require.Equal(t, "200000", fee["gas_limit"])
+
+			require.Contains(t, typedData.Types[eip712.TxPrimaryType], apitypes.Type{Name: "msg0", Type: "AnyCosmosBankV1beta1MsgSend"})
+			require.Contains(t, typedData.Types[eip712.TxPrimaryType], apitypes.Type{Name: "msg1", Type: "AnyCosmosGovV1MsgVote"})
+			require.Contains(t, typedData.Types[eip712.TxPrimaryType], apitypes.Type{Name: "msg2", Type: "AnyCosmosAuthzV1beta1MsgExec"})
+			require.Contains(t, typedData.Types["CosmosAuthzV1beta1MsgExec"], apitypes.Type{Name: "msgs", Type: "AnyCosmosBankV1beta1MsgSend[]"})
+
+			// Enums are rendered with their value name
+			voteValue := typedData.Message["msg1"].(map[string]interface{})["value"].(map[string]interface{})
+			require.Equal(t, "VOTE_OPTION_YES", voteValue["option"])
+			require.Equal(t, "5", voteValue["proposal_id"])
+
+			// The payload is signable and verifies against the original sign doc
+			privKey, err := ethsecp256k1.GenerateKey()
+			require.NoError(t, err)
+
+			eip712Bytes, err := eip712.GetProtoEIP712BytesForMsg(signDoc)
+			require.NoError(t, err)
+
+			sig, err := privKey.Sign(eip712Bytes)
+			require.NoError(t, err)
+			require.True(t, privKey.PubKey().VerifySignature(signDoc, sig))
+
+			// The flattened encoding rejects protobuf sign docs with a timeout height
+			_, err = eip712.GetEIP712TypedDataForMsg(signDoc)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGetProtoEIP712TypedDataTimeoutTimestampAndUnordered(t *testing.T) {
+	encoding.MakeConfig(chainID)
+
+	send := banktypes.NewMsgSend(
+		utiltx.GenerateAddress().Bytes(),
+		utiltx.GenerateAddress().Bytes(),
+		sdk.NewCoins(sdk.NewCoin(constants.ExampleAttoDenom, math.NewInt(1))),
+	)
+	sendAny, err := codectypes.NewAnyWithValue(send)
+	require.NoError(t, err)
+
+	timeoutTimestamp := time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC)
+	body := txtypes.TxBody{Messages: []*codectypes.Any{sendAny}, TimeoutTimestamp: &timeoutTimestamp, Unordered: true}
+
+	typedData, err := eip712.GetProtoEIP712TypedDataForMsg(protoSignDocForBody(t, body))
+	require.NoError(t, err)
+	require.Contains(t, typedData.Types[eip712.TxPrimaryType], apitypes.Type{Name: "timeout_timestamp", Type: "string"})
+	require.Contains(t, typedData.Types[eip712.TxPrimaryType], apitypes.Type{Name: "unordered", Type: "bool"})
+	require.Equal(t, "2026-01-02T03:04:05.0000006Z", typedData.Message["timeout_timestamp"])
+	require.Equal(t, true, typedData.Message["unordered"])
+
+	// Both fields are signed, so they cannot be changed once the payload is signed
+	signedBytes, err := eip712.GetProtoEIP712BytesForMsg(protoSignDocForBody(t, body))
+	require.NoError(t, err)
+
+	for _, tamper := range []func(*txtypes.TxBody){
+		func(b *txtypes.TxBody) { b.Unordered = false },
+		func(b *txtypes.TxBody) { b.TimeoutTimestamp = nil },
+		func(b *txtypes.TxBody) {
+			later := timeoutTimestamp.Add(time.Hour)
+			b.TimeoutTimestamp = &later
+		},
+	} {
+		tampered := body
+		tamper(&tampered)
+
+		tamperedBytes, err := eip712.GetProtoEIP712BytesForMsg(protoSignDocForBody(t, tampered))
+		require.NoError(t, err)
+		require.NotEqual(t, signedBytes, tamperedBytes)
+	}
+}
+
+func TestGetProtoEIP712TypedDataDuration(t *testing.T) {
+	encoding.MakeConfig(chainID)
+
+	testCases := []struct {
+		name        string
+		duration    *durationpb.Duration
+		expDuration string
+		expErr      string
+	}{
+		{
+			name:        "pass - seconds and nanos",
+			duration:    &durationpb.Duration{Seconds: 1, Nanos: 500_000_000},
+			expDuration: "1.5s",
+		},
+		{
+			name:        "pass - negative duration",
+			duration:    &durationpb.Duration{Seconds: -1, Nanos: -5},
+			expDuration: "-1.000000005s",
+		},
+		{
+			name:        "pass - duration out of the range of time.Duration",
+			duration:    &durationpb.Duration{Seconds: 315_576_000_000},
+			expDuration: "315576000000s",
+		},
+		{
+			name:     "fail - seconds out of the range of a Duration",
+			duration: &durationpb.Duration{Seconds: 315_576_000_001},
+			expErr:   "invalid duration",
+		},
+		{
+			name:     "fail - seconds and nanos with different signs",
+			duration: &durationpb.Duration{Seconds: 1, Nanos: -1},
+			expErr:   "invalid duration",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &govv1api.MsgUpdateParams{
+				Authority: feePayerAddress,
+				Params:    &govv1api.Params{MaxDepositPeriod: tc.duration},
+			}
+			value, err := protov2.Marshal(msg)
+			require.NoError(t, err)
+
+			body := txtypes.TxBody{Messages: []*codectypes.Any{{TypeUrl: "/cosmos.gov.v1.MsgUpdateParams", Value: value}}}
+
+			typedData, err := eip712.GetProtoEIP712TypedDataForMsg(protoSignDocForBody(t, body))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			msgValue := typedData.Message["msg0"].(map[string]interface{})["value"].(map[string]interface{})
+			params := msgValue["params"].(map[string]interface{})
+			require.Equal(t, tc.expDuration, params["max_deposit_period"])
+		})
+	}
+}
+
+func TestGetProtoEIP712Types(t *testing.T) {
+	encoding.MakeConfig(chainID)
+
+	testCases := []struct {
+		name       string
+		typeURL    string
+		expMsgType string
+		expField   apitypes.Type
+		expErr     string
+	}{
+		{
+			name:       "pass - MsgSend",
+			typeURL:    "/cosmos.bank.v1beta1.MsgSend",
+			expMsgType: "CosmosBankV1beta1MsgSend",
+			expField:   apitypes.Type{Name: "amount", Type: "CosmosBaseV1beta1Coin[]"},
+		},
+		{
+			name:       "pass - MsgExec with unresolved Any",
+			typeURL:    "/cosmos.authz.v1beta1.MsgExec",
+			expMsgType: "CosmosAuthzV1beta1MsgExec",
+			expField:   apitypes.Type{Name: "msgs", Type: "GoogleProtobufAny[]"},
+		},
+		{
+			name:    "fail - unknown type URL",
+			typeURL: "/cosmos.bank.v1beta1.MsgUnknown",
+			expErr:  "unable to resolve type URL",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			types, msgType, err := eip712.GetProtoEIP712Types(tc.typeURL)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expMsgType, msgType)
+			require.Contains(t, types[msgType], tc.expField)
+			require.Contains(t, types[eip712.TxPrimaryType], apitypes.Type{Name: "msg0", Type: "Any" + msgType})
+
+			// The schema can be used by wallets to build typed data
+			_, err = json.Marshal(types)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -13,7 +13,9 @@ import (
 )
 
 // PreprocessLedgerTx reformats Ledger-signed Cosmos transactions to match the fork expected by Cosmos EVM
-// by including the signature in a Web3Tx extension and sending a blank signature in the body.
+// by including the signature in a Web3Tx extension and sending a blank signature in the body. For multisig
+// transactions, the signatures of the members are kept in the multisig signature data and the Web3Tx
+// extension only carries the fee payer and chain ID. The fee granter is kept in the transaction fee.
 func PreprocessLedgerTx(evmChainID uint64, keyType cosmoskr.KeyType, txBuilder client.TxBuilder) error {
 	// Only process Ledger transactions
 	if keyType != cosmoskr.TypeLedger {
@@ -38,11 +40,44 @@ func PreprocessLedgerTx(evmChainID uint64, keyType cosmoskr.KeyType, txBuilder c
 	}
 
 	signature := sigs[0]
-	sigData, ok := signature.Data.(*signing.SingleSignatureData)
-	if !ok {
-		return fmt.Errorf("unexpected signature type, expected SingleSignatureData")
+
+	var (
+		sigBytes []byte
+		sigData  signing.SignatureData
+	)
+
+	switch data := signature.Data.(type) {
+	case *signing.SingleSignatureData:
+		// The signature is moved to the extension and a blank signature with Amino Sign Type is set
+		// (Regardless of input signMode, Cosmos EVM requires Amino signature type for Ledger)
+		sigBytes = data.Signature
+		sigData = &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: nil,
+		}
+	case *signing.MultiSignatureData:
+		// The signatures of the multisig members are kept in the multisig signature data
+		// with Amino Sign Type, and the extension carries no signature
+		memberSigs := make([]signing.SignatureData, len(data.Signatures))
+		for i, memberSig := range data.Signatures {
+			memberData, ok := memberSig.(*signing.SingleSignatureData)
+			if !ok {
+				return fmt.Errorf("unexpected multisig signature type at index %d, expected SingleSignatureData", i)
+			}
+
+			memberSigs[i] = &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: memberData.Signature,
+			}
+		}
+
+		sigData = &signing.MultiSignatureData{
+			BitArray:   data.BitArray,
+			Signatures: memberSigs,
+		}
+	default:
+		return fmt.Errorf("unexpected signature type, expected SingleSignatureData or MultiSignatureData")
 	}
-	sigBytes := sigData.Signature
 
 	addrCodec := address.Bech32Codec{
 		Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
@@ -64,15 +99,9 @@ func PreprocessLedgerTx(evmChainID uint64, keyType cosmoskr.KeyType, txBuilder c
 
 	extensionBuilder.SetExtensionOptions(option)
 
-	// Set blank signature with Amino Sign Type
-	// (Regardless of input signMode, Cosmos EVM requires Amino signature type for Ledger)
-	blankSig := signing.SingleSignatureData{
-		SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
-		Signature: nil,
-	}
 	sig := signing.SignatureV2{
 		PubKey:   signature.PubKey,
-		Data:     &blankSig,
+		Data:     sigData,
 		Sequence: signature.Sequence,
 	}
 
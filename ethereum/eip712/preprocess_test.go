@@ -20,6 +20,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -130,6 +133,79 @@ func TestLedgerPreprocessing(t *testing.T) {
 	}
 }
 
+func TestLedgerPreprocessingMultisig(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount(constants.ExampleBech32Prefix, "")
+	ctx := getTestContext()
+	txBuilder := ctx.TxConfig.NewTxBuilder()
+
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range pubKeys {
+		_, privKey := utiltx.NewAddrKey()
+		pubKeys[i] = privKey.PubKey()
+	}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	feePayer := sdk.AccAddress(multisigPubKey.Address())
+	feeGranter := sdk.AccAddress(pubKeys[2].Address())
+	txBuilder.SetFeePayer(feePayer)
+	txBuilder.SetFeeGranter(feeGranter)
+
+	// Create signatures unrelated to payload for testing
+	signatureBytes, err := hex.DecodeString(strings.Repeat("01", 65))
+	require.NoError(t, err)
+
+	sigData := multisig.NewMultisig(len(pubKeys))
+	for _, i := range []int{0, 2} {
+		sigData.BitArray.SetIndex(i, true)
+		sigData.Signatures = append(sigData.Signatures, &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: signatureBytes,
+		})
+	}
+
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     sigData,
+		Sequence: 3,
+	})
+	require.NoError(t, err)
+
+	err = eip712.PreprocessLedgerTx(chainID, keyring.TypeLedger, txBuilder)
+	require.NoError(t, err)
+
+	// Verify Web3 extension carries the fee payer without signature
+	hasExtOptsTx, ok := txBuilder.(ante.HasExtensionOptionsTx)
+	require.True(t, ok)
+	require.Len(t, hasExtOptsTx.GetExtensionOptions(), 1)
+
+	expectedExtAny, err := codectypes.NewAnyWithValue(&eip712.ExtensionOptionsWeb3Tx{
+		TypedDataChainID: chainID,
+		FeePayer:         feePayer.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedExtAny, hasExtOptsTx.GetExtensionOptions()[0])
+
+	// Verify the multisig signatures are kept with Amino sign mode
+	signatures, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, signatures, 1)
+	require.Equal(t, uint64(3), signatures[0].Sequence)
+
+	txSigData, ok := signatures[0].Data.(*signing.MultiSignatureData)
+	require.True(t, ok)
+	require.Equal(t, sigData.BitArray, txSigData.BitArray)
+	require.Len(t, txSigData.Signatures, 2)
+	for _, memberSig := range txSigData.Signatures {
+		memberData, ok := memberSig.(*signing.SingleSignatureData)
+		require.True(t, ok)
+		require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, memberData.SignMode)
+		require.Equal(t, signatureBytes, memberData.Signature)
+	}
+
+	// Verify the fee granter is unchanged
+	require.Equal(t, feeGranter.Bytes(), txBuilder.GetTx().FeeGranter())
+}
+
 func TestBlankTxBuilder(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForAccount(constants.ExampleBech32Prefix, "")
 	ctx := getTestContext()
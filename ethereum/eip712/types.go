@@ -27,6 +27,11 @@ const (
 
 	msgTypeField = "type"
 
+	feeType         = "Fee"
+	feeField        = "fee"
+	feePayerField   = "payer"
+	feeGranterField = "granter"
+
 	maxDuplicateTypeDefs = 1000
 )
 
@@ -34,28 +39,7 @@ const (
 // for the given message payload.
 func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, error) {
 	eip712Types := apitypes.Types{
-		"EIP712Domain": {
-			{
-				Name: "name",
-				Type: "string",
-			},
-			{
-				Name: "version",
-				Type: "string",
-			},
-			{
-				Name: "chainId",
-				Type: "uint256",
-			},
-			{
-				Name: "verifyingContract",
-				Type: "string",
-			},
-			{
-				Name: "salt",
-				Type: "string",
-			},
-		},
+		"EIP712Domain": eip712DomainTypes(),
 		"Tx": {
 			{Name: "account_number", Type: "string"},
 			{Name: "chain_id", Type: "string"},
@@ -74,6 +58,8 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 		},
 	}
 
+	addFeeDelegationTypes(eip712Types, messagePayload.payload.Get(feeField))
+
 	for i := 0; i < messagePayload.numPayloadMsgs; i++ {
 		field := msgFieldForIndex(i)
 		msg := messagePayload.payload.Get(field)
@@ -86,6 +72,17 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 	return eip712Types, nil
 }
 
+// addFeeDelegationTypes adds the fee payer and granter to the Fee type when
+// they are set on the fee, so that they are covered by the signature. They are
+// omitted otherwise to keep the typed data of other transactions unchanged.
+func addFeeDelegationTypes(eip712Types apitypes.Types, fee gjson.Result) {
+	for _, field := range []string{feePayerField, feeGranterField} {
+		if fee.Get(field).Exists() {
+			eip712Types[feeType] = append(eip712Types[feeType], apitypes.Type{Name: field, Type: ethString})
+		}
+	}
+}
+
 // addMsgTypesToRoot adds all types for the given message
 // to eip712Types, recursively handling object sub-fields.
 func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Result) (err error) {
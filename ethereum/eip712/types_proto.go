@@ -0,0 +1,418 @@
+package eip712
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	ethBytes  = "bytes"
+	ethInt32  = "int32"
+	ethUint32 = "uint32"
+	ethUint64 = "uint64"
+
+	// anyTypePrefix is prepended to the type name of the packed message
+	// to define the EIP-712 type of a resolved google.protobuf.Any.
+	anyTypePrefix = "Any"
+
+	// maxProtoDepth is the maximum nesting depth of messages rendered
+	// into EIP-712 typed data. It bounds recursive message definitions.
+	maxProtoDepth = 32
+
+	// maxDurationSeconds is the bound of the seconds of a google.protobuf.Duration,
+	// about 10,000 years.
+	maxDurationSeconds = 315_576_000_000
+
+	anyFullName       protoreflect.FullName = "google.protobuf.Any"
+	timestampFullName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationFullName  protoreflect.FullName = "google.protobuf.Duration"
+	feeFullName       protoreflect.FullName = "cosmos.tx.v1beta1.Fee"
+)
+
+// protoTypesBuilder derives EIP-712 types and values from protobuf messages,
+// using the message descriptors rather than their JSON representation.
+//
+// Fields are rendered as follows:
+//   - every field is always present, unset fields use their default value
+//   - 32 and 64-bit integers use the matching (u)int type with a decimal string value
+//   - floating point numbers, enums (value name), timestamps (RFC 3339) and durations are strings
+//   - bytes are hex encoded
+//   - maps are arrays of key/value entries sorted by key
+//   - google.protobuf.Any values are structs of the packed type URL and the decoded packed message
+type protoTypesBuilder struct {
+	resolver protodesc.Resolver
+	types    apitypes.Types
+}
+
+// newProtoTypesBuilder returns a builder resolving descriptors with the given resolver.
+func newProtoTypesBuilder(resolver protodesc.Resolver) *protoTypesBuilder {
+	return &protoTypesBuilder{
+		resolver: resolver,
+		types:    apitypes.Types{},
+	}
+}
+
+// encodeAnyBytes decodes the message packed with the given type URL and returns
+// the EIP-712 type of the Any wrapping it, along with its value.
+func (b *protoTypesBuilder) encodeAnyBytes(typeURL string, value []byte, depth int) (string, map[string]interface{}, error) {
+	if typeURL == "" {
+		return b.encodeUnresolvedAny(typeURL, value)
+	}
+
+	packedType, packedValue, err := b.decodeMessage(typeURL, value, depth+1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	anyType, err := b.addType(anyTypePrefix+packedType, []apitypes.Type{
+		{Name: "type_url", Type: ethString},
+		{Name: "value", Type: packedType},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return anyType, map[string]interface{}{
+		"type_url": typeURL,
+		"value":    packedValue,
+	}, nil
+}
+
+// decodeMessage decodes the protobuf bytes of the message with the given type URL
+// and returns its EIP-712 type along with its value.
+func (b *protoTypesBuilder) decodeMessage(typeURL string, value []byte, depth int) (string, map[string]interface{}, error) {
+	msg, err := b.newMessage(typeURL)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := protov2.Unmarshal(value, msg); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal %s: %w", typeURL, err)
+	}
+
+	return b.encodeMessage(msg, depth)
+}
+
+// encodeUnresolvedAny returns the EIP-712 type and value of an Any that carries
+// no type URL, whose value is kept as raw bytes.
+func (b *protoTypesBuilder) encodeUnresolvedAny(typeURL string, value []byte) (string, map[string]interface{}, error) {
+	anyType, err := b.addType(sanitizeTypedef(string(anyFullName)), []apitypes.Type{
+		{Name: "type_url", Type: ethString},
+		{Name: "value", Type: ethBytes},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return anyType, map[string]interface{}{
+		"type_url": typeURL,
+		"value":    hexutil.Encode(value),
+	}, nil
+}
+
+// newMessage returns an empty dynamic message for the given type URL.
+func (b *protoTypesBuilder) newMessage(typeURL string) (*dynamicpb.Message, error) {
+	name := typeURL
+	if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+		name = typeURL[i+1:]
+	}
+
+	desc, err := b.resolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve type URL %s: %w", typeURL, err)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("type URL %s does not reference a message", typeURL)
+	}
+
+	return dynamicpb.NewMessage(md), nil
+}
+
+// encodeMessage adds the EIP-712 type of the given message, and all the types it
+// references, to the builder and returns the type name along with the message value.
+func (b *protoTypesBuilder) encodeMessage(msg protoreflect.Message, depth int) (string, map[string]interface{}, error) {
+	if depth > maxProtoDepth {
+		return "", nil, fmt.Errorf("maximum message depth %d exceeded", maxProtoDepth)
+	}
+
+	md := msg.Descriptor()
+	fieldDescs := md.Fields()
+
+	fields := make([]apitypes.Type, 0, fieldDescs.Len())
+	value := make(map[string]interface{}, fieldDescs.Len())
+
+	for i := 0; i < fieldDescs.Len(); i++ {
+		fd := fieldDescs.Get(i)
+
+		fieldType, fieldValue, err := b.encodeField(msg, fd, depth)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s.%s: %w", md.FullName(), fd.Name(), err)
+		}
+
+		name := string(fd.Name())
+		fields = append(fields, apitypes.Type{Name: name, Type: fieldType})
+		value[name] = fieldValue
+	}
+
+	typeName, err := b.addType(sanitizeTypedef(string(md.FullName())), fields)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return typeName, value, nil
+}
+
+// encodeField returns the EIP-712 type and value of the given message field.
+func (b *protoTypesBuilder) encodeField(msg protoreflect.Message, fd protoreflect.FieldDescriptor, depth int) (string, interface{}, error) {
+	switch {
+	case fd.IsMap():
+		return b.encodeMap(fd, msg.Get(fd).Map(), depth)
+	case fd.IsList():
+		return b.encodeList(fd, msg.Get(fd).List(), depth)
+	default:
+		return b.encodeValue(fd, msg.Get(fd), depth)
+	}
+}
+
+// encodeList returns the EIP-712 array type and value of a repeated field. Since EIP-712
+// arrays are homogeneous, repeated Any fields must pack a single message type.
+func (b *protoTypesBuilder) encodeList(fd protoreflect.FieldDescriptor, list protoreflect.List, depth int) (string, interface{}, error) {
+	values := make([]interface{}, 0, list.Len())
+
+	if list.Len() == 0 {
+		// Render the default element to define the type of the empty array
+		elemType, _, err := b.encodeValue(fd, defaultValue(fd), depth)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return elemType + "[]", values, nil
+	}
+
+	var elemType string
+	for i := 0; i < list.Len(); i++ {
+		t, v, err := b.encodeValue(fd, list.Get(i), depth)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if i == 0 {
+			elemType = t
+		} else if t != elemType {
+			return "", nil, fmt.Errorf("repeated field elements have different types %s and %s", elemType, t)
+		}
+
+		values = append(values, v)
+	}
+
+	return elemType + "[]", values, nil
+}
+
+// defaultValue returns the default value of a single element of the given field.
+func defaultValue(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(false)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(0)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(0)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(0)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString("")
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(nil)
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(fd.Enum().Values().Get(0).Number())
+	default:
+		return protoreflect.ValueOfMessage(dynamicpb.NewMessage(fd.Message()))
+	}
+}
+
+// encodeMap returns the EIP-712 type and value of a map field as an array of
+// key/value entries sorted by key.
+func (b *protoTypesBuilder) encodeMap(fd protoreflect.FieldDescriptor, m protoreflect.Map, depth int) (string, interface{}, error) {
+	keyDesc, valueDesc := fd.MapKey(), fd.MapValue()
+
+	keyType, _, err := b.encodeValue(keyDesc, defaultValue(keyDesc), depth)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	var valueType string
+	values := make([]interface{}, 0, len(keys))
+
+	if len(keys) == 0 {
+		valueType, _, err = b.encodeValue(valueDesc, defaultValue(valueDesc), depth)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	for i, k := range keys {
+		t, v, err := b.encodeValue(valueDesc, m.Get(k), depth)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if i == 0 {
+			valueType = t
+		} else if t != valueType {
+			return "", nil, fmt.Errorf("map values have different types %s and %s", valueType, t)
+		}
+
+		_, keyValue, err := b.encodeValue(keyDesc, k.Value(), depth)
+		if err != nil {
+			return "", nil, err
+		}
+
+		values = append(values, map[string]interface{}{
+			"key":   keyValue,
+			"value": v,
+		})
+	}
+
+	entryType, err := b.addType(sanitizeTypedef(string(fd.Message().FullName())), []apitypes.Type{
+		{Name: "key", Type: keyType},
+		{Name: "value", Type: valueType},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return entryType + "[]", values, nil
+}
+
+// encodeValue returns the EIP-712 type and value of a singular field value.
+func (b *protoTypesBuilder) encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, depth int) (string, interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return ethBool, v.Bool(), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return ethInt32, strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return ethInt64, strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return ethUint32, strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return ethUint64, strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.FloatKind:
+		return ethString, strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+	case protoreflect.DoubleKind:
+		return ethString, strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case protoreflect.StringKind:
+		return ethString, v.String(), nil
+	case protoreflect.BytesKind:
+		return ethBytes, hexutil.Encode(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return ethString, string(ev.Name()), nil
+		}
+		return ethString, strconv.FormatInt(int64(v.Enum()), 10), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.encodeMessageValue(v.Message(), depth+1)
+	default:
+		return "", nil, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// encodeMessageValue returns the EIP-712 type and value of a message field value,
+// rendering well-known types in their human readable form.
+func (b *protoTypesBuilder) encodeMessageValue(msg protoreflect.Message, depth int) (string, interface{}, error) {
+	md := msg.Descriptor()
+	fields := md.Fields()
+
+	switch md.FullName() {
+	case anyFullName:
+		typeURL := msg.Get(fields.ByName("type_url")).String()
+		value := msg.Get(fields.ByName("value")).Bytes()
+		return b.encodeAnyBytes(typeURL, value, depth)
+	case timestampFullName:
+		if !msg.IsValid() {
+			return ethString, "", nil
+		}
+		seconds := msg.Get(fields.ByName("seconds")).Int()
+		nanos := msg.Get(fields.ByName("nanos")).Int()
+		return ethString, time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano), nil
+	case durationFullName:
+		if !msg.IsValid() {
+			return ethString, "", nil
+		}
+		seconds := msg.Get(fields.ByName("seconds")).Int()
+		nanos := msg.Get(fields.ByName("nanos")).Int()
+		duration, err := formatDuration(seconds, nanos)
+		if err != nil {
+			return "", nil, err
+		}
+		return ethString, duration, nil
+	default:
+		return b.encodeMessage(msg, depth)
+	}
+}
+
+// addType adds the type definition under the given name and returns the name. Since the
+// types of Any fields depend on the packed messages, a message type can have several
+// definitions within the same payload, in which case an index is appended to the name.
+func (b *protoTypesBuilder) addType(name string, fields []apitypes.Type) (string, error) {
+	for i := 0; i < maxDuplicateTypeDefs; i++ {
+		typeName := name
+		if i > 0 {
+			typeName = typeDefWithIndex(name, i)
+		}
+
+		existing, found := b.types[typeName]
+		if !found {
+			b.types[typeName] = fields
+			return typeName, nil
+		}
+
+		if typesAreEqual(existing, fields) {
+			return typeName, nil
+		}
+	}
+
+	return "", errors.New("exceeded maximum number of duplicates for a single type definition")
+}
+
+// formatDuration renders a google.protobuf.Duration from its seconds and nanos, as in
+// its JSON mapping (e.g. "-1.500s"). The values are rendered directly since the
+// range of a Duration exceeds the one of time.Duration.
+func formatDuration(seconds, nanos int64) (string, error) {
+	if seconds < -maxDurationSeconds || seconds > maxDurationSeconds ||
+		nanos <= -1e9 || nanos >= 1e9 || (seconds < 0 && nanos > 0) || (seconds > 0 && nanos < 0) {
+		return "", fmt.Errorf("invalid duration: %d seconds and %d nanos", seconds, nanos)
+	}
+
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign, seconds, nanos = "-", -seconds, -nanos
+	}
+	if nanos == 0 {
+		return fmt.Sprintf("%s%ds", sign, seconds), nil
+	}
+	return fmt.Sprintf("%s%d.%ss", sign, seconds, strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")), nil
+}
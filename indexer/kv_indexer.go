@@ -23,8 +23,12 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixReceipt    = 3
+	KeyPrefixBlockBloom = 4
+	KeyPrefixLogAddress = 5
+	KeyPrefixLogTopic   = 6
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -50,11 +54,37 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
-	height := block.Height
-
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
+	err := forEachEthTx(kv.logger, kv.clientCtx.TxConfig.TxDecoder(), block, txResults, func(
+		ethMsg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult, _ *abci.ExecTxResult,
+	) error {
+		return saveTxResult(kv.clientCtx.Codec, batch, ethMsg.Hash(), txResult)
+	})
+	if err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// ethTxHandler is invoked for every eth tx message of a block together with its TxResult.
+type ethTxHandler func(ethMsg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult, result *abci.ExecTxResult) error
+
+// forEachEthTx builds the TxResult of every eth tx message in the block from the
+// parsed events and passes it to the handler.
+func forEachEthTx(
+	logger log.Logger,
+	txDecoder sdk.TxDecoder,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+	handler ethTxHandler,
+) error {
+	height := block.Height
+
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -63,9 +93,9 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			continue
 		}
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := txDecoder(tx)
 		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -75,14 +105,13 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 		txs, err := rpctypes.ParseTxResult(result, tx)
 		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
 		var cumulativeGasUsed uint64
 		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
 
 			txResult := servertypes.TxResult{
 				Height:     height,
@@ -98,11 +127,11 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			} else {
 				parsedTx := txs.GetTxByMsgIndex(msgIndex)
 				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
 					continue
 				}
 				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
@@ -112,14 +141,11 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+			if err := handler(ethMsg, &txResult, result); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
 	return nil
 }
 
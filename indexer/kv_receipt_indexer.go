@@ -0,0 +1,475 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/zenanetwork/zena/rpc/types"
+	servertypes "github.com/zenanetwork/zena/server/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// BlockBloomKeyLength is the length of block-bloom key
+	BlockBloomKeyLength = 1 + 8
+	// logPositionLength is the length of the (block number, tx index, log index) suffix of the log keys
+	logPositionLength = 8 + 8 + 8
+)
+
+var _ servertypes.EVMReceiptIndexer = &KVReceiptIndexer{}
+
+// KVReceiptIndexer implements an eth tx indexer on a KV db which, on top of the
+// KVIndexer entries, stores the receipts, the block blooms and the logs keyed by
+// address and topic.
+type KVReceiptIndexer struct {
+	*KVIndexer
+}
+
+// NewKVReceiptIndexer creates the KVReceiptIndexer
+func NewKVReceiptIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVReceiptIndexer {
+	return &KVReceiptIndexer{NewKVIndexer(db, logger, clientCtx)}
+}
+
+// IndexBlock indexes the block without the finalize block events, the effective
+// gas price of the receipts falls back to the fee cap of the txs.
+func (kv *KVReceiptIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	return kv.IndexBlockResults(block, txResults, nil)
+}
+
+// IndexBlockResults indexes all the eth txs in a block like KVIndexer.IndexBlock
+// and additionally stores for the block:
+// - the receipt of every eth tx, keyed by tx hash
+// - the position of every log, keyed by emitting address and by topic
+// - the block bloom, which is stored for every block and marks it as indexed
+func (kv *KVReceiptIndexer) IndexBlockResults(block *cmttypes.Block, txResults []*abci.ExecTxResult, finalizeBlockEvents []abci.Event) error {
+	height := block.Height
+	blockHash := common.BytesToHash(block.Hash())
+	baseFee := baseFeeFromEvents(finalizeBlockEvents)
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	var blockLogs []*ethtypes.Log
+	err := forEachEthTx(kv.logger, kv.clientCtx.TxConfig.TxDecoder(), block, txResults, func(
+		ethMsg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult, result *abci.ExecTxResult,
+	) error {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, ethMsg.Hash(), txResult); err != nil {
+			return err
+		}
+		rcpt, err := newIndexedReceipt(blockHash, baseFee, ethMsg, txResult, result)
+		if err != nil {
+			return err
+		}
+		blockLogs = append(blockLogs, rcpt.Receipt.Logs...)
+		return saveReceipt(batch, rcpt, txResult)
+	})
+	if err != nil {
+		return err
+	}
+
+	bloom := ethtypes.CreateBloom(&ethtypes.Receipt{Logs: blockLogs})
+	if err := batch.Set(BlockBloomKey(height), bloom.Bytes()); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set block-bloom key", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (kv *KVReceiptIndexer) LastIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromBloomKey(it.Key())
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (kv *KVReceiptIndexer) FirstIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromBloomKey(it.Key())
+}
+
+// GetReceiptByTxHash finds the receipt by eth tx hash, returns nil if not found
+func (kv *KVReceiptIndexer) GetReceiptByTxHash(hash common.Hash) (*servertypes.IndexedReceipt, error) {
+	bz, err := kv.db.Get(ReceiptKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rcpt servertypes.IndexedReceipt
+	if err := json.Unmarshal(bz, &rcpt); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptByTxHash %s", hash.Hex())
+	}
+	return &rcpt, nil
+}
+
+// GetBlockBloom returns the bloom of the block, returns false if the block is not indexed
+func (kv *KVReceiptIndexer) GetBlockBloom(height int64) (ethtypes.Bloom, bool, error) {
+	bz, err := kv.db.Get(BlockBloomKey(height))
+	if err != nil {
+		return ethtypes.Bloom{}, false, errorsmod.Wrapf(err, "GetBlockBloom %d", height)
+	}
+	if bz == nil {
+		return ethtypes.Bloom{}, false, nil
+	}
+	return ethtypes.BytesToBloom(bz), true, nil
+}
+
+// GetLogsByHeight returns the logs of every eth tx in the block, returns false
+// if the block is not indexed
+func (kv *KVReceiptIndexer) GetLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error) {
+	if _, found, err := kv.GetBlockBloom(height); err != nil || !found {
+		return nil, false, err
+	}
+
+	it, err := kv.db.Iterator(TxIndexKey(height, 0), TxIndexKey(height+1, 0))
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetLogsByHeight %d", height)
+	}
+	defer it.Close()
+
+	logs := [][]*ethtypes.Log{}
+	for ; it.Valid(); it.Next() {
+		rcpt, err := kv.GetReceiptByTxHash(common.BytesToHash(it.Value()))
+		if err != nil {
+			return nil, false, err
+		}
+		if rcpt == nil {
+			return nil, false, fmt.Errorf("receipt not found, block: %d, hash: %x", height, it.Value())
+		}
+		logs = append(logs, rcpt.Receipt.Logs)
+	}
+	return logs, true, nil
+}
+
+// FilterLogs returns the logs within the [from, to] block range matching the
+// addresses and topics criteria, returns false if the range is not indexed.
+//
+// The candidate txs are looked up through the address index if addresses are
+// given, otherwise through the topic index of the first non-empty topic position,
+// otherwise every eth tx in the range is a candidate.
+func (kv *KVReceiptIndexer) FilterLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]*ethtypes.Log, bool, error) {
+	// the indexer doesn't leave gaps, so it's enough to check the range bounds
+	for _, height := range []int64{from, to} {
+		if _, found, err := kv.GetBlockBloom(height); err != nil || !found {
+			return nil, false, err
+		}
+	}
+
+	var (
+		candidates  map[[16]byte]common.Hash
+		topicFilter = firstTopicFilter(topics)
+		err         error
+	)
+	switch {
+	case len(addresses) > 0:
+		keys := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			keys[i] = address.Bytes()
+		}
+		candidates, err = kv.logCandidates(KeyPrefixLogAddress, keys, from, to)
+	case len(topicFilter) > 0:
+		keys := make([][]byte, len(topicFilter))
+		for i, topic := range topicFilter {
+			keys[i] = topic.Bytes()
+		}
+		candidates, err = kv.logCandidates(KeyPrefixLogTopic, keys, from, to)
+	default:
+		candidates, err = kv.txCandidates(from, to)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "FilterLogs [%d, %d]", from, to)
+	}
+
+	positions := make([][16]byte, 0, len(candidates))
+	for position := range candidates {
+		positions = append(positions, position)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		return bytes.Compare(positions[i][:], positions[j][:]) < 0
+	})
+
+	logs := []*ethtypes.Log{}
+	for _, position := range positions {
+		hash := candidates[position]
+		rcpt, err := kv.GetReceiptByTxHash(hash)
+		if err != nil {
+			return nil, false, err
+		}
+		if rcpt == nil {
+			return nil, false, fmt.Errorf("receipt not found, hash: %s", hash.Hex())
+		}
+		for _, ethLog := range rcpt.Receipt.Logs {
+			if matchLog(ethLog, addresses, topics) {
+				logs = append(logs, ethLog)
+			}
+		}
+	}
+	return logs, true, nil
+}
+
+// logCandidates returns the txs emitting logs under any of the index keys
+// within the block range, keyed by their (block number, tx index) position.
+func (kv *KVReceiptIndexer) logCandidates(prefix byte, keys [][]byte, from, to int64) (map[[16]byte]common.Hash, error) {
+	candidates := make(map[[16]byte]common.Hash)
+	for _, key := range keys {
+		start := logKey(prefix, key, from, 0, 0)
+		end := logKey(prefix, key, to+1, 0, 0)
+		if err := iterateTxPositions(kv.db, start, end, len(start)-logPositionLength, candidates); err != nil {
+			return nil, err
+		}
+	}
+	return candidates, nil
+}
+
+// txCandidates returns all the eth txs within the block range, keyed by their
+// (block number, tx index) position.
+func (kv *KVReceiptIndexer) txCandidates(from, to int64) (map[[16]byte]common.Hash, error) {
+	candidates := make(map[[16]byte]common.Hash)
+	if err := iterateTxPositions(kv.db, TxIndexKey(from, 0), TxIndexKey(to+1, 0), 1, candidates); err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// iterateTxPositions collects the tx hashes stored within [start, end), keyed by
+// the (block number, tx index) position found at the offset of the keys.
+func iterateTxPositions(db dbm.DB, start, end []byte, offset int, candidates map[[16]byte]common.Hash) error {
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var position [16]byte
+		copy(position[:], it.Key()[offset:offset+16])
+		candidates[position] = common.BytesToHash(it.Value())
+	}
+	return nil
+}
+
+// ReceiptKey returns the key for db entry: `tx hash -> receipt`
+func ReceiptKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixReceipt}, hash.Bytes()...)
+}
+
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append([]byte{KeyPrefixBlockBloom}, bz...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, tx index, log index) -> tx hash`
+func LogAddressKey(address common.Address, blockNumber int64, txIndex int32, logIndex uint) []byte {
+	return logKey(KeyPrefixLogAddress, address.Bytes(), blockNumber, txIndex, logIndex)
+}
+
+// LogTopicKey returns the key for db entry: `(topic, block number, tx index, log index) -> tx hash`
+func LogTopicKey(topic common.Hash, blockNumber int64, txIndex int32, logIndex uint) []byte {
+	return logKey(KeyPrefixLogTopic, topic.Bytes(), blockNumber, txIndex, logIndex)
+}
+
+func logKey(prefix byte, key []byte, blockNumber int64, txIndex int32, logIndex uint) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	bz3 := sdk.Uint64ToBigEndian(uint64(logIndex))
+	res := make([]byte, 0, 1+len(key)+logPositionLength)
+	res = append(res, prefix)
+	res = append(res, key...)
+	res = append(res, bz1...)
+	res = append(res, bz2...)
+	return append(res, bz3...)
+}
+
+// newIndexedReceipt builds the receipt of an eth tx message from its tx result
+func newIndexedReceipt(
+	blockHash common.Hash,
+	baseFee *big.Int,
+	ethMsg *evmtypes.MsgEthereumTx,
+	txResult *servertypes.TxResult,
+	result *abci.ExecTxResult,
+) (*servertypes.IndexedReceipt, error) {
+	ethTx := ethMsg.AsTransaction()
+
+	var signer ethtypes.Signer
+	if ethTx.Protected() {
+		signer = ethtypes.LatestSignerForChainID(ethTx.ChainId())
+	} else {
+		signer = ethtypes.FrontierSigner{}
+	}
+	from, err := ethMsg.GetSenderLegacy(signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get sender")
+	}
+
+	logs := []*ethtypes.Log{}
+	if result.Code == abci.CodeTypeOK {
+		logs, err = evmtypes.DecodeMsgLogs(
+			result.Data,
+			int(txResult.MsgIndex),
+			uint64(txResult.Height), //#nosec G115 -- int overflow is not a concern here
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to decode logs of tx %s", ethTx.Hash().Hex())
+		}
+	}
+	for _, ethLog := range logs {
+		if ethLog.BlockHash == (common.Hash{}) {
+			ethLog.BlockHash = blockHash
+		}
+	}
+
+	var effectiveGasPrice *big.Int
+	if baseFee != nil {
+		effectiveGasPrice = rpctypes.EffectiveGasPrice(ethTx, baseFee)
+	} else {
+		effectiveGasPrice = ethTx.GasFeeCap()
+	}
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if txResult.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	contractAddress := common.Address{}
+	if ethTx.To() == nil {
+		contractAddress = crypto.CreateAddress(from, ethTx.Nonce())
+	}
+
+	receipt := &ethtypes.Receipt{
+		Type:              ethTx.Type(),
+		Status:            status,
+		CumulativeGasUsed: txResult.CumulativeGasUsed,
+		Bloom:             ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
+		Logs:              logs,
+		TxHash:            ethTx.Hash(),
+		ContractAddress:   contractAddress,
+		GasUsed:           txResult.GasUsed,
+		EffectiveGasPrice: effectiveGasPrice,
+		BlobGasPrice:      big.NewInt(0),
+		BlockHash:         blockHash,
+		BlockNumber:       big.NewInt(txResult.Height),
+		TransactionIndex:  uint(txResult.EthTxIndex), //#nosec G115 -- checked for int overflow already
+	}
+
+	return &servertypes.IndexedReceipt{Receipt: receipt, Tx: ethTx, From: from}, nil
+}
+
+// saveReceipt index the receipt and its logs into the kv db batch
+func saveReceipt(batch dbm.Batch, rcpt *servertypes.IndexedReceipt, txResult *servertypes.TxResult) error {
+	txHash := rcpt.Receipt.TxHash
+	bz, err := json.Marshal(rcpt)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal receipt")
+	}
+	if err := batch.Set(ReceiptKey(txHash), bz); err != nil {
+		return errorsmod.Wrap(err, "set receipt key")
+	}
+
+	for _, ethLog := range rcpt.Receipt.Logs {
+		if err := batch.Set(LogAddressKey(ethLog.Address, txResult.Height, txResult.EthTxIndex, ethLog.Index), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for _, topic := range ethLog.Topics {
+			if err := batch.Set(LogTopicKey(topic, txResult.Height, txResult.EthTxIndex, ethLog.Index), txHash.Bytes()); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
+
+// baseFeeFromEvents parses the base fee from the fee market finalize block event,
+// returns nil if not found
+func baseFeeFromEvents(events []abci.Event) *big.Int {
+	for i := len(events) - 1; i >= 0; i-- {
+		evt := events[i]
+		if evt.Type == evmtypes.EventTypeFeeMarket && len(evt.Attributes) > 0 {
+			baseFee, ok := sdkmath.NewIntFromString(evt.Attributes[0].Value)
+			if ok {
+				return baseFee.BigInt()
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// firstTopicFilter returns the first non-empty topic position of the criteria
+func firstTopicFilter(topics [][]common.Hash) []common.Hash {
+	for _, sub := range topics {
+		if len(sub) > 0 {
+			return sub
+		}
+	}
+	return nil
+}
+
+// matchLog checks the log against the addresses and topics criteria, following
+// the eth_getLogs semantics.
+func matchLog(ethLog *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !slices.Contains(addresses, ethLog.Address) {
+		return false
+	}
+	// If the to filtered topics is greater than the amount of topics in logs, skip.
+	if len(topics) > len(ethLog.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue // empty rule set == wildcard
+		}
+		if !slices.Contains(sub, ethLog.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseBlockNumberFromBloomKey(key []byte) (int64, error) {
+	if len(key) != BlockBloomKeyLength {
+		return 0, fmt.Errorf("wrong block bloom key length, expect: %d, got: %d", BlockBloomKeyLength, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
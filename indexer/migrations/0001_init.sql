@@ -0,0 +1,86 @@
+-- Initial schema of the EVM SQL indexer.
+--
+-- The schema is shared by the PostgreSQL and SQLite backends, so it only uses
+-- types and statements supported by both. Hashes and addresses are stored as
+-- lowercase 0x-prefixed hex strings and big integers as decimal strings.
+
+CREATE TABLE blocks (
+    height   BIGINT  PRIMARY KEY,
+    hash     TEXT    NOT NULL,
+    time     BIGINT  NOT NULL,
+    base_fee TEXT,
+    bloom    BYTEA   NOT NULL,
+    tx_count INTEGER NOT NULL
+);
+
+CREATE TABLE transactions (
+    hash                TEXT     PRIMARY KEY,
+    height              BIGINT   NOT NULL,
+    tx_index            INTEGER  NOT NULL,
+    msg_index           INTEGER  NOT NULL,
+    eth_tx_index        INTEGER  NOT NULL,
+    type                SMALLINT NOT NULL,
+    from_address        TEXT     NOT NULL,
+    to_address          TEXT,
+    nonce               BIGINT   NOT NULL,
+    value               TEXT     NOT NULL,
+    gas_limit           BIGINT   NOT NULL,
+    gas_used            BIGINT   NOT NULL,
+    cumulative_gas_used BIGINT   NOT NULL,
+    failed              BOOLEAN  NOT NULL,
+    raw                 BYTEA    NOT NULL,
+    UNIQUE (height, eth_tx_index)
+);
+
+CREATE INDEX transactions_from_address ON transactions (from_address, height);
+CREATE INDEX transactions_to_address ON transactions (to_address, height);
+
+CREATE TABLE receipts (
+    tx_hash             TEXT     PRIMARY KEY,
+    height              BIGINT   NOT NULL,
+    status              SMALLINT NOT NULL,
+    contract_address    TEXT,
+    effective_gas_price TEXT     NOT NULL,
+    bloom               BYTEA    NOT NULL,
+    data                TEXT     NOT NULL
+);
+
+CREATE INDEX receipts_height ON receipts (height);
+
+CREATE TABLE logs (
+    tx_hash    TEXT    NOT NULL,
+    log_index  INTEGER NOT NULL,
+    height     BIGINT  NOT NULL,
+    tx_index   INTEGER NOT NULL,
+    block_hash TEXT    NOT NULL,
+    address    TEXT    NOT NULL,
+    topic0     TEXT,
+    topic1     TEXT,
+    topic2     TEXT,
+    topic3     TEXT,
+    data       BYTEA   NOT NULL,
+    PRIMARY KEY (tx_hash, log_index)
+);
+
+CREATE INDEX logs_height ON logs (height, tx_index, log_index);
+CREATE INDEX logs_address ON logs (address, height);
+CREATE INDEX logs_topic0 ON logs (topic0, height);
+CREATE INDEX logs_topic1 ON logs (topic1, height);
+CREATE INDEX logs_topic2 ON logs (topic2, height);
+CREATE INDEX logs_topic3 ON logs (topic3, height);
+
+-- ERC20 Transfer events
+CREATE TABLE token_transfers (
+    tx_hash       TEXT    NOT NULL,
+    log_index     INTEGER NOT NULL,
+    height        BIGINT  NOT NULL,
+    token_address TEXT    NOT NULL,
+    from_address  TEXT    NOT NULL,
+    to_address    TEXT    NOT NULL,
+    value         TEXT    NOT NULL,
+    PRIMARY KEY (tx_hash, log_index)
+);
+
+CREATE INDEX token_transfers_token_address ON token_transfers (token_address, height);
+CREATE INDEX token_transfers_from_address ON token_transfers (from_address, height);
+CREATE INDEX token_transfers_to_address ON token_transfers (to_address, height);
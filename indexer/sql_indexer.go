@@ -0,0 +1,450 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	_ "github.com/lib/pq" // register the postgres driver
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/zenanetwork/zena/server/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	// SQLDriverPostgres is the database/sql driver name of the PostgreSQL backend
+	SQLDriverPostgres = "postgres"
+	// SQLDriverSQLite is the database/sql driver name of the SQLite backend
+	SQLDriverSQLite = "sqlite"
+)
+
+// transferEventSig is the topic of the ERC20 Transfer(address,address,uint256) event
+var transferEventSig = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+var _ servertypes.EVMReceiptIndexer = &SQLIndexer{}
+
+// SQLIndexer implements an eth tx indexer on a relational database. It stores
+// the blocks, transactions, receipts, logs and ERC20 token transfers in the
+// schema defined by the embedded migrations, so the database can be queried
+// directly by analytics tools and explorers.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSQLIndexer opens the database with the given driver and data source name,
+// applies the pending schema migrations and creates the SQLIndexer.
+func NewSQLIndexer(driver, dsn string, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if driver != SQLDriverPostgres && driver != SQLDriverSQLite {
+		return nil, fmt.Errorf("unsupported sql indexer driver %s", driver)
+	}
+	if !slices.Contains(sql.Drivers(), driver) {
+		// the sqlite driver is only registered by the binaries built with the sqlite tag
+		return nil, fmt.Errorf("sql indexer driver %s is not registered, build the binary with the %s build tag", driver, driver)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "open %s indexer db", driver)
+	}
+	if driver == SQLDriverSQLite {
+		// sqlite doesn't support concurrent writers, and every connection to an
+		// in-memory database opens a distinct database.
+		db.SetMaxOpenConns(1)
+	}
+
+	if err := Migrate(db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &SQLIndexer{db, logger, clientCtx}, nil
+}
+
+// Close closes the underlying database
+func (s *SQLIndexer) Close() error {
+	return s.db.Close()
+}
+
+// IndexBlock indexes the block without the finalize block events, the effective
+// gas price of the receipts falls back to the fee cap of the txs.
+func (s *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	return s.IndexBlockResults(block, txResults, nil)
+}
+
+// IndexBlockResults writes the block and all its eth txs, receipts, logs and
+// ERC20 token transfers within a single database transaction. Re-indexing a
+// block replaces the previously indexed rows.
+func (s *SQLIndexer) IndexBlockResults(block *cmttypes.Block, txResults []*abci.ExecTxResult, finalizeBlockEvents []abci.Event) error {
+	height := block.Height
+	blockHash := common.BytesToHash(block.Hash())
+	baseFee := baseFeeFromEvents(finalizeBlockEvents)
+
+	dbTx, err := s.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin db tx", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op after commit
+
+	for _, table := range []string{"blocks", "transactions", "receipts", "logs", "token_transfers"} {
+		if _, err := dbTx.Exec(`DELETE FROM `+table+` WHERE height = $1`, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", height, table)
+		}
+	}
+
+	var (
+		blockLogs []*ethtypes.Log
+		txCount   int
+	)
+	err = forEachEthTx(s.logger, s.clientCtx.TxConfig.TxDecoder(), block, txResults, func(
+		ethMsg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult, result *abci.ExecTxResult,
+	) error {
+		rcpt, err := newIndexedReceipt(blockHash, baseFee, ethMsg, txResult, result)
+		if err != nil {
+			return err
+		}
+		blockLogs = append(blockLogs, rcpt.Receipt.Logs...)
+		txCount++
+		return insertEthTx(dbTx, rcpt, txResult)
+	})
+	if err != nil {
+		return err
+	}
+
+	var baseFeeStr sql.NullString
+	if baseFee != nil {
+		baseFeeStr = sql.NullString{String: baseFee.String(), Valid: true}
+	}
+	bloom := ethtypes.CreateBloom(&ethtypes.Receipt{Logs: blockLogs})
+	if _, err := dbTx.Exec(
+		`INSERT INTO blocks (height, hash, time, base_fee, bloom, tx_count) VALUES ($1, $2, $3, $4, $5, $6)`,
+		height, blockHash.Hex(), block.Time.Unix(), baseFeeStr, bloom.Bytes(), txCount,
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit db tx", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (s *SQLIndexer) LastIndexedBlock() (int64, error) {
+	var height sql.NullInt64
+	if err := s.db.QueryRow(`SELECT MAX(height) FROM blocks`).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (s *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	var height sql.NullInt64
+	if err := s.db.QueryRow(`SELECT MIN(height) FROM blocks`).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (s *SQLIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
+	var txResult servertypes.TxResult
+	err := s.db.QueryRow(
+		`SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used
+		FROM transactions WHERE hash = $1`,
+		hash.Hex(),
+	).Scan(
+		&txResult.Height, &txResult.TxIndex, &txResult.MsgIndex, &txResult.EthTxIndex,
+		&txResult.Failed, &txResult.GasUsed, &txResult.CumulativeGasUsed,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return &txResult, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (s *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*servertypes.TxResult, error) {
+	var hash string
+	err := s.db.QueryRow(
+		`SELECT hash FROM transactions WHERE height = $1 AND eth_tx_index = $2`,
+		blockNumber, txIndex,
+	).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return s.GetByTxHash(common.HexToHash(hash))
+}
+
+// GetReceiptByTxHash finds the receipt by eth tx hash, returns nil if not found
+func (s *SQLIndexer) GetReceiptByTxHash(hash common.Hash) (*servertypes.IndexedReceipt, error) {
+	var data string
+	err := s.db.QueryRow(`SELECT data FROM receipts WHERE tx_hash = $1`, hash.Hex()).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptByTxHash %s", hash.Hex())
+	}
+	var rcpt servertypes.IndexedReceipt
+	if err := json.Unmarshal([]byte(data), &rcpt); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptByTxHash %s", hash.Hex())
+	}
+	return &rcpt, nil
+}
+
+// GetBlockBloom returns the bloom of the block, returns false if the block is not indexed
+func (s *SQLIndexer) GetBlockBloom(height int64) (ethtypes.Bloom, bool, error) {
+	var bz []byte
+	err := s.db.QueryRow(`SELECT bloom FROM blocks WHERE height = $1`, height).Scan(&bz)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ethtypes.Bloom{}, false, nil
+	}
+	if err != nil {
+		return ethtypes.Bloom{}, false, errorsmod.Wrapf(err, "GetBlockBloom %d", height)
+	}
+	return ethtypes.BytesToBloom(bz), true, nil
+}
+
+// GetLogsByHeight returns the logs of every eth tx in the block, returns false
+// if the block is not indexed
+func (s *SQLIndexer) GetLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error) {
+	if _, found, err := s.GetBlockBloom(height); err != nil || !found {
+		return nil, false, err
+	}
+
+	rows, err := s.db.Query(
+		`SELECT r.data FROM receipts r JOIN transactions t ON t.hash = r.tx_hash
+		WHERE r.height = $1 ORDER BY t.eth_tx_index`,
+		height,
+	)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetLogsByHeight %d", height)
+	}
+	defer rows.Close()
+
+	logs := [][]*ethtypes.Log{}
+	for rows.Next() {
+		rcpt, err := scanReceipt(rows)
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetLogsByHeight %d", height)
+		}
+		logs = append(logs, rcpt.Receipt.Logs)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetLogsByHeight %d", height)
+	}
+	return logs, true, nil
+}
+
+// FilterLogs returns the logs within the [from, to] block range matching the
+// addresses and topics criteria, returns false if the range is not indexed.
+func (s *SQLIndexer) FilterLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]*ethtypes.Log, bool, error) {
+	// the indexer doesn't leave gaps, so it's enough to check the range bounds
+	for _, height := range []int64{from, to} {
+		if _, found, err := s.GetBlockBloom(height); err != nil || !found {
+			return nil, false, err
+		}
+	}
+
+	// select the receipts of the txs having at least a matching log
+	var (
+		conds = []string{"l.height >= $1", "l.height <= $2"}
+		args  = []interface{}{from, to}
+	)
+	inClause := func(column string, values []string) string {
+		placeholders := make([]string, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		return fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", "))
+	}
+	if len(addresses) > 0 {
+		values := make([]string, len(addresses))
+		for i, address := range addresses {
+			values[i] = hexAddress(address)
+		}
+		conds = append(conds, inClause("l.address", values))
+	}
+	for i, sub := range topics {
+		if i > 3 {
+			// logs have at most 4 topics
+			return []*ethtypes.Log{}, true, nil
+		}
+		if len(sub) == 0 {
+			continue
+		}
+		values := make([]string, len(sub))
+		for j, topic := range sub {
+			values[j] = topic.Hex()
+		}
+		conds = append(conds, inClause(fmt.Sprintf("l.topic%d", i), values))
+	}
+
+	rows, err := s.db.Query(
+		`SELECT r.data FROM receipts r JOIN transactions t ON t.hash = r.tx_hash
+		WHERE r.tx_hash IN (SELECT l.tx_hash FROM logs l WHERE `+strings.Join(conds, " AND ")+`)
+		ORDER BY t.height, t.eth_tx_index`,
+		args...,
+	)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "FilterLogs [%d, %d]", from, to)
+	}
+	defer rows.Close()
+
+	logs := []*ethtypes.Log{}
+	for rows.Next() {
+		rcpt, err := scanReceipt(rows)
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "FilterLogs [%d, %d]", from, to)
+		}
+		for _, ethLog := range rcpt.Receipt.Logs {
+			if matchLog(ethLog, addresses, topics) {
+				logs = append(logs, ethLog)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, errorsmod.Wrapf(err, "FilterLogs [%d, %d]", from, to)
+	}
+	return logs, true, nil
+}
+
+// insertEthTx inserts the transaction, receipt, logs and token transfers of an eth tx
+func insertEthTx(dbTx *sql.Tx, rcpt *servertypes.IndexedReceipt, txResult *servertypes.TxResult) error {
+	ethTx := rcpt.Tx
+	receipt := rcpt.Receipt
+	txHash := receipt.TxHash.Hex()
+
+	raw, err := ethTx.MarshalBinary()
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal tx")
+	}
+	var toAddress sql.NullString
+	if ethTx.To() != nil {
+		toAddress = sql.NullString{String: hexAddress(*ethTx.To()), Valid: true}
+	}
+	if _, err := dbTx.Exec(
+		`INSERT INTO transactions (
+			hash, height, tx_index, msg_index, eth_tx_index, type, from_address, to_address,
+			nonce, value, gas_limit, gas_used, cumulative_gas_used, failed, raw
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		txHash, txResult.Height, txResult.TxIndex, txResult.MsgIndex, txResult.EthTxIndex, ethTx.Type(),
+		hexAddress(rcpt.From), toAddress, ethTx.Nonce(), ethTx.Value().String(), ethTx.Gas(),
+		txResult.GasUsed, txResult.CumulativeGasUsed, txResult.Failed, raw,
+	); err != nil {
+		return errorsmod.Wrap(err, "insert transaction")
+	}
+
+	bz, err := json.Marshal(rcpt)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal receipt")
+	}
+	var contractAddress sql.NullString
+	if receipt.ContractAddress != (common.Address{}) {
+		contractAddress = sql.NullString{String: hexAddress(receipt.ContractAddress), Valid: true}
+	}
+	if _, err := dbTx.Exec(
+		`INSERT INTO receipts (tx_hash, height, status, contract_address, effective_gas_price, bloom, data)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		txHash, txResult.Height, receipt.Status, contractAddress,
+		receipt.EffectiveGasPrice.String(), receipt.Bloom.Bytes(), string(bz),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+
+	for _, ethLog := range receipt.Logs {
+		var topics [4]sql.NullString
+		for i, topic := range ethLog.Topics {
+			if i >= len(topics) {
+				break
+			}
+			topics[i] = sql.NullString{String: topic.Hex(), Valid: true}
+		}
+		data := ethLog.Data
+		if data == nil {
+			data = []byte{}
+		}
+		if _, err := dbTx.Exec(
+			`INSERT INTO logs (
+				tx_hash, log_index, height, tx_index, block_hash, address, topic0, topic1, topic2, topic3, data
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			txHash, ethLog.Index, txResult.Height, ethLog.TxIndex, ethLog.BlockHash.Hex(), hexAddress(ethLog.Address),
+			topics[0], topics[1], topics[2], topics[3], data,
+		); err != nil {
+			return errorsmod.Wrap(err, "insert log")
+		}
+
+		if !isTokenTransfer(ethLog) {
+			continue
+		}
+		if _, err := dbTx.Exec(
+			`INSERT INTO token_transfers (tx_hash, log_index, height, token_address, from_address, to_address, value)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			txHash, ethLog.Index, txResult.Height, hexAddress(ethLog.Address),
+			hexAddress(common.BytesToAddress(ethLog.Topics[1].Bytes())),
+			hexAddress(common.BytesToAddress(ethLog.Topics[2].Bytes())),
+			new(big.Int).SetBytes(ethLog.Data).String(),
+		); err != nil {
+			return errorsmod.Wrap(err, "insert token transfer")
+		}
+	}
+	return nil
+}
+
+// isTokenTransfer checks if the log is an ERC20 Transfer event, ERC721
+// transfers index the token id as a fourth topic and are skipped.
+func isTokenTransfer(ethLog *ethtypes.Log) bool {
+	return len(ethLog.Topics) == 3 && ethLog.Topics[0] == transferEventSig && len(ethLog.Data) == 32
+}
+
+// scanReceipt decodes the receipt json of the current row
+func scanReceipt(rows *sql.Rows) (*servertypes.IndexedReceipt, error) {
+	var data string
+	if err := rows.Scan(&data); err != nil {
+		return nil, err
+	}
+	var rcpt servertypes.IndexedReceipt
+	if err := json.Unmarshal([]byte(data), &rcpt); err != nil {
+		return nil, err
+	}
+	return &rcpt, nil
+}
+
+// hexAddress returns the lowercase hex representation of the address
+func hexAddress(address common.Address) string {
+	return strings.ToLower(address.Hex())
+}
@@ -0,0 +1,7 @@
+//go:build sqlite
+
+package indexer
+
+// The SQLite driver and its dependencies are only linked in the binaries built
+// with the sqlite build tag, e.g. `BUILD_TAGS=sqlite make install`.
+import _ "modernc.org/sqlite" // register the sqlite driver
@@ -0,0 +1,103 @@
+package indexer
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// migrationsFS contains the schema migrations of the SQL indexer, named
+// `<version>_<description>.sql` and applied in version order.
+//
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// migration is a versioned schema change of the SQL indexer
+type migration struct {
+	version int
+	name    string
+	stmts   string
+}
+
+// loadMigrations returns the embedded migrations sorted by version
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationsFS.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]migration, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %s: %w", name, err)
+		}
+		bz, err := migrationsFS.ReadFile(path.Join("migrations", name))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{version: version, name: name, stmts: string(bz)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].version == migrations[i-1].version {
+			return nil, fmt.Errorf("duplicated migration version %d", migrations[i].version)
+		}
+	}
+	return migrations, nil
+}
+
+// Migrate applies the pending schema migrations to the SQL indexer database,
+// recording the applied versions in the `schema_migrations` table. Every
+// migration is applied within its own transaction.
+func Migrate(db *sql.DB) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`); err != nil {
+		return errorsmod.Wrap(err, "create schema_migrations table")
+	}
+
+	var current sql.NullInt64
+	if err := db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&current); err != nil {
+		return errorsmod.Wrap(err, "query schema version")
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return errorsmod.Wrap(err, "load migrations")
+	}
+
+	for _, m := range migrations {
+		if current.Valid && int64(m.version) <= current.Int64 {
+			continue
+		}
+		if err := applyMigration(db, m); err != nil {
+			return errorsmod.Wrapf(err, "apply migration %s", m.name)
+		}
+	}
+	return nil
+}
+
+func applyMigration(db *sql.DB, m migration) error {
+	dbTx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op after commit
+
+	if _, err := dbTx.Exec(m.stmts); err != nil {
+		return err
+	}
+	if _, err := dbTx.Exec(`INSERT INTO schema_migrations (version) VALUES ($1)`, m.version); err != nil {
+		return err
+	}
+	return dbTx.Commit()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/zenanetwork/zena/x/ibc/callbacks/keeper"
 	transferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	precisebankkeeper "github.com/zenanetwork/zena/x/precisebank/keeper"
+	revenuekeeper "github.com/zenanetwork/zena/x/revenue/keeper"
 	evmkeeper "github.com/zenanetwork/zena/x/vm/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
@@ -51,6 +52,7 @@ type EvmApp interface { //nolint:revive
 	GetStakingKeeper() *stakingkeeper.Keeper
 	GetMintKeeper() mintkeeper.Keeper
 	GetPreciseBankKeeper() *precisebankkeeper.Keeper
+	GetRevenueKeeper() *revenuekeeper.Keeper
 	GetFeeGrantKeeper() feegrantkeeper.Keeper
 	GetConsensusParamsKeeper() consensusparamkeeper.Keeper
 	GetCallbackKeeper() keeper.ContractKeeper
@@ -355,3 +355,223 @@ func (i *EVMMempoolIterator) convertEVMToSDKTx(nextEVMTx *txpool.LazyTransaction
 	i.logger.Debug("successfully converted EVM transaction to Cosmos transaction", "tx_hash", hash)
 	return cosmosTx
 }
+
+// =============================================================================
+// BLOCK SPACE POLICIES
+// =============================================================================
+
+var (
+	_ mempool.Iterator = &policyIterator{}
+	_ mempool.Iterator = &laneIterator{}
+)
+
+// policyIterator wraps an iterator over Cosmos transactions and skips the transactions
+// exceeding the block space limits of a CosmosTxPolicy. The gas and the sender usage
+// of a transaction are accounted when the iterator advances past it, that is when the
+// transaction has been selected.
+type policyIterator struct {
+	iterator        mempool.Iterator
+	signerExtractor mempool.SignerExtractionAdapter
+	logger          log.Logger
+
+	/** Limits **/
+	maxGas          uint64
+	maxTxsPerSender uint64
+	maxGasPerSender uint64
+
+	/** Usage **/
+	usedGas   uint64
+	senderTxs map[string]uint64
+	senderGas map[string]uint64
+
+	// skippedSenders are the senders with a skipped transaction, whose later
+	// transactions would fail with a nonce gap if selected
+	skippedSenders map[string]struct{}
+}
+
+// newPolicyIterator returns an iterator over the transactions of the given iterator
+// within the gas and per-sender limits. Zero per-sender limits are disabled.
+// Returns nil if no transaction is within the limits.
+func newPolicyIterator(
+	iterator mempool.Iterator,
+	signerExtractor mempool.SignerExtractionAdapter,
+	logger log.Logger,
+	maxGas, maxTxsPerSender, maxGasPerSender uint64,
+) mempool.Iterator {
+	i := &policyIterator{
+		iterator:        iterator,
+		signerExtractor: signerExtractor,
+		logger:          logger,
+		maxGas:          maxGas,
+		maxTxsPerSender: maxTxsPerSender,
+		maxGasPerSender: maxGasPerSender,
+		senderTxs:       make(map[string]uint64),
+		senderGas:       make(map[string]uint64),
+		skippedSenders:  make(map[string]struct{}),
+	}
+	if !i.skipToAllowed() {
+		return nil
+	}
+	return i
+}
+
+// Tx returns the current transaction of the iterator.
+func (i *policyIterator) Tx() sdk.Tx {
+	return i.iterator.Tx()
+}
+
+// Next accounts the usage of the current transaction and advances the iterator
+// to the next transaction within the limits. Returns nil when no more transactions
+// are available.
+func (i *policyIterator) Next() mempool.Iterator {
+	tx := i.iterator.Tx()
+	gas := txGas(tx)
+	i.usedGas += gas
+	if i.hasSenderLimits() {
+		if sender := i.sender(tx); sender != "" {
+			i.senderTxs[sender]++
+			i.senderGas[sender] += gas
+		}
+	}
+
+	i.iterator = i.iterator.Next()
+	if !i.skipToAllowed() {
+		return nil
+	}
+	return i
+}
+
+// skipToAllowed advances the wrapped iterator until its current transaction is within
+// the limits. Returns false if the wrapped iterator is exhausted.
+func (i *policyIterator) skipToAllowed() bool {
+	for i.iterator != nil {
+		tx := i.iterator.Tx()
+		if tx == nil {
+			return false
+		}
+		if i.allowed(tx) {
+			return true
+		}
+		i.iterator = i.iterator.Next()
+	}
+	return false
+}
+
+// allowed returns true if selecting the transaction keeps the usage within the limits.
+// Once a transaction of a sender is skipped, the later transactions of the sender are
+// skipped for the rest of the block as they would fail with a nonce gap.
+func (i *policyIterator) allowed(tx sdk.Tx) bool {
+	sender := i.sender(tx)
+	if _, ok := i.skippedSenders[sender]; ok {
+		i.logger.Debug("skipping Cosmos transaction of a sender with a skipped transaction", "sender", sender)
+		return false
+	}
+	if i.withinLimits(tx, sender) {
+		return true
+	}
+	if sender != "" {
+		i.skippedSenders[sender] = struct{}{}
+	}
+	return false
+}
+
+// withinLimits returns true if the gas and the sender usage of the transaction are
+// within the limits.
+func (i *policyIterator) withinLimits(tx sdk.Tx, sender string) bool {
+	gas := txGas(tx)
+	if gas > i.maxGas-i.usedGas {
+		i.logger.Debug("skipping Cosmos transaction exceeding the gas limit", "gas", gas, "used_gas", i.usedGas, "max_gas", i.maxGas)
+		return false
+	}
+
+	if !i.hasSenderLimits() || sender == "" {
+		return true
+	}
+	if i.maxTxsPerSender > 0 && i.senderTxs[sender] >= i.maxTxsPerSender {
+		i.logger.Debug("skipping Cosmos transaction exceeding the sender transactions limit", "sender", sender, "max_txs", i.maxTxsPerSender)
+		return false
+	}
+	if i.maxGasPerSender > 0 && gas > i.maxGasPerSender-min(i.senderGas[sender], i.maxGasPerSender) {
+		i.logger.Debug("skipping Cosmos transaction exceeding the sender gas limit", "sender", sender, "gas", gas, "max_gas", i.maxGasPerSender)
+		return false
+	}
+	return true
+}
+
+// hasSenderLimits returns true if the selected transactions are limited per sender.
+func (i *policyIterator) hasSenderLimits() bool {
+	return i.maxTxsPerSender > 0 || i.maxGasPerSender > 0
+}
+
+// sender returns the first signer of the transaction, or an empty string if the
+// signers cannot be extracted.
+func (i *policyIterator) sender(tx sdk.Tx) string {
+	if i.signerExtractor == nil {
+		return ""
+	}
+	signers, err := i.signerExtractor.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		i.logger.Debug("failed to extract signers of Cosmos transaction", "error", err)
+		return ""
+	}
+	return signers[0].Signer.String()
+}
+
+// txGas returns the gas limit of a transaction, or zero if the transaction
+// doesn't implement the FeeTx interface.
+func txGas(tx sdk.Tx) uint64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+	return feeTx.GetGas()
+}
+
+// laneIterator selects the transactions of the reserved lanes, in the lanes order,
+// before the transactions of the next iterator.
+type laneIterator struct {
+	lanes []mempool.Iterator
+	next  mempool.Iterator
+}
+
+// newLaneIterator returns an iterator over the transactions of the lane iterators
+// followed by the ones of the next iterator. Nil iterators are ignored, and nil is
+// returned if all the iterators are nil.
+func newLaneIterator(lanes []mempool.Iterator, next mempool.Iterator) mempool.Iterator {
+	nonNil := make([]mempool.Iterator, 0, len(lanes))
+	for _, lane := range lanes {
+		if lane != nil {
+			nonNil = append(nonNil, lane)
+		}
+	}
+	if len(nonNil) == 0 {
+		return next
+	}
+	return &laneIterator{lanes: nonNil, next: next}
+}
+
+// Tx returns the current transaction of the first non-exhausted lane, or of the
+// next iterator once all the lanes are exhausted.
+func (i *laneIterator) Tx() sdk.Tx {
+	if len(i.lanes) > 0 {
+		return i.lanes[0].Tx()
+	}
+	return i.next.Tx()
+}
+
+// Next advances the iterator providing the current transaction. Returns nil when
+// no more transactions are available.
+func (i *laneIterator) Next() mempool.Iterator {
+	if len(i.lanes) > 0 {
+		if i.lanes[0] = i.lanes[0].Next(); i.lanes[0] == nil {
+			i.lanes = i.lanes[1:]
+		}
+	} else {
+		i.next = i.next.Next()
+	}
+
+	if len(i.lanes) == 0 && i.next == nil {
+		return nil
+	}
+	return i
+}
@@ -48,6 +48,8 @@ type (
 		txPool       *txpool.TxPool
 		legacyTxPool *legacypool.LegacyPool
 		cosmosPool   sdkmempool.ExtMempool
+		lanes        []*lane
+		senderPools  map[string]*senderPool // pool of the pending Cosmos transactions per sender, when lanes are set
 
 		/** Utils **/
 		logger        log.Logger
@@ -56,6 +58,10 @@ type (
 		blockGasLimit uint64 // Block gas limit from consensus parameters
 		minTip        *uint256.Int
 
+		/** Block Space Policies **/
+		cosmosTxPolicy  CosmosTxPolicy
+		signerExtractor sdkmempool.SignerExtractionAdapter
+
 		/** Verification **/
 		anteHandler sdk.AnteHandler
 
@@ -76,12 +82,16 @@ type EVMMempoolConfig struct {
 	BroadCastTxFn    func(txs []*ethtypes.Transaction) error
 	BlockGasLimit    uint64 // Block gas limit from consensus parameters
 	MinTip           *uint256.Int
+	// CosmosTxPolicy bounds the block space given to Cosmos transactions
+	CosmosTxPolicy CosmosTxPolicy
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
 // It initializes both EVM and Cosmos transaction pools, sets up blockchain interfaces,
 // and configures fee-based prioritization. The config parameter allows customization
 // of pools and verification functions, with sensible defaults created if not provided.
+// If the legacy pool journal is enabled, the journaled EVM transactions are replayed
+// into the pool once the state of the chain head is available.
 func NewExperimentalEVMMempool(
 	getCtxCallback func(height int64, prove bool) (sdk.Context, error),
 	logger log.Logger,
@@ -106,6 +116,10 @@ func NewExperimentalEVMMempool(
 		panic("config must not be nil")
 	}
 
+	if err := config.CosmosTxPolicy.Validate(); err != nil {
+		panic(fmt.Errorf("invalid cosmos tx policy: %w", err))
+	}
+
 	if config.BlockGasLimit == 0 {
 		logger.Warn("block gas limit is 0, setting to fallback", "fallback_limit", fallbackBlockGasLimit)
 		config.BlockGasLimit = fallbackBlockGasLimit
@@ -176,20 +190,44 @@ func NewExperimentalEVMMempool(
 		cosmosPoolConfig = &defaultConfig
 	}
 
+	// EVM transactions with a fee granter are kept in the Cosmos pool, so the
+	// signer must be extracted from the Ethereum transaction.
+	if cosmosPoolConfig.SignerExtractor == nil {
+		cosmosPoolConfig.SignerExtractor = NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter())
+	}
+
 	cosmosPoolConfig.MaxTx = cosmosPoolMaxTx
 	cosmosPool = sdkmempool.NewPriorityMempool(*cosmosPoolConfig)
 
+	// Each reserved lane keeps its transactions in a dedicated Cosmos pool
+	lanes := make([]*lane, 0, len(config.CosmosTxPolicy.Lanes))
+	for _, laneConfig := range config.CosmosTxPolicy.Lanes {
+		msgTypes := make(map[string]struct{}, len(laneConfig.MsgTypeURLs))
+		for _, msgType := range laneConfig.MsgTypeURLs {
+			msgTypes[msgType] = struct{}{}
+		}
+		lanes = append(lanes, &lane{
+			LaneConfig: laneConfig,
+			msgTypes:   msgTypes,
+			pool:       sdkmempool.NewPriorityMempool(*cosmosPoolConfig),
+		})
+	}
+
 	evmMempool := &ExperimentalEVMMempool{
-		vmKeeper:      vmKeeper,
-		txPool:        txPool,
-		legacyTxPool:  txPool.Subpools[0].(*legacypool.LegacyPool),
-		cosmosPool:    cosmosPool,
-		logger:        logger,
-		txConfig:      txConfig,
-		blockchain:    blockchain,
-		blockGasLimit: config.BlockGasLimit,
-		minTip:        config.MinTip,
-		anteHandler:   config.AnteHandler,
+		vmKeeper:        vmKeeper,
+		txPool:          txPool,
+		legacyTxPool:    txPool.Subpools[0].(*legacypool.LegacyPool),
+		cosmosPool:      cosmosPool,
+		lanes:           lanes,
+		senderPools:     make(map[string]*senderPool),
+		logger:          logger,
+		txConfig:        txConfig,
+		blockchain:      blockchain,
+		blockGasLimit:   config.BlockGasLimit,
+		minTip:          config.MinTip,
+		cosmosTxPolicy:  config.CosmosTxPolicy,
+		signerExtractor: cosmosPoolConfig.SignerExtractor,
+		anteHandler:     config.AnteHandler,
 	}
 
 	vmKeeper.SetEvmMempool(evmMempool)
@@ -238,10 +276,16 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 
 	// Insert into cosmos pool for non-EVM transactions
 	m.logger.Debug("inserting Cosmos transaction", "error", err)
-	err = m.cosmosPool.Insert(goCtx, tx)
+	pool := m.getCosmosPool(tx)
+	if err := m.checkSenderPool(tx, pool); err != nil {
+		m.logger.Debug("rejecting Cosmos transaction", "error", err)
+		return err
+	}
+	err = pool.Insert(goCtx, tx)
 	if err != nil {
 		m.logger.Error("failed to insert Cosmos transaction", "error", err)
 	} else {
+		m.trackSenderTx(tx, pool)
 		m.logger.Debug("Cosmos transaction inserted successfully")
 	}
 	return err
@@ -257,6 +301,10 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 		return err
 	}
 
+	if hasFeeGranter(tx) {
+		return ErrFeeGrantedEVMTransaction
+	}
+
 	var ethTxs []*ethtypes.Transaction
 	msgs := tx.GetMsgs()
 	if len(msgs) != 1 {
@@ -285,20 +333,19 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 func (m *ExperimentalEVMMempool) Select(goCtx context.Context, i [][]byte) sdkmempool.Iterator {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
-
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
 
-	return combinedIterator
+	return m.getCombinedIterator(goCtx, i)
 }
 
 // CountTx returns the total number of transactions in both EVM and Cosmos pools.
 // This provides a combined count across all mempool types.
 func (m *ExperimentalEVMMempool) CountTx() int {
 	pending, _ := m.txPool.Stats()
-	return m.cosmosPool.CountTx() + pending
+	count := m.cosmosPool.CountTx() + pending
+	for _, lane := range m.lanes {
+		count += lane.pool.CountTx()
+	}
+	return count
 }
 
 // Remove removes a transaction from the appropriate sdkmempool.
@@ -335,10 +382,11 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 	}
 
 	m.logger.Debug("removing Cosmos transaction")
-	err = m.cosmosPool.Remove(tx)
+	err = m.getCosmosPool(tx).Remove(tx)
 	if err != nil {
 		m.logger.Error("failed to remove Cosmos transaction", "error", err)
 	} else {
+		m.untrackSenderTx(tx)
 		m.logger.Debug("Cosmos transaction removed successfully")
 	}
 	return err
@@ -383,11 +431,8 @@ func (m *ExperimentalEVMMempool) shouldRemoveFromEVMPool(tx sdk.Tx) bool {
 func (m *ExperimentalEVMMempool) SelectBy(goCtx context.Context, i [][]byte, f func(sdk.Tx) bool) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
 
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
+	combinedIterator := m.getCombinedIterator(goCtx, i)
 
 	for combinedIterator != nil && f(combinedIterator.Tx()) {
 		combinedIterator = combinedIterator.Next()
@@ -434,6 +479,8 @@ func (m *ExperimentalEVMMempool) Close() error {
 
 // getEVMMessage validates that the transaction contains exactly one message and returns it if it's an EVM message.
 // Returns an error if the transaction has no messages, multiple messages, or the single message is not an EVM transaction.
+// EVM transactions with a fee granter are handled as Cosmos transactions, since the EVM pool only keeps the Ethereum
+// transaction and would drop the fee granter.
 func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereumTx, error) {
 	msgs := tx.GetMsgs()
 	if len(msgs) == 0 {
@@ -446,9 +493,18 @@ func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereum
 	if !ok {
 		return nil, ErrNotEVMTransaction
 	}
+	if hasFeeGranter(tx) {
+		return nil, ErrFeeGrantedEVMTransaction
+	}
 	return ethMsg, nil
 }
 
+// hasFeeGranter returns true if the fees of the transaction are paid by a fee granter.
+func hasFeeGranter(tx sdk.Tx) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	return ok && len(feeTx.FeeGranter()) > 0
+}
+
 // getIterators prepares iterators over pending EVM and Cosmos transactions.
 // It configures EVM transactions with proper base fee filtering and priority ordering,
 // while setting up the Cosmos iterator with the provided exclusion list.
@@ -477,6 +533,102 @@ func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte)
 	return orderedEVMPendingTxes, cosmosPendingTxes
 }
 
+// getCombinedIterator returns the iterator over the transactions of the reserved lanes
+// followed by the EVM and Cosmos transactions, honouring the Cosmos transactions policy.
+func (m *ExperimentalEVMMempool) getCombinedIterator(goCtx context.Context, i [][]byte) sdkmempool.Iterator {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
+
+	policy := m.cosmosTxPolicy
+	if cosmosIterator != nil && policy.limitsCosmosTxs() {
+		maxGas := gasFraction(m.blockGasLimit, policy.MaxBlockGasFraction)
+		cosmosIterator = newPolicyIterator(cosmosIterator, m.signerExtractor, m.logger, maxGas, policy.MaxTxsPerSender, policy.MaxGasPerSender)
+	}
+
+	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
+
+	laneIterators := make([]sdkmempool.Iterator, 0, len(m.lanes))
+	for _, lane := range m.lanes {
+		laneIterator := lane.pool.Select(ctx, i)
+		if laneIterator == nil {
+			continue
+		}
+		maxGas := gasFraction(m.blockGasLimit, lane.BlockGasFraction)
+		laneIterators = append(laneIterators, newPolicyIterator(laneIterator, m.signerExtractor, m.logger.With("lane", lane.Name), maxGas, 0, 0))
+	}
+
+	return newLaneIterator(laneIterators, combinedIterator)
+}
+
+// getCosmosPool returns the pool of the Cosmos transaction, which is the pool of the
+// first lane matching the transaction messages or the default Cosmos pool.
+func (m *ExperimentalEVMMempool) getCosmosPool(tx sdk.Tx) sdkmempool.ExtMempool {
+	for _, lane := range m.lanes {
+		if lane.matches(tx) {
+			return lane.pool
+		}
+	}
+	return m.cosmosPool
+}
+
+// checkSenderPool returns an error if the sender of the Cosmos transaction has pending
+// transactions in another pool, since the nonce ordering is only enforced within a pool.
+func (m *ExperimentalEVMMempool) checkSenderPool(tx sdk.Tx, pool sdkmempool.ExtMempool) error {
+	sender, _, ok := m.senderNonce(tx)
+	if !ok {
+		return nil
+	}
+	if pending, found := m.senderPools[sender]; found && pending.pool != pool {
+		return fmt.Errorf("%w: %s", ErrSenderInOtherLane, sender)
+	}
+	return nil
+}
+
+// trackSenderTx records the Cosmos transaction as pending in the given pool.
+func (m *ExperimentalEVMMempool) trackSenderTx(tx sdk.Tx, pool sdkmempool.ExtMempool) {
+	sender, nonce, ok := m.senderNonce(tx)
+	if !ok {
+		return
+	}
+	pending, found := m.senderPools[sender]
+	if !found {
+		pending = &senderPool{pool: pool, nonces: make(map[uint64]struct{})}
+		m.senderPools[sender] = pending
+	}
+	pending.nonces[nonce] = struct{}{}
+}
+
+// untrackSenderTx removes the Cosmos transaction from the pending transactions of its sender.
+func (m *ExperimentalEVMMempool) untrackSenderTx(tx sdk.Tx) {
+	sender, nonce, ok := m.senderNonce(tx)
+	if !ok {
+		return
+	}
+	pending, found := m.senderPools[sender]
+	if !found {
+		return
+	}
+	delete(pending.nonces, nonce)
+	if len(pending.nonces) == 0 {
+		delete(m.senderPools, sender)
+	}
+}
+
+// senderNonce returns the first signer of the Cosmos transaction and its sequence.
+// Returns false if no lane is configured, as all the transactions share the default
+// Cosmos pool, or if the signers cannot be extracted.
+func (m *ExperimentalEVMMempool) senderNonce(tx sdk.Tx) (string, uint64, bool) {
+	if len(m.lanes) == 0 || m.signerExtractor == nil {
+		return "", 0, false
+	}
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return "", 0, false
+	}
+	return signers[0].Signer.String(), signers[0].Sequence, true
+}
+
 // broadcastEVMTransactions converts Ethereum transactions to Cosmos SDK format and broadcasts them.
 // This function wraps EVM transactions in MsgEthereumTx messages and submits them to the network
 // using the provided client context. It handles encoding and error reporting for each transaction.
@@ -0,0 +1,128 @@
+package mempool
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// CosmosTxPolicy bounds the block space given to Cosmos transactions when the
+// mempool transactions are selected for a block proposal. Zero values disable
+// the corresponding limit.
+type CosmosTxPolicy struct {
+	// MaxBlockGasFraction is the maximum fraction of the block gas limit used by
+	// the Cosmos transactions that don't belong to a lane
+	MaxBlockGasFraction float64
+	// MaxTxsPerSender is the maximum number of Cosmos transactions of a sender
+	// selected for a block. Lane transactions are not limited.
+	MaxTxsPerSender uint64
+	// MaxGasPerSender is the maximum gas of the Cosmos transactions of a sender
+	// selected for a block. Lane transactions are not limited.
+	MaxGasPerSender uint64
+	// Lanes reserve block space for Cosmos transactions with specific message types
+	Lanes []LaneConfig
+}
+
+// LaneConfig reserves a fraction of the block gas limit for the Cosmos transactions
+// whose messages all have one of the lane message types, e.g. the IBC relayer
+// messages. Lane transactions are kept in a dedicated pool and are selected
+// before any other transaction, up to the reserved gas.
+//
+// Since the nonce ordering is only enforced within a pool, the pending Cosmos
+// transactions of a sender are kept in a single pool: a transaction belonging to
+// another pool than the pending transactions of its sender is rejected until
+// they are included in a block.
+type LaneConfig struct {
+	// Name identifies the lane in the logs
+	Name string
+	// MsgTypeURLs are the type URLs of the messages of the lane transactions
+	MsgTypeURLs []string
+	// BlockGasFraction is the fraction of the block gas limit reserved for the lane
+	BlockGasFraction float64
+}
+
+// limitsCosmosTxs returns true if the policy limits the Cosmos transactions
+// that don't belong to a lane.
+func (p CosmosTxPolicy) limitsCosmosTxs() bool {
+	return p.MaxBlockGasFraction > 0 || p.MaxTxsPerSender > 0 || p.MaxGasPerSender > 0
+}
+
+// Validate returns an error if the policy is invalid.
+func (p CosmosTxPolicy) Validate() error {
+	if err := validateFraction(p.MaxBlockGasFraction); err != nil {
+		return fmt.Errorf("invalid cosmos max block gas fraction: %w", err)
+	}
+
+	var reserved float64
+	msgTypes := make(map[string]string)
+	for _, lane := range p.Lanes {
+		if lane.Name == "" {
+			return fmt.Errorf("lane name cannot be empty")
+		}
+		if len(lane.MsgTypeURLs) == 0 {
+			return fmt.Errorf("lane %s has no message types", lane.Name)
+		}
+		for _, msgType := range lane.MsgTypeURLs {
+			if other, ok := msgTypes[msgType]; ok {
+				return fmt.Errorf("message type %s is in lanes %s and %s", msgType, other, lane.Name)
+			}
+			msgTypes[msgType] = lane.Name
+		}
+		if err := validateFraction(lane.BlockGasFraction); err != nil {
+			return fmt.Errorf("invalid block gas fraction of lane %s: %w", lane.Name, err)
+		}
+		if lane.BlockGasFraction == 0 {
+			return fmt.Errorf("block gas fraction of lane %s must be positive", lane.Name)
+		}
+		reserved += lane.BlockGasFraction
+	}
+	if reserved > 1 {
+		return fmt.Errorf("lanes reserve more than the block gas limit: %g", reserved)
+	}
+	return nil
+}
+
+func validateFraction(fraction float64) error {
+	if math.IsNaN(fraction) || fraction < 0 || fraction > 1 {
+		return fmt.Errorf("fraction must be between 0 and 1, got %g", fraction)
+	}
+	return nil
+}
+
+// gasFraction returns the given fraction of the block gas limit, or the block
+// gas limit if the fraction is zero.
+func gasFraction(blockGasLimit uint64, fraction float64) uint64 {
+	if fraction <= 0 || fraction >= 1 {
+		return blockGasLimit
+	}
+	return uint64(float64(blockGasLimit) * fraction)
+}
+
+// lane is a reserved lane of the mempool with its own Cosmos transactions pool
+type lane struct {
+	LaneConfig
+	msgTypes map[string]struct{}
+	pool     sdkmempool.ExtMempool
+}
+
+// matches returns true if all the messages of the transaction have a lane message type.
+func (l *lane) matches(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := l.msgTypes[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// senderPool is the pool holding the pending Cosmos transactions of a sender
+type senderPool struct {
+	pool   sdkmempool.ExtMempool
+	nonces map[uint64]struct{}
+}
@@ -0,0 +1,218 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockPolicyTx struct {
+	id     int
+	sender string
+	gas    uint64
+	msgs   []sdk.Msg
+}
+
+func (tx mockPolicyTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockPolicyTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockPolicyTx) GetGas() uint64                        { return tx.gas }
+func (tx mockPolicyTx) GetFee() sdk.Coins                     { return nil }
+func (tx mockPolicyTx) FeePayer() []byte                      { return []byte(tx.sender) }
+func (tx mockPolicyTx) FeeGranter() []byte                    { return nil }
+
+type mockPolicySignerExtractor struct{}
+
+func (mockPolicySignerExtractor) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	return []sdkmempool.SignerData{sdkmempool.NewSignerData(sdk.AccAddress(tx.(mockPolicyTx).sender), 0)}, nil
+}
+
+// sliceIterator iterates over a slice of transactions
+type sliceIterator struct {
+	txs []sdk.Tx
+}
+
+func newSliceIterator(txs ...sdk.Tx) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+	return &sliceIterator{txs: txs}
+}
+
+func (i *sliceIterator) Tx() sdk.Tx { return i.txs[0] }
+
+func (i *sliceIterator) Next() sdkmempool.Iterator {
+	return newSliceIterator(i.txs[1:]...)
+}
+
+// selectedIDs returns the IDs of the transactions of the iterator
+func selectedIDs(iterator sdkmempool.Iterator) []int {
+	ids := []int{}
+	for ; iterator != nil; iterator = iterator.Next() {
+		ids = append(ids, iterator.Tx().(mockPolicyTx).id)
+	}
+	return ids
+}
+
+func TestCosmosTxPolicyValidate(t *testing.T) {
+	recvPacket := "/ibc.core.channel.v1.MsgRecvPacket"
+
+	testCases := []struct {
+		name   string
+		policy CosmosTxPolicy
+		expErr string
+	}{
+		{"empty policy", CosmosTxPolicy{}, ""},
+		{
+			"valid policy",
+			CosmosTxPolicy{
+				MaxBlockGasFraction: 0.5,
+				MaxTxsPerSender:     10,
+				MaxGasPerSender:     1_000_000,
+				Lanes:               []LaneConfig{{Name: "ibc", MsgTypeURLs: []string{recvPacket}, BlockGasFraction: 0.2}},
+			},
+			"",
+		},
+		{"negative fraction", CosmosTxPolicy{MaxBlockGasFraction: -0.1}, "invalid cosmos max block gas fraction"},
+		{"fraction above one", CosmosTxPolicy{MaxBlockGasFraction: 1.1}, "invalid cosmos max block gas fraction"},
+		{"lane without name", CosmosTxPolicy{Lanes: []LaneConfig{{MsgTypeURLs: []string{recvPacket}, BlockGasFraction: 0.2}}}, "lane name cannot be empty"},
+		{"lane without message types", CosmosTxPolicy{Lanes: []LaneConfig{{Name: "ibc", BlockGasFraction: 0.2}}}, "has no message types"},
+		{"lane without gas", CosmosTxPolicy{Lanes: []LaneConfig{{Name: "ibc", MsgTypeURLs: []string{recvPacket}}}}, "must be positive"},
+		{
+			"message type in two lanes",
+			CosmosTxPolicy{Lanes: []LaneConfig{
+				{Name: "ibc", MsgTypeURLs: []string{recvPacket}, BlockGasFraction: 0.2},
+				{Name: "other", MsgTypeURLs: []string{recvPacket}, BlockGasFraction: 0.2},
+			}},
+			"is in lanes ibc and other",
+		},
+		{
+			"lanes reserve more than the block",
+			CosmosTxPolicy{Lanes: []LaneConfig{
+				{Name: "ibc", MsgTypeURLs: []string{recvPacket}, BlockGasFraction: 0.6},
+				{Name: "bank", MsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend"}, BlockGasFraction: 0.6},
+			}},
+			"lanes reserve more than the block gas limit",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestGasFraction(t *testing.T) {
+	require.Equal(t, uint64(1000), gasFraction(1000, 0))
+	require.Equal(t, uint64(250), gasFraction(1000, 0.25))
+	require.Equal(t, uint64(1000), gasFraction(1000, 1))
+	require.Less(t, gasFraction(^uint64(0), 0.999999), ^uint64(0))
+}
+
+func TestPolicyIterator(t *testing.T) {
+	txs := []sdk.Tx{
+		mockPolicyTx{id: 0, sender: "alice", gas: 100},
+		mockPolicyTx{id: 1, sender: "alice", gas: 100},
+		mockPolicyTx{id: 2, sender: "bob", gas: 300},
+		mockPolicyTx{id: 3, sender: "alice", gas: 100},
+		mockPolicyTx{id: 4, sender: "carol", gas: 50},
+	}
+
+	testCases := []struct {
+		name            string
+		maxGas          uint64
+		maxTxsPerSender uint64
+		maxGasPerSender uint64
+		expIDs          []int
+	}{
+		{"no limits", ^uint64(0), 0, 0, []int{0, 1, 2, 3, 4}},
+		{"gas limit", 400, 0, 0, []int{0, 1, 3, 4}},
+		{"gas limit skips first transactions", 60, 0, 0, []int{4}},
+		{"no transaction within gas limit", 10, 0, 0, []int{}},
+		{"transactions per sender", ^uint64(0), 2, 0, []int{0, 1, 2, 4}},
+		{"gas per sender", ^uint64(0), 0, 250, []int{0, 1, 4}},
+		{"all limits", 500, 1, 250, []int{0, 4}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			iterator := newPolicyIterator(newSliceIterator(txs...), mockPolicySignerExtractor{}, log.NewNopLogger(), tc.maxGas, tc.maxTxsPerSender, tc.maxGasPerSender)
+			require.Equal(t, tc.expIDs, selectedIDs(iterator))
+		})
+	}
+}
+
+func TestPolicyIteratorSkipsSender(t *testing.T) {
+	txs := []sdk.Tx{
+		mockPolicyTx{id: 0, sender: "alice", gas: 100},
+		mockPolicyTx{id: 1, sender: "bob", gas: 300},
+		mockPolicyTx{id: 2, sender: "bob", gas: 50},
+		mockPolicyTx{id: 3, sender: "carol", gas: 50},
+	}
+
+	// bob's second transaction fits in the gas limit but would fail with a nonce gap
+	iterator := newPolicyIterator(newSliceIterator(txs...), mockPolicySignerExtractor{}, log.NewNopLogger(), 200, 0, 0)
+	require.Equal(t, []int{0, 3}, selectedIDs(iterator))
+
+	// without signer extractor, the senders are unknown
+	iterator = newPolicyIterator(newSliceIterator(txs...), nil, log.NewNopLogger(), 200, 0, 0)
+	require.Equal(t, []int{0, 2, 3}, selectedIDs(iterator))
+}
+
+func TestSenderPool(t *testing.T) {
+	newPool := func() sdkmempool.ExtMempool {
+		return sdkmempool.NewPriorityMempool(sdkmempool.PriorityNonceMempoolConfig[int64]{})
+	}
+	bankLane := &lane{msgTypes: map[string]struct{}{sdk.MsgTypeURL(&banktypes.MsgSend{}): {}}, pool: newPool()}
+	m := &ExperimentalEVMMempool{
+		cosmosPool:      newPool(),
+		lanes:           []*lane{bankLane},
+		senderPools:     make(map[string]*senderPool),
+		signerExtractor: mockPolicySignerExtractor{},
+	}
+
+	laneTx := mockPolicyTx{sender: "alice", msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+	otherTx := mockPolicyTx{sender: "alice", msgs: []sdk.Msg{&banktypes.MsgMultiSend{}}}
+
+	require.Equal(t, bankLane.pool, m.getCosmosPool(laneTx))
+	require.Equal(t, m.cosmosPool, m.getCosmosPool(otherTx))
+	require.NoError(t, m.checkSenderPool(otherTx, m.cosmosPool))
+
+	m.trackSenderTx(laneTx, bankLane.pool)
+	require.NoError(t, m.checkSenderPool(laneTx, bankLane.pool))
+	require.ErrorIs(t, m.checkSenderPool(otherTx, m.cosmosPool), ErrSenderInOtherLane)
+	require.NoError(t, m.checkSenderPool(mockPolicyTx{sender: "bob"}, m.cosmosPool))
+
+	m.untrackSenderTx(laneTx)
+	require.Empty(t, m.senderPools)
+	require.NoError(t, m.checkSenderPool(otherTx, m.cosmosPool))
+}
+
+func TestLaneIterator(t *testing.T) {
+	lane1 := newSliceIterator(mockPolicyTx{id: 0}, mockPolicyTx{id: 1})
+	lane2 := newSliceIterator(mockPolicyTx{id: 2})
+	next := newSliceIterator(mockPolicyTx{id: 3}, mockPolicyTx{id: 4})
+
+	require.Equal(t, []int{0, 1, 2, 3, 4}, selectedIDs(newLaneIterator([]sdkmempool.Iterator{lane1, nil, lane2}, next)))
+	require.Equal(t, []int{3, 4}, selectedIDs(newLaneIterator([]sdkmempool.Iterator{nil}, next)))
+	require.Equal(t, []int{0, 1}, selectedIDs(newLaneIterator([]sdkmempool.Iterator{lane1}, nil)))
+	require.Nil(t, newLaneIterator(nil, nil))
+}
+
+func TestLaneMatches(t *testing.T) {
+	l := &lane{msgTypes: map[string]struct{}{sdk.MsgTypeURL(&banktypes.MsgSend{}): {}}}
+
+	require.True(t, l.matches(mockPolicyTx{msgs: []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}}}))
+	require.False(t, l.matches(mockPolicyTx{msgs: []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}}}))
+	require.False(t, l.matches(mockPolicyTx{}))
+}
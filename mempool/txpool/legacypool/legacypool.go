@@ -139,8 +139,8 @@ type BlockChain interface {
 type Config struct {
 	Locals    []common.Address // Addresses that should be treated by default as local
 	NoLocals  bool             // Whether local transaction handling should be disabled
-	Journal   string           // Journal of local transactions to survive node restarts
-	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
+	Journal   string           // Journal of pooled transactions to survive node restarts, disabled if empty
+	Rejournal time.Duration    // Time interval to regenerate the transaction journal
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
@@ -154,8 +154,11 @@ type Config struct {
 }
 
 // DefaultConfig contains the default configurations for the transaction pool.
+//
+// Note, unlike upstream the journal is disabled by default, since a relative path
+// would be resolved against the working directory of the node.
 var DefaultConfig = Config{
-	Journal:   "transactions.rlp",
+	Journal:   "",
 	Rejournal: time.Hour,
 
 	PriceLimit: 1,
@@ -173,6 +176,10 @@ var DefaultConfig = Config{
 // unreasonable or unworkable.
 func (config *Config) sanitize() Config {
 	conf := *config
+	if conf.Rejournal < time.Second {
+		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
+		conf.Rejournal = time.Second
+	}
 	if conf.PriceLimit < 1 {
 		log.Warn("Sanitizing invalid txpool price limit", "provided", conf.PriceLimit, "updated", DefaultConfig.PriceLimit)
 		conf.PriceLimit = DefaultConfig.PriceLimit
@@ -245,6 +252,9 @@ type LegacyPool struct {
 	all     *lookup                      // All transactions to allow lookups
 	priced  *pricedList                  // All transactions sorted by price
 
+	journal       *journal    // Journal of pooled transactions to back up to disk
+	journalLoaded atomic.Bool // Whether the journal has been replayed into the pool
+
 	reqResetCh      chan *txpoolResetRequest
 	reqPromoteCh    chan *accountSet
 	queueTxEventCh  chan *types.Transaction
@@ -287,6 +297,9 @@ func New(config Config, chain BlockChain) *LegacyPool {
 	}
 	pool.priced = newPricedList(pool.all)
 
+	if config.Journal != "" {
+		pool.journal = newTxJournal(config.Journal)
+	}
 	return pool
 }
 
@@ -328,6 +341,9 @@ func (pool *LegacyPool) Init(gasTip uint64, head *types.Header, reserver txpool.
 	pool.wg.Add(1)
 	go pool.scheduleReorgLoop()
 
+	// If journaling is enabled, load from disk
+	pool.loadJournal()
+
 	pool.wg.Add(1)
 	go pool.loop()
 	return nil
@@ -343,11 +359,13 @@ func (pool *LegacyPool) loop() {
 		prevPending, prevQueued, prevStales int
 
 		// Start the stats reporting and transaction eviction tickers
-		report = time.NewTicker(statsReportInterval)
-		evict  = time.NewTicker(evictionInterval)
+		report  = time.NewTicker(statsReportInterval)
+		evict   = time.NewTicker(evictionInterval)
+		journal = time.NewTicker(pool.config.Rejournal)
 	)
 	defer report.Stop()
 	defer evict.Stop()
+	defer journal.Stop()
 
 	// Notify tests that the init phase is done
 	close(pool.initDoneCh)
@@ -383,6 +401,16 @@ func (pool *LegacyPool) loop() {
 				}
 			}
 			pool.mu.Unlock()
+
+		// Handle transaction journal rotation
+		case <-journal.C:
+			if pool.journal != nil && pool.journalLoaded.Load() {
+				pool.mu.Lock()
+				if err := pool.journal.rotate(pool.journaled()); err != nil {
+					log.Warn("Failed to rotate transaction journal", "err", err)
+				}
+				pool.mu.Unlock()
+			}
 		}
 	}
 }
@@ -393,6 +421,13 @@ func (pool *LegacyPool) Close() error {
 	close(pool.reorgShutdownCh)
 	pool.wg.Wait()
 
+	if pool.journal != nil {
+		pool.mu.Lock()
+		if err := pool.journal.close(); err != nil {
+			log.Warn("Failed to close transaction journal", "err", err)
+		}
+		pool.mu.Unlock()
+	}
 	log.Info("Transaction pool stopped")
 	return nil
 }
@@ -402,6 +437,64 @@ func (pool *LegacyPool) Close() error {
 func (pool *LegacyPool) Reset(oldHead, newHead *types.Header) {
 	wait := pool.requestReset(oldHead, newHead)
 	<-wait
+
+	// The journal can only be replayed once the head state is available
+	pool.loadJournal()
+}
+
+// loadJournal replays the journaled transactions into the pool and rotates the
+// journal, if journaling is enabled and the state of the chain head is available.
+// The journal is only loaded once.
+//
+// Note, unlike upstream the journal may not be loaded when the pool is initialized,
+// since the state is not available before the first block after a node restart.
+// The journal is not rotated until it is loaded so that it is not overwritten.
+func (pool *LegacyPool) loadJournal() {
+	if pool.journal == nil || pool.journalLoaded.Load() {
+		return
+	}
+	pool.mu.RLock()
+	ready := pool.currentState != nil
+	pool.mu.RUnlock()
+	if !ready || !pool.journalLoaded.CompareAndSwap(false, true) {
+		return
+	}
+
+	if err := pool.journal.load(func(txs []*types.Transaction) []error {
+		return pool.Add(txs, false)
+	}); err != nil {
+		log.Warn("Failed to load transaction journal", "err", err)
+	}
+	pool.mu.Lock()
+	if err := pool.journal.rotate(pool.journaled()); err != nil {
+		log.Warn("Failed to rotate transaction journal", "err", err)
+	}
+	pool.mu.Unlock()
+}
+
+// journaled retrieves all the pending and queued transactions of the pool,
+// grouped by account and sorted by nonce.
+func (pool *LegacyPool) journaled() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions, len(pool.pending)+len(pool.queue))
+	for addr, list := range pool.pending {
+		txs[addr] = append(txs[addr], list.Flatten()...)
+	}
+	for addr, list := range pool.queue {
+		txs[addr] = append(txs[addr], list.Flatten()...)
+	}
+	return txs
+}
+
+// journalTx adds the specified transaction to the disk journal if journaling is
+// enabled. Transactions added before the journal is loaded are journaled by the
+// rotation following the load.
+func (pool *LegacyPool) journalTx(tx *types.Transaction) {
+	if pool.journal == nil {
+		return
+	}
+	if err := pool.journal.insert(tx); err != nil && !errors.Is(err, errNoActiveJournal) {
+		log.Warn("Failed to journal transaction", "err", err)
+	}
 }
 
 // SubscribeTransactions registers a subscription for new transaction events,
@@ -792,6 +885,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
+		pool.journalTx(tx)
 		pool.queueTxEvent(tx)
 		log.Trace("Pooled new executable transaction", "hash", hash, "from", from, "to", tx.To())
 
@@ -804,6 +898,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 	if err != nil {
 		return false, err
 	}
+	pool.journalTx(tx)
 
 	log.Trace("Pooled new future transaction", "hash", hash, "from", from, "to", tx.To())
 	return replaced, nil
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"path/filepath"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -2236,6 +2237,149 @@ func TestStatusCheck(t *testing.T) {
 	}
 }
 
+// Tests that the pooled transactions are journaled to disk and replayed into the
+// pool on restart, and that the journal is regenerated with the pool contents.
+func TestJournaling(t *testing.T) {
+	t.Parallel()
+
+	journal := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	// Create the original pool to inject transaction into the journal
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.Journal = journal
+	config.Rejournal = time.Second
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+
+	// Create two test accounts, one with executable and one with gapped transactions
+	sender, _ := crypto.GenerateKey()
+	gapped, _ := crypto.GenerateKey()
+
+	testAddBalance(pool, crypto.PubkeyToAddress(sender.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(gapped.PublicKey), big.NewInt(1000000000))
+
+	// Add three executable and a gapped transactions and ensure they are pooled
+	txs := types.Transactions{
+		pricedTransaction(0, 100000, big.NewInt(1), sender),
+		pricedTransaction(1, 100000, big.NewInt(1), sender),
+		pricedTransaction(2, 100000, big.NewInt(1), sender),
+		pricedTransaction(2, 100000, big.NewInt(1), gapped),
+	}
+	for _, err := range pool.addRemotesSync(txs) {
+		if err != nil {
+			t.Fatalf("failed to add transaction: %v", err)
+		}
+	}
+	pending, queued := pool.Stats()
+	if pending != 3 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 3)
+	}
+	if queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// Terminate the old pool, bump the sender nonce, create a new pool and ensure relevant transaction survive
+	pool.Close()
+	statedb.SetNonce(crypto.PubkeyToAddress(sender.PublicKey), 1, tracing.NonceChangeUnspecified)
+	blockchain = newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer))
+
+	pending, queued = pool.Stats()
+	if pending != 2 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 2)
+	}
+	if queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// Bump the nonce temporarily and ensure the newly invalidated transaction is removed
+	statedb.SetNonce(crypto.PubkeyToAddress(sender.PublicKey), 2, tracing.NonceChangeUnspecified)
+	<-pool.requestReset(nil, nil)
+	time.Sleep(2 * config.Rejournal)
+	pool.Close()
+
+	statedb.SetNonce(crypto.PubkeyToAddress(sender.PublicKey), 1, tracing.NonceChangeUnspecified)
+	blockchain = newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer))
+
+	pending, queued = pool.Stats()
+	if pending != 0 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 0)
+	}
+	if queued != 2 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 2)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	pool.Close()
+}
+
+// Tests that the journal is not replayed nor overwritten until the state of the
+// chain head is available, as after a node restart.
+func TestJournalingWithoutState(t *testing.T) {
+	t.Parallel()
+
+	journal := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.Journal = journal
+
+	// Journal a gapped transaction
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+	if err := pool.addRemoteSync(pricedTransaction(1, 100000, big.NewInt(1), key)); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	pool.Close()
+
+	// Restart the pool before the state is available
+	blockchain = newTestBlockChain(params.TestChainConfig, 1000000, nil, new(event.Feed))
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	if pool.journalLoaded.Load() {
+		t.Fatalf("journal loaded without state")
+	}
+	if _, queued := pool.Stats(); queued != 0 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 0)
+	}
+
+	// Make the state available and ensure the journal is replayed on reset
+	pool.mu.Lock()
+	blockchain.statedb = statedb
+	pool.mu.Unlock()
+	pool.Reset(nil, nil)
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer))
+
+	if !pool.journalLoaded.Load() {
+		t.Fatalf("journal not loaded after reset")
+	}
+	if _, queued := pool.Stats(); queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+}
+
 // Test the transaction slots consumption is computed correctly
 func TestSlotCount(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,184 @@
+package authz
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+// DefaultAllowedMsgTypes defines the message types that can be granted and
+// executed through the authz precompile by default.
+var DefaultAllowedMsgTypes = []string{
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawValidatorCommission{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+}
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract for authz.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	authzMsgServer  authztypes.MsgServer
+	authzQuerier    authztypes.QueryServer
+	codec           codec.Codec
+	addrCdc         address.Codec
+	allowedMsgTypes map[string]struct{}
+}
+
+// NewPrecompile creates a new authz Precompile instance as a
+// PrecompiledContract interface.
+//
+// Only the message types included in allowedMsgTypes can be granted and
+// executed through the precompile.
+func NewPrecompile(
+	authzMsgServer authztypes.MsgServer,
+	authzQuerier authztypes.QueryServer,
+	bankKeeper cmn.BankKeeper,
+	codec codec.Codec,
+	addrCdc address.Codec,
+	allowedMsgTypes []string,
+) *Precompile {
+	allowed := make(map[string]struct{}, len(allowedMsgTypes))
+	for _, msgType := range allowedMsgTypes {
+		allowed[msgType] = struct{}{}
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(evmtypes.AuthzPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:             ABI,
+		authzMsgServer:  authzMsgServer,
+		authzQuerier:    authzQuerier,
+		codec:           codec,
+		addrCdc:         addrCdc,
+		allowedMsgTypes: allowed,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+
+	switch method.Name {
+	// authz transactions
+	case GrantMethod:
+		bz, err = p.Grant(ctx, contract, stateDB, method, args)
+	case RevokeMethod:
+		bz, err = p.Revoke(ctx, contract, stateDB, method, args)
+	case ExecMethod:
+		bz, err = p.Exec(ctx, contract, stateDB, method, args)
+	// authz queries
+	case GrantsMethod:
+		bz, err = p.Grants(ctx, method, contract, args)
+	case GranterGrantsMethod:
+		bz, err = p.GranterGrants(ctx, method, contract, args)
+	case GranteeGrantsMethod:
+		bz, err = p.GranteeGrants(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available authz transactions are:
+// - Grant
+// - Revoke
+// - Exec
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case GrantMethod,
+		RevokeMethod,
+		ExecMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "authz")
+}
+
+// isAllowedMsgType returns true if the given message type can be granted and
+// executed through the precompile.
+func (p Precompile) isAllowedMsgType(msgType string) bool {
+	_, ok := p.allowedMsgTypes[msgType]
+	return ok
+}
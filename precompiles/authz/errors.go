@@ -0,0 +1,18 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type: %v"
+	// ErrInvalidExpiration is raised when the authorization expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrMsgTypeNotAllowed is raised when the message type cannot be granted or executed through the precompile.
+	ErrMsgTypeNotAllowed = "message type %s is not allowed in the authz precompile"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrEmptyMsgs is raised when no messages are provided to execute.
+	ErrEmptyMsgs = "messages to execute cannot be empty"
+)
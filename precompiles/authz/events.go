@@ -0,0 +1,143 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrant defines the event type for the authz Grant transaction.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the authz Revoke transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeExec defines the event type for the authz Exec transaction.
+	EventTypeExec = "Exec"
+)
+
+// EmitGrantEvent creates a new event emitted on a Grant transaction.
+func (p Precompile) EmitGrantEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+	msgType string,
+	expiration int64,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeGrant]
+	topics, err := p.createGrantTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(msgType, expiration)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+	msgType string,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeRevoke]
+	topics, err := p.createGrantTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(msgType)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitExecEvent creates a new event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	grantee common.Address,
+	msgTypes []string,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeExec]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(msgTypes)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// createGrantTopics returns the topics shared by the grant and revoke events,
+// which index the granter and the grantee.
+func (p Precompile) createGrantTopics(event abi.Event, granter, grantee common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,104 @@
+package authz
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the authorizations given by a granter to a grantee. If the
+// message type is set, only the authorization for that type is returned.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.Grants(ctx, req)
+	if err != nil {
+		// NOTE: the authz module returns an error when filtering by a message
+		// type that has no authorization, return an empty list instead.
+		if !errors.Is(err, authztypes.ErrNoAuthorizationFound) {
+			return nil, err
+		}
+		res = &authztypes.QueryGrantsResponse{}
+	}
+
+	// NOTE: the arguments were already validated when building the request
+	granter, grantee := args[0].(common.Address), args[1].(common.Address)
+	out, err := new(GrantsOutput).FromGrantsResponse(res, granter, grantee, p.codec)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GranterGrants returns all the authorizations given by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GranteeGrants returns all the authorizations received by a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
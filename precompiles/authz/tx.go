@@ -0,0 +1,178 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization for a whitelisted message type from the
+// caller to the grantee.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the authorization was set by NewMsgGrant, so the cached value is always present
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+	msgType := authorization.MsgTypeURL()
+	expiration := expirationUnix(msg.Grant.Expiration)
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, msg_type: %s, expiration: %d }",
+			granter, grantee, msgType, expiration,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if !p.isAllowedMsgType(msgType) {
+		return nil, fmt.Errorf(ErrMsgTypeNotAllowed, msgType)
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.authzMsgServer.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the grant transaction
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, msgType, expiration); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes an authorization given by the caller to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, msg_type: %s }",
+			granter, grantee, msg.MsgTypeUrl,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.authzMsgServer.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the revoke transaction
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes whitelisted messages on behalf of their signers using the
+// authorizations given to the caller.
+func (p Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, msgTypes, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ grantee: %s, msg_types: %v }",
+			grantee, msgTypes,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != grantee {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), grantee.String())
+	}
+
+	// NOTE: only whitelisted messages can be executed. This also prevents nesting
+	// MsgExec messages and dispatching EVM transactions from the precompile.
+	for _, msgType := range msgTypes {
+		if !p.isAllowedMsgType(msgType) {
+			return nil, fmt.Errorf(ErrMsgTypeNotAllowed, msgType)
+		}
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.authzMsgServer.Exec(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the exec transaction
+	if err = p.EmitExecEvent(ctx, stateDB, grantee, msgTypes); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
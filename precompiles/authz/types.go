@@ -0,0 +1,412 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// GrantData defines an authorization given by a granter to a grantee.
+type GrantData struct {
+	Granter    common.Address `abi:"granter"`
+	Grantee    common.Address `abi:"grantee"`
+	MsgType    string         `abi:"msgType"`
+	Expiration int64          `abi:"expiration"`
+}
+
+// GrantsInput defines the input for the grants query.
+type GrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Grantee    common.Address    `abi:"grantee"`
+	MsgType    string            `abi:"msgType"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranterGrantsInput defines the input for the granterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranteeGrantsInput defines the input for the granteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GrantsOutput defines the output for the grants, granterGrants and granteeGrants queries.
+type GrantsOutput struct {
+	Grants       []GrantData        `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// EventGrant defines the event data for the Grant transaction.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgType    string
+	Expiration int64
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter common.Address
+	Grantee common.Address
+	MsgType string
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee  common.Address
+	MsgTypes []string
+}
+
+// NewMsgGrant creates a new MsgGrant instance with a generic authorization for the
+// given message type and does sanity checks on the given arguments before populating the message.
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgType, ok := args[2].(string)
+	if !ok || msgType == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	expirationUnix, ok := args[3].(int64)
+	if !ok || expirationUnix < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	// NOTE: a zero expiration defines an authorization that never expires
+	var expiration *time.Time
+	if expirationUnix > 0 {
+		exp := time.Unix(expirationUnix, 0).UTC()
+		expiration = &exp
+	}
+
+	granterAddr, granteeAddr, err := toBech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authztypes.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant:   authztypes.Grant{Expiration: expiration},
+	}
+	if err := msg.SetAuthorization(authztypes.NewGenericAuthorization(msgType)); err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authztypes.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgType, ok := args[2].(string)
+	if !ok || msgType == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	granterAddr, granteeAddr, err := toBech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authztypes.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgType,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance from the JSON encoded messages and does
+// sanity checks on the given arguments before populating the message. It returns the
+// message, the grantee and the type URLs of the messages to execute.
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authztypes.MsgExec, common.Address, []string, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, args[1])
+	}
+	if len(jsonMsgs) == 0 {
+		return nil, common.Address{}, nil, errors.New(ErrEmptyMsgs)
+	}
+
+	msgAnys := make([]*codectypes.Any, len(jsonMsgs))
+	msgTypes := make([]string, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(jsonMsg, &msg); err != nil {
+			return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, fmt.Errorf("message %d: %w", i, err))
+		}
+
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, nil, err
+		}
+
+		msgAnys[i] = msgAny
+		msgTypes[i] = sdk.MsgTypeURL(msg)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authztypes.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    msgAnys,
+	}
+
+	return msg, grantee, msgTypes, nil
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granterAddr, granteeAddr, err := toBech32Addresses(addrCdc, input.Granter, input.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authztypes.QueryGrantsRequest{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: input.MsgType,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	return &authztypes.QueryGranterGrantsRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authztypes.QueryGranteeGrantsRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse. The
+// response doesn't include the granter and grantee, so they're taken from the request.
+func (o *GrantsOutput) FromGrantsResponse(
+	res *authztypes.QueryGrantsResponse,
+	granter, grantee common.Address,
+	cdc codec.Codec,
+) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(res.Grants))
+	for i, grant := range res.Grants {
+		msgType, err := authorizationMsgType(cdc, grant.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		o.Grants[i] = GrantData{
+			Granter:    granter,
+			Grantee:    grantee,
+			MsgType:    msgType,
+			Expiration: expirationUnix(grant.Expiration),
+		}
+	}
+
+	o.setPageResponse(res.Pagination)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grant authorizations returned
+// by the granterGrants and granteeGrants queries.
+func (o *GrantsOutput) FromGrantAuthorizations(
+	grants []*authztypes.GrantAuthorization,
+	pageRes *query.PageResponse,
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		granter, err := addrCdc.StringToBytes(grant.Granter)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGranter, grant.Granter)
+		}
+
+		grantee, err := addrCdc.StringToBytes(grant.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGrantee, grant.Grantee)
+		}
+
+		msgType, err := authorizationMsgType(cdc, grant.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		o.Grants[i] = GrantData{
+			Granter:    common.BytesToAddress(granter),
+			Grantee:    common.BytesToAddress(grantee),
+			MsgType:    msgType,
+			Expiration: expirationUnix(grant.Expiration),
+		}
+	}
+
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// parseGranterAndGrantee parses the granter and grantee addresses from the
+// first two arguments.
+func parseGranterAndGrantee(args []interface{}) (common.Address, common.Address, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// toBech32Addresses converts the granter and grantee addresses to their
+// bech32 string representation.
+func toBech32Addresses(addrCdc address.Codec, granter, grantee common.Address) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
+
+// authorizationMsgType returns the message type URL of the packed authorization.
+func authorizationMsgType(cdc codec.Codec, authorizationAny *codectypes.Any) (string, error) {
+	var authorization authztypes.Authorization
+	if err := cdc.UnpackAny(authorizationAny, &authorization); err != nil {
+		return "", fmt.Errorf("failed to unpack authorization: %w", err)
+	}
+
+	return authorization.MsgTypeURL(), nil
+}
+
+// expirationUnix returns the unix timestamp of the expiration, or zero if the
+// authorization never expires.
+func expirationUnix(expiration *time.Time) int64 {
+	if expiration == nil {
+		return 0
+	}
+	return expiration.Unix()
+}
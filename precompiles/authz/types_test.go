@@ -0,0 +1,215 @@
+package authz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/encoding"
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/server/config"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestNewMsgGrant(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	expGranter, err := addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	expGrantee, err := addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		args           []interface{}
+		wantErr        bool
+		errMsg         string
+		wantExpiration *time.Time
+	}{
+		{
+			name: "valid without expiration",
+			args: []interface{}{granter, grantee, msgType, int64(0)},
+		},
+		{
+			name:           "valid with expiration",
+			args:           []interface{}{granter, grantee, msgType, int64(1000)},
+			wantExpiration: func() *time.Time { exp := time.Unix(1000, 0).UTC(); return &exp }(),
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty granter",
+			args:    []interface{}{common.Address{}, grantee, msgType, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid granter address",
+		},
+		{
+			name:    "invalid grantee type",
+			args:    []interface{}{granter, "grantee", msgType, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+		{
+			name:    "empty message type",
+			args:    []interface{}{granter, grantee, "", int64(0)},
+			wantErr: true,
+			errMsg:  "invalid message type",
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, msgType, int64(-1)},
+			wantErr: true,
+			errMsg:  "invalid expiration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotGranter, gotGrantee, err := NewMsgGrant(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, gotGranter)
+			require.Equal(t, grantee, gotGrantee)
+			require.Equal(t, expGranter, msg.Granter)
+			require.Equal(t, expGrantee, msg.Grantee)
+			require.Equal(t, tt.wantExpiration, msg.Grant.Expiration)
+
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, msgType, authorization.MsgTypeURL())
+		})
+	}
+}
+
+func TestNewMsgExec(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(config.DefaultEVMChainID)
+	stakingtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := encodingConfig.Codec
+
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	delegator, err := addrCodec.BytesToString(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	require.NoError(t, err)
+
+	delegateMsg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: "cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		Amount:           sdk.NewInt64Coin("aatom", 100),
+	}
+	delegateJSON, err := cdc.MarshalInterfaceJSON(delegateMsg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{grantee, [][]byte{delegateJSON}},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "empty grantee",
+			args:    []interface{}{common.Address{}, [][]byte{delegateJSON}},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+		{
+			name:    "empty messages",
+			args:    []interface{}{grantee, [][]byte{}},
+			wantErr: true,
+			errMsg:  ErrEmptyMsgs,
+		},
+		{
+			name:    "invalid messages type",
+			args:    []interface{}{grantee, []byte("msg")},
+			wantErr: true,
+			errMsg:  "invalid messages",
+		},
+		{
+			name:    "unknown message type",
+			args:    []interface{}{grantee, [][]byte{[]byte(`{"@type":"/unknown.MsgUnknown"}`)}},
+			wantErr: true,
+			errMsg:  "message 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotGrantee, msgTypes, err := NewMsgExec(tt.args, cdc, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, grantee, gotGrantee)
+			require.Equal(t, []string{sdk.MsgTypeURL(delegateMsg)}, msgTypes)
+
+			msgs, err := msg.GetMessages()
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			require.Equal(t, delegateMsg, msgs[0])
+		})
+	}
+}
+
+func TestFromGrantAuthorizations(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(config.DefaultEVMChainID)
+	authztypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := encodingConfig.Codec
+
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	granterAddr, granteeAddr, err := toBech32Addresses(addrCodec, granter, grantee)
+	require.NoError(t, err)
+
+	msgType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	authorizationAny, err := codectypes.NewAnyWithValue(authztypes.NewGenericAuthorization(msgType))
+	require.NoError(t, err)
+	expiration := time.Unix(1000, 0).UTC()
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(
+		[]*authztypes.GrantAuthorization{
+			{Granter: granterAddr, Grantee: granteeAddr, Authorization: authorizationAny, Expiration: &expiration},
+			{Granter: granterAddr, Grantee: granteeAddr, Authorization: authorizationAny},
+		},
+		nil,
+		cdc,
+		addrCodec,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []GrantData{
+		{Granter: granter, Grantee: grantee, MsgType: msgType, Expiration: 1000},
+		{Granter: granter, Grantee: grantee, MsgType: msgType, Expiration: 0},
+	}, out.Grants)
+}
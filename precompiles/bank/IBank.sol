@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,73 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the coins of a multiSend transfer.
+struct Output {
+    /// to defines the recipient address.
+    address to;
+    /// amount defines the coins sent to the recipient.
+    Coin[] amount;
+}
+
+/// @dev DenomUnit represents a unit of a denomination with its exponent.
+struct DenomUnit {
+    /// denom defines the name of the unit.
+    string denom;
+    /// exponent defines the power of 10 to multiply the base unit by.
+    uint32 exponent;
+    /// aliases defines the aliases of the unit.
+    string[] aliases;
+}
+
+/// @dev DenomMetadata represents the x/bank metadata of a denomination.
+struct DenomMetadata {
+    string description;
+    DenomUnit[] denomUnits;
+    string base;
+    string display;
+    string name;
+    string symbol;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module and
+ * for sending native coins of the caller.
  */
 interface IBank {
+    /// @dev Send defines an event emitted when native coins are sent through the precompile.
+    /// @param from the address of the sender.
+    /// @param to the address of the recipient.
+    /// @param denom the denomination of the sent coins.
+    /// @param amount the amount of sent coins.
+    event Send(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev send defines a method for sending native coins of any x/bank denomination
+    /// from the caller to the given address.
+    /// @param to the address of the recipient.
+    /// @param denom the denomination of the coins to send.
+    /// @param amount the amount of coins to send.
+    /// @return success true if the coins were sent.
+    function send(
+        address to,
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins from the caller
+    /// to multiple addresses.
+    /// @param outputs the recipients and the coins sent to each of them.
+    /// @return success true if the coins were sent.
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -39,4 +102,11 @@ interface IBank {
     function supplyOf(
         address erc20Address
     ) external view returns (uint256 totalSupply);
+
+    /// @dev denomMetadata defines a method for retrieving the x/bank metadata of a denomination.
+    /// @param denom the denomination to query the metadata for.
+    /// @return metadata the metadata, empty if the denomination has no metadata.
+    function denomMetadata(
+        string memory denom
+    ) external view returns (DenomMetadata memory metadata);
 }
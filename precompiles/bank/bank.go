@@ -1,7 +1,8 @@
 //
 // The bank package contains the implementation of the x/bank module precompile.
 // The precompiles returns all bank's information in the original decimals
-// representation stored in the module.
+// representation stored in the module, and allows to send native coins of any
+// x/bank denomination from the caller.
 
 package bank
 
@@ -30,6 +31,13 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasDenomMetadata defines the gas cost for a single denomMetadata query, taken from name of ERC20
+	GasDenomMetadata = 3_421
+
+	// GasSend defines the gas cost for a single send transaction, taken from transfer of ERC20.
+	// It is charged for each recipient of a multiSend transaction.
+	GasSend = 9_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -69,9 +77,10 @@ func NewPrecompile(
 	// during the run execution
 	return &Precompile{
 		Precompile: cmn.Precompile{
-			KvGasConfig:          storetypes.GasConfig{},
-			TransientKVGasConfig: storetypes.GasConfig{},
-			ContractAddress:      common.HexToAddress(evmtypes.BankPrecompileAddress),
+			KvGasConfig:           storetypes.GasConfig{},
+			TransientKVGasConfig:  storetypes.GasConfig{},
+			ContractAddress:       common.HexToAddress(evmtypes.BankPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
 		},
 		ABI:         ABI,
 		bankKeeper:  bankKeeper,
@@ -101,6 +110,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case DenomMetadataMethod:
+		return GasDenomMetadata
+	case SendMethod, MultiSendMethod:
+		return GasSend
 	}
 
 	return 0
@@ -108,12 +121,18 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
 	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
-		return p.Execute(ctx, contract, readonly)
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
 	})
 }
 
-// Execute executes the precompiled contract bank query methods defined in the ABI.
-func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+// Execute executes the precompiled contract bank methods defined in the ABI.
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	// The bank precompile cannot receive funds, since they could not be
+	// recovered from the precompile address.
+	if value := contract.Value(); value.Sign() == 1 {
+		return nil, fmt.Errorf(ErrCannotReceiveFunds, value.String())
+	}
+
 	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
@@ -121,6 +140,11 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 
 	var bz []byte
 	switch method.Name {
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, contract, stateDB, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, method, args)
@@ -128,6 +152,8 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 		bz, err = p.TotalSupply(ctx, method, args)
 	case SupplyOfMethod:
 		bz, err = p.SupplyOf(ctx, method, args)
+	case DenomMetadataMethod:
+		bz, err = p.DenomMetadata(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -136,7 +162,12 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SendMethod,
+		MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
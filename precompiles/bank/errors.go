@@ -0,0 +1,7 @@
+package bank
+
+// Errors that have formatted information are defined here as a string.
+const (
+	// ErrCannotReceiveFunds is raised when the precompile is called with a value.
+	ErrCannotReceiveFunds = "cannot receive funds, received: %s"
+)
@@ -19,6 +19,9 @@ const (
 	// SupplyOfMethod defines the ABI method name for the bank SupplyOf
 	// query.
 	SupplyOfMethod = "supplyOf"
+	// DenomMetadataMethod defines the ABI method name for the bank
+	// DenomMetadata query.
+	DenomMetadataMethod = "denomMetadata"
 )
 
 // Balances returns given account's balances of all tokens registered in the x/bank module
@@ -127,3 +130,20 @@ func (p Precompile) SupplyOf(
 
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
+
+// DenomMetadata returns the x/bank metadata of a given denomination. If the
+// denomination has no metadata, the method returns empty metadata.
+func (p Precompile) DenomMetadata(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := ParseDenomMetadataArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the denom metadata in bank precompile: %s", err)
+	}
+
+	metadata, _ := p.bankKeeper.GetDenomMetaData(ctx, denom)
+
+	return method.Outputs.Pack(NewDenomMetadataResponse(metadata))
+}
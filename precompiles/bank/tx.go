@@ -0,0 +1,119 @@
+package bank
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/erc20"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends the given amount of native coins of any x/bank denomination from
+// the caller to the destination address.
+//
+// NOTE: as with the ERC-20 precompile transfer method, only the caller's coins
+// can be sent and the x/bank send restrictions apply.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, coin, err := ParseSendArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.send(ctx, stateDB, contract.Caller(), to, sdk.Coins{coin}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends native coins from the caller to each of the given outputs.
+// The gas of a send transaction is charged for each coin of each output after
+// the first one.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	outputs, err := ParseMultiSendArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	sends := 0
+	for _, output := range outputs {
+		coins, err := cmn.NewSdkCoinsFromCoins(output.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		for range coins {
+			// NOTE: we already charged for a single send so we don't need to
+			// charge on the first coin of the first output
+			if sends > 0 {
+				ctx.GasMeter().ConsumeGas(GasSend, "bank precompile multiSend method")
+			}
+			sends++
+		}
+
+		if err := p.send(ctx, stateDB, contract.Caller(), output.To, coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// send executes a bank Send message through the same message server used by
+// the ERC-20 precompile and emits a Send event for each of the sent coins.
+// The resulting changes of the native balances are applied to the stateDB by
+// the balance handler of the precompile.
+func (p Precompile) send(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	from, to common.Address,
+	coins sdk.Coins,
+) error {
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	msgSrv := erc20.NewMsgServerImpl(p.bankKeeper)
+	if err := msgSrv.Send(ctx, msg); err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		if err := p.EmitSendEvent(ctx, stateDB, from, to, coin.Denom, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/zenanetwork/zena/precompiles/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Balance contains the amount for a corresponding ERC-20 contract address.
@@ -17,6 +21,60 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Output contains the recipient and the coins of a multiSend transfer.
+type Output struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// MultiSendInput defines the input of the bank MultiSend transaction.
+type MultiSendInput struct {
+	Outputs []Output
+}
+
+// DenomUnit represents a unit of a denomination with its exponent.
+type DenomUnit struct {
+	Denom    string
+	Exponent uint32
+	Aliases  []string
+}
+
+// DenomMetadata contains the x/bank metadata of a denomination.
+type DenomMetadata struct {
+	Description string
+	DenomUnits  []DenomUnit
+	Base        string
+	Display     string
+	Name        string
+	Symbol      string
+}
+
+// NewDenomMetadataResponse converts the x/bank metadata of a denomination to
+// its ABI representation.
+func NewDenomMetadataResponse(metadata banktypes.Metadata) DenomMetadata {
+	denomUnits := make([]DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		aliases := unit.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		denomUnits[i] = DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  aliases,
+		}
+	}
+
+	return DenomMetadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +102,60 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseDenomMetadataArgs parses the call arguments for the bank DenomMetadata query.
+func ParseDenomMetadataArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[0])
+	}
+
+	return denom, nil
+}
+
+// ParseSendArgs parses the call arguments for the bank Send transaction and
+// returns the recipient address and the coin to send.
+func ParseSendArgs(args []interface{}) (common.Address, sdk.Coin, error) {
+	if len(args) != 3 {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidType, "amount", &big.Int{}, args[2])
+	}
+
+	return to, sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)}, nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+func ParseMultiSendArgs(method *abi.Method, args []interface{}) ([]Output, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to MultiSendInput: %s", err)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, fmt.Errorf("no outputs provided")
+	}
+
+	return input.Outputs, nil
+}
@@ -6,10 +6,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/holiman/uint256"
 
 	"github.com/zenanetwork/zena/utils"
 	precisebanktypes "github.com/zenanetwork/zena/x/precisebank/types"
 	"github.com/zenanetwork/zena/x/vm/statedb"
+	vmtracers "github.com/zenanetwork/zena/x/vm/tracers"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -65,8 +68,11 @@ func (bh *BalanceHandler) BeforeBalanceChange(ctx sdk.Context) {
 //
 // To prevent this, balance changes from events involving blocked addresses are not applied to the StateDB.
 // Instead, the state changes resulting from the precompile call are applied directly via the MultiStore.
+//
+// The applied balance changes are reported to the Cosmos hooks of the context, if any.
 func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.StateDB) error {
 	events := ctx.EventManager().Events()
+	cosmosHooks := vmtracers.CosmosHooksFromContext(ctx)
 
 	for i, event := range events[bh.prevEventsLen:] {
 		eventIdx := bh.prevEventsLen + i
@@ -93,6 +99,7 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 			}
 
 			stateDB.SubBalance(common.BytesToAddress(spenderAddr.Bytes()), amount, tracing.BalanceChangeUnspecified)
+			traceBalanceChange(cosmosHooks, common.BytesToAddress(spenderAddr.Bytes()), amount, false)
 
 		case banktypes.EventTypeCoinReceived:
 			receiverAddr, err := ParseAddress(event, banktypes.AttributeKeyReceiver)
@@ -110,8 +117,14 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 			}
 
 			stateDB.AddBalance(common.BytesToAddress(receiverAddr.Bytes()), amount, tracing.BalanceChangeUnspecified)
+			traceBalanceChange(cosmosHooks, common.BytesToAddress(receiverAddr.Bytes()), amount, true)
 
 		case precisebanktypes.EventTypeFractionalBalanceChange:
+			// Only the fractional balances of the EVM coin are part of the EVM balances
+			if denom, ok := event.GetAttribute(precisebanktypes.AttributeKeyDenom); ok && denom.Value != evmtypes.GetEVMCoinExtendedDenom() {
+				continue
+			}
+
 			addr, err := ParseAddress(event, precisebanktypes.AttributeKeyAddress)
 			if err != nil {
 				return fmt.Errorf("failed to parse address from event %q: %w", precisebanktypes.EventTypeFractionalBalanceChange, err)
@@ -133,8 +146,10 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 
 			if delta.Sign() == 1 {
 				stateDB.AddBalance(common.BytesToAddress(addr.Bytes()), deltaAbs, tracing.BalanceChangeUnspecified)
+				traceBalanceChange(cosmosHooks, common.BytesToAddress(addr.Bytes()), deltaAbs, true)
 			} else if delta.Sign() == -1 {
 				stateDB.SubBalance(common.BytesToAddress(addr.Bytes()), deltaAbs, tracing.BalanceChangeUnspecified)
+				traceBalanceChange(cosmosHooks, common.BytesToAddress(addr.Bytes()), deltaAbs, false)
 			}
 
 		default:
@@ -144,3 +159,10 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 
 	return nil
 }
+
+// traceBalanceChange reports a balance change applied to the stateDB to the Cosmos hooks, if any.
+func traceBalanceChange(hooks *vmtracers.CosmosHooks, addr common.Address, amount *uint256.Int, isAdd bool) {
+	if hooks != nil && hooks.OnCosmosBalanceChange != nil {
+		hooks.OnCosmosBalanceChange(addr, amount, isAdd)
+	}
+}
@@ -0,0 +1,88 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/zenanetwork/zena/x/vm/statedb"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type msgFilterKey struct{}
+
+// paramsKeeper defines the EVM keeper method used to read the msg filter.
+type paramsKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// withMsgFilter returns a copy of the context carrying the keeper of the stateDB,
+// from which the msg filter of the EVM params is read when a Cosmos message is
+// executed by the precompile.
+func withMsgFilter(ctx sdk.Context, keeper statedb.Keeper) sdk.Context {
+	paramsKeeper, ok := keeper.(paramsKeeper)
+	if !ok {
+		return ctx
+	}
+	return ctx.WithValue(msgFilterKey{}, paramsKeeper)
+}
+
+// CheckCosmosMsg returns an error if the Cosmos message executed by the precompile
+// is blocked by the msg filter of the EVM params, so that the precompiles apply the
+// same filter as the Cosmos ante handler. The messages executed or granted through
+// authz are also checked against the msgs blocked within authz.
+//
+// The ERC-20 precompiles don't check their bank messages since they implement the
+// token interface of the EVM rather than executing Cosmos messages of the caller.
+func CheckCosmosMsg(ctx sdk.Context, msg sdk.Msg) error {
+	keeper, ok := ctx.Value(msgFilterKey{}).(paramsKeeper)
+	if !ok {
+		return nil
+	}
+	msgFilter := keeper.GetParams(ctx).MsgFilter
+	if len(msgFilter.BlockedMsgTypeURLs) == 0 && len(msgFilter.BlockedAuthzMsgTypeURLs) == 0 {
+		return nil
+	}
+	if err := checkBlockedMsg(msgFilter, msg, false); err != nil {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, err.Error())
+	}
+	return nil
+}
+
+// checkBlockedMsg returns an error if the message, or one of the messages it executes
+// or grants through authz, is blocked.
+func checkBlockedMsg(msgFilter evmtypes.MsgFilter, msg sdk.Msg, isAuthzMsg bool) error {
+	url := sdk.MsgTypeURL(msg)
+	if slices.Contains(msgFilter.BlockedMsgTypeURLs, url) ||
+		(isAuthzMsg && slices.Contains(msgFilter.BlockedAuthzMsgTypeURLs, url)) {
+		return fmt.Errorf("found blocked msg type: %s", url)
+	}
+
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		innerMsgs, err := msg.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, innerMsg := range innerMsgs {
+			if err := checkBlockedMsg(msgFilter, innerMsg, true); err != nil {
+				return err
+			}
+		}
+	case *authz.MsgGrant:
+		authorization, err := msg.GetAuthorization()
+		if err != nil {
+			return err
+		}
+		url := authorization.MsgTypeURL()
+		if slices.Contains(msgFilter.BlockedMsgTypeURLs, url) || slices.Contains(msgFilter.BlockedAuthzMsgTypeURLs, url) {
+			return fmt.Errorf("found blocked msg type: %s", url)
+		}
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/zenanetwork/zena/x/vm/statedb"
+	vmtracers "github.com/zenanetwork/zena/x/vm/tracers"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -91,6 +92,7 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
 		WithKVGasConfig(p.KvGasConfig).
 		WithTransientKVGasConfig(p.TransientKVGasConfig)
+	ctx = withMsgFilter(ctx, stateDB.Keeper())
 
 	// we need to consume the gas that was already used by the EVM
 	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
@@ -104,6 +106,10 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 		balanceHandler.BeforeBalanceChange(ctx)
 	}
 
+	// record the events emitted before the call to only trace the ones emitted by the precompile
+	cosmosHooks := vmtracers.CosmosHooksFromContext(ctx)
+	prevEventsLen := len(ctx.EventManager().Events())
+
 	bz, err = action(ctx)
 	if err != nil {
 		return bz, err
@@ -111,6 +117,8 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
 
+	traceCosmosEvents(cosmosHooks, ctx.EventManager().Events()[prevEventsLen:])
+
 	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
 		return nil, vm.ErrOutOfGas
 	}
@@ -121,6 +129,10 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 		}
 	}
 
+	if cosmosHooks != nil && cosmosHooks.OnCosmosStoreGas != nil {
+		cosmosHooks.OnCosmosStoreGas(cost)
+	}
+
 	return bz, nil
 }
 
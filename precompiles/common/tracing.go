@@ -0,0 +1,28 @@
+package common
+
+import (
+	vmtracers "github.com/zenanetwork/zena/x/vm/tracers"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TraceCosmosMsg reports the Cosmos message executed by the precompile to the
+// tracer of the EVM call, if any. It must be called before executing the message
+// so that the events it emits are traced after it.
+func TraceCosmosMsg(ctx sdk.Context, msg sdk.Msg) {
+	hooks := vmtracers.CosmosHooksFromContext(ctx)
+	if hooks != nil && hooks.OnCosmosMsg != nil {
+		hooks.OnCosmosMsg(msg)
+	}
+}
+
+// traceCosmosEvents reports the SDK events emitted by the precompile to the
+// tracer of the EVM call, if any.
+func traceCosmosEvents(hooks *vmtracers.CosmosHooks, events sdk.Events) {
+	if hooks == nil || hooks.OnCosmosEvent == nil {
+		return
+	}
+	for _, event := range events {
+		hooks.OnCosmosEvent(event)
+	}
+}
@@ -102,6 +102,11 @@ func (p Precompile) SetWithdrawAddress(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	if _, err = p.distributionMsgServer.SetWithdrawAddress(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -131,6 +136,11 @@ func (p *Precompile) WithdrawDelegatorReward(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	res, err := p.distributionMsgServer.WithdrawDelegatorReward(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -161,6 +171,11 @@ func (p *Precompile) WithdrawValidatorCommission(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), validatorHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	res, err := p.distributionMsgServer.WithdrawValidatorCommission(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -191,6 +206,11 @@ func (p *Precompile) FundCommunityPool(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	_, err = p.distributionMsgServer.FundCommunityPool(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -222,6 +242,11 @@ func (p *Precompile) DepositValidatorRewardsPool(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	_, err = p.distributionMsgServer.DepositValidatorRewardsPool(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -111,6 +113,8 @@ func (p *Precompile) transfer(
 		}
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	msgSrv := NewMsgServerImpl(p.BankKeeper)
 	if err = msgSrv.Send(ctx, msg); err != nil {
 		// This should return an error to avoid the contract from being executed and an event being emitted
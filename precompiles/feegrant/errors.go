@@ -0,0 +1,18 @@
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidExpiration is raised when the allowance expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrInvalidAllowedMsgs is raised when the allowed messages are not valid.
+	ErrInvalidAllowedMsgs = "invalid allowed messages: %v"
+	// ErrUnknownAllowanceType is raised when the allowance type is not supported by the precompile.
+	ErrUnknownAllowanceType = "unknown allowance type %T"
+)
@@ -0,0 +1,95 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrantAllowance defines the event type for the feegrant grant allowance transactions.
+	EventTypeGrantAllowance = "GrantAllowance"
+	// EventTypeRevokeAllowance defines the event type for the feegrant RevokeAllowance transaction.
+	EventTypeRevokeAllowance = "RevokeAllowance"
+)
+
+// EmitGrantAllowanceEvent creates a new event emitted on the grant allowance transactions.
+func (p Precompile) EmitGrantAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+	allowanceType string,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeGrantAllowance]
+	topics, err := p.createAllowanceTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(allowanceType)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitRevokeAllowanceEvent creates a new event emitted on a RevokeAllowance transaction.
+func (p Precompile) EmitRevokeAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	granter, grantee common.Address,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeRevokeAllowance]
+	topics, err := p.createAllowanceTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// createAllowanceTopics returns the topics shared by the allowance events,
+// which index the granter and the grantee.
+func (p Precompile) createAllowanceTopics(event abi.Event, granter, grantee common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
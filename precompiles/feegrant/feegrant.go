@@ -0,0 +1,150 @@
+package feegrant
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract for feegrant.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	feegrantKeeper    feegrantkeeper.Keeper
+	feegrantMsgServer feegrant.MsgServer
+	addrCdc           address.Codec
+}
+
+// NewPrecompile creates a new feegrant Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	feegrantKeeper feegrantkeeper.Keeper,
+	feegrantMsgServer feegrant.MsgServer,
+	bankKeeper cmn.BankKeeper,
+	addrCdc address.Codec,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(evmtypes.FeegrantPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:               ABI,
+		feegrantKeeper:    feegrantKeeper,
+		feegrantMsgServer: feegrantMsgServer,
+		addrCdc:           addrCdc,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+
+	switch method.Name {
+	// feegrant transactions
+	case GrantBasicAllowanceMethod:
+		bz, err = p.GrantBasicAllowance(ctx, contract, stateDB, method, args)
+	case GrantPeriodicAllowanceMethod:
+		bz, err = p.GrantPeriodicAllowance(ctx, contract, stateDB, method, args)
+	case GrantAllowedMsgAllowanceMethod:
+		bz, err = p.GrantAllowedMsgAllowance(ctx, contract, stateDB, method, args)
+	case RevokeAllowanceMethod:
+		bz, err = p.RevokeAllowance(ctx, contract, stateDB, method, args)
+	// feegrant queries
+	case AllowanceMethod:
+		bz, err = p.Allowance(ctx, method, contract, args)
+	case AllowancesMethod:
+		bz, err = p.Allowances(ctx, method, contract, args)
+	case AllowancesByGranterMethod:
+		bz, err = p.AllowancesByGranter(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available feegrant transactions are:
+// - GrantBasicAllowance
+// - GrantPeriodicAllowance
+// - GrantAllowedMsgAllowance
+// - RevokeAllowance
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case GrantBasicAllowanceMethod,
+		GrantPeriodicAllowanceMethod,
+		GrantAllowedMsgAllowanceMethod,
+		RevokeAllowanceMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "feegrant")
+}
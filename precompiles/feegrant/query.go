@@ -0,0 +1,111 @@
+package feegrant
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the ABI method name for the feegrant AllowancesByGranter query.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance returns the fee allowance given by a granter to a grantee. If there
+// is no allowance, an empty allowance is returned.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := p.feegrantKeeper.GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+	if err != nil {
+		if !errors.Is(err, errortypes.ErrNotFound) {
+			return nil, err
+		}
+		return method.Outputs.Pack(AllowanceData{
+			SpendLimit:       []cmn.Coin{},
+			PeriodSpendLimit: []cmn.Coin{},
+			PeriodCanSpend:   []cmn.Coin{},
+			AllowedMessages:  []string{},
+		})
+	}
+
+	out, err := NewAllowanceData(granter, grantee, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// Allowances returns all the fee allowances received by a grantee.
+func (p Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowancesRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
+
+// AllowancesByGranter returns all the fee allowances given by a granter.
+func (p Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowancesByGranterRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
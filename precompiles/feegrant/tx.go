@@ -0,0 +1,164 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantBasicAllowanceMethod defines the ABI method name for the feegrant GrantBasicAllowance transaction.
+	GrantBasicAllowanceMethod = "grantBasicAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant GrantPeriodicAllowance transaction.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// GrantAllowedMsgAllowanceMethod defines the ABI method name for the feegrant GrantAllowedMsgAllowance transaction.
+	GrantAllowedMsgAllowanceMethod = "grantAllowedMsgAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantBasicAllowance grants a basic fee allowance from the caller to the grantee.
+func (p Precompile) GrantBasicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantBasicAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantPeriodicAllowance grants a periodic fee allowance from the caller to the grantee.
+func (p Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantPeriodicAllowance(args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantAllowedMsgAllowance grants a basic fee allowance restricted to the given
+// message types from the caller to the grantee.
+func (p Precompile) GrantAllowedMsgAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantAllowedMsgAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// RevokeAllowance revokes a fee allowance given by the caller to the grantee.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s }",
+			granter, grantee,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.feegrantMsgServer.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the revoke allowance transaction
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantAllowance executes the given grant allowance message on behalf of the
+// granter, which must be the caller.
+func (p Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegrant.MsgGrantAllowance,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	allowanceType := msg.Allowance.TypeUrl
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, allowance_type: %s }",
+			granter, grantee, allowanceType,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err := p.feegrantMsgServer.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the grant allowance transaction
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee, allowanceType); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
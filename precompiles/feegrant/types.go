@@ -0,0 +1,434 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// AllowanceData defines a fee allowance given by a granter to a grantee.
+type AllowanceData struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	AllowanceType    string         `abi:"allowanceType"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+	PeriodCanSpend   []cmn.Coin     `abi:"periodCanSpend"`
+	PeriodReset      int64          `abi:"periodReset"`
+	AllowedMessages  []string       `abi:"allowedMessages"`
+}
+
+// AllowancesInput defines the input for the allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// AllowancesByGranterInput defines the input for the allowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// AllowancesOutput defines the output for the allowances and allowancesByGranter queries.
+type AllowancesOutput struct {
+	Allowances   []AllowanceData    `abi:"allowances"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// EventGrantAllowance defines the event data for the grant allowance transactions.
+type EventGrantAllowance struct {
+	Granter       common.Address
+	Grantee       common.Address
+	AllowanceType string
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// NewMsgGrantBasicAllowance creates a new MsgGrantAllowance instance with a basic
+// allowance and does sanity checks on the given arguments before populating the message.
+func NewMsgGrantBasicAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, basic, err := parseBasicAllowance(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, basic, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance instance with a periodic
+// allowance and does sanity checks on the given arguments before populating the message.
+// The first period starts at the given block time.
+func NewMsgGrantPeriodicAllowance(args []interface{}, blockTime time.Time, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	granter, grantee, basic, err := parseBasicAllowance(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	periodSeconds, ok := args[4].(int64)
+	if !ok || periodSeconds <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, args[4])
+	}
+	period := time.Duration(periodSeconds) * time.Second
+
+	periodSpendLimit, err := parseSpendLimit(args[5])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	allowance := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(period),
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, allowance, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantAllowedMsgAllowance creates a new MsgGrantAllowance instance with a basic
+// allowance restricted to the given message types and does sanity checks on the given
+// arguments before populating the message.
+func NewMsgGrantAllowedMsgAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, basic, err := parseBasicAllowance(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	allowedMsgs, ok := args[4].([]string)
+	if !ok || len(allowedMsgs) == 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAllowedMsgs, args[4])
+	}
+	for _, msgType := range allowedMsgs {
+		if msgType == "" {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAllowedMsgs, allowedMsgs)
+		}
+	}
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(basic, allowedMsgs)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, allowance, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := toBech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewAllowancesRequest creates a new QueryAllowancesRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewAllowancesRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewAllowancesByGranterRequest creates a new QueryAllowancesByGranterRequest instance and
+// does sanity checks on the given arguments before populating the request.
+func NewAllowancesByGranterRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewAllowanceData creates a new AllowanceData instance from the fee allowance
+// given by the granter to the grantee.
+func NewAllowanceData(granter, grantee common.Address, allowance feegrant.FeeAllowanceI) (AllowanceData, error) {
+	data := AllowanceData{
+		Granter:          granter,
+		Grantee:          grantee,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+
+	// NOTE: the allowed msg allowance wraps the allowance that defines the spend limits
+	if allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		inner, err := allowedMsgAllowance.GetAllowance()
+		if err != nil {
+			return AllowanceData{}, err
+		}
+
+		data.AllowanceType = sdk.MsgTypeURL(allowedMsgAllowance)
+		data.AllowedMessages = allowedMsgAllowance.AllowedMessages
+		allowance = inner
+	}
+
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		data.setBasicAllowance(a)
+	case *feegrant.PeriodicAllowance:
+		data.setBasicAllowance(&a.Basic)
+		data.Period = int64(a.Period.Seconds())
+		data.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		data.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		data.PeriodReset = a.PeriodReset.Unix()
+	default:
+		return AllowanceData{}, fmt.Errorf(ErrUnknownAllowanceType, allowance)
+	}
+
+	if data.AllowanceType == "" {
+		data.AllowanceType = sdk.MsgTypeURL(allowance.(proto.Message))
+	}
+
+	return data, nil
+}
+
+// FromGrants populates the AllowancesOutput from the grants returned by the
+// allowances and allowancesByGranter queries.
+func (o *AllowancesOutput) FromGrants(
+	grants []*feegrant.Grant,
+	pageRes *query.PageResponse,
+	addrCdc address.Codec,
+) (*AllowancesOutput, error) {
+	o.Allowances = make([]AllowanceData, len(grants))
+	for i, grant := range grants {
+		granter, err := addrCdc.StringToBytes(grant.Granter)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGranter, grant.Granter)
+		}
+
+		grantee, err := addrCdc.StringToBytes(grant.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGrantee, grant.Grantee)
+		}
+
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			return nil, err
+		}
+
+		o.Allowances[i], err = NewAllowanceData(common.BytesToAddress(granter), common.BytesToAddress(grantee), allowance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+func (d *AllowanceData) setBasicAllowance(basic *feegrant.BasicAllowance) {
+	d.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		d.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// parseBasicAllowance parses the granter, the grantee and the basic allowance
+// from the first four arguments, which are shared by all the grant methods.
+func parseBasicAllowance(args []interface{}) (common.Address, common.Address, *feegrant.BasicAllowance, error) {
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, err
+	}
+
+	spendLimit, err := parseSpendLimit(args[2])
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, err
+	}
+
+	expirationUnix, ok := args[3].(int64)
+	if !ok || expirationUnix < 0 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	// NOTE: a zero expiration defines an allowance that never expires
+	var expiration *time.Time
+	if expirationUnix > 0 {
+		exp := time.Unix(expirationUnix, 0).UTC()
+		expiration = &exp
+	}
+
+	return granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}, nil
+}
+
+// parseSpendLimit parses a spend limit from the ABI coins. An empty spend limit
+// is returned as nil, which the feegrant module treats as no limit.
+func parseSpendLimit(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	return spendLimit, nil
+}
+
+// parseGranterAndGrantee parses the granter and grantee addresses from the
+// first two arguments.
+func parseGranterAndGrantee(args []interface{}) (common.Address, common.Address, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// newMsgGrantAllowance packs the allowance into a MsgGrantAllowance.
+func newMsgGrantAllowance(
+	granter, grantee common.Address,
+	allowance feegrant.FeeAllowanceI,
+	addrCdc address.Codec,
+) (*feegrant.MsgGrantAllowance, error) {
+	granterAddr, granteeAddr, err := toBech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}, nil
+}
+
+// toBech32Addresses converts the granter and grantee addresses to their
+// bech32 string representation.
+func toBech32Addresses(addrCdc address.Codec, granter, grantee common.Address) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
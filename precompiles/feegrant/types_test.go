@@ -0,0 +1,285 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewMsgGrantBasicAllowance(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	coins := []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(100)}}
+
+	expGranter, err := addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	expGrantee, err := addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		args         []interface{}
+		wantErr      bool
+		errMsg       string
+		expAllowance *feegrant.BasicAllowance
+	}{
+		{
+			name:         "valid without limit and expiration",
+			args:         []interface{}{granter, grantee, []cmn.Coin{}, int64(0)},
+			expAllowance: &feegrant.BasicAllowance{},
+		},
+		{
+			name: "valid with limit and expiration",
+			args: []interface{}{granter, grantee, coins, int64(1000)},
+			expAllowance: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100))),
+				Expiration: func() *time.Time { exp := time.Unix(1000, 0).UTC(); return &exp }(),
+			},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty granter",
+			args:    []interface{}{common.Address{}, grantee, coins, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid granter address",
+		},
+		{
+			name:    "invalid grantee type",
+			args:    []interface{}{granter, "grantee", coins, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+		{
+			name:    "invalid spend limit",
+			args:    []interface{}{granter, grantee, []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(-1)}}, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid spend limit",
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, coins, int64(-1)},
+			wantErr: true,
+			errMsg:  "invalid expiration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotGranter, gotGrantee, err := NewMsgGrantBasicAllowance(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, gotGranter)
+			require.Equal(t, grantee, gotGrantee)
+			require.Equal(t, expGranter, msg.Granter)
+			require.Equal(t, expGrantee, msg.Grantee)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tt.expAllowance, allowance)
+			require.NoError(t, allowance.ValidateBasic())
+		})
+	}
+}
+
+func TestNewMsgGrantPeriodicAllowance(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	coins := []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(100)}}
+	periodCoins := []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(10)}}
+	blockTime := time.Unix(500, 0).UTC()
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granter, grantee, coins, int64(0), int64(3600), periodCoins},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			name:    "zero period",
+			args:    []interface{}{granter, grantee, coins, int64(0), int64(0), periodCoins},
+			wantErr: true,
+			errMsg:  "invalid period",
+		},
+		{
+			name:    "invalid period spend limit",
+			args:    []interface{}{granter, grantee, coins, int64(0), int64(3600), "coins"},
+			wantErr: true,
+			errMsg:  "invalid spend limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, err := NewMsgGrantPeriodicAllowance(tt.args, blockTime, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+
+			periodic, ok := allowance.(*feegrant.PeriodicAllowance)
+			require.True(t, ok)
+			require.Equal(t, time.Hour, periodic.Period)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(10))), periodic.PeriodSpendLimit)
+			require.Equal(t, periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+			require.Equal(t, blockTime.Add(time.Hour), periodic.PeriodReset)
+			require.NoError(t, periodic.ValidateBasic())
+		})
+	}
+}
+
+func TestNewMsgGrantAllowedMsgAllowance(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgType := "/cosmos.evm.vm.v1.MsgEthereumTx"
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{msgType}},
+		},
+		{
+			name:    "no allowed messages",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{}},
+			wantErr: true,
+			errMsg:  "invalid allowed messages",
+		},
+		{
+			name:    "empty allowed message",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{msgType, ""}},
+			wantErr: true,
+			errMsg:  "invalid allowed messages",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, err := NewMsgGrantAllowedMsgAllowance(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+
+			allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance)
+			require.True(t, ok)
+			require.Equal(t, []string{msgType}, allowedMsgAllowance.AllowedMessages)
+			require.NoError(t, allowedMsgAllowance.ValidateBasic())
+		})
+	}
+}
+
+func TestNewAllowanceData(t *testing.T) {
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	expiration := time.Unix(1000, 0).UTC()
+	periodReset := time.Unix(2000, 0).UTC()
+	basic := feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100))),
+		Expiration: &expiration,
+	}
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            basic,
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(10))),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(5))),
+		PeriodReset:      periodReset,
+	}
+	allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(periodic, []string{"/cosmos.evm.vm.v1.MsgEthereumTx"})
+	require.NoError(t, err)
+
+	spendLimit := []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(100)}}
+
+	tests := []struct {
+		name      string
+		allowance feegrant.FeeAllowanceI
+		exp       AllowanceData
+	}{
+		{
+			name:      "basic allowance",
+			allowance: &basic,
+			exp: AllowanceData{
+				Granter:          granter,
+				Grantee:          grantee,
+				AllowanceType:    "/cosmos.feegrant.v1beta1.BasicAllowance",
+				SpendLimit:       spendLimit,
+				Expiration:       expiration.Unix(),
+				PeriodSpendLimit: []cmn.Coin{},
+				PeriodCanSpend:   []cmn.Coin{},
+				AllowedMessages:  []string{},
+			},
+		},
+		{
+			name:      "allowed msg allowance wrapping a periodic allowance",
+			allowance: allowedMsgAllowance,
+			exp: AllowanceData{
+				Granter:          granter,
+				Grantee:          grantee,
+				AllowanceType:    "/cosmos.feegrant.v1beta1.AllowedMsgAllowance",
+				SpendLimit:       spendLimit,
+				Expiration:       expiration.Unix(),
+				Period:           3600,
+				PeriodSpendLimit: []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(10)}},
+				PeriodCanSpend:   []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(5)}},
+				PeriodReset:      periodReset.Unix(),
+				AllowedMessages:  []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := NewAllowanceData(granter, grantee, tt.allowance)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, data)
+		})
+	}
+}
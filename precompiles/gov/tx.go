@@ -42,6 +42,11 @@ func (p *Precompile) SubmitProposal(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	res, err := p.govMsgServer.SubmitProposal(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -72,6 +77,11 @@ func (p *Precompile) Deposit(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	if _, err = p.govMsgServer.Deposit(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -101,6 +111,11 @@ func (p *Precompile) CancelProposal(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	if _, err = p.govMsgServer.CancelProposal(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -130,6 +145,11 @@ func (p Precompile) Vote(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	if _, err = p.govMsgServer.Vote(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -159,6 +179,11 @@ func (p Precompile) VoteWeighted(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	if _, err = p.govMsgServer.VoteWeighted(ctx, msg); err != nil {
 		return nil, err
 	}
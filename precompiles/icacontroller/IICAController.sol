@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IICAController contract's address.
+address constant ICA_CONTROLLER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IICAController contract's instance.
+IICAController constant ICA_CONTROLLER_CONTRACT = IICAController(
+    ICA_CONTROLLER_PRECOMPILE_ADDRESS
+);
+
+/// @dev CosmosMsg is a protobuf-encoded Cosmos SDK message, as packed in a
+/// google.protobuf.Any, to be executed by the interchain account on the host chain.
+struct CosmosMsg {
+    /// @dev The type URL of the message (e.g. "/cosmos.bank.v1beta1.MsgSend")
+    string typeUrl;
+    /// @dev The protobuf-encoded message bytes
+    bytes value;
+}
+
+/// @author Evmos Team
+/// @title ICA Controller Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// IBC interchain accounts controller submodule.
+/// Packet acknowledgements and timeouts are reported to the contract given as
+/// "src_callback" in the memo of sendTx through the ICallbacks interface.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IICAController {
+    /// @dev Emitted when the registration of an interchain account is initiated.
+    /// @param owner The address of the interchain account owner
+    /// @param connectionId The connection identifier to the host chain
+    /// @param portId The controller port identifier of the owner
+    /// @param channelId The identifier of the channel being opened
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string portId,
+        string channelId
+    );
+
+    /// @dev Emitted when a transaction is sent to an interchain account.
+    /// @param owner The address of the interchain account owner
+    /// @param connectionId The connection identifier to the host chain
+    /// @param sequence The sequence of the sent packet
+    event SendTx(address indexed owner, string connectionId, uint64 sequence);
+
+    /// @dev Registers an interchain account on the host chain connected through the given connection.
+    /// The channel handshake is completed by relayers, after which the account address
+    /// can be queried with interchainAccount.
+    /// @param owner The address of the interchain account owner. Must be the msg.sender
+    /// @param connectionId The connection identifier to the host chain
+    /// @param version The ICS27 channel version. Empty to use the default version
+    /// @return channelId The identifier of the channel being opened
+    function registerInterchainAccount(
+        address owner,
+        string calldata connectionId,
+        string calldata version
+    ) external returns (string memory channelId);
+
+    /// @dev Sends the given messages to be executed by the interchain account of the owner.
+    /// @param owner The address of the interchain account owner. Must be the msg.sender
+    /// @param connectionId The connection identifier to the host chain
+    /// @param msgs The messages to be executed on the host chain
+    /// @param memo The packet memo, which can be used to request IBC callbacks
+    /// @param relativeTimeout The packet timeout in nanoseconds relative to the current block time
+    /// @return sequence The sequence of the sent packet
+    function sendTx(
+        address owner,
+        string calldata connectionId,
+        CosmosMsg[] calldata msgs,
+        string calldata memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev Returns the address of the interchain account of the owner on the host chain.
+    /// @param owner The address of the interchain account owner
+    /// @param connectionId The connection identifier to the host chain
+    /// @return accountAddress The interchain account address or an empty string if
+    /// the account is not registered yet
+    function interchainAccount(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
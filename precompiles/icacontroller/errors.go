@@ -0,0 +1,18 @@
+package icacontroller
+
+const (
+	// ErrInvalidOwner is raised when the interchain account owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection id: %v"
+	// ErrInvalidVersion is raised when the channel version is not valid.
+	ErrInvalidVersion = "invalid version: %v"
+	// ErrInvalidMsgs is raised when the messages to send are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrInvalidMemo is raised when the packet memo is not valid.
+	ErrInvalidMemo = "invalid memo: %v"
+	// ErrInvalidRelativeTimeout is raised when the relative packet timeout is not valid.
+	ErrInvalidRelativeTimeout = "invalid relative timeout: %v"
+	// ErrUnknownMsgType is raised when a message can't be resolved for a proto3 JSON encoded channel.
+	ErrUnknownMsgType = "unknown message type %s"
+)
@@ -0,0 +1,99 @@
+package icacontroller
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICA controller RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICA controller SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on a RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID, channelID string,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeRegisterInterchainAccount]
+	topics, err := p.createOwnerTopics(event, owner)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(connectionID, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitSendTxEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID string,
+	sequence uint64,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeSendTx]
+	topics, err := p.createOwnerTopics(event, owner)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(connectionID, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// createOwnerTopics returns the topics shared by the ICA controller events,
+// which index the interchain account owner.
+func (p Precompile) createOwnerTopics(event abi.Event, owner common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,47 @@
+package icacontroller
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterchainAccountMethod defines the ABI method name for the ICA controller InterchainAccount query.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount returns the address of the interchain account of the owner
+// on the host chain connected through the given connection. If the account is
+// not registered yet, an empty string is returned.
+func (p Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := ParseInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr, err := p.addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	portID, err := icatypes.NewControllerPortID(ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the address is empty if it's not found
+	address, _ := p.controllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+
+	return method.Outputs.Pack(address)
+}
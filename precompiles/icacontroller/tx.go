@@ -0,0 +1,159 @@
+package icacontroller
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICA controller RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICA controller SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the registration of an interchain account
+// owned by the caller on the host chain connected through the given connection.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgRegisterInterchainAccount(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ owner: %s, connection_id: %s, version: %s }",
+			owner, msg.ConnectionId, msg.Version,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	res, err := p.controllerMsgServer.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the register interchain account transaction
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.PortId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId)
+}
+
+// SendTx sends the given messages to be executed by the interchain account of
+// the caller. The messages are encoded in the format negotiated for the active
+// channel of the interchain account.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseSendTxArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ owner: %s, connection_id: %s, msgs: %d, relative_timeout: %d }",
+			input.Owner, input.ConnectionID, len(input.Msgs), input.RelativeTimeout,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != input.Owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), input.Owner.String())
+	}
+
+	owner, err := p.addrCdc.BytesToString(input.Owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	encoding, err := p.channelEncoding(ctx, input.ConnectionID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMsgSendTx(p.cdc, input, encoding, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	res, err := p.controllerMsgServer.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the send tx transaction
+	if err = p.EmitSendTxEvent(ctx, stateDB, input.Owner, input.ConnectionID, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// channelEncoding returns the CosmosTx encoding negotiated for the open active
+// channel of the interchain account of the given owner on the given connection.
+func (p Precompile) channelEncoding(ctx sdk.Context, connectionID, owner string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	channelID, found := p.controllerKeeper.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return "", errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	version, found := p.controllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return "", errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", portID, channelID)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	return metadata.Encoding, nil
+}
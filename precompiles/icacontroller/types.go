@@ -0,0 +1,216 @@
+package icacontroller
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CosmosMsg defines a protobuf encoded Cosmos SDK message to be executed by an
+// interchain account on the host chain.
+type CosmosMsg struct {
+	TypeURL string `abi:"typeUrl"`
+	Value   []byte `abi:"value"`
+}
+
+// SendTxInput defines the input for the sendTx transaction.
+type SendTxInput struct {
+	Owner           common.Address `abi:"owner"`
+	ConnectionID    string         `abi:"connectionId"`
+	Msgs            []CosmosMsg    `abi:"msgs"`
+	Memo            string         `abi:"memo"`
+	RelativeTimeout uint64         `abi:"relativeTimeout"`
+}
+
+// EventRegisterInterchainAccount defines the event data for the RegisterInterchainAccount transaction.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address `abi:"owner"`
+	ConnectionID string         `abi:"connectionId"`
+	PortID       string         `abi:"portId"`
+	ChannelID    string         `abi:"channelId"`
+}
+
+// EventSendTx defines the event data for the SendTx transaction.
+type EventSendTx struct {
+	Owner        common.Address `abi:"owner"`
+	ConnectionID string         `abi:"connectionId"`
+	Sequence     uint64         `abi:"sequence"`
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance
+// from the given arguments. The channel is always opened as an unordered channel.
+func NewMsgRegisterInterchainAccount(
+	args []interface{},
+	addrCdc address.Codec,
+) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, connectionID, err := parseOwnerAndConnectionID(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVersion, args[2])
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, ownerAddr, version, channeltypes.UNORDERED)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// ParseSendTxArgs parses the arguments of the sendTx transaction.
+func ParseSendTxArgs(method *abi.Method, args []interface{}) (*SendTxInput, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input SendTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SendTxInput struct: %s", err)
+	}
+
+	if input.Owner == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidOwner, input.Owner)
+	}
+	if len(input.Msgs) == 0 {
+		return nil, fmt.Errorf(ErrInvalidMsgs, "no messages provided")
+	}
+	if input.RelativeTimeout == 0 {
+		return nil, fmt.Errorf(ErrInvalidRelativeTimeout, input.RelativeTimeout)
+	}
+
+	return &input, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance from the given input, encoding
+// the messages in the format negotiated for the interchain account channel.
+func NewMsgSendTx(
+	cdc codec.Codec,
+	input *SendTxInput,
+	encoding string,
+	addrCdc address.Codec,
+) (*icacontrollertypes.MsgSendTx, error) {
+	ownerAddr, err := addrCdc.BytesToString(input.Owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	packetData, err := NewInterchainAccountPacketData(cdc, input.Msgs, input.Memo, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(ownerAddr, input.ConnectionID, input.RelativeTimeout, packetData)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewInterchainAccountPacketData packs the given messages into a CosmosTx using
+// the given channel encoding and returns the resulting EXECUTE_TX packet data.
+//
+// Protobuf encoded messages are forwarded as-is, so they don't need to be known
+// to the controller chain. Proto3 JSON encoding requires the messages to be
+// registered in the interface registry of the given codec.
+func NewInterchainAccountPacketData(
+	cdc codec.Codec,
+	msgs []CosmosMsg,
+	memo string,
+	encoding string,
+) (icatypes.InterchainAccountPacketData, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		if msg.TypeURL == "" {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf(ErrInvalidMsgs, "empty type url")
+		}
+		anys[i] = &codectypes.Any{TypeUrl: msg.TypeURL, Value: msg.Value}
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		data, err = cdc.Marshal(&icatypes.CosmosTx{Messages: anys})
+		if err != nil {
+			return icatypes.InterchainAccountPacketData{}, errorsmod.Wrapf(err, "cannot marshal CosmosTx with protobuf")
+		}
+	case icatypes.EncodingProto3JSON:
+		protoMsgs := make([]proto.Message, len(anys))
+		for i, protoAny := range anys {
+			var msg sdk.Msg
+			if err := cdc.UnpackAny(protoAny, &msg); err != nil {
+				return icatypes.InterchainAccountPacketData{}, errorsmod.Wrapf(err, ErrUnknownMsgType, protoAny.TypeUrl)
+			}
+			protoMsgs[i] = msg
+		}
+
+		data, err = icatypes.SerializeCosmosTx(cdc, protoMsgs, encoding)
+		if err != nil {
+			return icatypes.InterchainAccountPacketData{}, err
+		}
+	default:
+		return icatypes.InterchainAccountPacketData{}, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}, nil
+}
+
+// ParseInterchainAccountArgs parses the arguments of the interchainAccount query.
+func ParseInterchainAccountArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return parseOwnerAndConnectionID(args)
+}
+
+// parseOwnerAndConnectionID parses the owner and connection identifier, which
+// are the first two arguments of all the ICA controller methods.
+func parseOwnerAndConnectionID(args []interface{}) (common.Address, string, error) {
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok || connectionID == "" {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	return owner, connectionID, nil
+}
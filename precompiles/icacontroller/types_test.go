@@ -0,0 +1,295 @@
+package icacontroller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const connectionID = "connection-0"
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	expOwner, err := addrCodec.BytesToString(owner.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid with default version",
+			args: []interface{}{owner, connectionID, ""},
+		},
+		{
+			name: "valid with version",
+			args: []interface{}{owner, connectionID, icatypes.NewDefaultMetadataString(connectionID, connectionID)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "empty owner",
+			args:    []interface{}{common.Address{}, connectionID, ""},
+			wantErr: true,
+			errMsg:  "invalid owner address",
+		},
+		{
+			name:    "empty connection id",
+			args:    []interface{}{owner, "", ""},
+			wantErr: true,
+			errMsg:  "invalid connection id",
+		},
+		{
+			name:    "invalid connection id",
+			args:    []interface{}{owner, "channel-0", ""},
+			wantErr: true,
+			errMsg:  "invalid connection ID",
+		},
+		{
+			name:    "invalid version type",
+			args:    []interface{}{owner, connectionID, 1},
+			wantErr: true,
+			errMsg:  "invalid version",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, msgOwner, err := NewMsgRegisterInterchainAccount(tc.args, addrCodec)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, msgOwner)
+			require.Equal(t, expOwner, msg.Owner)
+			require.Equal(t, connectionID, msg.ConnectionId)
+			require.Equal(t, tc.args[2], msg.Version)
+			require.Equal(t, channeltypes.UNORDERED, msg.Ordering)
+		})
+	}
+}
+
+func TestParseSendTxArgs(t *testing.T) {
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	msgs := []CosmosMsg{{TypeURL: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{1}}}
+	method := ABI.Methods[SendTxMethod]
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{owner, connectionID, msgs, "memo", uint64(1000)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "empty owner",
+			args:    []interface{}{common.Address{}, connectionID, msgs, "", uint64(1000)},
+			wantErr: true,
+			errMsg:  "invalid owner address",
+		},
+		{
+			name:    "no messages",
+			args:    []interface{}{owner, connectionID, []CosmosMsg{}, "", uint64(1000)},
+			wantErr: true,
+			errMsg:  "no messages provided",
+		},
+		{
+			name:    "zero relative timeout",
+			args:    []interface{}{owner, connectionID, msgs, "", uint64(0)},
+			wantErr: true,
+			errMsg:  "invalid relative timeout",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			input, err := ParseSendTxArgs(&method, tc.args)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, input.Owner)
+			require.Equal(t, connectionID, input.ConnectionID)
+			require.Equal(t, msgs, input.Msgs)
+			require.Equal(t, "memo", input.Memo)
+			require.Equal(t, uint64(1000), input.RelativeTimeout)
+		})
+	}
+}
+
+func TestNewInterchainAccountPacketData(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(100))),
+	}
+	bankMsgBz, err := cdc.Marshal(bankMsg)
+	require.NoError(t, err)
+
+	// unknownMsg is not registered in the interface registry of the codec
+	unknownMsg := CosmosMsg{TypeURL: "/host.custom.v1.MsgCustom", Value: []byte{1, 2, 3}}
+
+	tests := []struct {
+		name     string
+		msgs     []CosmosMsg
+		encoding string
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "protobuf encoding",
+			msgs:     []CosmosMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bankMsgBz}},
+			encoding: icatypes.EncodingProtobuf,
+		},
+		{
+			name:     "protobuf encoding with message unknown to the controller chain",
+			msgs:     []CosmosMsg{unknownMsg},
+			encoding: icatypes.EncodingProtobuf,
+		},
+		{
+			name:     "proto3 JSON encoding",
+			msgs:     []CosmosMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bankMsgBz}},
+			encoding: icatypes.EncodingProto3JSON,
+		},
+		{
+			name:     "proto3 JSON encoding with message unknown to the controller chain",
+			msgs:     []CosmosMsg{unknownMsg},
+			encoding: icatypes.EncodingProto3JSON,
+			wantErr:  true,
+			errMsg:   "unknown message type /host.custom.v1.MsgCustom",
+		},
+		{
+			name:     "empty type url",
+			msgs:     []CosmosMsg{{Value: bankMsgBz}},
+			encoding: icatypes.EncodingProtobuf,
+			wantErr:  true,
+			errMsg:   "empty type url",
+		},
+		{
+			name:     "unsupported encoding",
+			msgs:     []CosmosMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bankMsgBz}},
+			encoding: "json",
+			wantErr:  true,
+			errMsg:   "unsupported encoding format json",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			packetData, err := NewInterchainAccountPacketData(cdc, tc.msgs, "memo", tc.encoding)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, packetData.ValidateBasic())
+			require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+			require.Equal(t, "memo", packetData.Memo)
+
+			var cosmosTx icatypes.CosmosTx
+			if tc.encoding == icatypes.EncodingProtobuf {
+				// NOTE: the messages are not unpacked, as they may be unknown to the codec
+				require.NoError(t, cosmosTx.Unmarshal(packetData.Data))
+				require.Len(t, cosmosTx.Messages, len(tc.msgs))
+				for i, msg := range tc.msgs {
+					require.Equal(t, msg.TypeURL, cosmosTx.Messages[i].TypeUrl)
+					require.Equal(t, msg.Value, cosmosTx.Messages[i].Value)
+				}
+				return
+			}
+
+			msgs, err := icatypes.DeserializeCosmosTx(cdc, packetData.Data, tc.encoding)
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			require.Equal(t, bankMsg.String(), msgs[0].(*banktypes.MsgSend).String())
+		})
+	}
+}
+
+func TestParseInterchainAccountArgs(t *testing.T) {
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{owner, connectionID},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "invalid owner type",
+			args:    []interface{}{"owner", connectionID},
+			wantErr: true,
+			errMsg:  "invalid owner address",
+		},
+		{
+			name:    "invalid connection id type",
+			args:    []interface{}{owner, 0},
+			wantErr: true,
+			errMsg:  "invalid connection id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedOwner, parsedConnectionID, err := ParseInterchainAccountArgs(tc.args)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, parsedOwner)
+			require.Equal(t, connectionID, parsedConnectionID)
+		})
+	}
+}
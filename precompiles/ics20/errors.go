@@ -5,6 +5,10 @@ const (
 	ErrInvalidSourcePort = "invalid source port"
 	// ErrInvalidSourceChannel is raised when the source channel is invalid.
 	ErrInvalidSourceChannel = "invalid source port"
+	// ErrInvalidSourceClient is raised when the source client of an IBC v2 transfer is invalid.
+	ErrInvalidSourceClient = "invalid source client: %s"
+	// ErrInvalidEncoding is raised when the payload encoding of an IBC v2 transfer is not supported.
+	ErrInvalidEncoding = "invalid encoding: %s"
 	// ErrInvalidSender is raised when the sender is invalid.
 	ErrInvalidSender = "invalid sender: %s"
 	// ErrInvalidReceiver is raised when the receiver is invalid.
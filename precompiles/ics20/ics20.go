@@ -109,6 +109,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, contract, stateDB, method, args)
+	case TransferV2Method:
+		bz, err = p.TransferV2(ctx, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomMethod:
 		bz, err = p.Denom(ctx, contract, method, args)
@@ -127,9 +129,10 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferV2
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case TransferMethod:
+	case TransferMethod, TransferV2Method:
 		return true
 	default:
 		return false
@@ -30,6 +30,10 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferV2Method defines the ABI method name for the ICS20 Transfer
+	// transaction over an IBC v2 client. The ABI declares it as an overload
+	// of transfer, which go-ethereum exposes under the "transfer0" name.
+	TransferV2Method = "transfer0"
 )
 
 // validateV1TransferChannel does the following validation on an ibc v1 channel specified in a MsgTransfer:
@@ -208,11 +212,49 @@ func (p *Precompile) Transfer(
 		)
 	}
 
+	return p.transfer(ctx, contract, stateDB, method, msg, sender, msg.SourcePort)
+}
+
+// TransferV2 implements the ICS20 transfer transaction over an IBC v2 client.
+// The packet payload is encoded with the requested encoding and sent through the
+// transfer port to the given source client, without requiring a v1 channel.
+func (p *Precompile) TransferV2(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, err := NewMsgTransferV2(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// IBC v2 packets have no source port, so it is left empty on the event
+	return p.transfer(ctx, contract, stateDB, method, msg, sender, "")
+}
+
+// transfer executes the given transfer message on behalf of the sender and
+// emits the IBCTransfer event with the given source port.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+	sourcePort string,
+) ([]byte, error) {
 	msgSender := contract.Caller()
 	if msgSender != sender {
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	stateDBExp := stateDB.(*statedb.StateDB)
 	res, err := p.transferWithStateDB(ctx, stateDBExp, msg)
 	if err != nil {
@@ -226,7 +268,7 @@ func (p *Precompile) Transfer(
 		p.Address(),
 		sender,
 		msg.Receiver,
-		msg.SourcePort,
+		sourcePort,
 		msg.SourceChannel,
 		msg.Token,
 		msg.Memo,
@@ -12,6 +12,8 @@ import (
 	cmn "github.com/zenanetwork/zena/precompiles/common"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -140,6 +142,108 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// NewMsgTransferV2 returns a new transfer message for an IBC v2 packet from the given arguments.
+// The packet is sent over the transfer port to the given source client using the given
+// payload encoding. An empty encoding defaults to JSON.
+func NewMsgTransferV2(args []interface{}) (*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 8 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 8, len(args))
+	}
+
+	sourceClient, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourceClient, args[0])
+	}
+	if err := ValidateV2SourceClient(sourceClient); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	encoding, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidEncoding, args[1])
+	}
+	if err := ValidateEncoding(encoding); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	denom, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidDenomForTransfer, cmn.ErrInvalidDenom, args[2])
+	}
+
+	amount, ok := args[3].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, args[3])
+	}
+
+	sender, ok := args[4].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[4])
+	}
+
+	receiver, ok := args[5].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[5])
+	}
+
+	// IBC v2 packets only support timestamp based timeouts
+	timeoutTimestamp, ok := args[6].(uint64)
+	if !ok || timeoutTimestamp == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[6])
+	}
+
+	memo, ok := args[7].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[7])
+	}
+
+	// Use instance to prevent errors on denom or amount
+	token := sdk.Coin{
+		Denom:  denom,
+		Amount: math.NewIntFromBigInt(amount),
+	}
+
+	msg := transfertypes.NewMsgTransferWithEncoding(
+		transfertypes.PortID,
+		sourceClient,
+		token,
+		sdk.AccAddress(sender.Bytes()).String(),
+		receiver,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		memo,
+		encoding,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, sender, nil
+}
+
+// ValidateV2SourceClient checks that the given identifier is a valid client
+// identifier and can't be mistaken for an IBC v1 channel identifier.
+func ValidateV2SourceClient(sourceClient string) error {
+	if channeltypes.IsChannelIDFormat(sourceClient) {
+		return fmt.Errorf(ErrInvalidSourceClient, sourceClient)
+	}
+	if err := host.ClientIdentifierValidator(sourceClient); err != nil {
+		return errorsmod.Wrapf(err, ErrInvalidSourceClient, sourceClient)
+	}
+	return nil
+}
+
+// ValidateEncoding checks that the given IBC v2 payload encoding is supported
+// by the transfer application. An empty encoding is accepted and defaults to JSON.
+func ValidateEncoding(encoding string) error {
+	switch encoding {
+	case "", transfertypes.EncodingJSON, transfertypes.EncodingProtobuf, transfertypes.EncodingABI:
+		return nil
+	default:
+		return fmt.Errorf(ErrInvalidEncoding, encoding)
+	}
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IRevenue contract's address.
+address constant REVENUE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080A;
+
+/// @dev The IRevenue contract's instance.
+IRevenue constant REVENUE_CONTRACT = IRevenue(REVENUE_PRECOMPILE_ADDRESS);
+
+/// @dev RevenueData describes a contract registered for fee distribution.
+struct RevenueData {
+    /// @dev The address of the registered contract
+    address contractAddress;
+    /// @dev The address of the account that deployed the contract
+    address deployer;
+    /// @dev The address of the account receiving the developer fees
+    address withdrawer;
+}
+
+/// @author Evmos Team
+/// @title Revenue Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// revenue module, which distributes a share of the transaction fees of the
+/// registered contracts to their developers.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x000000000000000000000000000000000000080A
+interface IRevenue {
+    /// @dev Emitted when a contract is registered for fee distribution.
+    /// @param deployer The address of the contract deployer
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawer The address receiving the developer fees
+    event RegisterRevenue(
+        address indexed deployer,
+        address indexed contractAddress,
+        address withdrawer
+    );
+
+    /// @dev Emitted when the withdrawer of a registered contract is updated.
+    /// @param deployer The address of the contract deployer
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawer The new address receiving the developer fees
+    event UpdateRevenue(
+        address indexed deployer,
+        address indexed contractAddress,
+        address withdrawer
+    );
+
+    /// @dev Emitted when a contract is removed from fee distribution.
+    /// @param deployer The address of the contract deployer
+    /// @param contractAddress The address of the removed contract
+    event CancelRevenue(
+        address indexed deployer,
+        address indexed contractAddress
+    );
+
+    /// @dev Registers a contract for fee distribution. The contract address must
+    /// be derived from the deployer address and the given nonces, so factory
+    /// contracts can register the contracts they deploy.
+    /// @param deployer The address of the contract deployer. Must be the msg.sender
+    /// @param contractAddress The address of the contract to register
+    /// @param withdrawer The address receiving the developer fees
+    /// @param nonces The nonces of the address derivation path from the deployer to the contract
+    /// @return success Whether the registration was successful
+    function registerRevenue(
+        address deployer,
+        address contractAddress,
+        address withdrawer,
+        uint64[] calldata nonces
+    ) external returns (bool success);
+
+    /// @dev Updates the withdrawer of a registered contract.
+    /// @param deployer The address of the contract deployer. Must be the msg.sender
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawer The new address receiving the developer fees
+    /// @return success Whether the update was successful
+    function updateRevenue(
+        address deployer,
+        address contractAddress,
+        address withdrawer
+    ) external returns (bool success);
+
+    /// @dev Removes a contract from fee distribution.
+    /// @param deployer The address of the contract deployer. Must be the msg.sender
+    /// @param contractAddress The address of the registered contract
+    /// @return success Whether the cancellation was successful
+    function cancelRevenue(
+        address deployer,
+        address contractAddress
+    ) external returns (bool success);
+
+    /// @dev Returns the registration of a contract. If the contract is not
+    /// registered, an empty registration is returned.
+    /// @param contractAddress The address of the contract
+    /// @return revenue The registration of the contract
+    function revenue(
+        address contractAddress
+    ) external view returns (RevenueData memory revenue);
+
+    /// @dev Returns the contracts registered by a deployer.
+    /// @param deployer The address of the contract deployer
+    /// @param pagination The pagination options
+    /// @return contracts The addresses of the registered contracts
+    /// @return pageResponse The pagination information
+    function deployerRevenues(
+        address deployer,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (address[] memory contracts, PageResponse memory pageResponse);
+
+    /// @dev Returns the contracts whose developer fees are sent to a withdrawer
+    /// that differs from their deployer.
+    /// @param withdrawer The address receiving the developer fees
+    /// @param pagination The pagination options
+    /// @return contracts The addresses of the registered contracts
+    /// @return pageResponse The pagination information
+    function withdrawerRevenues(
+        address withdrawer,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (address[] memory contracts, PageResponse memory pageResponse);
+}
@@ -0,0 +1,12 @@
+package revenue
+
+const (
+	// ErrInvalidDeployer is raised when the deployer address is not valid.
+	ErrInvalidDeployer = "invalid deployer address: %v"
+	// ErrInvalidContract is raised when the contract address is not valid.
+	ErrInvalidContract = "invalid contract address: %v"
+	// ErrInvalidWithdrawer is raised when the withdrawer address is not valid.
+	ErrInvalidWithdrawer = "invalid withdrawer address: %v"
+	// ErrInvalidNonces is raised when the nonces of the address derivation path are not valid.
+	ErrInvalidNonces = "invalid nonces: %v"
+)
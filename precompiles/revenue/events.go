@@ -0,0 +1,116 @@
+package revenue
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterRevenue defines the event type for the revenue RegisterRevenue transaction.
+	EventTypeRegisterRevenue = "RegisterRevenue"
+	// EventTypeUpdateRevenue defines the event type for the revenue UpdateRevenue transaction.
+	EventTypeUpdateRevenue = "UpdateRevenue"
+	// EventTypeCancelRevenue defines the event type for the revenue CancelRevenue transaction.
+	EventTypeCancelRevenue = "CancelRevenue"
+)
+
+// EmitRegisterRevenueEvent creates a new event emitted on a RegisterRevenue transaction.
+func (p Precompile) EmitRegisterRevenueEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	deployer, contractAddr, withdrawer common.Address,
+) error {
+	return p.emitWithdrawerEvent(ctx, stateDB, EventTypeRegisterRevenue, deployer, contractAddr, withdrawer)
+}
+
+// EmitUpdateRevenueEvent creates a new event emitted on an UpdateRevenue transaction.
+func (p Precompile) EmitUpdateRevenueEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	deployer, contractAddr, withdrawer common.Address,
+) error {
+	return p.emitWithdrawerEvent(ctx, stateDB, EventTypeUpdateRevenue, deployer, contractAddr, withdrawer)
+}
+
+// EmitCancelRevenueEvent creates a new event emitted on a CancelRevenue transaction.
+func (p Precompile) EmitCancelRevenueEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	deployer, contractAddr common.Address,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCancelRevenue]
+	topics, err := p.createRevenueTopics(event, deployer, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// emitWithdrawerEvent emits the given event, which indexes the deployer and the
+// contract and holds the withdrawer in its data.
+func (p Precompile) emitWithdrawerEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	deployer, contractAddr, withdrawer common.Address,
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics, err := p.createRevenueTopics(event, deployer, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(withdrawer)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// createRevenueTopics returns the topics shared by the revenue events, which
+// index the deployer and the contract.
+func (p Precompile) createRevenueTopics(event abi.Event, deployer, contractAddr common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(deployer)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
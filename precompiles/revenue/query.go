@@ -0,0 +1,90 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RevenueMethod defines the ABI method name for the revenue Revenue query.
+	RevenueMethod = "revenue"
+	// DeployerRevenuesMethod defines the ABI method name for the revenue DeployerRevenues query.
+	DeployerRevenuesMethod = "deployerRevenues"
+	// WithdrawerRevenuesMethod defines the ABI method name for the revenue WithdrawerRevenues query.
+	WithdrawerRevenuesMethod = "withdrawerRevenues"
+)
+
+// Revenue returns the revenue registered for a contract. If the contract is
+// not registered, an empty revenue is returned.
+func (p Precompile) Revenue(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	contractAddr, ok := args[0].(common.Address)
+	if !ok || contractAddr == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	revenue, found := p.revenueKeeper.GetRevenue(ctx, contractAddr)
+	if !found {
+		return method.Outputs.Pack(RevenueData{})
+	}
+
+	return method.Outputs.Pack(NewRevenueData(revenue))
+}
+
+// DeployerRevenues returns the contracts registered by a deployer.
+func (p Precompile) DeployerRevenues(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDeployerRevenuesRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.revenueKeeper.DeployerRevenues(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ContractsOutput).FromResponse(res.ContractAddresses, res.Pagination)
+	return method.Outputs.Pack(out.Contracts, out.PageResponse)
+}
+
+// WithdrawerRevenues returns the contracts whose fees are withdrawn by a
+// withdrawer.
+func (p Precompile) WithdrawerRevenues(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewWithdrawerRevenuesRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.revenueKeeper.WithdrawerRevenues(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ContractsOutput).FromResponse(res.ContractAddresses, res.Pagination)
+	return method.Outputs.Pack(out.Contracts, out.PageResponse)
+}
@@ -0,0 +1,158 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterRevenueMethod defines the ABI method name for the revenue RegisterRevenue transaction.
+	RegisterRevenueMethod = "registerRevenue"
+	// UpdateRevenueMethod defines the ABI method name for the revenue UpdateRevenue transaction.
+	UpdateRevenueMethod = "updateRevenue"
+	// CancelRevenueMethod defines the ABI method name for the revenue CancelRevenue transaction.
+	CancelRevenueMethod = "cancelRevenue"
+)
+
+// RegisterRevenue registers a contract deployed by the caller for fee
+// distribution. Factory contracts can register the contracts they deploy.
+func (p Precompile) RegisterRevenue(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, deployer, contractAddr, withdrawer, err := NewMsgRegisterRevenue(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ deployer: %s, contract: %s, withdrawer: %s, nonces: %v }",
+			deployer, contractAddr, withdrawer, msg.Nonces,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != deployer {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), deployer.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.revenueKeeper.RegisterRevenue(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the register revenue transaction
+	if err = p.EmitRegisterRevenueEvent(ctx, stateDB, deployer, contractAddr, withdrawer); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UpdateRevenue updates the withdrawer of a contract registered by the caller.
+func (p Precompile) UpdateRevenue(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, deployer, contractAddr, withdrawer, err := NewMsgUpdateRevenue(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ deployer: %s, contract: %s, withdrawer: %s }",
+			deployer, contractAddr, withdrawer,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != deployer {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), deployer.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.revenueKeeper.UpdateRevenue(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the update revenue transaction
+	if err = p.EmitUpdateRevenueEvent(ctx, stateDB, deployer, contractAddr, withdrawer); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelRevenue removes a contract registered by the caller from fee
+// distribution.
+func (p Precompile) CancelRevenue(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, deployer, contractAddr, err := NewMsgCancelRevenue(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ deployer: %s, contract: %s }",
+			deployer, contractAddr,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != deployer {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), deployer.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.revenueKeeper.CancelRevenue(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the cancel revenue transaction
+	if err = p.EmitCancelRevenueEvent(ctx, stateDB, deployer, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,288 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RevenueData defines the fee distribution registered for a contract.
+type RevenueData struct {
+	ContractAddress common.Address `abi:"contractAddress"`
+	Deployer        common.Address `abi:"deployer"`
+	Withdrawer      common.Address `abi:"withdrawer"`
+}
+
+// RevenueOutput defines the output for the revenue query.
+type RevenueOutput struct {
+	Revenue RevenueData
+}
+
+// DeployerRevenuesInput defines the input for the deployerRevenues query.
+type DeployerRevenuesInput struct {
+	Deployer   common.Address    `abi:"deployer"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// WithdrawerRevenuesInput defines the input for the withdrawerRevenues query.
+type WithdrawerRevenuesInput struct {
+	Withdrawer common.Address    `abi:"withdrawer"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// ContractsOutput defines the output for the deployerRevenues and withdrawerRevenues queries.
+type ContractsOutput struct {
+	Contracts    []common.Address   `abi:"contracts"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// EventRegisterRevenue defines the event data for the RegisterRevenue transaction.
+type EventRegisterRevenue struct {
+	Deployer        common.Address
+	ContractAddress common.Address
+	Withdrawer      common.Address
+}
+
+// EventUpdateRevenue defines the event data for the UpdateRevenue transaction.
+type EventUpdateRevenue struct {
+	Deployer        common.Address
+	ContractAddress common.Address
+	Withdrawer      common.Address
+}
+
+// EventCancelRevenue defines the event data for the CancelRevenue transaction.
+type EventCancelRevenue struct {
+	Deployer        common.Address
+	ContractAddress common.Address
+}
+
+// NewMsgRegisterRevenue creates a new MsgRegisterRevenue instance and does sanity checks
+// on the given arguments before populating the message. A zero withdrawer address
+// registers the deployer as the withdrawer.
+func NewMsgRegisterRevenue(args []interface{}, addrCdc address.Codec) (*revenuetypes.MsgRegisterRevenue, common.Address, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	deployer, contractAddr, err := parseDeployerAndContract(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	withdrawer, ok := args[2].(common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidWithdrawer, args[2])
+	}
+
+	nonces, ok := args[3].([]uint64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidNonces, args[3])
+	}
+
+	deployerAddr, err := addrCdc.BytesToString(deployer.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf("failed to decode deployer address: %w", err)
+	}
+
+	var withdrawerAddr string
+	if withdrawer != (common.Address{}) {
+		withdrawerAddr, err = addrCdc.BytesToString(withdrawer.Bytes())
+		if err != nil {
+			return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf("failed to decode withdrawer address: %w", err)
+		}
+	}
+
+	msg := &revenuetypes.MsgRegisterRevenue{
+		ContractAddress:   contractAddr.Hex(),
+		DeployerAddress:   deployerAddr,
+		WithdrawerAddress: withdrawerAddr,
+		Nonces:            nonces,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	return msg, deployer, contractAddr, withdrawer, nil
+}
+
+// NewMsgUpdateRevenue creates a new MsgUpdateRevenue instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgUpdateRevenue(args []interface{}, addrCdc address.Codec) (*revenuetypes.MsgUpdateRevenue, common.Address, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	deployer, contractAddr, err := parseDeployerAndContract(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	withdrawer, ok := args[2].(common.Address)
+	if !ok || withdrawer == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidWithdrawer, args[2])
+	}
+
+	deployerAddr, err := addrCdc.BytesToString(deployer.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf("failed to decode deployer address: %w", err)
+	}
+
+	withdrawerAddr, err := addrCdc.BytesToString(withdrawer.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf("failed to decode withdrawer address: %w", err)
+	}
+
+	msg := &revenuetypes.MsgUpdateRevenue{
+		ContractAddress:   contractAddr.Hex(),
+		DeployerAddress:   deployerAddr,
+		WithdrawerAddress: withdrawerAddr,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	return msg, deployer, contractAddr, withdrawer, nil
+}
+
+// NewMsgCancelRevenue creates a new MsgCancelRevenue instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgCancelRevenue(args []interface{}, addrCdc address.Codec) (*revenuetypes.MsgCancelRevenue, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	deployer, contractAddr, err := parseDeployerAndContract(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	deployerAddr, err := addrCdc.BytesToString(deployer.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode deployer address: %w", err)
+	}
+
+	msg := &revenuetypes.MsgCancelRevenue{
+		ContractAddress: contractAddr.Hex(),
+		DeployerAddress: deployerAddr,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, deployer, contractAddr, nil
+}
+
+// NewDeployerRevenuesRequest creates a new QueryDeployerRevenuesRequest instance and does
+// sanity checks on the given arguments before populating the request.
+func NewDeployerRevenuesRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*revenuetypes.QueryDeployerRevenuesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DeployerRevenuesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DeployerRevenuesInput: %s", err)
+	}
+
+	if input.Deployer == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidDeployer, input.Deployer)
+	}
+
+	deployerAddr, err := addrCdc.BytesToString(input.Deployer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode deployer address: %w", err)
+	}
+
+	return &revenuetypes.QueryDeployerRevenuesRequest{
+		DeployerAddress: deployerAddr,
+		Pagination:      &input.Pagination,
+	}, nil
+}
+
+// NewWithdrawerRevenuesRequest creates a new QueryWithdrawerRevenuesRequest instance and
+// does sanity checks on the given arguments before populating the request.
+func NewWithdrawerRevenuesRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*revenuetypes.QueryWithdrawerRevenuesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input WithdrawerRevenuesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to WithdrawerRevenuesInput: %s", err)
+	}
+
+	if input.Withdrawer == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidWithdrawer, input.Withdrawer)
+	}
+
+	withdrawerAddr, err := addrCdc.BytesToString(input.Withdrawer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode withdrawer address: %w", err)
+	}
+
+	return &revenuetypes.QueryWithdrawerRevenuesRequest{
+		WithdrawerAddress: withdrawerAddr,
+		Pagination:        &input.Pagination,
+	}, nil
+}
+
+// NewRevenueData creates a new RevenueData instance from the given revenue. The
+// deployer is returned as the withdrawer if no withdrawer is set.
+func NewRevenueData(revenue revenuetypes.Revenue) RevenueData {
+	deployer := common.BytesToAddress(revenue.GetDeployerAddr())
+
+	withdrawer := deployer
+	if withdrawerAddr := revenue.GetWithdrawerAddr(); withdrawerAddr != nil {
+		withdrawer = common.BytesToAddress(withdrawerAddr)
+	}
+
+	return RevenueData{
+		ContractAddress: revenue.GetContractAddr(),
+		Deployer:        deployer,
+		Withdrawer:      withdrawer,
+	}
+}
+
+// FromResponse populates the ContractsOutput from the hex contract addresses
+// returned by the deployerRevenues and withdrawerRevenues queries.
+func (o *ContractsOutput) FromResponse(contracts []string, pageRes *query.PageResponse) *ContractsOutput {
+	o.Contracts = make([]common.Address, len(contracts))
+	for i, contract := range contracts {
+		o.Contracts[i] = common.HexToAddress(contract)
+	}
+
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o
+}
+
+// parseDeployerAndContract parses the deployer and contract addresses from the
+// first two arguments.
+func parseDeployerAndContract(args []interface{}) (common.Address, common.Address, error) {
+	deployer, ok := args[0].(common.Address)
+	if !ok || deployer == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidDeployer, args[0])
+	}
+
+	contractAddr, ok := args[1].(common.Address)
+	if !ok || contractAddr == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidContract, args[1])
+	}
+
+	return deployer, contractAddr, nil
+}
@@ -0,0 +1,221 @@
+package revenue
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestNewMsgRegisterRevenue(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	deployer := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contract := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	withdrawer := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	expDeployer, err := addrCodec.BytesToString(deployer.Bytes())
+	require.NoError(t, err)
+	expWithdrawer, err := addrCodec.BytesToString(withdrawer.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		expWithdrawer string
+	}{
+		{
+			name:          "valid",
+			args:          []interface{}{deployer, contract, withdrawer, []uint64{1}},
+			expWithdrawer: expWithdrawer,
+		},
+		{
+			name:          "valid without withdrawer",
+			args:          []interface{}{deployer, contract, common.Address{}, []uint64{1, 2}},
+			expWithdrawer: "",
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty deployer",
+			args:    []interface{}{common.Address{}, contract, withdrawer, []uint64{1}},
+			wantErr: true,
+			errMsg:  "invalid deployer address",
+		},
+		{
+			name:    "invalid contract type",
+			args:    []interface{}{deployer, "contract", withdrawer, []uint64{1}},
+			wantErr: true,
+			errMsg:  "invalid contract address",
+		},
+		{
+			name:    "invalid withdrawer type",
+			args:    []interface{}{deployer, contract, "withdrawer", []uint64{1}},
+			wantErr: true,
+			errMsg:  "invalid withdrawer address",
+		},
+		{
+			name:    "invalid nonces type",
+			args:    []interface{}{deployer, contract, withdrawer, []int64{1}},
+			wantErr: true,
+			errMsg:  "invalid nonces",
+		},
+		{
+			name:    "empty nonces",
+			args:    []interface{}{deployer, contract, withdrawer, []uint64{}},
+			wantErr: true,
+			errMsg:  "invalid nonces - empty array",
+		},
+		{
+			name:    "too many nonces",
+			args:    []interface{}{deployer, contract, withdrawer, make([]uint64, revenuetypes.MaxNonces+1)},
+			wantErr: true,
+			errMsg:  "invalid nonces - array length must be less than or equal to",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotDeployer, gotContract, _, err := NewMsgRegisterRevenue(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, deployer, gotDeployer)
+			require.Equal(t, contract, gotContract)
+			require.Equal(t, expDeployer, msg.DeployerAddress)
+			require.Equal(t, contract.Hex(), msg.ContractAddress)
+			require.Equal(t, tt.expWithdrawer, msg.WithdrawerAddress)
+			require.Equal(t, tt.args[3], msg.Nonces)
+		})
+	}
+}
+
+func TestNewMsgUpdateRevenue(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	deployer := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contract := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	withdrawer := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	expWithdrawer, err := addrCodec.BytesToString(withdrawer.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{deployer, contract, withdrawer},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "empty contract",
+			args:    []interface{}{deployer, common.Address{}, withdrawer},
+			wantErr: true,
+			errMsg:  "invalid contract address",
+		},
+		{
+			name:    "empty withdrawer",
+			args:    []interface{}{deployer, contract, common.Address{}},
+			wantErr: true,
+			errMsg:  "invalid withdrawer address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, gotWithdrawer, err := NewMsgUpdateRevenue(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, withdrawer, gotWithdrawer)
+			require.Equal(t, expWithdrawer, msg.WithdrawerAddress)
+		})
+	}
+}
+
+func TestNewMsgCancelRevenue(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	deployer := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contract := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	msg, gotDeployer, gotContract, err := NewMsgCancelRevenue([]interface{}{deployer, contract}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, deployer, gotDeployer)
+	require.Equal(t, contract, gotContract)
+	require.Equal(t, contract.Hex(), msg.ContractAddress)
+
+	_, _, _, err = NewMsgCancelRevenue([]interface{}{deployer}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
+
+func TestNewDeployerRevenuesRequest(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	deployer := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	method := ABI.Methods[DeployerRevenuesMethod]
+
+	expDeployer, err := addrCodec.BytesToString(deployer.Bytes())
+	require.NoError(t, err)
+
+	pagination := query.PageRequest{Limit: 10, CountTotal: true}
+	req, err := NewDeployerRevenuesRequest(&method, []interface{}{deployer, pagination}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, expDeployer, req.DeployerAddress)
+	require.Equal(t, uint64(10), req.Pagination.Limit)
+	require.True(t, req.Pagination.CountTotal)
+
+	_, err = NewDeployerRevenuesRequest(&method, []interface{}{common.Address{}, pagination}, addrCodec)
+	require.ErrorContains(t, err, "invalid deployer address")
+}
+
+func TestNewRevenueData(t *testing.T) {
+	deployer := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contract := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	withdrawer := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	data := NewRevenueData(revenuetypes.NewRevenue(contract, deployer.Bytes(), nil))
+	require.Equal(t, RevenueData{ContractAddress: contract, Deployer: deployer, Withdrawer: deployer}, data)
+
+	data = NewRevenueData(revenuetypes.NewRevenue(contract, deployer.Bytes(), withdrawer.Bytes()))
+	require.Equal(t, RevenueData{ContractAddress: contract, Deployer: deployer, Withdrawer: withdrawer}, data)
+}
+
+func TestContractsOutputFromResponse(t *testing.T) {
+	contract := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	out := new(ContractsOutput).FromResponse([]string{contract.Hex()}, &query.PageResponse{Total: 1})
+	require.Equal(t, []common.Address{contract}, out.Contracts)
+	require.Equal(t, uint64(1), out.PageResponse.Total)
+
+	out = new(ContractsOutput).FromResponse(nil, nil)
+	require.Empty(t, out.Contracts)
+	require.Equal(t, query.PageResponse{}, out.PageResponse)
+}
@@ -51,6 +51,11 @@ func (p Precompile) Unjail(
 		ValidatorAddr: valAddr,
 	}
 
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
 	if _, err := p.slashingMsgServer.Unjail(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -5,15 +5,21 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	authzprecompile "github.com/zenanetwork/zena/precompiles/authz"
 	cmn "github.com/zenanetwork/zena/precompiles/common"
 	erc20Keeper "github.com/zenanetwork/zena/x/erc20/keeper"
+	revenuekeeper "github.com/zenanetwork/zena/x/revenue/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	transferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -24,16 +30,18 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
-	ValidatorAddrCodec address.Codec // used by slashing
-	ConsensusAddrCodec address.Codec // used by slashing
+	AddressCodec         address.Codec // used by gov/staking/vesting/authz/feegrant/icacontroller/revenue
+	ValidatorAddrCodec   address.Codec // used by slashing
+	ConsensusAddrCodec   address.Codec // used by slashing
+	AuthzAllowedMsgTypes []string      // used by authz
 }
 
 func defaultOptionals() Optionals {
 	return Optionals{
-		AddressCodec:       evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32AccountAddrPrefix()),
-		ValidatorAddrCodec: evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32ValidatorAddrPrefix()),
-		ConsensusAddrCodec: evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32ConsensusAddrPrefix()),
+		AddressCodec:         evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32AccountAddrPrefix()),
+		ValidatorAddrCodec:   evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32ValidatorAddrPrefix()),
+		ConsensusAddrCodec:   evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32ConsensusAddrPrefix()),
+		AuthzAllowedMsgTypes: authzprecompile.DefaultAllowedMsgTypes,
 	}
 }
 
@@ -57,12 +65,19 @@ func WithConsensusAddrCodec(codec address.Codec) Option {
 	}
 }
 
+func WithAuthzAllowedMsgTypes(msgTypes ...string) Option {
+	return func(opts *Optionals) {
+		opts.AuthzAllowedMsgTypes = msgTypes
+	}
+}
+
 const bech32PrecompileBaseGas = 6_000
 
 // DefaultStaticPrecompiles returns the list of all available static precompiled contracts from Cosmos EVM.
 //
 // NOTE: this should only be used during initialization of the Keeper.
 func DefaultStaticPrecompiles(
+	accountKeeper authkeeper.AccountKeeper,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper cmn.BankKeeper,
@@ -71,6 +86,10 @@ func DefaultStaticPrecompiles(
 	channelKeeper *channelkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
+	revenueKeeper *revenuekeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -81,9 +100,14 @@ func DefaultStaticPrecompiles(
 		WithStakingPrecompile(stakingKeeper, bankKeeper, opts...).
 		WithDistributionPrecompile(distributionKeeper, stakingKeeper, bankKeeper, opts...).
 		WithICS20Precompile(bankKeeper, stakingKeeper, transferKeeper, channelKeeper, erc20Keeper).
+		WithVestingPrecompile(accountKeeper, bankKeeper, opts...).
 		WithBankPrecompile(bankKeeper, erc20Keeper).
 		WithGovPrecompile(govKeeper, bankKeeper, codec, opts...).
-		WithSlashingPrecompile(slashingKeeper, bankKeeper, opts...)
+		WithSlashingPrecompile(slashingKeeper, bankKeeper, opts...).
+		WithAuthzPrecompile(authzKeeper, bankKeeper, codec, opts...).
+		WithFeegrantPrecompile(feegrantKeeper, bankKeeper, opts...).
+		WithICAControllerPrecompile(icaControllerKeeper, bankKeeper, codec, opts...).
+		WithRevenuePrecompile(revenueKeeper, bankKeeper, opts...)
 
 	return map[common.Address]vm.PrecompiledContract(precompiles)
 }
@@ -7,20 +7,34 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	authzprecompile "github.com/zenanetwork/zena/precompiles/authz"
 	bankprecompile "github.com/zenanetwork/zena/precompiles/bank"
 	"github.com/zenanetwork/zena/precompiles/bech32"
 	cmn "github.com/zenanetwork/zena/precompiles/common"
 	distprecompile "github.com/zenanetwork/zena/precompiles/distribution"
+	feegrantprecompile "github.com/zenanetwork/zena/precompiles/feegrant"
 	govprecompile "github.com/zenanetwork/zena/precompiles/gov"
+	icacontrollerprecompile "github.com/zenanetwork/zena/precompiles/icacontroller"
 	ics20precompile "github.com/zenanetwork/zena/precompiles/ics20"
 	"github.com/zenanetwork/zena/precompiles/p256"
+	revenueprecompile "github.com/zenanetwork/zena/precompiles/revenue"
 	slashingprecompile "github.com/zenanetwork/zena/precompiles/slashing"
 	stakingprecompile "github.com/zenanetwork/zena/precompiles/staking"
+	vestingprecompile "github.com/zenanetwork/zena/precompiles/vesting"
+	clawbackkeeper "github.com/zenanetwork/zena/x/clawback/keeper"
 	erc20Keeper "github.com/zenanetwork/zena/x/erc20/keeper"
+	revenuekeeper "github.com/zenanetwork/zena/x/revenue/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	transferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -118,6 +132,35 @@ func (s StaticPrecompiles) WithICS20Precompile(
 	return s
 }
 
+func (s StaticPrecompiles) WithVestingPrecompile(
+	accountKeeper authkeeper.AccountKeeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// NOTE: the vesting msg server requires the bank keeper to check if the
+	// vested coins are send enabled, which is not part of cmn.BankKeeper.
+	vestingBankKeeper, ok := bankKeeper.(vestingtypes.BankKeeper)
+	if !ok {
+		panic(fmt.Errorf("failed to instantiate vesting precompile: bank keeper %T does not implement vesting BankKeeper", bankKeeper))
+	}
+
+	vestingPrecompile := vestingprecompile.NewPrecompile(
+		accountKeeper,
+		vesting.NewMsgServerImpl(accountKeeper, vestingBankKeeper),
+		clawbackkeeper.NewKeeper(accountKeeper, vestingBankKeeper),
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[vestingPrecompile.Address()] = vestingPrecompile
+	return s
+}
+
 func (s StaticPrecompiles) WithBankPrecompile(
 	bankKeeper cmn.BankKeeper,
 	erc20Keeper *erc20Keeper.Keeper,
@@ -171,3 +214,90 @@ func (s StaticPrecompiles) WithSlashingPrecompile(
 	s[slashingPrecompile.Address()] = slashingPrecompile
 	return s
 }
+
+func (s StaticPrecompiles) WithAuthzPrecompile(
+	authzKeeper authzkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	codec codec.Codec,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	authzPrecompile := authzprecompile.NewPrecompile(
+		authzKeeper,
+		authzKeeper,
+		bankKeeper,
+		codec,
+		options.AddressCodec,
+		options.AuthzAllowedMsgTypes,
+	)
+
+	s[authzPrecompile.Address()] = authzPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithFeegrantPrecompile(
+	feegrantKeeper feegrantkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	feegrantPrecompile := feegrantprecompile.NewPrecompile(
+		feegrantKeeper,
+		feegrantkeeper.NewMsgServerImpl(feegrantKeeper),
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[feegrantPrecompile.Address()] = feegrantPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithICAControllerPrecompile(
+	controllerKeeper *icacontrollerkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	codec codec.Codec,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	icaControllerPrecompile := icacontrollerprecompile.NewPrecompile(
+		controllerKeeper,
+		bankKeeper,
+		codec,
+		options.AddressCodec,
+	)
+
+	s[icaControllerPrecompile.Address()] = icaControllerPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithRevenuePrecompile(
+	revenueKeeper *revenuekeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	revenuePrecompile := revenueprecompile.NewPrecompile(
+		revenueKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[revenuePrecompile.Address()] = revenuePrecompile
+	return s
+}
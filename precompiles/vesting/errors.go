@@ -0,0 +1,22 @@
+package vesting
+
+const (
+	// ErrInvalidFunder is raised when the funder address is not valid.
+	ErrInvalidFunder = "invalid funder address: %v"
+	// ErrInvalidVestingAddress is raised when the vesting account address is not valid.
+	ErrInvalidVestingAddress = "invalid vesting address: %v"
+	// ErrInvalidAmount is raised when the vesting amount cannot be parsed into valid coins.
+	ErrInvalidAmount = "invalid vesting amount: %v"
+	// ErrInvalidDestination is raised when the clawback destination address is not valid.
+	ErrInvalidDestination = "invalid clawback destination address: %v"
+	// ErrInvalidEndTime is raised when the vesting end time is not valid.
+	ErrInvalidEndTime = "invalid vesting end time: %v"
+	// ErrEmptyPeriods is raised when no vesting periods are provided.
+	ErrEmptyPeriods = "vesting periods cannot be empty"
+	// ErrInvalidPeriodAmount is raised when the amount of a vesting period is not valid.
+	ErrInvalidPeriodAmount = "invalid amount in vesting period %d: %v"
+	// ErrNotVestingAccount is raised when the queried account is not a vesting account.
+	ErrNotVestingAccount = "account %s is not a vesting account"
+	// ErrUnsupportedVestingAccount is raised when the vesting account type is not supported.
+	ErrUnsupportedVestingAccount = "unsupported vesting account type: %T"
+)
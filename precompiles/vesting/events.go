@@ -0,0 +1,240 @@
+package vesting
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// EventTypeCreateVestingAccount defines the event type for the vesting CreateVestingAccount transaction.
+	EventTypeCreateVestingAccount = "CreateVestingAccount"
+	// EventTypeCreatePeriodicVestingAccount defines the event type for the vesting CreatePeriodicVestingAccount transaction.
+	EventTypeCreatePeriodicVestingAccount = "CreatePeriodicVestingAccount"
+	// EventTypeCreatePermanentLockedAccount defines the event type for the vesting CreatePermanentLockedAccount transaction.
+	EventTypeCreatePermanentLockedAccount = "CreatePermanentLockedAccount"
+	// EventTypeCreateClawbackVestingAccount defines the event type for the vesting CreateClawbackVestingAccount transaction.
+	EventTypeCreateClawbackVestingAccount = "CreateClawbackVestingAccount"
+	// EventTypeFundVestingAccount defines the event type for the vesting FundVestingAccount transaction.
+	EventTypeFundVestingAccount = "FundVestingAccount"
+	// EventTypeClawback defines the event type for the vesting Clawback transaction.
+	EventTypeClawback = "Clawback"
+)
+
+// EmitCreateVestingAccountEvent creates a new event emitted on a CreateVestingAccount transaction.
+func (p Precompile) EmitCreateVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	amount sdk.Coins,
+	endTime int64,
+	delayed bool,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreateVestingAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount), endTime, delayed)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitCreatePeriodicVestingAccountEvent creates a new event emitted on a CreatePeriodicVestingAccount transaction.
+func (p Precompile) EmitCreatePeriodicVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	startTime int64,
+	periods vestingtypes.Periods,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreatePeriodicVestingAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(startTime, NewPeriodsResponse(periods))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitCreatePermanentLockedAccountEvent creates a new event emitted on a CreatePermanentLockedAccount transaction.
+func (p Precompile) EmitCreatePermanentLockedAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	amount sdk.Coins,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreatePermanentLockedAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitCreateClawbackVestingAccountEvent creates a new event emitted on a CreateClawbackVestingAccount transaction.
+func (p Precompile) EmitCreateClawbackVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	startTime int64,
+	periods vestingtypes.Periods,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreateClawbackVestingAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(startTime, NewPeriodsResponse(periods))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitFundVestingAccountEvent creates a new event emitted on a FundVestingAccount transaction.
+func (p Precompile) EmitFundVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	periods vestingtypes.Periods,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeFundVestingAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(NewPeriodsResponse(periods))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitClawbackEvent creates a new event emitted on a Clawback transaction.
+func (p Precompile) EmitClawbackEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress, dest common.Address,
+	coins sdk.Coins,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeClawback]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(dest, cmn.NewCoinsResponse(coins))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// createVestingTopics returns the topics shared by all vesting events, which
+// index the funder and the vesting account address.
+func (p Precompile) createVestingTopics(event abi.Event, funder, vestingAddress common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funder)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(vestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
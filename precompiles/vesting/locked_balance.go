@@ -0,0 +1,85 @@
+package vesting
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// lockedBalanceHandler keeps the EVM balance of the vesting account of a
+// transaction in line with its spendable balance.
+//
+// The EVM balance of an account only includes its spendable coins, while the
+// balance handler of the precompile applies the full amounts of the bank
+// events. The coins locked or unlocked by the transaction (e.g. the vesting
+// coins of a new vesting account or the unvested coins of a clawback) are
+// therefore removed from or added back to the EVM balance of the vesting
+// account, so that they aren't minted or burned when the state is committed.
+type lockedBalanceHandler struct {
+	accountKeeper  cmn.AccountKeeper
+	vestingAddress common.Address
+	lockedBefore   *big.Int
+	lockedIncrease *uint256.Int
+}
+
+// newLockedBalanceHandler creates a new lockedBalanceHandler for the given
+// vesting account address.
+func newLockedBalanceHandler(accountKeeper cmn.AccountKeeper, vestingAddress common.Address) *lockedBalanceHandler {
+	return &lockedBalanceHandler{
+		accountKeeper:  accountKeeper,
+		vestingAddress: vestingAddress,
+	}
+}
+
+// BeforeLockedChange records the locked coins of the vesting account before
+// the transaction is executed.
+func (h *lockedBalanceHandler) BeforeLockedChange(ctx sdk.Context) {
+	h.lockedBefore = h.lockedCoins(ctx)
+}
+
+// AfterLockedChange compares the locked coins of the vesting account with the
+// ones recorded before the transaction. Unlocked coins are added to the EVM
+// balance right away, before the balance handler subtracts the bank events
+// spending them. Locked coins are only removed by ApplyLockedChange, once the
+// balance handler added the bank events receiving them.
+func (h *lockedBalanceHandler) AfterLockedChange(ctx sdk.Context, stateDB vm.StateDB) {
+	delta := new(big.Int).Sub(h.lockedCoins(ctx), h.lockedBefore)
+
+	switch delta.Sign() {
+	case 1:
+		h.lockedIncrease = uint256.MustFromBig(delta)
+	case -1:
+		stateDB.AddBalance(h.vestingAddress, uint256.MustFromBig(delta.Neg(delta)), tracing.BalanceChangeUnspecified)
+	}
+}
+
+// ApplyLockedChange removes the coins locked by the transaction from the EVM
+// balance of the vesting account. It must be called after the balance handler
+// of the precompile processed the bank events.
+func (h *lockedBalanceHandler) ApplyLockedChange(stateDB vm.StateDB) {
+	if h.lockedIncrease != nil {
+		stateDB.SubBalance(h.vestingAddress, h.lockedIncrease, tracing.BalanceChangeUnspecified)
+	}
+}
+
+// lockedCoins returns the locked amount of the EVM coin of the vesting
+// account, scaled to 18 decimals like the EVM balances.
+func (h *lockedBalanceHandler) lockedCoins(ctx sdk.Context) *big.Int {
+	account := h.accountKeeper.GetAccount(ctx, h.vestingAddress.Bytes())
+	vestingAccount, ok := account.(vestingexported.VestingAccount)
+	if !ok {
+		return big.NewInt(0)
+	}
+
+	locked := vestingAccount.LockedCoins(ctx.BlockTime()).AmountOf(evmtypes.GetEVMCoinDenom())
+	return evmtypes.ConvertAmountTo18DecimalsBigInt(locked.BigInt())
+}
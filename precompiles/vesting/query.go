@@ -0,0 +1,102 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+const (
+	// BalancesMethod defines the ABI method name for the vesting Balances query.
+	BalancesMethod = "balances"
+	// GetVestingAccountMethod defines the ABI method name for the vesting
+	// GetVestingAccount query.
+	GetVestingAccountMethod = "getVestingAccount"
+)
+
+// Balances returns the locked, unlocked, vested and unvested coins of a
+// vesting account at the current block time.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	vestingAddress, err := ParseVestingAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := p.getVestingAccount(ctx, vestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime()
+
+	var balance sdk.Coins
+	p.bankKeeper.IterateAccountBalances(ctx, vestingAddress.Bytes(), func(coin sdk.Coin) bool {
+		balance = balance.Add(coin)
+		return false
+	})
+
+	// NOTE: this mirrors the x/bank spendable coins calculation, where no coins
+	// are unlocked if the locked coins exceed the account balance.
+	locked := account.LockedCoins(blockTime)
+	unlocked, hasNeg := balance.SafeSub(locked...)
+	if hasNeg {
+		unlocked = sdk.NewCoins()
+	}
+
+	out := BalancesOutput{
+		Locked:   cmn.NewCoinsResponse(locked),
+		Unlocked: cmn.NewCoinsResponse(unlocked),
+		Vested:   cmn.NewCoinsResponse(account.GetVestedCoins(blockTime)),
+		Unvested: cmn.NewCoinsResponse(account.GetVestingCoins(blockTime)),
+	}
+
+	return method.Outputs.Pack(out.Locked, out.Unlocked, out.Vested, out.Unvested)
+}
+
+// GetVestingAccount returns the vesting schedule of a vesting account.
+func (p Precompile) GetVestingAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	vestingAddress, err := ParseVestingAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := p.getVestingAccount(ctx, vestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewVestingAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// getVestingAccount returns the vesting account stored at the given address.
+func (p Precompile) getVestingAccount(ctx sdk.Context, vestingAddress common.Address) (vestingexported.VestingAccount, error) {
+	account := p.accountKeeper.GetAccount(ctx, vestingAddress.Bytes())
+	vestingAccount, ok := account.(vestingexported.VestingAccount)
+	if account == nil || !ok {
+		return nil, fmt.Errorf(ErrNotVestingAccount, vestingAddress)
+	}
+
+	return vestingAccount, nil
+}
@@ -0,0 +1,310 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateVestingAccountMethod defines the ABI method name for the vesting
+	// CreateVestingAccount transaction.
+	CreateVestingAccountMethod = "createVestingAccount"
+	// CreatePeriodicVestingAccountMethod defines the ABI method name for the vesting
+	// CreatePeriodicVestingAccount transaction.
+	CreatePeriodicVestingAccountMethod = "createPeriodicVestingAccount"
+	// CreatePermanentLockedAccountMethod defines the ABI method name for the vesting
+	// CreatePermanentLockedAccount transaction.
+	CreatePermanentLockedAccountMethod = "createPermanentLockedAccount"
+	// CreateClawbackVestingAccountMethod defines the ABI method name for the vesting
+	// CreateClawbackVestingAccount transaction.
+	CreateClawbackVestingAccountMethod = "createClawbackVestingAccount"
+	// FundVestingAccountMethod defines the ABI method name for the vesting
+	// FundVestingAccount transaction.
+	FundVestingAccountMethod = "fundVestingAccount"
+	// ClawbackMethod defines the ABI method name for the vesting Clawback
+	// transaction.
+	ClawbackMethod = "clawback"
+)
+
+// CreateVestingAccount creates a new continuous or delayed vesting account
+// funded by the caller.
+func (p Precompile) CreateVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, vestingAddress, err := NewMsgCreateVestingAccount(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, amount: %s, end_time: %d, delayed: %t }",
+			funder, vestingAddress, msg.Amount, msg.EndTime, msg.Delayed,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funder.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.vestingMsgServer.CreateVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the create vesting account transaction
+	if err = p.EmitCreateVestingAccountEvent(ctx, stateDB, funder, vestingAddress, msg.Amount, msg.EndTime, msg.Delayed); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePeriodicVestingAccount creates a new periodic vesting account funded
+// by the caller with the total amount of the given periods.
+func (p Precompile) CreatePeriodicVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, input, err := NewMsgCreatePeriodicVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, start_time: %d, periods: %v }",
+			input.Funder, input.VestingAddress, msg.StartTime, msg.VestingPeriods,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != input.Funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), input.Funder.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.vestingMsgServer.CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the create periodic vesting account transaction
+	if err = p.EmitCreatePeriodicVestingAccountEvent(ctx, stateDB, input.Funder, input.VestingAddress, msg.StartTime, msg.VestingPeriods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePermanentLockedAccount creates a new permanently locked account funded
+// by the caller.
+func (p Precompile) CreatePermanentLockedAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, vestingAddress, err := NewMsgCreatePermanentLockedAccount(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, amount: %s }",
+			funder, vestingAddress, msg.Amount,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funder.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.vestingMsgServer.CreatePermanentLockedAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the create permanent locked account transaction
+	if err = p.EmitCreatePermanentLockedAccountEvent(ctx, stateDB, funder, vestingAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreateClawbackVestingAccount creates a new clawback vesting account funded
+// by the caller with the total amount of the given periods.
+func (p Precompile) CreateClawbackVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, input, err := NewMsgCreateClawbackVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, start_time: %d, periods: %v }",
+			input.Funder, input.VestingAddress, msg.StartTime, msg.VestingPeriods,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != input.Funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), input.Funder.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.clawbackMsgServer.CreateClawbackVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the create clawback vesting account transaction
+	if err = p.EmitCreateClawbackVestingAccountEvent(ctx, stateDB, input.Funder, input.VestingAddress, msg.StartTime, msg.VestingPeriods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// FundVestingAccount appends the given periods to the schedule of a clawback
+// vesting account funded by the caller.
+func (p Precompile) FundVestingAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, input, err := NewMsgFundVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, periods: %v }",
+			input.Funder, input.VestingAddress, msg.VestingPeriods,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != input.Funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), input.Funder.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	if _, err = p.clawbackMsgServer.FundVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the fund vesting account transaction
+	if err = p.EmitFundVestingAccountEvent(ctx, stateDB, input.Funder, input.VestingAddress, msg.VestingPeriods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Clawback transfers the unvested coins of a clawback vesting account funded
+// by the caller to the given destination.
+func (p Precompile) Clawback(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, vestingAddress, dest, err := NewMsgClawback(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, dest: %s }",
+			funder, vestingAddress, dest,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funder.String())
+	}
+
+	if err := cmn.CheckCosmosMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+	cmn.TraceCosmosMsg(ctx, msg)
+
+	// Execute the transaction using the message server
+	res, err := p.clawbackMsgServer.Clawback(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the clawback transaction
+	if err = p.EmitClawbackEvent(ctx, stateDB, funder, vestingAddress, dest, res.Coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Coins))
+}
@@ -0,0 +1,488 @@
+package vesting
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	clawbacktypes "github.com/zenanetwork/zena/x/clawback/types"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// ContinuousVestingAccountType is the account type returned for continuous vesting accounts.
+	ContinuousVestingAccountType = "continuous"
+	// DelayedVestingAccountType is the account type returned for delayed vesting accounts.
+	DelayedVestingAccountType = "delayed"
+	// PeriodicVestingAccountType is the account type returned for periodic vesting accounts.
+	PeriodicVestingAccountType = "periodic"
+	// PermanentLockedAccountType is the account type returned for permanently locked accounts.
+	PermanentLockedAccountType = "permanent_locked"
+	// ClawbackVestingAccountType is the account type returned for clawback vesting accounts.
+	ClawbackVestingAccountType = "clawback"
+)
+
+// Period defines a length of time and amount of coins that will vest.
+// We need this to be able to unpack to big.Int instead of math.Int.
+type Period struct {
+	Length int64
+	Amount []cmn.Coin
+}
+
+// CreatePeriodicVestingAccountInput defines the input for the createPeriodicVestingAccount transaction.
+type CreatePeriodicVestingAccountInput struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	StartTime      int64
+	Periods        []Period
+}
+
+// CreateClawbackVestingAccountInput defines the input for the createClawbackVestingAccount transaction.
+type CreateClawbackVestingAccountInput struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	StartTime      int64
+	Periods        []Period
+}
+
+// FundVestingAccountInput defines the input for the fundVestingAccount transaction.
+type FundVestingAccountInput struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	Periods        []Period
+}
+
+// EventCreateVestingAccount defines the event data for the CreateVestingAccount transaction.
+type EventCreateVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	Amount         []cmn.Coin
+	EndTime        int64
+	Delayed        bool
+}
+
+// EventCreatePeriodicVestingAccount defines the event data for the CreatePeriodicVestingAccount transaction.
+type EventCreatePeriodicVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	StartTime      int64
+	Periods        []Period
+}
+
+// EventCreatePermanentLockedAccount defines the event data for the CreatePermanentLockedAccount transaction.
+type EventCreatePermanentLockedAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	Amount         []cmn.Coin
+}
+
+// EventCreateClawbackVestingAccount defines the event data for the CreateClawbackVestingAccount transaction.
+type EventCreateClawbackVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	StartTime      int64
+	Periods        []Period
+}
+
+// EventFundVestingAccount defines the event data for the FundVestingAccount transaction.
+type EventFundVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	Periods        []Period
+}
+
+// EventClawback defines the event data for the Clawback transaction.
+type EventClawback struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	Dest           common.Address
+	Coins          []cmn.Coin
+}
+
+// BalancesOutput defines the output for the balances query.
+type BalancesOutput struct {
+	Locked   []cmn.Coin
+	Unlocked []cmn.Coin
+	Vested   []cmn.Coin
+	Unvested []cmn.Coin
+}
+
+// VestingAccount defines the vesting schedule returned by the getVestingAccount query.
+type VestingAccount struct {
+	AccountType      string
+	StartTime        int64
+	EndTime          int64
+	OriginalVesting  []cmn.Coin
+	DelegatedFree    []cmn.Coin
+	DelegatedVesting []cmn.Coin
+	Periods          []Period
+}
+
+// VestingAccountOutput defines the output for the getVestingAccount query.
+type VestingAccountOutput struct {
+	Account VestingAccount
+}
+
+// NewMsgCreateVestingAccount creates a new MsgCreateVestingAccount instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgCreateVestingAccount(args []interface{}, addrCdc address.Codec) (*vestingtypes.MsgCreateVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	funder, vestingAddress, err := parseFunderAndVestingAddress(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	amount, err := parseCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	endTime, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidEndTime, args[3])
+	}
+
+	delayed, ok := args[4].(bool)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "delayed", false, args[4])
+	}
+
+	fromAddr, toAddr, err := toBech32Addresses(addrCdc, funder, vestingAddress)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &vestingtypes.MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+
+	return msg, funder, vestingAddress, nil
+}
+
+// NewMsgCreatePeriodicVestingAccount creates a new MsgCreatePeriodicVestingAccount instance and does
+// sanity checks on the given arguments before populating the message.
+func NewMsgCreatePeriodicVestingAccount(method *abi.Method, args []interface{}, addrCdc address.Codec) (*vestingtypes.MsgCreatePeriodicVestingAccount, *CreatePeriodicVestingAccountInput, error) {
+	if len(args) != 4 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input CreatePeriodicVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to CreatePeriodicVestingAccountInput: %s", err)
+	}
+
+	if input.Funder == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidFunder, input.Funder)
+	}
+
+	if input.VestingAddress == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidVestingAddress, input.VestingAddress)
+	}
+
+	periods, err := toSdkPeriods(input.Periods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromAddr, toAddr, err := toBech32Addresses(addrCdc, input.Funder, input.VestingAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &vestingtypes.MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      input.StartTime,
+		VestingPeriods: periods,
+	}
+
+	return msg, &input, nil
+}
+
+// NewMsgCreatePermanentLockedAccount creates a new MsgCreatePermanentLockedAccount instance and does
+// sanity checks on the given arguments before populating the message.
+func NewMsgCreatePermanentLockedAccount(args []interface{}, addrCdc address.Codec) (*vestingtypes.MsgCreatePermanentLockedAccount, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	funder, vestingAddress, err := parseFunderAndVestingAddress(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	amount, err := parseCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	fromAddr, toAddr, err := toBech32Addresses(addrCdc, funder, vestingAddress)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &vestingtypes.MsgCreatePermanentLockedAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+	}
+
+	return msg, funder, vestingAddress, nil
+}
+
+// NewMsgCreateClawbackVestingAccount creates a new MsgCreateClawbackVestingAccount instance and does
+// sanity checks on the given arguments before populating the message.
+func NewMsgCreateClawbackVestingAccount(method *abi.Method, args []interface{}, addrCdc address.Codec) (*clawbacktypes.MsgCreateClawbackVestingAccount, *CreateClawbackVestingAccountInput, error) {
+	if len(args) != 4 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input CreateClawbackVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to CreateClawbackVestingAccountInput: %s", err)
+	}
+
+	if input.Funder == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidFunder, input.Funder)
+	}
+
+	if input.VestingAddress == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidVestingAddress, input.VestingAddress)
+	}
+
+	periods, err := toSdkPeriods(input.Periods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromAddr, toAddr, err := toBech32Addresses(addrCdc, input.Funder, input.VestingAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &clawbacktypes.MsgCreateClawbackVestingAccount{
+		FunderAddress:  fromAddr,
+		VestingAddress: toAddr,
+		StartTime:      input.StartTime,
+		VestingPeriods: periods,
+	}
+
+	return msg, &input, nil
+}
+
+// NewMsgFundVestingAccount creates a new MsgFundVestingAccount instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgFundVestingAccount(method *abi.Method, args []interface{}, addrCdc address.Codec) (*clawbacktypes.MsgFundVestingAccount, *FundVestingAccountInput, error) {
+	if len(args) != 3 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input FundVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to FundVestingAccountInput: %s", err)
+	}
+
+	if input.Funder == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidFunder, input.Funder)
+	}
+
+	if input.VestingAddress == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidVestingAddress, input.VestingAddress)
+	}
+
+	periods, err := toSdkPeriods(input.Periods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromAddr, toAddr, err := toBech32Addresses(addrCdc, input.Funder, input.VestingAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &clawbacktypes.MsgFundVestingAccount{
+		FunderAddress:  fromAddr,
+		VestingAddress: toAddr,
+		VestingPeriods: periods,
+	}
+
+	return msg, &input, nil
+}
+
+// NewMsgClawback creates a new MsgClawback instance and does sanity checks on the given
+// arguments before populating the message.
+func NewMsgClawback(args []interface{}, addrCdc address.Codec) (*clawbacktypes.MsgClawback, common.Address, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	funder, vestingAddress, err := parseFunderAndVestingAddress(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	dest, ok := args[2].(common.Address)
+	if !ok || dest == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidDestination, args[2])
+	}
+
+	fromAddr, vestingAddr, err := toBech32Addresses(addrCdc, funder, vestingAddress)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	destAddr, err := addrCdc.BytesToString(dest.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf("failed to decode destination address: %w", err)
+	}
+
+	msg := &clawbacktypes.MsgClawback{
+		FunderAddress:  fromAddr,
+		VestingAddress: vestingAddr,
+		DestAddress:    destAddr,
+	}
+
+	return msg, funder, vestingAddress, dest, nil
+}
+
+// ParseVestingAddressArgs parses the vesting account address used by the queries.
+func ParseVestingAddressArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	vestingAddress, ok := args[0].(common.Address)
+	if !ok || vestingAddress == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[0])
+	}
+
+	return vestingAddress, nil
+}
+
+// NewVestingAccount converts a vesting account into the ABI representation
+// returned by the getVestingAccount query.
+func NewVestingAccount(account vestingexported.VestingAccount) (VestingAccount, error) {
+	out := VestingAccount{
+		StartTime:        account.GetStartTime(),
+		EndTime:          account.GetEndTime(),
+		OriginalVesting:  cmn.NewCoinsResponse(account.GetOriginalVesting()),
+		DelegatedFree:    cmn.NewCoinsResponse(account.GetDelegatedFree()),
+		DelegatedVesting: cmn.NewCoinsResponse(account.GetDelegatedVesting()),
+		Periods:          []Period{},
+	}
+
+	switch acc := account.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		out.AccountType = ContinuousVestingAccountType
+	case *vestingtypes.DelayedVestingAccount:
+		out.AccountType = DelayedVestingAccountType
+	case *vestingtypes.PeriodicVestingAccount:
+		out.AccountType = PeriodicVestingAccountType
+		out.Periods = NewPeriodsResponse(acc.GetVestingPeriods())
+	case *vestingtypes.PermanentLockedAccount:
+		out.AccountType = PermanentLockedAccountType
+	case *clawbacktypes.ClawbackVestingAccount:
+		out.AccountType = ClawbackVestingAccountType
+		out.Periods = NewPeriodsResponse(acc.GetVestingPeriods())
+	default:
+		return VestingAccount{}, fmt.Errorf(ErrUnsupportedVestingAccount, account)
+	}
+
+	return out, nil
+}
+
+// NewPeriodsResponse converts the SDK vesting periods into their ABI representation.
+func NewPeriodsResponse(periods vestingtypes.Periods) []Period {
+	out := make([]Period, len(periods))
+	for i, period := range periods {
+		out[i] = Period{
+			Length: period.Length,
+			Amount: cmn.NewCoinsResponse(period.Amount),
+		}
+	}
+	return out
+}
+
+// toSdkPeriods converts the ABI vesting periods into the SDK vesting periods.
+func toSdkPeriods(periods []Period) (vestingtypes.Periods, error) {
+	if len(periods) == 0 {
+		return nil, errors.New(ErrEmptyPeriods)
+	}
+
+	out := make(vestingtypes.Periods, len(periods))
+	for i, period := range periods {
+		amount, err := cmn.NewSdkCoinsFromCoins(period.Amount)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidPeriodAmount, i, err)
+		}
+
+		out[i] = vestingtypes.Period{
+			Length: period.Length,
+			Amount: amount,
+		}
+	}
+
+	return out, nil
+}
+
+// parseFunderAndVestingAddress parses the first two arguments of the vesting
+// transactions, which are always the funder and the vesting account address.
+func parseFunderAndVestingAddress(args []interface{}) (common.Address, common.Address, error) {
+	funder, ok := args[0].(common.Address)
+	if !ok || funder == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidFunder, args[0])
+	}
+
+	vestingAddress, ok := args[1].(common.Address)
+	if !ok || vestingAddress == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[1])
+	}
+
+	return funder, vestingAddress, nil
+}
+
+// parseCoins parses a Coin[] argument into sdk.Coins.
+func parseCoins(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidAmount, err)
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidAmount, err)
+	}
+
+	return amount, nil
+}
+
+// toBech32Addresses converts the funder and vesting account hex addresses into
+// their bech32 representation.
+func toBech32Addresses(addrCdc address.Codec, funder, vestingAddress common.Address) (string, string, error) {
+	fromAddr, err := addrCdc.BytesToString(funder.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode funder address: %w", err)
+	}
+
+	toAddr, err := addrCdc.BytesToString(vestingAddress.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode vesting address: %w", err)
+	}
+
+	return fromAddr, toAddr, nil
+}
@@ -0,0 +1,197 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestNewMsgCreateVestingAccount(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	funder := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	vestingAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	coins := []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(100)}}
+
+	expFrom, err := addrCodec.BytesToString(funder.Bytes())
+	require.NoError(t, err)
+	expTo, err := addrCodec.BytesToString(vestingAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{funder, vestingAddr, coins, int64(1000), true},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "empty funder",
+			args:    []interface{}{common.Address{}, vestingAddr, coins, int64(1000), true},
+			wantErr: true,
+			errMsg:  "invalid funder address",
+		},
+		{
+			name:    "invalid vesting address type",
+			args:    []interface{}{funder, "vesting", coins, int64(1000), true},
+			wantErr: true,
+			errMsg:  "invalid vesting address",
+		},
+		{
+			name:    "invalid amount",
+			args:    []interface{}{funder, vestingAddr, []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(-1)}}, int64(1000), true},
+			wantErr: true,
+			errMsg:  "invalid vesting amount",
+		},
+		{
+			name:    "invalid end time type",
+			args:    []interface{}{funder, vestingAddr, coins, uint64(1000), true},
+			wantErr: true,
+			errMsg:  "invalid vesting end time",
+		},
+		{
+			name:    "invalid delayed type",
+			args:    []interface{}{funder, vestingAddr, coins, int64(1000), "true"},
+			wantErr: true,
+			errMsg:  "invalid type for delayed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotFunder, gotVestingAddr, err := NewMsgCreateVestingAccount(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, funder, gotFunder)
+			require.Equal(t, vestingAddr, gotVestingAddr)
+			require.Equal(t, expFrom, msg.FromAddress)
+			require.Equal(t, expTo, msg.ToAddress)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aatom", 100)), msg.Amount)
+			require.Equal(t, int64(1000), msg.EndTime)
+			require.True(t, msg.Delayed)
+		})
+	}
+}
+
+func TestNewMsgCreatePeriodicVestingAccount(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	method := ABI.Methods[CreatePeriodicVestingAccountMethod]
+	funder := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	vestingAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	tests := []struct {
+		name       string
+		args       []interface{}
+		wantErr    bool
+		errMsg     string
+		wantPeriod vestingtypes.Periods
+	}{
+		{
+			name: "valid",
+			args: []interface{}{funder, vestingAddr, int64(10), []Period{
+				{Length: 5, Amount: []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(1)}}},
+				{Length: 7, Amount: []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(2)}}},
+			}},
+			wantPeriod: vestingtypes.Periods{
+				{Length: 5, Amount: sdk.NewCoins(sdk.NewInt64Coin("aatom", 1))},
+				{Length: 7, Amount: sdk.NewCoins(sdk.NewInt64Coin("aatom", 2))},
+			},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty periods",
+			args:    []interface{}{funder, vestingAddr, int64(10), []Period{}},
+			wantErr: true,
+			errMsg:  ErrEmptyPeriods,
+		},
+		{
+			name: "invalid period amount",
+			args: []interface{}{funder, vestingAddr, int64(10), []Period{
+				{Length: 5, Amount: []cmn.Coin{{Denom: "", Amount: big.NewInt(1)}}},
+			}},
+			wantErr: true,
+			errMsg:  "invalid amount in vesting period 0",
+		},
+		{
+			name:    "empty vesting address",
+			args:    []interface{}{funder, common.Address{}, int64(10), []Period{{Length: 5}}},
+			wantErr: true,
+			errMsg:  "invalid vesting address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, input, err := NewMsgCreatePeriodicVestingAccount(&method, tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, funder, input.Funder)
+			require.Equal(t, vestingAddr, input.VestingAddress)
+			require.Equal(t, int64(10), msg.StartTime)
+			require.Equal(t, tt.wantPeriod, vestingtypes.Periods(msg.VestingPeriods))
+		})
+	}
+}
+
+func TestNewVestingAccount(t *testing.T) {
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("vesting"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("aatom", 100))
+
+	continuous, err := vestingtypes.NewContinuousVestingAccount(baseAcc, coins, 10, 20)
+	require.NoError(t, err)
+	out, err := NewVestingAccount(continuous)
+	require.NoError(t, err)
+	require.Equal(t, ContinuousVestingAccountType, out.AccountType)
+	require.Equal(t, int64(10), out.StartTime)
+	require.Equal(t, int64(20), out.EndTime)
+	require.Equal(t, cmn.NewCoinsResponse(coins), out.OriginalVesting)
+	require.Empty(t, out.Periods)
+
+	locked, err := vestingtypes.NewPermanentLockedAccount(baseAcc, coins)
+	require.NoError(t, err)
+	out, err = NewVestingAccount(locked)
+	require.NoError(t, err)
+	require.Equal(t, PermanentLockedAccountType, out.AccountType)
+	require.Equal(t, int64(0), out.EndTime)
+
+	periods := vestingtypes.Periods{{Length: 10, Amount: coins}}
+	periodic, err := vestingtypes.NewPeriodicVestingAccount(baseAcc, coins, 10, periods)
+	require.NoError(t, err)
+	out, err = NewVestingAccount(periodic)
+	require.NoError(t, err)
+	require.Equal(t, PeriodicVestingAccountType, out.AccountType)
+	require.Equal(t, NewPeriodsResponse(periods), out.Periods)
+}
@@ -0,0 +1,205 @@
+package vesting
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	clawbacktypes "github.com/zenanetwork/zena/x/clawback/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract for vesting accounts.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	accountKeeper     cmn.AccountKeeper
+	bankKeeper        cmn.BankKeeper
+	vestingMsgServer  vestingtypes.MsgServer
+	clawbackMsgServer clawbacktypes.MsgServer
+	addrCdc           address.Codec
+}
+
+// NewPrecompile creates a new vesting Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	accountKeeper cmn.AccountKeeper,
+	vestingMsgServer vestingtypes.MsgServer,
+	clawbackMsgServer clawbacktypes.MsgServer,
+	bankKeeper cmn.BankKeeper,
+	addrCdc address.Codec,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(evmtypes.VestingPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:               ABI,
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		vestingMsgServer:  vestingMsgServer,
+		clawbackMsgServer: clawbackMsgServer,
+		addrCdc:           addrCdc,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	vestingAddress, ok := p.vestingAddress(contract.Input)
+	if !ok {
+		return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+			return p.Execute(ctx, evm.StateDB, contract, readonly)
+		})
+	}
+
+	lockedBalanceHandler := newLockedBalanceHandler(p.accountKeeper, vestingAddress)
+	bz, err := p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		lockedBalanceHandler.BeforeLockedChange(ctx)
+
+		bz, err := p.Execute(ctx, evm.StateDB, contract, readonly)
+		if err != nil {
+			return nil, err
+		}
+
+		lockedBalanceHandler.AfterLockedChange(ctx, evm.StateDB)
+		return bz, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lockedBalanceHandler.ApplyLockedChange(evm.StateDB)
+	return bz, nil
+}
+
+// vestingAddress returns the vesting account address of a transaction, which
+// is the second argument of all the vesting transactions. It returns false for
+// queries and invalid inputs, which are left to Execute to handle.
+func (p Precompile) vestingAddress(input []byte) (common.Address, bool) {
+	if len(input) < 4 {
+		return common.Address{}, false
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil || !p.IsTransaction(method) {
+		return common.Address{}, false
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil || len(args) < 2 {
+		return common.Address{}, false
+	}
+
+	vestingAddress, ok := args[1].(common.Address)
+	return vestingAddress, ok
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+
+	switch method.Name {
+	// vesting transactions
+	case CreateVestingAccountMethod:
+		bz, err = p.CreateVestingAccount(ctx, contract, stateDB, method, args)
+	case CreatePeriodicVestingAccountMethod:
+		bz, err = p.CreatePeriodicVestingAccount(ctx, contract, stateDB, method, args)
+	case CreatePermanentLockedAccountMethod:
+		bz, err = p.CreatePermanentLockedAccount(ctx, contract, stateDB, method, args)
+	case CreateClawbackVestingAccountMethod:
+		bz, err = p.CreateClawbackVestingAccount(ctx, contract, stateDB, method, args)
+	case FundVestingAccountMethod:
+		bz, err = p.FundVestingAccount(ctx, contract, stateDB, method, args)
+	case ClawbackMethod:
+		bz, err = p.Clawback(ctx, contract, stateDB, method, args)
+	// vesting queries
+	case BalancesMethod:
+		bz, err = p.Balances(ctx, method, contract, args)
+	case GetVestingAccountMethod:
+		bz, err = p.GetVestingAccount(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available vesting transactions are:
+// - CreateVestingAccount
+// - CreatePeriodicVestingAccount
+// - CreatePermanentLockedAccount
+// - CreateClawbackVestingAccount
+// - FundVestingAccount
+// - Clawback
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateVestingAccountMethod,
+		CreatePeriodicVestingAccountMethod,
+		CreatePermanentLockedAccountMethod,
+		CreateClawbackVestingAccountMethod,
+		FundVestingAccountMethod,
+		ClawbackMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "vesting")
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/cometbft/cometbft/libs/bytes"
@@ -19,9 +21,19 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+const (
+	// AccountRangeMaxResults is the maximum number of accounts returned by a single
+	// account range query, as in geth.
+	AccountRangeMaxResults = 256
+	// StorageRangeMaxResults is the maximum number of storage entries returned by a
+	// single storage range query.
+	StorageRangeMaxResults = 1024
+)
+
 // GetCode returns the contract code at the given address and block number.
 func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
@@ -214,3 +226,107 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	n = hexutil.Uint64(nonce)
 	return &n, nil
 }
+
+// StorageRangeAt returns at most maxResult storage slots of the contract at the given
+// block number, ordered by key and starting from keyStart. Unlike in geth, the slots
+// are ordered by their raw key instead of its hash, so keyStart and the next key
+// are raw slot keys.
+func (b *Backend) StorageRangeAt(address common.Address, keyStart hexutil.Bytes, maxResult int, blockNum rpctypes.BlockNumber) (rpctypes.StorageRangeResult, error) {
+	if maxResult <= 0 || maxResult > StorageRangeMaxResults {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("invalid max result %d, must be between 1 and %d", maxResult, StorageRangeMaxResults)
+	}
+
+	pagination := &query.PageRequest{Limit: uint64(maxResult)} //#nosec G115 -- checked for negative values already
+	if len(keyStart) > 0 {
+		pagination.Key = common.BytesToHash(keyStart).Bytes()
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address:    address.String(),
+		Pagination: pagination,
+	}
+
+	res, err := b.QueryClient.StorageRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	// the storage entries are indexed by the hash of their key, as in the geth secure
+	// trie, but the next key is a raw key as the store isn't ordered by hash
+	result := rpctypes.StorageRangeResult{Storage: make(rpctypes.StorageMap, len(res.Storage))}
+	for _, entry := range res.Storage {
+		key := common.HexToHash(entry.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(entry.Value),
+		}
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// AccountRange returns at most maxResults accounts with an Ethereum address at the given
+// block number, ordered by address and starting from the start address.
+func (b *Backend) AccountRange(start hexutil.Bytes, maxResults int, nocode, nostorage bool, blockNum rpctypes.BlockNumber) (state.Dump, error) {
+	if maxResults <= 0 || maxResults > AccountRangeMaxResults {
+		return state.Dump{}, fmt.Errorf("invalid max results %d, must be between 1 and %d", maxResults, AccountRangeMaxResults)
+	}
+
+	header, err := b.HeaderByNumber(blockNum)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	if header == nil {
+		// the error message imitates geth behavior
+		return state.Dump{}, errors.New("header not found")
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Pagination:  &query.PageRequest{Key: start, Limit: uint64(maxResults)}, //#nosec G115 -- checked for negative values already
+		SkipCode:    nocode,
+		SkipStorage: nostorage,
+	}
+
+	res, err := b.QueryClient.AccountRange(rpctypes.ContextWithHeight(header.Number.Int64()), req)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	dump := state.Dump{Accounts: make(map[string]state.DumpAccount, len(res.Accounts))}
+	dump.OnRoot(header.Root)
+	for _, account := range res.Accounts {
+		address := common.HexToAddress(account.Address)
+		dump.OnAccount(&address, dumpAccount(account))
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		dump.Next = res.Pagination.NextKey
+	}
+
+	return dump, nil
+}
+
+// dumpAccount converts an account returned by the EVM module to its geth dump format.
+func dumpAccount(account evmtypes.DumpAccount) state.DumpAccount {
+	res := state.DumpAccount{
+		Balance:  account.Balance,
+		Nonce:    account.Nonce,
+		CodeHash: common.HexToHash(account.CodeHash).Bytes(),
+		Code:     account.Code,
+	}
+
+	if len(account.Storage) > 0 {
+		res.Storage = make(map[common.Hash]string, len(account.Storage))
+		for _, entry := range account.Storage {
+			value := common.HexToHash(entry.Value)
+			res.Storage[common.HexToHash(entry.Key)] = common.Bytes2Hex(common.TrimLeftZeroes(value.Bytes()))
+		}
+	}
+
+	return res
+}
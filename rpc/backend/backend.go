@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -56,6 +57,7 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	RPCModifiedAccountsRangeCap() int32 // global block range cap for debug_getModifiedAccounts: DoS protection
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -89,6 +91,8 @@ type EVMBackend interface {
 	GetStorageAt(address common.Address, key string, blockNrOrHash types.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash types.BlockNumberOrHash) (*types.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum types.BlockNumber) (*hexutil.Uint64, error)
+	StorageRangeAt(address common.Address, keyStart hexutil.Bytes, maxResult int, blockNum types.BlockNumber) (types.StorageRangeResult, error)
+	AccountRange(start hexutil.Bytes, maxResults int, nocode, nostorage bool, blockNum types.BlockNumber) (state.Dump, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -99,6 +103,7 @@ type EVMBackend interface {
 	PendingTransactions() ([]*sdk.Tx, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*types.FeeHistoryResult, error)
+	BurnedBaseFee(blockNrOrHash types.BlockNumberOrHash) (*types.BurnedBaseFeeResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
 
 	// Tx Info
@@ -118,6 +123,7 @@ type EVMBackend interface {
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
@@ -317,6 +317,33 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// BurnedBaseFee returns the base fees burned at the end of the given block and
+// since genesis.
+func (b *Backend) BurnedBaseFee(blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.BurnedBaseFeeResult, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CometHeaderByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	height := header.Header.Height
+	res, err := b.QueryClient.FeeMarket.BurnedBaseFee(rpctypes.ContextWithHeight(height), &feemarkettypes.QueryBurnedBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.BurnedBaseFeeResult{
+		BlockNumber:  (*hexutil.Big)(big.NewInt(height)),
+		Burned:       (*hexutil.Big)(res.Block.Amount.BigInt()),
+		TotalBurned:  (*hexutil.Big)(res.Total.Amount.BigInt()),
+		BurnFraction: res.Fraction.String(),
+	}, nil
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	servertypes "github.com/zenanetwork/zena/server/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -20,6 +22,16 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if rcptIndexer, ok := b.Indexer.(servertypes.EVMReceiptIndexer); ok && height != nil {
+		logs, found, err := rcptIndexer.GetLogsByHeight(*height)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return logs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.RPCClient.BlockResults(b.Ctx, height)
 	if err != nil {
@@ -29,6 +41,21 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// FilterIndexedLogs returns the logs within the [from, to] block range matching the
+// addresses and topics from the receipt indexer. It returns false if the indexer
+// doesn't store receipts or the range is not indexed.
+func (b *Backend) FilterIndexedLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]*ethtypes.Log, bool, error) {
+	rcptIndexer, ok := b.Indexer.(servertypes.EVMReceiptIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+	return rcptIndexer.FilterLogs(from, to, addresses, topics)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
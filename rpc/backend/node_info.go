@@ -327,6 +327,12 @@ func (b *Backend) RPCFeeHistoryCap() int32 {
 	return b.Cfg.JSONRPC.FeeHistoryCap
 }
 
+// RPCModifiedAccountsRangeCap is the limit for the block range of the
+// debug_getModifiedAccounts queries
+func (b *Backend) RPCModifiedAccountsRangeCap() int32 {
+	return b.Cfg.JSONRPC.ModifiedAccountsRangeCap
+}
+
 // RPCLogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 func (b *Backend) RPCLogsCap() int32 {
 	return b.Cfg.JSONRPC.LogsCap
@@ -174,6 +174,16 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, nil
 	}
 
+	// serve the receipt from the indexer if it stores them, otherwise rebuild it from the block results
+	if rcptIndexer, ok := b.Indexer.(servertypes.EVMReceiptIndexer); ok {
+		rcpt, err := rcptIndexer.GetReceiptByTxHash(hash)
+		if err != nil {
+			b.Logger.Debug("failed to get receipt from indexer", "hash", hexTx, "error", err.Error())
+		} else if rcpt != nil {
+			return rpctypes.RPCMarshalReceipt(rcpt.Receipt, rcpt.Tx, rcpt.From)
+		}
+	}
+
 	resBlock, err := b.CometBlockByNumber(rpctypes.BlockNumber(res.Height))
 	if err != nil {
 		b.Logger.Debug("block not found", "height", res.Height, "error", err.Error())
@@ -181,7 +181,17 @@ func (b *Backend) ProcessBlock(
 		if err != nil {
 			return err
 		}
-		nextBaseFee, err := types.CalcBaseFee(cfg, &header, params.Params)
+		// the next base fee is adjusted from the block gas selected by the base
+		// fee algorithm, which may differ from the gas used of the eth block
+		parent := header
+		blockGas, err := b.QueryClient.FeeMarket.BlockGas(ctx, &feemarkettypes.QueryBlockGasRequest{})
+		if err != nil {
+			return err
+		}
+		if blockGas.Gas >= 0 && blockGas.GasUsed >= 0 {
+			parent.GasUsed = params.Params.ParentBlockGas(uint64(blockGas.Gas), uint64(blockGas.GasUsed))
+		}
+		nextBaseFee, err := types.CalcBaseFee(cfg, &parent, params.Params)
 		if err != nil {
 			return err
 		}
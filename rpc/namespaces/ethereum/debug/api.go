@@ -2,6 +2,7 @@ package debug
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -10,18 +11,21 @@ import (
 	"runtime"
 	"runtime/debug"
 	"runtime/pprof"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	stderrors "github.com/pkg/errors"
 
 	"github.com/zenanetwork/zena/rpc/backend"
 	rpctypes "github.com/zenanetwork/zena/rpc/types"
+	vmtracers "github.com/zenanetwork/zena/x/vm/tracers"
 	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -453,3 +457,230 @@ func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]co
 	}
 	return ([]common.Hash)(nil), nil
 }
+
+// GetBadBlocks returns the list of invalid blocks the node has seen.
+// Unsupported in Cosmos EVM, as invalid blocks are rejected by CometBFT and not kept.
+func (a *API) GetBadBlocks() error {
+	a.logger.Debug("Unsupported rpc function: debug_getBadBlocks")
+	return errors.New("unsupported rpc function: debug_getBadBlocks")
+}
+
+// StorageRangeAt returns the storage of the contract at the given block and transaction
+// index. The state can only be retrieved at the beginning or at the end of the block, so
+// the transaction index must be either zero or the number of transactions of the block.
+// At most backend.StorageRangeMaxResults slots are returned. Unlike in geth, where the
+// storage is iterated by the hash of the slot keys, the slots are ordered by their raw
+// key, so keyStart and the returned next key are raw slot keys.
+func (a *API) StorageRangeAt(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "block number or hash", blockNrOrHash, "tx index", txIndex, "address", contractAddress)
+
+	blockNum, err := a.backend.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	block, err := a.backend.EthBlockByNumber(blockNum)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if block == nil {
+		return rpctypes.StorageRangeResult{}, errors.New("block not found")
+	}
+
+	height := block.Number().Int64()
+	switch txIndex {
+	case 0:
+		// the state at the beginning of the block is the state of its parent
+		height--
+	case len(block.Transactions()):
+	default:
+		return rpctypes.StorageRangeResult{}, fmt.Errorf(
+			"state at transaction index %d is not available, only the state before (0) or after (%d) the block transactions can be queried",
+			txIndex, len(block.Transactions()),
+		)
+	}
+
+	if maxResult <= 0 || maxResult > backend.StorageRangeMaxResults {
+		maxResult = backend.StorageRangeMaxResults
+	}
+
+	return a.backend.StorageRangeAt(contractAddress, keyStart, maxResult, rpctypes.BlockNumber(height))
+}
+
+// AccountRange enumerates the accounts with an Ethereum address at the given block,
+// starting from the start address. At most backend.AccountRangeMaxResults accounts
+// are returned. Accounts are stored with their address, so all of them are complete.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (state.Dump, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "max results", maxResults)
+
+	blockNum, err := a.backend.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	if maxResults <= 0 || maxResults > backend.AccountRangeMaxResults {
+		maxResults = backend.AccountRangeMaxResults
+	}
+
+	return a.backend.AccountRange(start, maxResults, nocode, nostorage, blockNum)
+}
+
+// DumpBlock retrieves the accounts with an Ethereum address at the given block. As in
+// geth, at most backend.AccountRangeMaxResults accounts are returned.
+func (a *API) DumpBlock(blockNr rpctypes.BlockNumber) (state.Dump, error) {
+	a.logger.Debug("debug_dumpBlock", "number", blockNr)
+	return a.backend.AccountRange(nil, backend.AccountRangeMaxResults, false, false, blockNr)
+}
+
+// GetModifiedAccountsByNumber returns all accounts that have changed between the two
+// blocks specified, i.e. in the blocks after startNum up to endNum. With one parameter,
+// returns the list of accounts modified in the specified block. Only the changes made by
+// the EVM transactions are included (see getModifiedAccounts).
+func (a *API) GetModifiedAccountsByNumber(startNum uint64, endNum *uint64) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByNumber", "start", startNum, "end", endNum)
+
+	if endNum == nil {
+		if startNum == 0 {
+			return nil, errors.New("genesis is not traceable")
+		}
+		return a.getModifiedAccounts(startNum-1, startNum)
+	}
+	return a.getModifiedAccounts(startNum, *endNum)
+}
+
+// GetModifiedAccountsByHash returns all accounts that have changed between the two
+// blocks specified, i.e. in the blocks after startHash up to endHash. With one parameter,
+// returns the list of accounts modified in the specified block. Only the changes made by
+// the EVM transactions are included (see getModifiedAccounts).
+func (a *API) GetModifiedAccountsByHash(startHash common.Hash, endHash *common.Hash) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByHash", "start", startHash, "end", endHash)
+
+	startNum, err := a.backend.BlockNumberFromCometByHash(startHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if endHash == nil {
+		if startNum.Sign() == 0 {
+			return nil, errors.New("genesis is not traceable")
+		}
+		return a.getModifiedAccounts(startNum.Uint64()-1, startNum.Uint64())
+	}
+
+	endNum, err := a.backend.BlockNumberFromCometByHash(*endHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.getModifiedAccounts(startNum.Uint64(), endNum.Uint64())
+}
+
+// getModifiedAccounts returns the accounts modified by the EVM transactions of the blocks
+// after start up to end. The blocks are traced with the prestate tracer in diff mode,
+// including the balance changes performed by the precompiles. The accounts are not
+// computed from the store changes, so the ones modified only by Cosmos transactions
+// (e.g. bank sends or staking rewards) or in the begin and end blockers are not included.
+func (a *API) getModifiedAccounts(start, end uint64) ([]common.Address, error) {
+	if start >= end {
+		return nil, fmt.Errorf("start block height (%d) must be less than end block height (%d)", start, end)
+	}
+	if end > math.MaxInt64 {
+		return nil, fmt.Errorf("block number overflow: %d exceeds max int64", end)
+	}
+	if maxRange := uint64(a.backend.RPCModifiedAccountsRangeCap()); end-start > maxRange { //#nosec G115 -- validated as positive in the config
+		return nil, fmt.Errorf("block range %d exceeds the maximum of %d blocks", end-start, maxRange)
+	}
+
+	config := &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "prestateTracer"},
+		TracerConfig: json.RawMessage(`{"diffMode":true,"withCosmos":true}`),
+	}
+
+	modified := make(map[common.Address]struct{})
+	for height := start + 1; height <= end; height++ {
+		blockNum := rpctypes.BlockNumber(height) //#nosec G115 -- overflow checked above
+		resBlock, err := a.backend.CometBlockByNumber(blockNum)
+		if err != nil {
+			return nil, err
+		}
+		if resBlock == nil || resBlock.Block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+
+		results, err := a.backend.TraceBlock(blockNum, config, resBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range results {
+			if res == nil || res.Error != "" {
+				continue
+			}
+			if err := addModifiedAccounts(modified, res.Result); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	addresses := make([]common.Address, 0, len(modified))
+	for address := range modified {
+		addresses = append(addresses, address)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int {
+		return a.Cmp(b)
+	})
+
+	return addresses, nil
+}
+
+// addModifiedAccounts adds the accounts of the prestate tracer diff to the set of
+// modified accounts.
+func addModifiedAccounts(modified map[common.Address]struct{}, result interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	// the diff is wrapped in the state field when the trace has Cosmos frames
+	type stateDiff struct {
+		Pre  map[common.Address]json.RawMessage `json:"pre"`
+		Post map[common.Address]json.RawMessage `json:"post"`
+	}
+	var trace struct {
+		stateDiff
+		State  stateDiff                   `json:"state"`
+		Cosmos []vmtracers.PrecompileFrame `json:"cosmos"`
+	}
+	if err := json.Unmarshal(bz, &trace); err != nil {
+		return fmt.Errorf("could not decode prestate trace: %w", err)
+	}
+
+	for _, diff := range []stateDiff{trace.stateDiff, trace.State} {
+		for address := range diff.Pre {
+			modified[address] = struct{}{}
+		}
+		for address := range diff.Post {
+			modified[address] = struct{}{}
+		}
+	}
+	for _, precompile := range trace.Cosmos {
+		for _, frame := range precompile.Calls {
+			if frame.Type == vmtracers.FrameTypeCosmosBalanceChange && frame.Address != nil {
+				modified[*frame.Address] = struct{}{}
+			}
+		}
+	}
+
+	return nil
+}
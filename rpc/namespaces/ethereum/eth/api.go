@@ -68,6 +68,7 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Chain Information
 	//
@@ -76,6 +77,7 @@ type EthereumAPI interface {
 	GasPrice() (*hexutil.Big, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
+	BurnedBaseFee(blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.BurnedBaseFeeResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
 
@@ -300,6 +302,17 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of transactions on top of a base state. The
+// transactions are packed into blocks, and the block and state overrides are
+// applied before the execution of each block.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+	return e.backend.SimulateV1(opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -336,6 +349,13 @@ func (e *PublicAPI) FeeHistory(
 	return e.backend.FeeHistory(blockCount, lastBlock, rewardPercentiles)
 }
 
+// BurnedBaseFee returns the base fees burned by the fee market at the end of
+// the given block and since genesis.
+func (e *PublicAPI) BurnedBaseFee(blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.BurnedBaseFeeResult, error) {
+	e.logger.Debug("eth_burnedBaseFee", "block number or hash", blockNrOrHash)
+	return e.backend.BurnedBaseFee(blockNrOrHash)
+}
+
 // MaxPriorityFeePerGas returns a suggestion for a gas tip cap for dynamic fee transactions.
 func (e *PublicAPI) MaxPriorityFeePerGas() (*hexutil.Big, error) {
 	e.logger.Debug("eth_maxPriorityFeePerGas")
@@ -56,6 +56,13 @@ type Backend interface {
 	RPCBlockRangeCap() int32
 }
 
+// IndexedLogsBackend is implemented by backends able to filter logs through an
+// indexer storing receipts, without fetching the block results of every block.
+type IndexedLogsBackend interface {
+	// FilterIndexedLogs returns false if the range is not indexed.
+	FilterIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, bool, error)
+}
+
 // consider a filter inactive if it has not been polled for within deadline
 const defaultDeadline = 5 * time.Minute
 
@@ -102,6 +102,10 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 			return nil, fmt.Errorf("failed to fetch header by hash %s: %w", f.criteria.BlockHash, err)
 		}
 
+		if logs, found, err := f.indexedLogs(resBlock.Block.Height, resBlock.Block.Height); err != nil || found {
+			return logs, err
+		}
+
 		blockRes, err := f.backend.CometBlockResultByNumber(&resBlock.Block.Height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from CometBFT", "height", resBlock.Block.Height, "error", err.Error())
@@ -169,6 +173,17 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
+	logs, found, err := f.indexedLogs(int64(from), int64(to)) //#nosec G115
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if len(logs) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		return logs, nil
+	}
+
 	for height := from; height <= to; height++ {
 		h := int64(height) //#nosec G115
 		blockRes, err := f.backend.CometBlockResultByNumber(&h)
@@ -196,6 +211,20 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria within the block range
+// from the indexer, if the backend supports it and the range is indexed.
+func (f *Filter) indexedLogs(from, to int64) ([]*ethtypes.Log, bool, error) {
+	idxBackend, ok := f.backend.(IndexedLogsBackend)
+	if !ok {
+		return nil, false, nil
+	}
+	logs, found, err := idxBackend.FilterIndexedLogs(from, to, f.criteria.Addresses, f.criteria.Topics)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to filter logs from indexer: %w", err)
+	}
+	return logs, found, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+)
+
+const (
+	// SimErrCodeReverted is the error code of a simulated call reverted by the EVM.
+	SimErrCodeReverted = 3
+	// SimErrCodeVMError is the error code of a simulated call that failed with a VM error.
+	SimErrCodeVMError = -32015
+)
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in a block.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is a set of header fields to override when simulating calls.
+// PrevRandao, BlobBaseFee, BeaconRoot and Withdrawals are not supported.
+type BlockOverrides struct {
+	Number        *hexutil.Big          `json:"number"`
+	Difficulty    *hexutil.Big          `json:"difficulty"` // ignored, there is no difficulty after the merge
+	Time          *hexutil.Uint64       `json:"time"`
+	GasLimit      *hexutil.Uint64       `json:"gasLimit"`
+	FeeRecipient  *common.Address       `json:"feeRecipient"`
+	PrevRandao    *common.Hash          `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big          `json:"baseFeePerGas"`
+	BlobBaseFee   *hexutil.Big          `json:"blobBaseFee"`
+	BeaconRoot    *common.Hash          `json:"beaconRoot"`
+	Withdrawals   *ethtypes.Withdrawals `json:"withdrawals"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	MaxUsedGas  hexutil.Uint64  `json:"maxUsedGas"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// RPCMarshalSimBlock converts a simulated block to the RPC output, including
+// the results of its calls. The transactions of the block aren't signed so the
+// given senders are used as their origin.
+func RPCMarshalSimBlock(
+	block *ethtypes.Block,
+	senders []common.Address,
+	calls []SimCallResult,
+	fullTx bool,
+	config *ethparams.ChainConfig,
+) map[string]interface{} {
+	blockHash := block.Hash()
+	fields := RPCMarshalHeader(block.Header(), blockHash.Bytes())
+	fields["size"] = hexutil.Uint64(block.Size())
+
+	txs := block.Transactions()
+	transactions := make([]interface{}, len(txs))
+	for i, tx := range txs {
+		if !fullTx {
+			transactions[i] = tx.Hash()
+			continue
+		}
+		rpcTx := NewRPCTransaction(tx, blockHash, block.NumberU64(), block.Time(), uint64(i), block.BaseFee(), config) //nolint:gosec // G115
+		rpcTx.From = senders[i]
+		transactions[i] = rpcTx
+	}
+	fields["transactions"] = transactions
+	fields["uncles"] = []common.Hash{}
+	fields["withdrawals"] = ethtypes.Withdrawals{}
+	fields["calls"] = calls
+	return fields
+}
@@ -37,6 +37,21 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // raw key of the next slot, nil if Storage includes the last key in the store.
+}
+
+// StorageMap maps the hash of the storage keys to their entries.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry defines a storage key and its value.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash           *common.Hash                    `json:"blockHash"`
@@ -163,6 +178,15 @@ type FeeHistoryResult struct {
 	BlobGasUsedRatio []float64        `json:"blobGasUsedRatio,omitempty"`
 }
 
+// BurnedBaseFeeResult is the result of eth_burnedBaseFee. The amounts are in
+// the 18 decimals representation of the EVM coin.
+type BurnedBaseFeeResult struct {
+	BlockNumber  *hexutil.Big `json:"blockNumber"`
+	Burned       *hexutil.Big `json:"burned"`
+	TotalBurned  *hexutil.Big `json:"totalBurned"`
+	BurnFraction string       `json:"burnFraction"`
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
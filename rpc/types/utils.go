@@ -359,7 +359,9 @@ func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
 }
 
-// CalcBaseFee calculates the basefee of the header.
+// CalcBaseFee calculates the basefee of the header with the base fee algorithm
+// of the feemarket params. The parent GasUsed must be the block gas the
+// algorithm adjusts the base fee from, see feemarkettypes.Params.ParentBlockGas.
 func CalcBaseFee(config *ethparams.ChainConfig, parent *ethtypes.Header, p feemarkettypes.Params) (*big.Int, error) {
 	// If the current block is the first EIP-1559 block, return the InitialBaseFee.
 	if !config.IsLondon(parent.Number) {
@@ -372,9 +374,13 @@ func CalcBaseFee(config *ethparams.ChainConfig, parent *ethtypes.Header, p feema
 
 	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
 	minGasPrice := p.MinGasPrice.Mul(sdkmath.LegacyNewDecFromInt(factor))
-	return feemarkettypes.CalcGasBaseFee(
-		parent.GasUsed, parentGasTarget, uint64(p.BaseFeeChangeDenominator),
-		sdkmath.LegacyNewDecFromBigInt(parent.BaseFee), sdkmath.LegacyOneDec(), minGasPrice,
+	maxBaseFee := sdkmath.LegacyZeroDec()
+	if p.IsMaxBaseFeeEnabled() {
+		maxBaseFee = p.MaxBaseFee.Mul(sdkmath.LegacyNewDecFromInt(factor))
+	}
+	return feemarkettypes.CalcBaseFee(
+		p.BaseFeeAlgorithm, parent.GasUsed, parentGasTarget, uint64(p.BaseFeeChangeDenominator),
+		sdkmath.LegacyNewDecFromBigInt(parent.BaseFee), sdkmath.LegacyOneDec(), minGasPrice, maxBaseFee,
 	).TruncateInt().BigInt(), nil
 }
 
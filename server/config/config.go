@@ -82,6 +82,10 @@ const (
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultModifiedAccountsRangeCap is the default cap of block range allowed for
+	// 'debug_getModifiedAccountsByNumber' and 'debug_getModifiedAccountsByHash' queries
+	DefaultModifiedAccountsRangeCap int32 = 100
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -122,9 +126,25 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// IndexerBackendKV stores the custom EVM tx indexer in a KV db next to the app db
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendPostgres stores the custom EVM tx indexer in a PostgreSQL database
+	IndexerBackendPostgres = "postgres"
+
+	// IndexerBackendSQLite stores the custom EVM tx indexer in a SQLite database,
+	// available in the binaries built with the sqlite build tag
+	IndexerBackendSQLite = "sqlite"
+
+	// DefaultIndexerBackend is the default backend of the custom EVM tx indexer
+	DefaultIndexerBackend = IndexerBackendKV
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var (
+	evmTracers      = []string{"json", "markdown", "struct", "access_list"}
+	indexerBackends = []string{IndexerBackendKV, IndexerBackendPostgres, IndexerBackendSQLite}
+)
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -171,6 +191,20 @@ type MempoolConfig struct {
 	GlobalQueue uint64 `mapstructure:"global-queue"`
 	// Lifetime is the maximum amount of time non-executable transaction are queued
 	Lifetime time.Duration `mapstructure:"lifetime"`
+	// Journal is the path of the journal of pooled transactions surviving node restarts, relative to the node home (empty = disabled)
+	Journal string `mapstructure:"journal"`
+	// Rejournal is the time interval to regenerate the transaction journal
+	Rejournal time.Duration `mapstructure:"rejournal"`
+	// CosmosMaxBlockGasFraction is the maximum fraction of the block gas used by Cosmos transactions (0 = no limit)
+	CosmosMaxBlockGasFraction float64 `mapstructure:"cosmos-max-block-gas-fraction"`
+	// CosmosMaxTxsPerSender is the maximum number of Cosmos transactions of a sender in a block (0 = no limit)
+	CosmosMaxTxsPerSender uint64 `mapstructure:"cosmos-max-txs-per-sender"`
+	// CosmosMaxGasPerSender is the maximum gas of the Cosmos transactions of a sender in a block (0 = no limit)
+	CosmosMaxGasPerSender uint64 `mapstructure:"cosmos-max-gas-per-sender"`
+	// LaneMsgTypes are the message types of the Cosmos transactions selected first in a reserved block space lane
+	LaneMsgTypes []string `mapstructure:"lane-msg-types"`
+	// LaneBlockGasFraction is the fraction of the block gas reserved for the lane transactions
+	LaneBlockGasFraction float64 `mapstructure:"lane-block-gas-fraction"`
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
@@ -183,6 +217,7 @@ func DefaultMempoolConfig() MempoolConfig {
 		AccountQueue: 64,            // 64 non-executable transaction slots per account
 		GlobalQueue:  1024,          // 1024 global non-executable slots
 		Lifetime:     3 * time.Hour, // 3 hour lifetime for queued transactions
+		Rejournal:    time.Hour,     // 1 hour interval to regenerate the journal
 	}
 }
 
@@ -209,6 +244,18 @@ func (c MempoolConfig) Validate() error {
 	if c.Lifetime < 1 {
 		return fmt.Errorf("lifetime must be at least 1 nanosecond, got %s", c.Lifetime)
 	}
+	if c.Journal != "" && c.Rejournal < time.Second {
+		return fmt.Errorf("rejournal must be at least 1 second, got %s", c.Rejournal)
+	}
+	if c.CosmosMaxBlockGasFraction < 0 || c.CosmosMaxBlockGasFraction > 1 {
+		return fmt.Errorf("cosmos max block gas fraction must be between 0 and 1, got %g", c.CosmosMaxBlockGasFraction)
+	}
+	if c.LaneBlockGasFraction < 0 || c.LaneBlockGasFraction > 1 {
+		return fmt.Errorf("lane block gas fraction must be between 0 and 1, got %g", c.LaneBlockGasFraction)
+	}
+	if len(c.LaneMsgTypes) > 0 && c.LaneBlockGasFraction == 0 {
+		return fmt.Errorf("lane block gas fraction must be positive when lane message types are set")
+	}
 	return nil
 }
 
@@ -232,6 +279,8 @@ type JSONRPCConfig struct {
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// ModifiedAccountsRangeCap defines the max block range allowed for `debug_getModifiedAccounts*` queries.
+	ModifiedAccountsRangeCap int32 `mapstructure:"modified-accounts-range-cap"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
@@ -254,6 +303,12 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableIndexerReceipts defines if the custom indexer also stores receipts, logs and block blooms.
+	EnableIndexerReceipts bool `mapstructure:"enable-indexer-receipts"`
+	// IndexerBackend defines the storage of the custom indexer: kv, postgres or sqlite.
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerDSN defines the data source name of the postgres and sqlite indexer backends.
+	IndexerDSN string `mapstructure:"indexer-dsn"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
@@ -318,28 +373,30 @@ func GetDefaultWSOrigins() []string {
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:               false,
-		API:                  GetDefaultAPINamespaces(),
-		Address:              DefaultJSONRPCAddress,
-		WsAddress:            DefaultJSONRPCWsAddress,
-		GasCap:               DefaultGasCap,
-		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
-		EVMTimeout:           DefaultEVMTimeout,
-		TxFeeCap:             DefaultTxFeeCap,
-		FilterCap:            DefaultFilterCap,
-		FeeHistoryCap:        DefaultFeeHistoryCap,
-		BlockRangeCap:        DefaultBlockRangeCap,
-		LogsCap:              DefaultLogsCap,
-		HTTPTimeout:          DefaultHTTPTimeout,
-		HTTPIdleTimeout:      DefaultHTTPIdleTimeout,
-		AllowUnprotectedTxs:  DefaultAllowUnprotectedTxs,
-		BatchRequestLimit:    DefaultBatchRequestLimit,
-		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
-		MaxOpenConnections:   DefaultMaxOpenConnections,
-		EnableIndexer:        false,
-		MetricsAddress:       DefaultJSONRPCMetricsAddress,
-		WSOrigins:            GetDefaultWSOrigins(),
-		EnableProfiling:      DefaultEnableProfiling,
+		Enable:                   false,
+		API:                      GetDefaultAPINamespaces(),
+		Address:                  DefaultJSONRPCAddress,
+		WsAddress:                DefaultJSONRPCWsAddress,
+		GasCap:                   DefaultGasCap,
+		AllowInsecureUnlock:      DefaultJSONRPCAllowInsecureUnlock,
+		EVMTimeout:               DefaultEVMTimeout,
+		TxFeeCap:                 DefaultTxFeeCap,
+		FilterCap:                DefaultFilterCap,
+		FeeHistoryCap:            DefaultFeeHistoryCap,
+		ModifiedAccountsRangeCap: DefaultModifiedAccountsRangeCap,
+		BlockRangeCap:            DefaultBlockRangeCap,
+		LogsCap:                  DefaultLogsCap,
+		HTTPTimeout:              DefaultHTTPTimeout,
+		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
+		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		MaxOpenConnections:       DefaultMaxOpenConnections,
+		EnableIndexer:            false,
+		IndexerBackend:           DefaultIndexerBackend,
+		MetricsAddress:           DefaultJSONRPCMetricsAddress,
+		WSOrigins:                GetDefaultWSOrigins(),
+		EnableProfiling:          DefaultEnableProfiling,
 	}
 }
 
@@ -357,6 +414,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
 
+	if c.ModifiedAccountsRangeCap <= 0 {
+		return errors.New("JSON-RPC modified-accounts-range-cap cannot be negative or 0")
+	}
+
 	if c.TxFeeCap < 0 {
 		return errors.New("JSON-RPC tx fee cap cannot be negative")
 	}
@@ -389,6 +450,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if !strings.StringInSlice(c.IndexerBackend, indexerBackends) {
+		return fmt.Errorf("invalid indexer backend %s, available backends: %v", c.IndexerBackend, indexerBackends)
+	}
+
+	if c.IndexerBackend == IndexerBackendPostgres && c.IndexerDSN == "" {
+		return errors.New("JSON-RPC indexer DSN is required by the postgres indexer backend")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
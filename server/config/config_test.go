@@ -55,6 +55,23 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal JSONRPC indexer backend",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.indexer-backend", serverconfig.IndexerBackendPostgres)
+				v.Set("json-rpc.indexer-dsn", "postgres://localhost:5432/evmindexer")
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				require.Equal(t, serverconfig.IndexerBackendKV, cfg.JSONRPC.IndexerBackend)
+				cfg.JSONRPC.IndexerBackend = serverconfig.IndexerBackendPostgres
+				cfg.JSONRPC.IndexerDSN = "postgres://localhost:5432/evmindexer"
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,3 +86,31 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigValidateIndexerBackend(t *testing.T) {
+	testCases := []struct {
+		name    string
+		backend string
+		dsn     string
+		expErr  bool
+	}{
+		{"kv", serverconfig.IndexerBackendKV, "", false},
+		{"sqlite without dsn", serverconfig.IndexerBackendSQLite, "", false},
+		{"postgres", serverconfig.IndexerBackendPostgres, "postgres://localhost:5432/evmindexer", false},
+		{"postgres without dsn", serverconfig.IndexerBackendPostgres, "", true},
+		{"unknown backend", "mysql", "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			cfg.IndexerBackend = tc.backend
+			cfg.IndexerDSN = tc.dsn
+			err := cfg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
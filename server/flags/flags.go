@@ -37,26 +37,29 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable               = "json-rpc.enable"
-	JSONRPCAPI                  = "json-rpc.api"
-	JSONRPCAddress              = "json-rpc.address"
-	JSONWsAddress               = "json-rpc.ws-address"
-	JSONRPCWSOrigins            = "json-rpc.ws-origins"
-	JSONRPCGasCap               = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
-	JSONRPCFilterCap            = "json-rpc.filter-cap"
-	JSONRPCLogsCap              = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
-	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
-	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
-	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnable                = "json-rpc.enable"
+	JSONRPCAPI                   = "json-rpc.api"
+	JSONRPCAddress               = "json-rpc.address"
+	JSONWsAddress                = "json-rpc.ws-address"
+	JSONRPCWSOrigins             = "json-rpc.ws-origins"
+	JSONRPCGasCap                = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock   = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout            = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap              = "json-rpc.txfee-cap"
+	JSONRPCFilterCap             = "json-rpc.filter-cap"
+	JSONRPCLogsCap               = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap         = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout           = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout       = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs   = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections    = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer         = "json-rpc.enable-indexer"
+	JSONRPCEnableIndexerReceipts = "json-rpc.enable-indexer-receipts"
+	JSONRPCIndexerBackend        = "json-rpc.indexer-backend"
+	JSONRPCIndexerDSN            = "json-rpc.indexer-dsn"
+	JSONRPCBatchRequestLimit     = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize  = "json-rpc.batch-response-max-size"
+	JSONRPCEnableProfiling       = "json-rpc.enable-profiling"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -72,13 +75,27 @@ const (
 	EVMMinTip                  = "evm.min-tip"
 	EvmGethMetricsAddress      = "evm.geth-metrics-address"
 
-	EVMMempoolPriceLimit   = "evm.mempool.price-limit"
-	EVMMempoolPriceBump    = "evm.mempool.price-bump"
-	EVMMempoolAccountSlots = "evm.mempool.account-slots"
-	EVMMempoolGlobalSlots  = "evm.mempool.global-slots"
-	EVMMempoolAccountQueue = "evm.mempool.account-queue"
-	EVMMempoolGlobalQueue  = "evm.mempool.global-queue"
-	EVMMempoolLifetime     = "evm.mempool.lifetime"
+	EVMMempoolPriceLimit                = "evm.mempool.price-limit"
+	EVMMempoolPriceBump                 = "evm.mempool.price-bump"
+	EVMMempoolAccountSlots              = "evm.mempool.account-slots"
+	EVMMempoolGlobalSlots               = "evm.mempool.global-slots"
+	EVMMempoolAccountQueue              = "evm.mempool.account-queue"
+	EVMMempoolGlobalQueue               = "evm.mempool.global-queue"
+	EVMMempoolLifetime                  = "evm.mempool.lifetime"
+	EVMMempoolJournal                   = "evm.mempool.journal"
+	EVMMempoolRejournal                 = "evm.mempool.rejournal"
+	EVMMempoolCosmosMaxBlockGasFraction = "evm.mempool.cosmos-max-block-gas-fraction"
+	EVMMempoolCosmosMaxTxsPerSender     = "evm.mempool.cosmos-max-txs-per-sender"
+	EVMMempoolCosmosMaxGasPerSender     = "evm.mempool.cosmos-max-gas-per-sender"
+	EVMMempoolLaneMsgTypes              = "evm.mempool.lane-msg-types"
+	EVMMempoolLaneBlockGasFraction      = "evm.mempool.lane-block-gas-fraction"
+)
+
+// PreciseBank flags
+const (
+	// PreciseBankAssertInvariants enables asserting the x/precisebank invariants
+	// at the end of every block, halting the node if any of them is broken.
+	PreciseBankAssertInvariants = "precisebank.assert-invariants"
 )
 
 // TLS flags
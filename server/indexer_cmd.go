@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 
@@ -9,12 +10,20 @@ import (
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
 
-	"github.com/zenanetwork/zena/indexer"
+	"github.com/zenanetwork/zena/server/config"
+	srvflags "github.com/zenanetwork/zena/server/flags"
+	servertypes "github.com/zenanetwork/zena/server/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+// backfillIndexer defines the indexer methods required to backfill historical blocks.
+type backfillIndexer interface {
+	servertypes.EVMTxIndexer
+	FirstIndexedBlock() (int64, error)
+}
+
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +35,9 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The indexer backend is selected by the json-rpc config (json-rpc.indexer-backend and json-rpc.indexer-dsn).
+		If receipts indexing is enabled (json-rpc.enable-indexer-receipts) or a SQL backend is used, the receipts, logs and block blooms are indexed as well.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,12 +55,26 @@ func NewIndexTxCmd() *cobra.Command {
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			evmCfg, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			txIdxer, err := OpenEVMIndexer(evmCfg.JSONRPC, home, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx)
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			if closer, ok := txIdxer.(io.Closer); ok {
+				defer func() {
+					if err := closer.Close(); err != nil {
+						logger.Error("failed to close evm indexer DB", "error", err.Error())
+					}
+				}()
+			}
+			idxer, ok := txIdxer.(backfillIndexer)
+			if !ok {
+				return fmt.Errorf("indexer %T doesn't support backfilling", txIdxer)
+			}
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -74,7 +100,12 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if rcptIdxer, ok := idxer.(servertypes.EVMReceiptIndexer); ok {
+					err = rcptIdxer.IndexBlockResults(blk, resBlk.TxResults, resBlk.Events)
+				} else {
+					err = idxer.IndexBlock(blk, resBlk.TxResults)
+				}
+				if err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -117,5 +148,8 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexerReceipts, false, "Index the receipts, logs and block blooms as well")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.DefaultIndexerBackend, "Sets the storage of the tx indexer (kv|postgres|sqlite)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "Sets the data source name of the postgres and sqlite tx indexer backends")
 	return cmd
 }
@@ -115,10 +115,19 @@ func (eis *EVMIndexerService) OnStart() error {
 				eis.Logger.Error("failed to fetch block result", "height", i, "err", blockErr)
 				break
 			}
-			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+			if err := eis.indexBlock(block.Block, blockResult); err != nil {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
 			lastBlock = blockResult.Height
 		}
 	}
 }
+
+// indexBlock indexes the block, passing the finalize block events along if the
+// indexer stores receipts.
+func (eis *EVMIndexerService) indexBlock(block *types.Block, blockResult *coretypes.ResultBlockResults) error {
+	if rcptIdxr, ok := eis.txIdxr.(servertypes.EVMReceiptIndexer); ok {
+		return rcptIdxr.IndexBlockResults(block, blockResult.TxsResults, blockResult.FinalizeBlockEvents)
+	}
+	return eis.txIdxr.IndexBlock(block, blockResult.TxsResults)
+}
@@ -214,6 +214,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexerReceipts, false, "Store receipts, logs and block blooms in the custom tx indexer")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Sets the storage of the custom tx indexer (kv|postgres|sqlite)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "Sets the data source name of the postgres and sqlite tx indexer backends")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
 
@@ -231,6 +234,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().AccountQueue, "the maximum number of non-executable transaction slots permitted per account")
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultMempoolConfig().Journal, "the path of the journal of pooled transactions surviving node restarts, relative to the node home (empty = disabled)")
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultMempoolConfig().Rejournal, "the time interval to regenerate the transaction journal")
+	cmd.Flags().Float64(srvflags.EVMMempoolCosmosMaxBlockGasFraction, cosmosevmserverconfig.DefaultMempoolConfig().CosmosMaxBlockGasFraction, "the maximum fraction of the block gas used by Cosmos transactions (0 = no limit)")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosMaxTxsPerSender, cosmosevmserverconfig.DefaultMempoolConfig().CosmosMaxTxsPerSender, "the maximum number of Cosmos transactions of a sender in a block (0 = no limit)")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosMaxGasPerSender, cosmosevmserverconfig.DefaultMempoolConfig().CosmosMaxGasPerSender, "the maximum gas of the Cosmos transactions of a sender in a block (0 = no limit)")
+	cmd.Flags().StringSlice(srvflags.EVMMempoolLaneMsgTypes, cosmosevmserverconfig.DefaultMempoolConfig().LaneMsgTypes, "the message types of the Cosmos transactions selected first in a reserved block space lane")
+	cmd.Flags().Float64(srvflags.EVMMempoolLaneBlockGasFraction, cosmosevmserverconfig.DefaultMempoolConfig().LaneBlockGasFraction, "the fraction of the block gas reserved for the lane transactions")
+
+	cmd.Flags().Bool(srvflags.PreciseBankAssertInvariants, false, "Assert the precisebank invariants at the end of every block and halt the node if any is broken (testnets only)")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -478,14 +490,19 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer servertypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = OpenEVMIndexer(config.JSONRPC, home, server.GetAppDBBackend(svrCtx.Viper), idxLogger, clientCtx)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if closer, ok := idxer.(io.Closer); ok {
+			defer func() {
+				if err := closer.Close(); err != nil {
+					logger.Error("failed to close evm indexer DB", "error", err.Error())
+				}
+			}()
+		}
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -564,6 +581,35 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenEVMIndexer opens the custom eth tx indexer on the backend selected by the json-rpc config
+func OpenEVMIndexer(
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	rootDir string,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (servertypes.EVMTxIndexer, error) {
+	switch cfg.IndexerBackend {
+	case cosmosevmserverconfig.IndexerBackendPostgres:
+		return indexer.NewSQLIndexer(indexer.SQLDriverPostgres, cfg.IndexerDSN, logger, clientCtx)
+	case cosmosevmserverconfig.IndexerBackendSQLite:
+		dsn := cfg.IndexerDSN
+		if dsn == "" {
+			dsn = filepath.Join(rootDir, "data", "evmindexer.sqlite")
+		}
+		return indexer.NewSQLIndexer(indexer.SQLDriverSQLite, dsn, logger, clientCtx)
+	default:
+		idxDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.EnableIndexerReceipts {
+			return indexer.NewKVReceiptIndexer(idxDB, logger, clientCtx), nil
+		}
+		return indexer.NewKVIndexer(idxDB, logger, clientCtx), nil
+	}
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
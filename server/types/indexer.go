@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -18,3 +19,33 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMReceiptIndexer defines the interface of an eth tx indexer that also
+// persists receipts, logs and block blooms, so that they can be served
+// without querying the CometBFT block results.
+type EVMReceiptIndexer interface {
+	EVMTxIndexer
+
+	// IndexBlockResults indexes the block like IndexBlock, using the finalize
+	// block events to resolve block level data such as the base fee.
+	IndexBlockResults(*cmttypes.Block, []*abci.ExecTxResult, []abci.Event) error
+
+	// GetReceiptByTxHash returns nil if the receipt is not found.
+	GetReceiptByTxHash(common.Hash) (*IndexedReceipt, error)
+	// GetBlockBloom returns false if the block is not indexed.
+	GetBlockBloom(int64) (ethtypes.Bloom, bool, error)
+	// GetLogsByHeight returns the logs of the block grouped by eth tx,
+	// returns false if the block is not indexed.
+	GetLogsByHeight(int64) ([][]*ethtypes.Log, bool, error)
+	// FilterLogs returns the logs within the [from, to] block range matching
+	// the given addresses and topics, returns false if the range is not indexed.
+	FilterLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, bool, error)
+}
+
+// IndexedReceipt is a receipt stored by an EVMReceiptIndexer together with
+// the transaction and sender needed to render it over JSON-RPC.
+type IndexedReceipt struct {
+	Receipt *ethtypes.Receipt     `json:"receipt"`
+	Tx      *ethtypes.Transaction `json:"tx"`
+	From    common.Address        `json:"from"`
+}
@@ -130,6 +130,7 @@ func (s *AnteTestSuite) SetupTest() {
 		}).
 		Configure()
 	s.Require().NoError(err)
+	s.Require().NoError(evmtypes.SetChainConfig(chainConfig))
 }
 
 func (s *AnteTestSuite) WithFeemarketEnabled(enabled bool) {
@@ -474,6 +474,17 @@ func (s *EvmAnteTestSuite) RegisterAccount(pubKey cryptotypes.PubKey, balance *u
 	s.Require().NoError(err)
 }
 
+// grantFeeAllowance grants an unlimited basic fee allowance from the granter to the grantee
+func (s *EvmAnteTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress) {
+	err := s.GetNetwork().App.GetFeeGrantKeeper().GrantAllowance(
+		s.GetNetwork().GetContext(),
+		granter,
+		grantee,
+		&feegrant.BasicAllowance{},
+	)
+	s.Require().NoError(err)
+}
+
 // createSignerBytes generates sign doc bytes using the given parameters
 func (s *EvmAnteTestSuite) createSignerBytes(chainID string, signMode signing.SignMode, pubKey cryptotypes.PubKey, txBuilder client.TxBuilder) []byte {
 	ctx := s.GetNetwork().GetContext()
@@ -520,6 +531,14 @@ func (s *EvmAnteTestSuite) createBaseTxBuilder(msg sdk.Msg, gas uint64) client.T
 // CreateTestSignedMultisigTx creates and sign a multi-signed tx for the given message. `signType` indicates whether to use standard signing ("Standard"),
 // EIP-712 signing ("EIP-712"), or a mix of the two ("mixed").
 func (s *EvmAnteTestSuite) CreateTestSignedMultisigTx(privKeys []cryptotypes.PrivKey, signMode signing.SignMode, msg sdk.Msg, chainID string, gas uint64, signType string) client.TxBuilder {
+	return s.CreateTestSignedMultisigTxWithFeeGranter(privKeys, signMode, msg, chainID, gas, signType, nil)
+}
+
+// CreateTestSignedMultisigTxWithFeeGranter creates and sign a multi-signed tx for the given message like
+// CreateTestSignedMultisigTx, with the fees paid by the given fee granter. The fee granter is only set if not nil.
+func (s *EvmAnteTestSuite) CreateTestSignedMultisigTxWithFeeGranter(
+	privKeys []cryptotypes.PrivKey, signMode signing.SignMode, msg sdk.Msg, chainID string, gas uint64, signType string, feeGranter sdk.AccAddress,
+) client.TxBuilder {
 	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
 	for i, privKey := range privKeys {
 		pubKeys[i] = privKey.PubKey()
@@ -532,6 +551,10 @@ func (s *EvmAnteTestSuite) CreateTestSignedMultisigTx(privKeys []cryptotypes.Pri
 	s.RegisterAccount(multiKey, uint256.NewInt(10000000000))
 
 	txBuilder := s.createBaseTxBuilder(msg, gas)
+	if feeGranter != nil {
+		s.grantFeeAllowance(feeGranter, sdk.AccAddress(multiKey.Address()))
+		txBuilder.SetFeeGranter(feeGranter)
+	}
 
 	// Prepare signature field
 	sig := multisig.NewMultisig(len(pubKeys))
@@ -861,6 +861,73 @@ func (s *EvmAnteTestSuite) TestAnteHandler() {
 				return txBuilder.GetTx()
 			}, false, false, false,
 		},
+		{
+			"passes - EIP-712 multi-key with fee granter",
+			func() sdk.Tx {
+				numKeys := 3
+				privKeys, pubKeys := s.GenerateMultipleKeys(numKeys)
+				pk := kmultisig.NewLegacyAminoPubKey(numKeys, pubKeys)
+
+				msg := banktypes.NewMsgSend(
+					sdk.AccAddress(pk.Address()),
+					addr[:],
+					sdk.NewCoins(
+						sdk.NewCoin(
+							"uatomz",
+							sdkmath.NewInt(1),
+						),
+					),
+				)
+
+				txBuilder := s.CreateTestSignedMultisigTxWithFeeGranter(
+					privKeys,
+					signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+					msg,
+					ctx.ChainID(),
+					2000000,
+					"EIP-712",
+					s.GetKeyring().GetAccAddr(1),
+				)
+
+				return txBuilder.GetTx()
+			}, false, false, true,
+		},
+		{
+			"Fails - Multi-Key with fee granter changed after signing",
+			func() sdk.Tx {
+				numKeys := 3
+				privKeys, pubKeys := s.GenerateMultipleKeys(numKeys)
+				pk := kmultisig.NewLegacyAminoPubKey(numKeys, pubKeys)
+
+				msg := banktypes.NewMsgSend(
+					sdk.AccAddress(pk.Address()),
+					addr[:],
+					sdk.NewCoins(
+						sdk.NewCoin(
+							"uatomz",
+							sdkmath.NewInt(1),
+						),
+					),
+				)
+
+				txBuilder := s.CreateTestSignedMultisigTxWithFeeGranter(
+					privKeys,
+					signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+					msg,
+					ctx.ChainID(),
+					2000000,
+					"EIP-712",
+					s.GetKeyring().GetAccAddr(1),
+				)
+
+				// the fee granter is part of the EIP-712 typed data
+				otherGranter := s.GetKeyring().GetAccAddr(0)
+				s.grantFeeAllowance(otherGranter, sdk.AccAddress(pk.Address()))
+				txBuilder.SetFeeGranter(otherGranter)
+
+				return txBuilder.GetTx()
+			}, false, false, false,
+		},
 		{
 			"Fails - Multi-Key with messages added after signing",
 			func() sdk.Tx {
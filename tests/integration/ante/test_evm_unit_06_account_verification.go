@@ -228,6 +228,7 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 				statedbAccount,
 				senderKey.Addr,
 				ethTx,
+				nil,
 			)
 
 			if tc.expectedError != nil {
@@ -0,0 +1,116 @@
+package ante
+
+import (
+	"github.com/zenanetwork/zena/testutil"
+	"github.com/zenanetwork/zena/testutil/integration/base/factory"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *AnteTestSuite) TestMsgFilter() {
+	var gasLimit uint64 = 1000000
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	s.SetupTest()
+	nw := s.GetNetwork()
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	sender := s.GetKeyring().GetAccAddr(0)
+	receiver := s.GetKeyring().GetAccAddr(1)
+
+	msgSend := banktypes.NewMsgSend(sender, receiver, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 100)))
+	msgExec := testutil.NewMsgExec(sender, []sdk.Msg{msgSend})
+
+	testCases := []struct {
+		name      string
+		msgFilter evmtypes.MsgFilter
+		msgs      []sdk.Msg
+		gasPrice  *math.Int
+		expErr    error
+	}{
+		{
+			name:      "pass - msg not blocked",
+			msgFilter: evmtypes.MsgFilter{BlockedMsgTypeURLs: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}},
+			msgs:      []sdk.Msg{msgSend},
+		},
+		{
+			name:      "fail - blocked msg",
+			msgFilter: evmtypes.MsgFilter{BlockedMsgTypeURLs: []string{sendTypeURL}},
+			msgs:      []sdk.Msg{msgSend},
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:      "fail - blocked msg wrapped in MsgExec",
+			msgFilter: evmtypes.MsgFilter{BlockedMsgTypeURLs: []string{sendTypeURL}},
+			msgs:      []sdk.Msg{msgExec},
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:      "pass - blocked authz msg not wrapped in MsgExec",
+			msgFilter: evmtypes.MsgFilter{BlockedAuthzMsgTypeURLs: []string{sendTypeURL}},
+			msgs:      []sdk.Msg{msgSend},
+		},
+		{
+			name:      "fail - blocked authz msg wrapped in MsgExec",
+			msgFilter: evmtypes.MsgFilter{BlockedAuthzMsgTypeURLs: []string{sendTypeURL}},
+			msgs:      []sdk.Msg{msgExec},
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "fail - gas price below the min gas price override",
+			msgFilter: evmtypes.MsgFilter{MinGasPriceOverrides: []evmtypes.MinGasPriceOverride{
+				{MsgTypeURL: sendTypeURL, MinGasPrice: math.LegacyNewDec(1e9)},
+			}},
+			msgs:   []sdk.Msg{msgSend},
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "fail - gas price below the min gas price override of a msg wrapped in MsgExec",
+			msgFilter: evmtypes.MsgFilter{MinGasPriceOverrides: []evmtypes.MinGasPriceOverride{
+				{MsgTypeURL: sendTypeURL, MinGasPrice: math.LegacyNewDec(1e9)},
+			}},
+			msgs:   []sdk.Msg{msgExec},
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "pass - gas price above the min gas price override",
+			msgFilter: evmtypes.MsgFilter{MinGasPriceOverrides: []evmtypes.MinGasPriceOverride{
+				{MsgTypeURL: sendTypeURL, MinGasPrice: math.LegacyNewDec(1e9)},
+			}},
+			msgs:     []sdk.Msg{msgSend},
+			gasPrice: func() *math.Int { gp := math.NewInt(1e9); return &gp }(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := nw.GetContext().CacheContext()
+
+			// the msg filter is read from the state by the ante handler on every tx
+			params := nw.App.GetEVMKeeper().GetParams(ctx)
+			params.MsgFilter = tc.msgFilter
+			s.Require().NoError(nw.App.GetEVMKeeper().SetParams(ctx, params))
+
+			tx, err := s.GetTxFactory().BuildCosmosTx(
+				s.GetKeyring().GetPrivKey(0),
+				factory.CosmosTxArgs{
+					Gas:      &gasLimit,
+					GasPrice: tc.gasPrice,
+					Msgs:     tc.msgs,
+				},
+			)
+			s.Require().NoError(err)
+
+			_, err = s.GetAnteHandler()(ctx, tx, false)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
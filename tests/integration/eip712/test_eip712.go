@@ -3,6 +3,7 @@ package eip712
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -134,6 +135,7 @@ func (s *TestSuite) TestEIP712() {
 		chainID       string
 		msgs          []sdk.Msg
 		timeoutHeight uint64
+		feeGranter    sdk.AccAddress
 		expectSuccess bool
 	}{
 		{
@@ -147,6 +149,18 @@ func (s *TestSuite) TestEIP712() {
 			},
 			expectSuccess: true,
 		},
+		{
+			title: "Succeeds - MsgSend with fee granter",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(
+					s.createTestAddress(),
+					s.createTestAddress(),
+					s.makeCoins(s.denom, math.NewInt(1)),
+				),
+			},
+			feeGranter:    s.createTestAddress(),
+			expectSuccess: true,
+		},
 		{
 			title: "Succeeds - Standard MsgVote",
 			msgs: []sdk.Msg{
@@ -342,6 +356,10 @@ func (s *TestSuite) TestEIP712() {
 					txBuilder.SetTimeoutHeight(tc.timeoutHeight)
 				}
 
+				if tc.feeGranter != nil {
+					txBuilder.SetFeeGranter(tc.feeGranter)
+				}
+
 				signerData := authsigning.SignerData{
 					ChainID:       chainID,
 					AccountNumber: params.accountNumber,
@@ -621,3 +639,82 @@ func (s *TestSuite) TestTypedDataGeneration() {
 	s.Require().NoError(err)
 	s.Require().False(typedData.Types["TypemsgType1"] == nil)
 }
+
+// TestProtoTypedData tests that transactions with messages of different types, a fee
+// granter, a timeout height, a timeout timestamp and the unordered flag are signable
+// with the protobuf-typed EIP-712 encoding.
+func (s *TestSuite) TestProtoTypedData() {
+	s.SetupTest()
+
+	signModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	}
+
+	address := s.createTestAddress()
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(
+			address,
+			s.createTestAddress(),
+			s.makeCoins(s.denom, math.NewInt(1)),
+		),
+		govtypes.NewMsgVote(
+			address,
+			5,
+			govtypes.OptionNo,
+		),
+	}
+
+	for _, signMode := range signModes {
+		s.Run(signMode.String(), func() {
+			privKey, pubKey := s.createTestKeyPair()
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			txBuilder.SetGasLimit(20000)
+			txBuilder.SetFeeAmount(s.makeCoins(s.denom, math.NewInt(2000)))
+			txBuilder.SetFeeGranter(s.createTestAddress())
+			txBuilder.SetTimeoutHeight(1000)
+			txBuilder.SetTimeoutTimestamp(time.Unix(1_700_000_000, 0))
+			txBuilder.SetUnordered(true)
+			s.Require().NoError(txBuilder.SetMsgs(msgs...))
+
+			txSig := signing.SignatureV2{
+				PubKey:   pubKey,
+				Data:     &signing.SingleSignatureData{SignMode: signMode},
+				Sequence: 78,
+			}
+			s.Require().NoError(txBuilder.SetSignatures(txSig))
+
+			signerData := authsigning.SignerData{
+				ChainID:       constants.ExampleChainID.ChainID,
+				AccountNumber: 25,
+				Sequence:      78,
+				PubKey:        pubKey,
+				Address:       sdk.MustBech32ifyAddressBytes(constants.ExampleBech32Prefix, pubKey.Bytes()),
+			}
+
+			bz, err := authsigning.GetSignBytesAdapter(
+				s.clientCtx.CmdContext,
+				s.clientCtx.TxConfig.SignModeHandler(),
+				signMode,
+				signerData,
+				txBuilder.GetTx(),
+			)
+			s.Require().NoError(err)
+
+			typedData, err := eip712.GetProtoEIP712TypedDataForMsg(bz)
+			s.Require().NoError(err)
+			s.Require().Equal("1000", typedData.Message["timeout_height"])
+			s.Require().Equal("78", typedData.Message["sequence"])
+			s.Require().Equal("2023-11-14T22:13:20Z", typedData.Message["timeout_timestamp"])
+			s.Require().Equal(true, typedData.Message["unordered"])
+
+			eip712Bytes, err := eip712.GetProtoEIP712BytesForMsg(bz)
+			s.Require().NoError(err)
+
+			sig, err := privKey.Sign(eip712Bytes)
+			s.Require().NoError(err)
+			s.Require().True(pubKey.VerifySignature(bz, sig))
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/zenanetwork/zena/crypto/ethsecp256k1"
+	"github.com/zenanetwork/zena/indexer"
+	servertypes "github.com/zenanetwork/zena/server/types"
+	"github.com/zenanetwork/zena/testutil/constants"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	utiltx "github.com/zenanetwork/zena/testutil/tx"
+	"github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// receiptIndexer is the interface of the tested indexers storing receipts
+type receiptIndexer interface {
+	servertypes.EVMReceiptIndexer
+	FirstIndexedBlock() (int64, error)
+}
+
+func TestKVReceiptIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	testReceiptIndexer(t, create, func(clientCtx client.Context) receiptIndexer {
+		return indexer.NewKVReceiptIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	}, options...)
+}
+
+// testReceiptIndexer indexes a block with an eth tx emitting a log and an ERC20
+// transfer log followed by an empty block, and checks the stored receipts, logs
+// and blooms.
+func testReceiptIndexer(
+	t *testing.T,
+	create network.CreateEvmApp,
+	newIndexer func(client.Context) receiptIndexer,
+	options ...network.ConfigOption,
+) receiptIndexer {
+	t.Helper()
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	ethTxParams := types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	}
+	tx := types.NewTx(&ethTxParams)
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	// the tx emits a log and an ERC20 transfer of 2 tokens from the sender to the recipient
+	addr1 := common.BigToAddress(big.NewInt(100))
+	addr2 := common.BigToAddress(big.NewInt(200))
+	topic1 := common.BigToHash(big.NewInt(1))
+	transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	fromTopic := common.BytesToHash(from.Bytes())
+	toTopic := common.BytesToHash(to.Bytes())
+	rsp := &types.MsgEthereumTxResponse{
+		Hash:    txHash.Hex(),
+		GasUsed: 21000,
+		Logs: []*types.Log{
+			{Address: addr1.Hex(), Topics: []string{topic1.Hex()}, Data: []byte{1}, BlockNumber: 1, TxHash: txHash.Hex(), Index: 0},
+			{
+				Address: addr2.Hex(), Topics: []string{transferTopic.Hex(), fromTopic.Hex(), toTopic.Hex()},
+				Data: common.BigToHash(big.NewInt(2)).Bytes(), BlockNumber: 1, TxHash: txHash.Hex(), Index: 1,
+			},
+		},
+	}
+	anyRsp, err := codectypes.NewAnyWithValue(rsp)
+	require.NoError(t, err)
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyRsp}})
+	require.NoError(t, err)
+
+	idxer := newIndexer(clientCtx)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	err = idxer.IndexBlockResults(
+		&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+		[]*abci.ExecTxResult{
+			{
+				Code:    0,
+				Data:    data,
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: to.Hex()},
+					}},
+				},
+			},
+		},
+		[]abci.Event{
+			{Type: types.EventTypeFeeMarket, Attributes: []abci.EventAttribute{{Key: "base_fee", Value: "1000000000"}}},
+		},
+	)
+	require.NoError(t, err)
+
+	// blocks without eth txs are indexed as well
+	err = idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil)
+	require.NoError(t, err)
+
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+
+	// tx results are still indexed
+	txResult, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), txResult.GasUsed)
+
+	t.Run("receipt", func(t *testing.T) {
+		rcpt, err := idxer.GetReceiptByTxHash(txHash)
+		require.NoError(t, err)
+		require.NotNil(t, rcpt)
+		require.Equal(t, from, rcpt.From)
+		require.Equal(t, txHash, rcpt.Tx.Hash())
+		require.Equal(t, txHash, rcpt.Receipt.TxHash)
+		require.Equal(t, ethtypes.ReceiptStatusSuccessful, rcpt.Receipt.Status)
+		require.Equal(t, uint64(21000), rcpt.Receipt.GasUsed)
+		require.Equal(t, uint64(21000), rcpt.Receipt.CumulativeGasUsed)
+		require.Equal(t, int64(1), rcpt.Receipt.BlockNumber.Int64())
+		require.Len(t, rcpt.Receipt.Logs, 2)
+		require.True(t, rcpt.Receipt.Bloom.Test(addr1.Bytes()))
+		require.True(t, rcpt.Receipt.Bloom.Test(transferTopic.Bytes()))
+
+		rcpt, err = idxer.GetReceiptByTxHash(common.BigToHash(big.NewInt(1)))
+		require.NoError(t, err)
+		require.Nil(t, rcpt)
+	})
+
+	t.Run("block bloom", func(t *testing.T) {
+		bloom, found, err := idxer.GetBlockBloom(1)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.True(t, bloom.Test(addr2.Bytes()))
+		require.True(t, bloom.Test(topic1.Bytes()))
+
+		bloom, found, err = idxer.GetBlockBloom(2)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, ethtypes.Bloom{}, bloom)
+
+		_, found, err = idxer.GetBlockBloom(3)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("logs by height", func(t *testing.T) {
+		logs, found, err := idxer.GetLogsByHeight(1)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Len(t, logs, 1)
+		require.Len(t, logs[0], 2)
+
+		logs, found, err = idxer.GetLogsByHeight(2)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Empty(t, logs)
+
+		_, found, err = idxer.GetLogsByHeight(3)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expFound  bool
+		expAddrs  []common.Address
+	}{
+		{"all logs", 1, 2, nil, nil, true, []common.Address{addr1, addr2}},
+		{"by address", 1, 2, []common.Address{addr2}, nil, true, []common.Address{addr2}},
+		{"by addresses", 1, 2, []common.Address{addr1, addr2}, nil, true, []common.Address{addr1, addr2}},
+		{"by first topic", 1, 2, nil, [][]common.Hash{{topic1}}, true, []common.Address{addr1}},
+		{"by second topic", 1, 2, nil, [][]common.Hash{{}, {fromTopic}}, true, []common.Address{addr2}},
+		{"by topics", 1, 2, nil, [][]common.Hash{{topic1, transferTopic}}, true, []common.Address{addr1, addr2}},
+		{"by address and topic", 1, 2, []common.Address{addr1}, [][]common.Hash{{transferTopic}}, true, []common.Address{}},
+		{"too many topics", 1, 2, nil, [][]common.Hash{{}, {}, {}, {}, {}}, true, []common.Address{}},
+		{"range without eth txs", 2, 2, nil, nil, true, []common.Address{}},
+		{"range not indexed", 1, 3, nil, nil, false, nil},
+	}
+	for _, tc := range testCases {
+		t.Run("filter logs, "+tc.name, func(t *testing.T) {
+			logs, found, err := idxer.FilterLogs(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+			if !tc.expFound {
+				return
+			}
+			addrs := make([]common.Address, len(logs))
+			for i, log := range logs {
+				addrs[i] = log.Address
+			}
+			require.Equal(t, tc.expAddrs, addrs)
+		})
+	}
+
+	return idxer
+}
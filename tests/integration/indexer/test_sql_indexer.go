@@ -0,0 +1,91 @@
+package indexer
+
+import (
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite" // register the sqlite driver, which is gated by the sqlite tag in the node
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/zenanetwork/zena/indexer"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// TestSQLIndexer runs the SQL indexer against a SQLite database, which shares
+// the schema of the PostgreSQL backend.
+func TestSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	dsn := filepath.Join(t.TempDir(), "evmindexer.sqlite")
+
+	var sqlIdxer *indexer.SQLIndexer
+	idxer := testReceiptIndexer(t, create, func(clientCtx client.Context) receiptIndexer {
+		var err error
+		sqlIdxer, err = indexer.NewSQLIndexer(indexer.SQLDriverSQLite, dsn, log.NewNopLogger(), clientCtx)
+		require.NoError(t, err)
+		return sqlIdxer
+	}, options...)
+	defer sqlIdxer.Close()
+
+	db, err := sql.Open(indexer.SQLDriverSQLite, dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var txHash string
+	require.NoError(t, db.QueryRow(`SELECT hash FROM transactions WHERE height = 1`).Scan(&txHash))
+	rcpt, err := idxer.GetReceiptByTxHash(common.HexToHash(txHash))
+	require.NoError(t, err)
+	require.NotNil(t, rcpt)
+
+	t.Run("relational tables", func(t *testing.T) {
+		var txCount int
+		require.NoError(t, db.QueryRow(`SELECT tx_count FROM blocks WHERE height = 1`).Scan(&txCount))
+		require.Equal(t, 1, txCount)
+
+		var baseFee sql.NullString
+		require.NoError(t, db.QueryRow(`SELECT base_fee FROM blocks WHERE height = 1`).Scan(&baseFee))
+		require.Equal(t, "1000000000", baseFee.String)
+
+		var fromAddress, toAddress string
+		require.NoError(t, db.QueryRow(`SELECT from_address, to_address FROM transactions WHERE height = 1`).Scan(&fromAddress, &toAddress))
+		require.Equal(t, strings.ToLower(rcpt.From.Hex()), fromAddress)
+		require.Equal(t, strings.ToLower(rcpt.Tx.To().Hex()), toAddress)
+
+		var logCount int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM logs WHERE height = 1`).Scan(&logCount))
+		require.Equal(t, 2, logCount)
+
+		var tokenFrom, tokenTo, value string
+		require.NoError(t, db.QueryRow(`SELECT from_address, to_address, value FROM token_transfers WHERE height = 1`).Scan(&tokenFrom, &tokenTo, &value))
+		require.Equal(t, fromAddress, tokenFrom)
+		require.Equal(t, toAddress, tokenTo)
+		require.Equal(t, "2", value)
+	})
+
+	t.Run("re-index block", func(t *testing.T) {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, nil))
+
+		var txCount int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM transactions WHERE height = 1`).Scan(&txCount))
+		require.Equal(t, 0, txCount)
+
+		rcpt, err := idxer.GetReceiptByTxHash(rcpt.Receipt.TxHash)
+		require.NoError(t, err)
+		require.Nil(t, rcpt)
+	})
+
+	t.Run("migrations are applied once", func(t *testing.T) {
+		require.NoError(t, indexer.Migrate(db))
+
+		var versions int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&versions))
+		require.Equal(t, 1, versions)
+	})
+}
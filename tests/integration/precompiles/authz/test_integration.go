@@ -0,0 +1,148 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	"github.com/zenanetwork/zena/precompiles/authz"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testutiltypes "github.com/zenanetwork/zena/testutil/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling authz precompile from EOA", func() {
+		var (
+			s       *PrecompileTestSuite
+			granter common.Address
+			grantee common.Address
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To: &precompileAddr,
+			}
+
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+		})
+
+		// =====================================
+		// 				TRANSACTIONS
+		// =====================================
+		Describe("Execute grant transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = authz.GrantMethod })
+
+			It("grants an authorization and emits event", func() {
+				callArgs.Args = []interface{}{granter, grantee, delegateMsgType, int64(0)}
+				eventCheck := passCheck.WithExpEvents(authz.EventTypeGrant)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), grantee.Bytes(), granter.Bytes(), delegateMsgType,
+				)
+				Expect(authorization).NotTo(BeNil())
+			})
+
+			It("fails if the granter is not the msg.sender", func() {
+				callArgs.Args = []interface{}{grantee, granter, delegateMsgType, int64(0)}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, granter, grantee)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Describe("Execute exec transaction", func() {
+			It("delegates on behalf of the granter", func() {
+				callArgs.MethodName = authz.GrantMethod
+				callArgs.Args = []interface{}{granter, grantee, delegateMsgType, int64(0)}
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = authz.ExecMethod
+				callArgs.Args = []interface{}{grantee, [][]byte{s.delegateMsgJSON(granter, math.NewInt(1e18))}}
+				txArgs.GasLimit = 500_000
+				eventCheck := passCheck.WithExpEvents(authz.EventTypeExec)
+
+				_, _, err = s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+				Expect(err).To(BeNil())
+				_, err = s.network.App.GetStakingKeeper().GetDelegation(s.network.GetContext(), granter.Bytes(), valAddr)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		// =====================================
+		// 				QUERIES
+		// =====================================
+		Describe("Execute grants query", func() {
+			It("returns the authorizations given to the grantee", func() {
+				s.grant(s.network.GetContext(), granter, grantee, delegateMsgType)
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = authz.GrantsMethod
+				callArgs.Args = []interface{}{granter, grantee, "", query.PageRequest{}}
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Grants).To(Equal([]authz.GrantData{
+					{Granter: granter, Grantee: grantee, MsgType: delegateMsgType},
+				}))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authz Precompile Suite")
+}
@@ -0,0 +1,230 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zenanetwork/zena/precompiles/authz"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var withdrawRewardMsgType = sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{})
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+	expiration := time.Unix(4_000_000_000, 0).UTC()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   []authz.GrantData
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - empty granter",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			nil,
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2), "", query.PageRequest{}}
+			},
+			[]authz.GrantData{},
+			false,
+			"",
+		},
+		{
+			"success - no grant for the message type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0), withdrawRewardMsgType, query.PageRequest{}}
+			},
+			[]authz.GrantData{},
+			false,
+			"",
+		},
+		{
+			"success - grant filtered by message type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), withdrawRewardMsgType, query.PageRequest{}}
+			},
+			[]authz.GrantData{
+				{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(1), MsgType: withdrawRewardMsgType, Expiration: expiration.Unix()},
+			},
+			false,
+			"",
+		},
+		{
+			"success - all grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			[]authz.GrantData{
+				{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(1), MsgType: withdrawRewardMsgType, Expiration: expiration.Unix()},
+				{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(1), MsgType: delegateMsgType, Expiration: 0},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.grant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType)
+			s.grantWithExpiration(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), withdrawRewardMsgType, expiration)
+
+			bz, err := s.precompile.Grants(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expGrants, out.Grants)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   []authz.GrantData
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			[]authz.GrantData{},
+			false,
+			"",
+		},
+		{
+			"success - grants to multiple grantees",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			[]authz.GrantData{
+				{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(1), MsgType: delegateMsgType},
+				{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(2), MsgType: delegateMsgType},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.grant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType)
+			s.grant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2), delegateMsgType)
+
+			bz, err := s.precompile.GranterGrants(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expGrants, out.Grants)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   []authz.GrantData
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			nil,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - grants from multiple granters",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			[]authz.GrantData{
+				{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(2), MsgType: delegateMsgType},
+				{Granter: s.keyring.GetAddr(1), Grantee: s.keyring.GetAddr(2), MsgType: withdrawRewardMsgType},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.grant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2), delegateMsgType)
+			s.grant(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(2), withdrawRewardMsgType)
+
+			bz, err := s.precompile.GranteeGrants(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expGrants, out.Grants)
+		})
+	}
+}
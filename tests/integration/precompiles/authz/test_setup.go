@@ -0,0 +1,60 @@
+package authz
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	"github.com/zenanetwork/zena/precompiles/authz"
+	"github.com/zenanetwork/zena/testutil/integration/evm/factory"
+	"github.com/zenanetwork/zena/testutil/integration/evm/grpc"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testkeyring "github.com/zenanetwork/zena/testutil/keyring"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *authz.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	authzKeeper := s.network.App.GetAuthzKeeper()
+	s.precompile = authz.NewPrecompile(
+		authzKeeper,
+		authzKeeper,
+		s.network.App.GetBankKeeper(),
+		s.network.App.AppCodec(),
+		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authz.DefaultAllowedMsgTypes,
+	)
+}
@@ -0,0 +1,382 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zenanetwork/zena/precompiles/authz"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var delegateMsgType = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[authz.GrantMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), "", delegateMsgType, int64(0)}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), delegateMsgType, int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - message type not allowed",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&banktypes.MsgSend{}), int64(0)}
+			},
+			func() {},
+			true,
+			"is not allowed in the authz precompile",
+		},
+		{
+			"fail - grantee is granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), delegateMsgType, int64(0)}
+			},
+			func() {},
+			true,
+			authztypes.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType, ctx.BlockTime().Unix() - 1}
+			},
+			func() {},
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"success - grant without expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType, int64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), delegateMsgType,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().Nil(expiration)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event authz.EventGrant
+				err := cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeGrant, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+				s.Require().Equal(delegateMsgType, event.MsgType)
+				s.Require().Equal(int64(0), event.Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - grant with expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType, ctx.BlockTime().Unix() + 1000}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), delegateMsgType,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(ctx.BlockTime().Unix()+1000, expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), delegateMsgType}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - authorization not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2), delegateMsgType}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - revoke authorization",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), delegateMsgType,
+				)
+				s.Require().Nil(authorization)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event authz.EventRevoke
+				err := cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeRevoke, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(delegateMsgType, event.MsgType)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			s.grant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgType)
+
+			res, err := s.precompile.Revoke(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[authz.ExecMethod]
+	delegationAmount := math.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), [][]byte{s.delegateMsgJSON(s.keyring.GetAddr(1), delegationAmount)}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - message type not allowed",
+			func() []interface{} {
+				sendMsg := &banktypes.MsgSend{
+					FromAddress: s.keyring.GetAccAddr(1).String(),
+					ToAddress:   s.keyring.GetAccAddr(0).String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), delegationAmount)),
+				}
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.msgJSON(sendMsg)}}
+			},
+			func() {},
+			true,
+			"is not allowed in the authz precompile",
+		},
+		{
+			"fail - nested exec message not allowed",
+			func() []interface{} {
+				execMsg := authztypes.NewMsgExec(s.keyring.GetAccAddr(0), []sdk.Msg{&stakingtypes.MsgDelegate{}})
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.msgJSON(&execMsg)}}
+			},
+			func() {},
+			true,
+			"is not allowed in the authz precompile",
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.delegateMsgJSON(s.keyring.GetAddr(2), delegationAmount)}}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - delegate on behalf of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.delegateMsgJSON(s.keyring.GetAddr(1), delegationAmount)}}
+			},
+			func() {
+				valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+				s.Require().NoError(err)
+				delegation, err := s.network.App.GetStakingKeeper().GetDelegation(ctx, s.keyring.GetAccAddr(1), valAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(1).String(), delegation.DelegatorAddress)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event authz.EventExec
+				err = cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeExec, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Grantee)
+				s.Require().Equal([]string{delegateMsgType}, event.MsgTypes)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 500_000)
+			s.grant(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(0), delegateMsgType)
+
+			res, err := s.precompile.Exec(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grant saves a generic authorization without expiration from the granter to the grantee.
+func (s *PrecompileTestSuite) grant(ctx sdk.Context, granter, grantee common.Address, msgType string) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(
+		ctx, grantee.Bytes(), granter.Bytes(), authztypes.NewGenericAuthorization(msgType), nil,
+	)
+	s.Require().NoError(err)
+}
+
+// grantWithExpiration saves a generic authorization from the granter to the grantee
+// that expires at the given time.
+func (s *PrecompileTestSuite) grantWithExpiration(ctx sdk.Context, granter, grantee common.Address, msgType string, expiration time.Time) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(
+		ctx, grantee.Bytes(), granter.Bytes(), authztypes.NewGenericAuthorization(msgType), &expiration,
+	)
+	s.Require().NoError(err)
+}
+
+// delegateMsgJSON returns the JSON encoded MsgDelegate from the delegator to the first validator.
+func (s *PrecompileTestSuite) delegateMsgJSON(delegator common.Address, amount math.Int) []byte {
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: s.network.GetValidators()[0].OperatorAddress,
+		Amount:           sdk.NewCoin(s.network.GetBaseDenom(), amount),
+	}
+	return s.msgJSON(msg)
+}
+
+// msgJSON returns the proto3 JSON encoding of the message including its type URL.
+func (s *PrecompileTestSuite) msgJSON(msg sdk.Msg) []byte {
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+	return bz
+}
@@ -14,6 +14,7 @@ import (
 
 	bank2 "github.com/zenanetwork/zena/precompiles/bank"
 	"github.com/zenanetwork/zena/precompiles/bank/testdata"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
 	"github.com/zenanetwork/zena/precompiles/testutil"
 	"github.com/zenanetwork/zena/testutil/integration/evm/factory"
 	"github.com/zenanetwork/zena/testutil/integration/evm/grpc"
@@ -295,6 +296,129 @@ func TestIntegrationSuite(t *testing.T, create network.CreateEvmApp, options ...
 			})
 		})
 
+		Context("Direct precompile transactions", func() {
+			Context("send transaction", func() {
+				It("should send a coin of the caller", func() {
+					receiver := utiltx.GenerateAddress()
+					balanceBefore, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, receiver, is.tokenDenom, amount)
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend)
+					_, _, err = is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					balance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balance.Balance.Amount.BigInt()).To(Equal(amount))
+
+					balance, err = is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balance.Balance.Amount).To(Equal(balanceBefore.Balance.Amount.Sub(math.NewIntFromBigInt(amount))))
+				})
+
+				It("should send the native denom and update the EVM balances", func() {
+					receiver := utiltx.GenerateAddress()
+					balanceBefore, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.network.GetBaseDenom())
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, receiver, is.network.GetBaseDenom(), amount)
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend)
+					_, _, err = is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					balance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), is.network.GetBaseDenom())
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balance.Balance.Amount.BigInt()).To(Equal(amount))
+
+					// the sent amount is not overwritten by the sender balance of the stateDB
+					balance, err = is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.network.GetBaseDenom())
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balanceBefore.Balance.Amount.Sub(balance.Balance.Amount).GT(math.NewIntFromBigInt(amount))).To(BeTrue())
+
+					evmBalance, err := is.grpcHandler.GetBalanceFromEVM(receiver.Bytes())
+					Expect(err).ToNot(HaveOccurred(), "failed to get EVM balance")
+					Expect(evmBalance.Balance).To(Equal(evmtypes.ConvertAmountTo18DecimalsBigInt(amount).String()))
+				})
+
+				It("should fail when sending more than the balance", func() {
+					balance, err := is.grpcHandler.GetBalanceFromBank(sender.AccAddr, is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					sendAmount := new(big.Int).Add(balance.Balance.Amount.BigInt(), big.NewInt(1))
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, utiltx.GenerateAddress(), is.tokenDenom, sendAmount)
+					failCheck := testutil.LogCheckArgs{}.WithErrContains("transfer amount exceeds balance")
+					_, _, err = is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, failCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+				})
+			})
+
+			Context("multiSend transaction", func() {
+				It("should send coins to all the outputs", func() {
+					receiver1 := utiltx.GenerateAddress()
+					receiver2 := utiltx.GenerateAddress()
+					outputs := []bank2.Output{
+						{To: receiver1, Amount: []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}, {Denom: is.network.GetBaseDenom(), Amount: amount}}},
+						{To: receiver2, Amount: []cmn.Coin{{Denom: is.tokenDenom, Amount: big.NewInt(1)}}},
+					}
+
+					txArgs, multiSendArgs := getTxAndCallArgs(directCall, contractData, bank2.MultiSendMethod, outputs)
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend, bank2.EventTypeSend, bank2.EventTypeSend)
+					_, ethRes, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, multiSendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					// the send gas is charged for each of the three sent coins
+					Expect(ethRes.GasUsed).To(BeNumerically(">=", 3*bank2.GasSend))
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					balances, err := is.grpcHandler.GetAllBalances(receiver1.Bytes())
+					Expect(err).ToNot(HaveOccurred(), "failed to get balances")
+					Expect(balances.Balances.AmountOf(is.tokenDenom).BigInt()).To(Equal(amount))
+					Expect(balances.Balances.AmountOf(is.network.GetBaseDenom()).BigInt()).To(Equal(amount))
+
+					balance, err := is.grpcHandler.GetBalanceFromBank(receiver2.Bytes(), is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balance.Balance.Amount.Int64()).To(Equal(int64(1)))
+				})
+
+				It("should fail without outputs", func() {
+					txArgs, multiSendArgs := getTxAndCallArgs(directCall, contractData, bank2.MultiSendMethod, []bank2.Output{})
+					failCheck := testutil.LogCheckArgs{}.WithErrContains("no outputs provided")
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, multiSendArgs, failCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+				})
+			})
+
+			Context("denomMetadata query", func() {
+				It("should return the metadata of the native denom", func() {
+					queryArgs, metadataArgs := getTxAndCallArgs(directCall, contractData, bank2.DenomMetadataMethod, is.network.GetBaseDenom())
+					_, ethRes, err := is.factory.CallContractAndCheckLogs(sender.Priv, queryArgs, metadataArgs, passCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+
+					var out struct{ Metadata bank2.DenomMetadata }
+					err = is.precompile.UnpackIntoInterface(&out, bank2.DenomMetadataMethod, ethRes.Ret)
+					Expect(err).ToNot(HaveOccurred(), "failed to unpack metadata")
+
+					metadata, found := is.network.App.GetBankKeeper().GetDenomMetaData(is.network.GetContext(), is.network.GetBaseDenom())
+					Expect(found).To(BeTrue())
+					Expect(out.Metadata).To(Equal(bank2.NewDenomMetadataResponse(metadata)))
+				})
+
+				It("should return empty metadata for an unknown denom", func() {
+					queryArgs, metadataArgs := getTxAndCallArgs(directCall, contractData, bank2.DenomMetadataMethod, "unknown")
+					_, ethRes, err := is.factory.CallContractAndCheckLogs(sender.Priv, queryArgs, metadataArgs, passCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+
+					var out struct{ Metadata bank2.DenomMetadata }
+					err = is.precompile.UnpackIntoInterface(&out, bank2.DenomMetadataMethod, ethRes.Ret)
+					Expect(err).ToNot(HaveOccurred(), "failed to unpack metadata")
+					Expect(out.Metadata.Base).To(BeEmpty())
+					Expect(out.Metadata.DenomUnits).To(BeEmpty())
+				})
+			})
+		})
+
 		Context("Calls from a contract", func() {
 			const (
 				BalancesFunction = "callBalances"
@@ -0,0 +1,199 @@
+package bank
+
+import (
+	"math/big"
+
+	"github.com/zenanetwork/zena/precompiles/bank"
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	cosmosevmutiltx "github.com/zenanetwork/zena/testutil/tx"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ibcDenom is an IBC voucher denomination without a registered token pair
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func (s *PrecompileTestSuite) TestSend() {
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.SendMethod]
+	receiver := cosmosevmutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver, ibcDenom}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - invalid to address",
+			func() []interface{} {
+				return []interface{}{"", ibcDenom, big.NewInt(1)}
+			},
+			false,
+			"invalid type for to",
+		},
+		{
+			"fail - invalid amount",
+			func() []interface{} {
+				return []interface{}{receiver, ibcDenom, ""}
+			},
+			false,
+			"invalid type for amount",
+		},
+		{
+			"fail - invalid denom",
+			func() []interface{} {
+				return []interface{}{receiver, "", big.NewInt(1)}
+			},
+			false,
+			"invalid denom",
+		},
+		{
+			"fail - negative amount",
+			func() []interface{} {
+				return []interface{}{receiver, ibcDenom, big.NewInt(-1)}
+			},
+			false,
+			"amount is not positive",
+		},
+		{
+			"fail - not enough balance",
+			func() []interface{} {
+				return []interface{}{receiver, ibcDenom, big.NewInt(2e18)}
+			},
+			false,
+			"transfer amount exceeds balance",
+		},
+		{
+			"pass - send IBC voucher without token pair",
+			func() []interface{} {
+				return []interface{}{receiver, ibcDenom, big.NewInt(1e18)}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest() // reset the chain each test
+			sender := s.keyring.GetKey(0)
+			ctx = s.mintAndSendCoin(ctx, sender.AccAddr, sdk.NewCoin(ibcDenom, math.NewInt(1e18)))
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender.Addr, s.precompile.Address(), 0)
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), ibcDenom)
+			s.Require().Equal(int64(1e18), balance.Amount.Int64())
+			balance = s.network.App.GetBankKeeper().GetBalance(ctx, sender.AccAddr, ibcDenom)
+			s.Require().True(balance.Amount.IsZero())
+			s.Require().Len(stateDB.Logs(), 1)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	receiver1 := cosmosevmutiltx.GenerateAddress()
+	receiver2 := cosmosevmutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - no outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			false,
+			"no outputs provided",
+		},
+		{
+			"fail - not enough balance",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receiver1, Amount: []cmn.Coin{{Denom: ibcDenom, Amount: big.NewInt(6e17)}}},
+					{To: receiver2, Amount: []cmn.Coin{{Denom: ibcDenom, Amount: big.NewInt(6e17)}}},
+				}}
+			},
+			false,
+			"transfer amount exceeds balance",
+		},
+		{
+			"pass - send to multiple outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receiver1, Amount: []cmn.Coin{{Denom: ibcDenom, Amount: big.NewInt(4e17)}, {Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+					{To: receiver2, Amount: []cmn.Coin{{Denom: ibcDenom, Amount: big.NewInt(6e17)}}},
+				}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest() // reset the chain each test
+			sender := s.keyring.GetKey(0)
+			ctx = s.mintAndSendCoin(ctx, sender.AccAddr, sdk.NewCoin(ibcDenom, math.NewInt(1e18)))
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender.Addr, s.precompile.Address(), 0)
+
+			args := tc.malleate()
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			// the send gas is charged for each sent coin after the first one
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(2*bank.GasSend))
+			bk := s.network.App.GetBankKeeper()
+			s.Require().Equal(int64(4e17), bk.GetBalance(ctx, receiver1.Bytes(), ibcDenom).Amount.Int64())
+			s.Require().Equal(int64(1), bk.GetBalance(ctx, receiver1.Bytes(), s.tokenDenom).Amount.Int64())
+			s.Require().Equal(int64(6e17), bk.GetBalance(ctx, receiver2.Bytes(), ibcDenom).Amount.Int64())
+			s.Require().True(bk.GetBalance(ctx, sender.AccAddr, ibcDenom).Amount.IsZero())
+			s.Require().Len(stateDB.Logs(), 3)
+		})
+	}
+}
@@ -46,6 +46,16 @@ func (s *PrecompileTestSuite) mintAndSendXMPLCoin(ctx sdk.Context, addr sdk.AccA
 	return ctx
 }
 
+// mintAndSendCoin is a helper function to mint and send a coin to a given address.
+func (s *PrecompileTestSuite) mintAndSendCoin(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) sdk.Context {
+	coins := sdk.NewCoins(coin)
+	err := s.network.App.GetBankKeeper().MintCoins(ctx, minttypes.ModuleName, coins)
+	s.Require().NoError(err)
+	err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
+	s.Require().NoError(err)
+	return ctx
+}
+
 // mintAndSendXMPLCoin is a helper function to mint and send a coin to a given address.
 func (is *IntegrationTestSuite) mintAndSendXMPLCoin(addr sdk.AccAddress, amount math.Int) { //nolint:unused
 	coins := sdk.NewCoins(sdk.NewCoin(is.tokenDenom, amount))
@@ -0,0 +1,142 @@
+package feegrant
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/feegrant"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testutiltypes "github.com/zenanetwork/zena/testutil/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling feegrant precompile from EOA", func() {
+		var (
+			s       *PrecompileTestSuite
+			granter common.Address
+			grantee common.Address
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To: &precompileAddr,
+			}
+
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+		})
+
+		// =====================================
+		// 				TRANSACTIONS
+		// =====================================
+		Describe("Execute grantAllowedMsgAllowance transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = feegrant.GrantAllowedMsgAllowanceMethod })
+
+			It("grants an allowance for EVM transactions and emits event", func() {
+				callArgs.Args = []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{ethTxMsgType}}
+				eventCheck := passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+					s.network.GetContext(), granter.Bytes(), grantee.Bytes(),
+				)
+				Expect(err).To(BeNil())
+				Expect(allowance).NotTo(BeNil())
+			})
+
+			It("fails if the granter is not the msg.sender", func() {
+				callArgs.Args = []interface{}{grantee, granter, []cmn.Coin{}, int64(0), []string{ethTxMsgType}}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, granter, grantee)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Describe("Execute revokeAllowance transaction", func() {
+			It("revokes the allowance and emits event", func() {
+				s.grantBasicAllowance(s.network.GetContext(), granter, grantee)
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = feegrant.RevokeAllowanceMethod
+				callArgs.Args = []interface{}{granter, grantee}
+				eventCheck := passCheck.WithExpEvents(feegrant.EventTypeRevokeAllowance)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(
+					s.network.GetContext(), granter.Bytes(), grantee.Bytes(),
+				)
+				Expect(err).NotTo(BeNil())
+			})
+		})
+
+		// =====================================
+		// 				QUERIES
+		// =====================================
+		Describe("Execute allowances query", func() {
+			It("returns the allowances received by the grantee", func() {
+				s.grantBasicAllowance(s.network.GetContext(), granter, grantee)
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = feegrant.AllowancesMethod
+				callArgs.Args = []interface{}{grantee, query.PageRequest{}}
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Allowances).To(Equal([]feegrant.AllowanceData{
+					s.basicAllowanceData(granter, grantee),
+				}))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feegrant Precompile Suite")
+}
@@ -0,0 +1,246 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const basicAllowanceType = "/cosmos.feegrant.v1beta1.BasicAllowance"
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	testCases := []struct {
+		name         string
+		malleate     func() []interface{}
+		expAllowance feegrant.AllowanceData
+		expError     bool
+		errContains  string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			feegrant.AllowanceData{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty granter",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			feegrant.AllowanceData{},
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - no allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2)}
+			},
+			feegrant.AllowanceData{
+				SpendLimit:       []cmn.Coin{},
+				PeriodSpendLimit: []cmn.Coin{},
+				PeriodCanSpend:   []cmn.Coin{},
+				AllowedMessages:  []string{},
+			},
+			false,
+			"",
+		},
+		{
+			"success - basic allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			feegrant.AllowanceData{
+				Granter:          s.keyring.GetAddr(0),
+				Grantee:          s.keyring.GetAddr(1),
+				AllowanceType:    basicAllowanceType,
+				SpendLimit:       []cmn.Coin{},
+				PeriodSpendLimit: []cmn.Coin{},
+				PeriodCanSpend:   []cmn.Coin{},
+				AllowedMessages:  []string{},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+
+			bz, err := s.precompile.Allowance(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out feegrant.AllowanceData
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAllowance, out)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expAllowances []feegrant.AllowanceData
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			nil,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			[]feegrant.AllowanceData{},
+			false,
+			"",
+		},
+		{
+			"success - allowances from multiple granters",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			[]feegrant.AllowanceData{
+				s.basicAllowanceData(s.keyring.GetAddr(0), s.keyring.GetAddr(2)),
+				s.basicAllowanceData(s.keyring.GetAddr(1), s.keyring.GetAddr(2)),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2))
+			s.grantBasicAllowance(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(2))
+
+			bz, err := s.precompile.Allowances(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out feegrant.AllowancesOutput
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expAllowances, out.Allowances)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expAllowances []feegrant.AllowanceData
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			[]feegrant.AllowanceData{},
+			false,
+			"",
+		},
+		{
+			"success - allowances to multiple grantees",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			[]feegrant.AllowanceData{
+				s.basicAllowanceData(s.keyring.GetAddr(0), s.keyring.GetAddr(1)),
+				s.basicAllowanceData(s.keyring.GetAddr(0), s.keyring.GetAddr(2)),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+			s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2))
+
+			bz, err := s.precompile.AllowancesByGranter(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out feegrant.AllowancesOutput
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expAllowances, out.Allowances)
+		})
+	}
+}
+
+// basicAllowanceData returns the expected query output for an unlimited basic allowance.
+func (s *PrecompileTestSuite) basicAllowanceData(granter, grantee common.Address) feegrant.AllowanceData {
+	return feegrant.AllowanceData{
+		Granter:          granter,
+		Grantee:          grantee,
+		AllowanceType:    basicAllowanceType,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+}
@@ -0,0 +1,60 @@
+package feegrant
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	"github.com/zenanetwork/zena/precompiles/feegrant"
+	"github.com/zenanetwork/zena/testutil/integration/evm/factory"
+	"github.com/zenanetwork/zena/testutil/integration/evm/grpc"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testkeyring "github.com/zenanetwork/zena/testutil/keyring"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *feegrant.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	feegrantKeeper := s.network.App.GetFeeGrantKeeper()
+	s.precompile = feegrant.NewPrecompile(
+		feegrantKeeper,
+		feegrantkeeper.NewMsgServerImpl(feegrantKeeper),
+		s.network.App.GetBankKeeper(),
+		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+	)
+}
@@ -0,0 +1,352 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/feegrant"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const ethTxMsgType = "/cosmos.evm.vm.v1.MsgEthereumTx"
+
+func (s *PrecompileTestSuite) TestGrantBasicAllowance() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[feegrant.GrantBasicAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), "", []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, ctx.BlockTime().Unix() - 1}
+			},
+			func() {},
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - grant with spend limit",
+			func() []interface{} {
+				spendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0)}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(big.NewInt(1e18), basic.SpendLimit.AmountOf(s.network.GetBaseDenom()).BigInt())
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event feegrant.EventGrantAllowance
+				err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeGrantAllowance, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+				s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", event.AllowanceType)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.GrantBasicAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - period spend limit in a different denom than the spend limit",
+			func() []interface{} {
+				spendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}}
+				periodSpendLimit := []cmn.Coin{{Denom: "uatom", Amount: big.NewInt(10)}}
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(3600), periodSpendLimit}
+			},
+			func() {},
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"success - grant periodic allowance",
+			func() []interface{} {
+				periodSpendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1000)}}
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), int64(3600), periodSpendLimit}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(ctx.BlockTime().Unix()+3600, periodic.PeriodReset.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.GrantPeriodicAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantAllowedMsgAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantAllowedMsgAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - no allowed messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"invalid allowed messages",
+		},
+		{
+			"success - grant allowance for EVM transactions",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), []string{ethTxMsgType}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				allowedMsgAllowance, ok := allowance.(*feegranttypes.AllowedMsgAllowance)
+				s.Require().True(ok)
+				s.Require().Equal([]string{ethTxMsgType}, allowedMsgAllowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.GrantAllowedMsgAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2)}
+			},
+			func() {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - revoke allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {
+				_, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().ErrorContains(err, "fee-grant not found")
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event feegrant.EventRevokeAllowance
+				err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeRevokeAllowance, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+
+			res, err := s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grantBasicAllowance saves an unlimited basic allowance from the granter to the grantee.
+func (s *PrecompileTestSuite) grantBasicAllowance(ctx sdk.Context, granter, grantee common.Address) {
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(
+		ctx, granter.Bytes(), grantee.Bytes(), &feegranttypes.BasicAllowance{},
+	)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,173 @@
+package revenue
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/revenue"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testutiltypes "github.com/zenanetwork/zena/testutil/types"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling revenue precompile from EOA", func() {
+		var (
+			s          *PrecompileTestSuite
+			deployer   common.Address
+			withdrawer common.Address
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To: &precompileAddr,
+			}
+
+			deployer = s.keyring.GetAddr(0)
+			withdrawer = s.keyring.GetAddr(2)
+		})
+
+		// =====================================
+		// 				TRANSACTIONS
+		// =====================================
+		Describe("Execute registerRevenue transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = revenue.RegisterRevenueMethod })
+
+			It("registers the contract and emits event", func() {
+				callArgs.Args = []interface{}{deployer, s.counterAddr, withdrawer, []uint64{s.counterNonce}}
+				eventCheck := passCheck.WithExpEvents(revenue.EventTypeRegisterRevenue)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				res, found := s.network.App.GetRevenueKeeper().GetRevenue(s.network.GetContext(), s.counterAddr)
+				Expect(found).To(BeTrue())
+				Expect(res.GetWithdrawerAddr()).To(Equal(s.keyring.GetAccAddr(2)))
+			})
+
+			It("fails if the deployer is not the msg.sender", func() {
+				callArgs.Args = []interface{}{withdrawer, s.counterAddr, withdrawer, []uint64{s.counterNonce}}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, deployer, withdrawer)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		// =====================================
+		// 			FEE DISTRIBUTION
+		// =====================================
+		Describe("Calling a registered contract", func() {
+			It("sends the developer share of the fees to the withdrawer", func() {
+				callArgs.MethodName = revenue.RegisterRevenueMethod
+				callArgs.Args = []interface{}{deployer, s.counterAddr, withdrawer, []uint64{s.counterNonce}}
+				eventCheck := passCheck.WithExpEvents(revenue.EventTypeRegisterRevenue)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				denom := evmtypes.GetEVMCoinExtendedDenom()
+				balanceBefore := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(2), denom)
+
+				res, err := s.factory.ExecuteContractCall(
+					s.keyring.GetPrivKey(1),
+					evmtypes.EvmTxArgs{To: &s.counterAddr},
+					testutiltypes.CallArgs{
+						ContractABI: s.counterContract.ABI,
+						MethodName:  "add",
+					},
+				)
+				Expect(err).To(BeNil())
+				Expect(res.IsOK()).To(BeTrue())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				var devFee math.Int
+				for _, event := range res.Events {
+					if event.Type != revenuetypes.EventTypeDistributeDevRevenue {
+						continue
+					}
+					for _, attr := range event.Attributes {
+						if attr.Key == sdk.AttributeKeyAmount {
+							var ok bool
+							devFee, ok = math.NewIntFromString(attr.Value)
+							Expect(ok).To(BeTrue())
+						}
+					}
+				}
+				Expect(devFee.IsPositive()).To(BeTrue())
+
+				balanceAfter := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(2), denom)
+				Expect(balanceAfter.Amount.Sub(balanceBefore.Amount)).To(Equal(devFee))
+			})
+		})
+
+		// =====================================
+		// 				QUERIES
+		// =====================================
+		Describe("Execute deployerRevenues query", func() {
+			It("returns the contracts registered by the deployer", func() {
+				callArgs.MethodName = revenue.RegisterRevenueMethod
+				callArgs.Args = []interface{}{deployer, s.counterAddr, common.Address{}, []uint64{s.counterNonce}}
+				eventCheck := passCheck.WithExpEvents(revenue.EventTypeRegisterRevenue)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = revenue.DeployerRevenuesMethod
+				callArgs.Args = []interface{}{deployer, query.PageRequest{}}
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out revenue.ContractsOutput
+				err = s.precompile.UnpackIntoInterface(&out, revenue.DeployerRevenuesMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Contracts).To(Equal([]common.Address{s.counterAddr}))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Revenue Precompile Suite")
+}
@@ -0,0 +1,170 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/revenue"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestRevenue() {
+	method := s.precompile.Methods[revenue.RevenueMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expRevenue  func() revenue.RevenueData
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() revenue.RevenueData { return revenue.RevenueData{} },
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty contract",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			func() revenue.RevenueData { return revenue.RevenueData{} },
+			true,
+			"invalid contract address",
+		},
+		{
+			"success - contract not registered",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2)}
+			},
+			func() revenue.RevenueData { return revenue.RevenueData{} },
+			false,
+			"",
+		},
+		{
+			"success - registered contract",
+			func() []interface{} {
+				return []interface{}{s.counterAddr}
+			},
+			func() revenue.RevenueData {
+				// the deployer receives the fees if no withdrawer is set
+				return revenue.RevenueData{
+					ContractAddress: s.counterAddr,
+					Deployer:        s.keyring.GetAddr(0),
+					Withdrawer:      s.keyring.GetAddr(0),
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.Require().NoError(s.registerRevenue(ctx, nil))
+
+			bz, err := s.precompile.Revenue(ctx, &method, nil, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out revenue.RevenueOutput
+			err = s.precompile.UnpackIntoInterface(&out, revenue.RevenueMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRevenue(), out.Revenue)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeployerWithdrawerRevenues() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.Require().NoError(s.registerRevenue(ctx, s.keyring.GetAccAddr(2)))
+
+	testCases := []struct {
+		name         string
+		methodName   string
+		args         []interface{}
+		expContracts []common.Address
+		expError     bool
+		errContains  string
+	}{
+		{
+			"fail - empty deployer",
+			revenue.DeployerRevenuesMethod,
+			[]interface{}{common.Address{}, query.PageRequest{}},
+			nil,
+			true,
+			"invalid deployer address",
+		},
+		{
+			"success - deployer revenues",
+			revenue.DeployerRevenuesMethod,
+			[]interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}},
+			[]common.Address{s.counterAddr},
+			false,
+			"",
+		},
+		{
+			"success - no deployer revenues",
+			revenue.DeployerRevenuesMethod,
+			[]interface{}{s.keyring.GetAddr(1), query.PageRequest{}},
+			[]common.Address{},
+			false,
+			"",
+		},
+		{
+			"fail - empty withdrawer",
+			revenue.WithdrawerRevenuesMethod,
+			[]interface{}{common.Address{}, query.PageRequest{}},
+			nil,
+			true,
+			"invalid withdrawer address",
+		},
+		{
+			"success - withdrawer revenues",
+			revenue.WithdrawerRevenuesMethod,
+			[]interface{}{s.keyring.GetAddr(2), query.PageRequest{}},
+			[]common.Address{s.counterAddr},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.methodName]
+
+			var (
+				bz  []byte
+				err error
+			)
+			if tc.methodName == revenue.DeployerRevenuesMethod {
+				bz, err = s.precompile.DeployerRevenues(ctx, &method, nil, tc.args)
+			} else {
+				bz, err = s.precompile.WithdrawerRevenues(ctx, &method, nil, tc.args)
+			}
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out revenue.ContractsOutput
+			err = s.precompile.UnpackIntoInterface(&out, tc.methodName, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expContracts, out.Contracts)
+		})
+	}
+}
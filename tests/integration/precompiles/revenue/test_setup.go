@@ -0,0 +1,102 @@
+package revenue
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	evmaddress "github.com/zenanetwork/zena/encoding/address"
+	"github.com/zenanetwork/zena/precompiles/revenue"
+	testcontracts "github.com/zenanetwork/zena/precompiles/testutil/contracts"
+	"github.com/zenanetwork/zena/testutil/integration/evm/factory"
+	"github.com/zenanetwork/zena/testutil/integration/evm/grpc"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testkeyring "github.com/zenanetwork/zena/testutil/keyring"
+	testutiltypes "github.com/zenanetwork/zena/testutil/types"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *revenue.Precompile
+
+	// counterContract is deployed by the first account of the keyring with
+	// the nonce counterNonce.
+	counterContract evmtypes.CompiledContract
+	counterAddr     common.Address
+	counterNonce    uint64
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	s.precompile = revenue.NewPrecompile(
+		s.network.App.GetRevenueKeeper(),
+		s.network.App.GetBankKeeper(),
+		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+	)
+
+	counterContract, err := testcontracts.LoadCounterContract()
+	if err != nil {
+		panic(err)
+	}
+
+	// the counter contract is registered by the tests with the nonce of its deployment
+	counterNonce := nw.App.GetEVMKeeper().GetNonce(nw.GetContext(), keyring.GetAddr(0))
+	counterAddr, err := txFactory.DeployContract(
+		keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{Contract: counterContract},
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := nw.NextBlock(); err != nil {
+		panic(err)
+	}
+
+	s.counterContract = counterContract
+	s.counterAddr = counterAddr
+	s.counterNonce = counterNonce
+}
+
+// registerRevenue registers the counter contract for fee distribution with
+// the given withdrawer.
+func (s *PrecompileTestSuite) registerRevenue(ctx sdk.Context, withdrawer sdk.AccAddress) error {
+	_, err := s.network.App.GetRevenueKeeper().RegisterRevenue(ctx, revenuetypes.NewMsgRegisterRevenue(
+		s.counterAddr.Hex(),
+		s.keyring.GetAccAddr(0),
+		withdrawer,
+		[]uint64{s.counterNonce},
+	))
+	return err
+}
@@ -0,0 +1,291 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/revenue"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestRegisterRevenue() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[revenue.RegisterRevenueMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid contract address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), "", common.Address{}, []uint64{s.counterNonce}}
+			},
+			func() {},
+			true,
+			"invalid contract address",
+		},
+		{
+			"fail - msg.sender address does not match the deployer address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.counterAddr, common.Address{}, []uint64{s.counterNonce}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - wrong nonce",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr, common.Address{}, []uint64{s.counterNonce + 1}}
+			},
+			func() {},
+			true,
+			"not contract deployer or wrong nonce",
+		},
+		{
+			"fail - already registered",
+			func() []interface{} {
+				s.Require().NoError(s.registerRevenue(ctx, nil))
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr, common.Address{}, []uint64{s.counterNonce}}
+			},
+			func() {},
+			true,
+			"contract is already registered",
+		},
+		{
+			"success - register with withdrawer",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr, s.keyring.GetAddr(2), []uint64{s.counterNonce}}
+			},
+			func() {
+				res, found := s.network.App.GetRevenueKeeper().GetRevenue(ctx, s.counterAddr)
+				s.Require().True(found)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), res.DeployerAddress)
+				s.Require().Equal(s.keyring.GetAccAddr(2).String(), res.WithdrawerAddress)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event revenue.EventRegisterRevenue
+				err := cmn.UnpackLog(s.precompile.ABI, &event, revenue.EventTypeRegisterRevenue, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Deployer)
+				s.Require().Equal(s.counterAddr, event.ContractAddress)
+				s.Require().Equal(s.keyring.GetAddr(2), event.Withdrawer)
+			},
+			false,
+			"",
+		},
+		{
+			"success - register without withdrawer",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr, common.Address{}, []uint64{s.counterNonce}}
+			},
+			func() {
+				res, found := s.network.App.GetRevenueKeeper().GetRevenue(ctx, s.counterAddr)
+				s.Require().True(found)
+				s.Require().Empty(res.WithdrawerAddress)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.RegisterRevenue(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateRevenue() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[revenue.UpdateRevenueMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - contract not registered",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr, s.keyring.GetAddr(2)}
+			},
+			func() {},
+			true,
+			"is not registered",
+		},
+		{
+			"fail - msg.sender address does not match the deployer address",
+			func() []interface{} {
+				s.Require().NoError(s.registerRevenue(ctx, nil))
+				return []interface{}{s.keyring.GetAddr(1), s.counterAddr, s.keyring.GetAddr(2)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - update withdrawer",
+			func() []interface{} {
+				s.Require().NoError(s.registerRevenue(ctx, nil))
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr, s.keyring.GetAddr(2)}
+			},
+			func() {
+				res, found := s.network.App.GetRevenueKeeper().GetRevenue(ctx, s.counterAddr)
+				s.Require().True(found)
+				s.Require().Equal(s.keyring.GetAccAddr(2).String(), res.WithdrawerAddress)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event revenue.EventUpdateRevenue
+				err := cmn.UnpackLog(s.precompile.ABI, &event, revenue.EventTypeUpdateRevenue, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(2), event.Withdrawer)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.UpdateRevenue(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelRevenue() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+	)
+	method := s.precompile.Methods[revenue.CancelRevenueMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - contract not registered",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr}
+			},
+			func() {},
+			true,
+			"is not registered",
+		},
+		{
+			"success - cancel revenue",
+			func() []interface{} {
+				s.Require().NoError(s.registerRevenue(ctx, s.keyring.GetAccAddr(2)))
+				return []interface{}{s.keyring.GetAddr(0), s.counterAddr}
+			},
+			func() {
+				revenueKeeper := s.network.App.GetRevenueKeeper()
+				s.Require().False(revenueKeeper.IsRevenueRegistered(ctx, s.counterAddr))
+				s.Require().False(revenueKeeper.IsWithdrawerMapSet(ctx, s.keyring.GetAccAddr(2), s.counterAddr))
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event revenue.EventCancelRevenue
+				err := cmn.UnpackLog(s.precompile.ABI, &event, revenue.EventTypeCancelRevenue, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Deployer)
+				s.Require().Equal(s.counterAddr, event.ContractAddress)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CancelRevenue(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
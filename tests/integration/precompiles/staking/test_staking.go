@@ -470,6 +470,57 @@ func (s *PrecompileTestSuite) TestRun() {
 	}
 }
 
+// TestRunBlockedMsg tests that the precompile cannot execute a message blocked
+// by the msg filter of the EVM params.
+func (s *PrecompileTestSuite) TestRunBlockedMsg() {
+	s.SetupTest()
+	ctx := s.network.GetContext().WithBlockTime(time.Now())
+	evmKeeper := s.network.App.GetEVMKeeper()
+
+	params := evmKeeper.GetParams(ctx)
+	params.MsgFilter.BlockedMsgTypeURLs = []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}
+	s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+	delegator := s.keyring.GetKey(0)
+	contract := vm.NewPrecompile(delegator.Addr, s.precompile.Address(), uint256.NewInt(0), 1000000)
+	contractAddr := contract.Address()
+
+	input, err := s.precompile.Pack(
+		staking.DelegateMethod,
+		delegator.Addr,
+		s.network.GetValidators()[0].GetOperator(),
+		big.NewInt(1000),
+	)
+	s.Require().NoError(err, "failed to pack input")
+	contract.Input = input
+
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		To:        &contractAddr,
+		GasLimit:  1000000,
+		GasFeeCap: evmKeeper.GetBaseFee(ctx),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msg, err := s.factory.GenerateGethCoreMsg(delegator.Priv, txArgs)
+	s.Require().NoError(err)
+
+	cfg, err := evmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	stDB := statedb.New(ctx, evmKeeper, statedb.NewEmptyTxConfig())
+	evm := evmKeeper.NewEVM(ctx, *msg, cfg, nil, stDB)
+
+	bz, err := s.precompile.Run(evm, contract, false)
+	s.Require().Error(err)
+	s.Require().ErrorContains(evmtypes.NewExecErrorWithReason(bz), "found blocked msg type: /cosmos.staking.v1beta1.MsgDelegate")
+
+	valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+	s.Require().NoError(err)
+	delegation, err := s.network.App.GetStakingKeeper().Delegation(ctx, delegator.AccAddr, valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(s.network.GetValidators()[0].DelegatorShares, delegation.GetShares())
+}
+
 // TestCMS tests the cache multistore writes.
 func (s *PrecompileTestSuite) TestCMS() {
 	s.customGenesis = true
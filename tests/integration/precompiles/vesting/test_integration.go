@@ -0,0 +1,187 @@
+package vesting
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/precompiles/vesting"
+	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	testutiltx "github.com/zenanetwork/zena/testutil/tx"
+	testutiltypes "github.com/zenanetwork/zena/testutil/types"
+	clawbacktypes "github.com/zenanetwork/zena/x/clawback/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling vesting precompile from EOA", func() {
+		var (
+			s              *PrecompileTestSuite
+			funder         common.Address
+			vestingAddress common.Address
+			amount         []cmn.Coin
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To: &precompileAddr,
+			}
+
+			funder = s.keyring.GetAddr(0)
+			vestingAddress = testutiltx.GenerateAddress()
+			amount = []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+		})
+
+		// =====================================
+		// 				TRANSACTIONS
+		// =====================================
+		Describe("Execute createVestingAccount transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = vesting.CreateVestingAccountMethod })
+
+			It("creates a continuous vesting account and emits event", func() {
+				endTime := s.network.GetContext().BlockTime().Unix() + 1000
+				callArgs.Args = []interface{}{funder, vestingAddress, amount, endTime, false}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreateVestingAccount)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddress.Bytes())
+				Expect(acc).To(BeAssignableToTypeOf(&vestingtypes.ContinuousVestingAccount{}))
+
+				// the vesting amount is reflected in the EVM balance of the vesting account
+				balance := s.network.App.GetEVMKeeper().GetBalance(s.network.GetContext(), vestingAddress)
+				Expect(balance.ToBig()).To(Equal(amount[0].Amount))
+			})
+
+			It("fails if the funder is not the msg.sender", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(1), vestingAddress, amount, int64(1), false}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, funder, s.keyring.GetAddr(1))
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Describe("Execute createPermanentLockedAccount transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = vesting.CreatePermanentLockedAccountMethod })
+
+			It("creates a permanent locked account and emits event", func() {
+				callArgs.Args = []interface{}{funder, vestingAddress, amount}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreatePermanentLockedAccount)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddress.Bytes())
+				Expect(acc).To(BeAssignableToTypeOf(&vestingtypes.PermanentLockedAccount{}))
+			})
+		})
+
+		Describe("Execute clawback transaction", func() {
+			It("claws back the unvested coins of a clawback vesting account to the destination", func() {
+				startTime := s.network.GetContext().BlockTime().Unix()
+				periods := []vesting.Period{{Length: 1000, Amount: amount}}
+				callArgs.MethodName = vesting.CreateClawbackVestingAccountMethod
+				callArgs.Args = []interface{}{funder, vestingAddress, startTime, periods}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreateClawbackVestingAccount)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddress.Bytes())
+				Expect(acc).To(BeAssignableToTypeOf(&clawbacktypes.ClawbackVestingAccount{}))
+
+				// the vesting coins are locked, so they are not part of the spendable EVM balance
+				bankBalance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), vestingAddress.Bytes(), s.network.GetBaseDenom())
+				Expect(bankBalance.Amount.BigInt()).To(Equal(amount[0].Amount))
+				spendable := s.network.App.GetEVMKeeper().SpendableCoin(s.network.GetContext(), vestingAddress)
+				Expect(spendable.IsZero()).To(BeTrue())
+
+				dest := testutiltx.GenerateAddress()
+				callArgs.MethodName = vesting.ClawbackMethod
+				callArgs.Args = []interface{}{funder, vestingAddress, dest}
+				eventCheck = passCheck.WithExpEvents(vesting.EventTypeClawback)
+
+				_, _, err = s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				bankBalance = s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), vestingAddress.Bytes(), s.network.GetBaseDenom())
+				Expect(bankBalance.IsZero()).To(BeTrue())
+				destBalance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), dest.Bytes(), s.network.GetBaseDenom())
+				Expect(destBalance.Amount.BigInt()).To(Equal(amount[0].Amount))
+			})
+		})
+
+		// =====================================
+		// 				QUERIES
+		// =====================================
+		Describe("Execute balances query", func() {
+			It("returns the locked balance of a permanent locked account", func() {
+				callArgs.MethodName = vesting.CreatePermanentLockedAccountMethod
+				callArgs.Args = []interface{}{funder, vestingAddress, amount}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreatePermanentLockedAccount)
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = vesting.BalancesMethod
+				callArgs.Args = []interface{}{vestingAddress}
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out vesting.BalancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Locked).To(Equal(amount))
+				Expect(out.Unlocked).To(BeEmpty())
+				Expect(out.Vested).To(BeEmpty())
+				Expect(out.Unvested).To(Equal(amount))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Vesting Precompile Suite")
+}
@@ -0,0 +1,236 @@
+package vesting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/precompiles/vesting"
+	utiltx "github.com/zenanetwork/zena/testutil/tx"
+	clawbacktypes "github.com/zenanetwork/zena/x/clawback/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *PrecompileTestSuite) TestBalances() {
+	method := s.precompile.Methods[vesting.BalancesMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(out *vesting.BalancesOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			func(_ *vesting.BalancesOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid vesting address",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			func(_ *vesting.BalancesOutput) {},
+			true,
+			"invalid vesting address",
+		},
+		{
+			"fail - not a vesting account",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			func(_ *vesting.BalancesOutput) {},
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - half vested continuous vesting account",
+			func(ctx sdk.Context) []interface{} {
+				vestingAddress := utiltx.GenerateAddress()
+				s.createVestingAccount(ctx, vestingAddress, math.NewInt(1000), ctx.BlockTime().Add(-100*time.Second).Unix(), ctx.BlockTime().Add(100*time.Second).Unix())
+				return []interface{}{vestingAddress}
+			},
+			func(out *vesting.BalancesOutput) {
+				s.Require().Equal(s.coinsResponse(500), out.Locked)
+				s.Require().Equal(s.coinsResponse(500), out.Unlocked)
+				s.Require().Equal(s.coinsResponse(500), out.Vested)
+				s.Require().Equal(s.coinsResponse(500), out.Unvested)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Balances(ctx, &method, contract, tc.malleate(ctx))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				var out vesting.BalancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetVestingAccount() {
+	method := s.precompile.Methods[vesting.GetVestingAccountMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(out *vesting.VestingAccountOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			func(_ *vesting.VestingAccountOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - not a vesting account",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{utiltx.GenerateAddress()}
+			},
+			func(_ *vesting.VestingAccountOutput) {},
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - continuous vesting account",
+			func(ctx sdk.Context) []interface{} {
+				vestingAddress := utiltx.GenerateAddress()
+				s.createVestingAccount(ctx, vestingAddress, math.NewInt(1000), ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+1000)
+				return []interface{}{vestingAddress}
+			},
+			func(out *vesting.VestingAccountOutput) {
+				s.Require().Equal(vesting.ContinuousVestingAccountType, out.Account.AccountType)
+				s.Require().Equal(out.Account.StartTime+1000, out.Account.EndTime)
+				s.Require().Equal(s.coinsResponse(1000), out.Account.OriginalVesting)
+				s.Require().Empty(out.Account.Periods)
+			},
+			false,
+			"",
+		},
+		{
+			"success - periodic vesting account",
+			func(ctx sdk.Context) []interface{} {
+				vestingAddress := utiltx.GenerateAddress()
+				acc := s.network.App.GetAccountKeeper().NewAccountWithAddress(ctx, vestingAddress.Bytes())
+				periods := vestingtypes.Periods{
+					{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 250))},
+					{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 750))},
+				}
+				bva, err := vestingtypes.NewPeriodicVestingAccount(acc.(*authtypes.BaseAccount), periods.TotalAmount(), ctx.BlockTime().Unix(), periods)
+				s.Require().NoError(err)
+				s.network.App.GetAccountKeeper().SetAccount(ctx, bva)
+				return []interface{}{vestingAddress}
+			},
+			func(out *vesting.VestingAccountOutput) {
+				s.Require().Equal(vesting.PeriodicVestingAccountType, out.Account.AccountType)
+				s.Require().Len(out.Account.Periods, 2)
+				s.Require().Equal(int64(100), out.Account.Periods[0].Length)
+				s.Require().Equal(s.coinsResponse(250), out.Account.Periods[0].Amount)
+			},
+			false,
+			"",
+		},
+		{
+			"success - clawback vesting account",
+			func(ctx sdk.Context) []interface{} {
+				vestingAddress := utiltx.GenerateAddress()
+				s.createClawbackVestingAccount(ctx, vestingAddress, ctx.BlockTime().Unix(), vestingtypes.Periods{
+					{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 250))},
+				})
+				return []interface{}{vestingAddress}
+			},
+			func(out *vesting.VestingAccountOutput) {
+				s.Require().Equal(vesting.ClawbackVestingAccountType, out.Account.AccountType)
+				s.Require().Equal(out.Account.StartTime+100, out.Account.EndTime)
+				s.Require().Len(out.Account.Periods, 1)
+				s.Require().Equal(s.coinsResponse(250), out.Account.Periods[0].Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GetVestingAccount(ctx, &method, contract, tc.malleate(ctx))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				var out vesting.VestingAccountOutput
+				err = s.precompile.UnpackIntoInterface(&out, vesting.GetVestingAccountMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+// createVestingAccount stores a continuous vesting account with the given
+// schedule and funds it from the first keyring account.
+func (s *PrecompileTestSuite) createVestingAccount(ctx sdk.Context, vestingAddress common.Address, amount math.Int, startTime, endTime int64) {
+	coins := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount))
+
+	acc := s.network.App.GetAccountKeeper().NewAccountWithAddress(ctx, vestingAddress.Bytes())
+	bva, err := vestingtypes.NewBaseVestingAccount(acc.(*authtypes.BaseAccount), coins, endTime)
+	s.Require().NoError(err)
+	s.network.App.GetAccountKeeper().SetAccount(ctx, vestingtypes.NewContinuousVestingAccountRaw(bva, startTime))
+
+	err = s.network.App.GetBankKeeper().SendCoins(ctx, s.keyring.GetAccAddr(0), vestingAddress.Bytes(), coins)
+	s.Require().NoError(err)
+}
+
+// coinsResponse returns the given amount of the base denom as precompile coins.
+func (s *PrecompileTestSuite) coinsResponse(amount int64) []cmn.Coin {
+	return cmn.NewCoinsResponse(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), amount)))
+}
+
+// createClawbackVestingAccount stores a clawback vesting account with the
+// given schedule and funds it from the first keyring account, which is set as
+// its funder.
+func (s *PrecompileTestSuite) createClawbackVestingAccount(ctx sdk.Context, vestingAddress common.Address, startTime int64, periods vestingtypes.Periods) {
+	acc := s.network.App.GetAccountKeeper().NewAccountWithAddress(ctx, vestingAddress.Bytes())
+	cva, err := clawbacktypes.NewClawbackVestingAccount(acc.(*authtypes.BaseAccount), s.keyring.GetAccAddr(0).String(), startTime, periods)
+	s.Require().NoError(err)
+	s.network.App.GetAccountKeeper().SetAccount(ctx, cva)
+
+	err = s.network.App.GetBankKeeper().SendCoins(ctx, s.keyring.GetAccAddr(0), vestingAddress.Bytes(), periods.TotalAmount())
+	s.Require().NoError(err)
+}
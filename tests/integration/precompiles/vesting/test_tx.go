@@ -0,0 +1,767 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/zenanetwork/zena/precompiles/common"
+	"github.com/zenanetwork/zena/precompiles/testutil"
+	"github.com/zenanetwork/zena/precompiles/vesting"
+	utiltx "github.com/zenanetwork/zena/testutil/tx"
+	clawbacktypes "github.com/zenanetwork/zena/x/clawback/types"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *PrecompileTestSuite) TestCreateVestingAccount() {
+	var (
+		stateDB        *statedb.StateDB
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.CreateVestingAccountMethod]
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - invalid funder address",
+			func() []interface{} {
+				return []interface{}{
+					"", vestingAddress, s.coins(amount), ctx.BlockTime().Unix() + 1000, false,
+				}
+			},
+			func() {},
+			true,
+			"invalid funder address",
+		},
+		{
+			"fail - invalid vesting amount",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, []cmn.Coin{{Denom: "", Amount: amount}}, ctx.BlockTime().Unix() + 1000, false,
+				}
+			},
+			func() {},
+			true,
+			"invalid vesting amount",
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, s.coins(amount), ctx.BlockTime().Unix() + 1000, false,
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - vesting account already exists",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.coins(amount), ctx.BlockTime().Unix() + 1000, false,
+				}
+			},
+			func() {},
+			true,
+			"already exists",
+		},
+		{
+			"success - create continuous vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, s.coins(amount), ctx.BlockTime().Unix() + 1000, false,
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				cva, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+				s.Require().True(ok, "expected continuous vesting account, got %T", acc)
+				s.Require().Equal(ctx.BlockTime().Unix(), cva.GetStartTime())
+				s.Require().Equal(ctx.BlockTime().Unix()+1000, cva.GetEndTime())
+				s.Require().Equal(amount.String(), cva.GetOriginalVesting().AmountOf(s.network.GetBaseDenom()).String())
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(amount.String(), balance.Amount.String())
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event vesting.EventCreateVestingAccount
+				err := cmn.UnpackLog(s.precompile.ABI, &event, vesting.EventTypeCreateVestingAccount, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(ctx.BlockTime().Unix()+1000, event.EndTime)
+				s.Require().False(event.Delayed)
+				s.Require().Equal(s.coins(amount), event.Amount)
+			},
+			false,
+			"",
+		},
+		{
+			"success - create delayed vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, s.coins(amount), ctx.BlockTime().Unix() + 1000, true,
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				_, ok := acc.(*vestingtypes.DelayedVestingAccount)
+				s.Require().True(ok, "expected delayed vesting account, got %T", acc)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreateVestingAccount(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreatePeriodicVestingAccount() {
+	var (
+		stateDB        *statedb.StateDB
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.CreatePeriodicVestingAccountMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - empty periods",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{},
+				}
+			},
+			func() {},
+			true,
+			vesting.ErrEmptyPeriods,
+		},
+		{
+			"fail - invalid period length",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 0, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"invalid period length",
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - create periodic vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+						{Length: 200, Amount: s.coins(big.NewInt(300))},
+					},
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				pva, ok := acc.(*vestingtypes.PeriodicVestingAccount)
+				s.Require().True(ok, "expected periodic vesting account, got %T", acc)
+				s.Require().Len(pva.GetVestingPeriods(), 2)
+				s.Require().Equal(ctx.BlockTime().Unix()+300, pva.GetEndTime())
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(math.NewInt(400), balance.Amount)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event vesting.EventCreatePeriodicVestingAccount
+				err := cmn.UnpackLog(s.precompile.ABI, &event, vesting.EventTypeCreatePeriodicVestingAccount, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(ctx.BlockTime().Unix(), event.StartTime)
+				s.Require().Len(event.Periods, 2)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreatePeriodicVestingAccount(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreatePermanentLockedAccount() {
+	var (
+		stateDB        *statedb.StateDB
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.CreatePermanentLockedAccountMethod]
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid vesting address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), common.Address{}, s.coins(amount),
+				}
+			},
+			func() {},
+			true,
+			"invalid vesting address",
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, s.coins(amount),
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - create permanent locked account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, s.coins(amount),
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				_, ok := acc.(*vestingtypes.PermanentLockedAccount)
+				s.Require().True(ok, "expected permanent locked account, got %T", acc)
+
+				spendable := s.network.App.GetBankKeeper().SpendableCoin(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().True(spendable.IsZero())
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event vesting.EventCreatePermanentLockedAccount
+				err := cmn.UnpackLog(s.precompile.ABI, &event, vesting.EventTypeCreatePermanentLockedAccount, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.coins(amount), event.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreatePermanentLockedAccount(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreateClawbackVestingAccount() {
+	var (
+		stateDB        *statedb.StateDB
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.CreateClawbackVestingAccountMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - empty periods",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{},
+				}
+			},
+			func() {},
+			true,
+			vesting.ErrEmptyPeriods,
+		},
+		{
+			"fail - invalid period length",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 0, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"invalid period length",
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - vesting account already exists",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"already exists",
+		},
+		{
+			"success - create clawback vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, ctx.BlockTime().Unix(), []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+						{Length: 200, Amount: s.coins(big.NewInt(300))},
+					},
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				cva, ok := acc.(*clawbacktypes.ClawbackVestingAccount)
+				s.Require().True(ok, "expected clawback vesting account, got %T", acc)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), cva.FunderAddress)
+				s.Require().Len(cva.GetVestingPeriods(), 2)
+				s.Require().Equal(ctx.BlockTime().Unix()+300, cva.GetEndTime())
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(math.NewInt(400), balance.Amount)
+
+				spendable := s.network.App.GetBankKeeper().SpendableCoin(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().True(spendable.IsZero())
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event vesting.EventCreateClawbackVestingAccount
+				err := cmn.UnpackLog(s.precompile.ABI, &event, vesting.EventTypeCreateClawbackVestingAccount, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(ctx.BlockTime().Unix(), event.StartTime)
+				s.Require().Len(event.Periods, 2)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreateClawbackVestingAccount(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFundVestingAccount() {
+	var (
+		stateDB        *statedb.StateDB
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.FundVestingAccountMethod]
+
+	testCases := []struct {
+		name        string
+		caller      int
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			0,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - not a clawback vesting account",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"account is not a clawback vesting account",
+		},
+		{
+			"fail - sender is not the funder of the vesting account",
+			1,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, []vesting.Period{
+						{Length: 100, Amount: s.coins(big.NewInt(100))},
+					},
+				}
+			},
+			func() {},
+			true,
+			"sender is not the funder of the clawback vesting account",
+		},
+		{
+			"success - append periods to the vesting schedule",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, []vesting.Period{
+						{Length: 50, Amount: s.coins(big.NewInt(200))},
+					},
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				cva, ok := acc.(*clawbacktypes.ClawbackVestingAccount)
+				s.Require().True(ok, "expected clawback vesting account, got %T", acc)
+				s.Require().Len(cva.GetVestingPeriods(), 2)
+				s.Require().Equal(ctx.BlockTime().Unix()+150, cva.GetEndTime())
+				s.Require().Equal(math.NewInt(300), cva.GetOriginalVesting().AmountOf(s.network.GetBaseDenom()))
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(math.NewInt(300), balance.Amount)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event vesting.EventFundVestingAccount
+				err := cmn.UnpackLog(s.precompile.ABI, &event, vesting.EventTypeFundVestingAccount, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Len(event.Periods, 1)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.caller), s.precompile.Address(), 200_000)
+			s.createClawbackVestingAccount(ctx, vestingAddress, ctx.BlockTime().Unix(), vestingtypes.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100))},
+			})
+
+			res, err := s.precompile.FundVestingAccount(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestClawback() {
+	var (
+		stateDB        *statedb.StateDB
+		ctx            sdk.Context
+		vestingAddress common.Address
+		dest           common.Address
+	)
+	method := s.precompile.Methods[vesting.ClawbackMethod]
+
+	testCases := []struct {
+		name        string
+		caller      int
+		malleate    func() []interface{}
+		postCheck   func(coins []cmn.Coin)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			0,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]cmn.Coin) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid destination address",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, common.Address{},
+				}
+			},
+			func([]cmn.Coin) {},
+			true,
+			"invalid clawback destination address",
+		},
+		{
+			"fail - msg.sender address does not match the funder address",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, dest,
+				}
+			},
+			func([]cmn.Coin) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - not a clawback vesting account",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), dest,
+				}
+			},
+			func([]cmn.Coin) {},
+			true,
+			"account is not a clawback vesting account",
+		},
+		{
+			"fail - sender is not the funder of the vesting account",
+			1,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), vestingAddress, dest,
+				}
+			},
+			func([]cmn.Coin) {},
+			true,
+			"sender is not the funder of the clawback vesting account",
+		},
+		{
+			"success - claw back the unvested coins",
+			0,
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), vestingAddress, dest,
+				}
+			},
+			func(coins []cmn.Coin) {
+				s.Require().Equal(s.coins(big.NewInt(300)), coins)
+
+				acc := s.network.App.GetAccountKeeper().GetAccount(ctx, vestingAddress.Bytes())
+				cva, ok := acc.(*clawbacktypes.ClawbackVestingAccount)
+				s.Require().True(ok, "expected clawback vesting account, got %T", acc)
+				s.Require().Len(cva.GetVestingPeriods(), 1)
+				s.Require().Equal(math.NewInt(100), cva.GetOriginalVesting().AmountOf(s.network.GetBaseDenom()))
+
+				// the vested coins stay in the account and are spendable
+				spendable := s.network.App.GetBankKeeper().SpendableCoin(ctx, vestingAddress.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(math.NewInt(100), spendable.Amount)
+
+				destBalance := s.network.App.GetBankKeeper().GetBalance(ctx, dest.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(math.NewInt(300), destBalance.Amount)
+
+				s.Require().Len(stateDB.Logs(), 1)
+				var event vesting.EventClawback
+				err := cmn.UnpackLog(s.precompile.ABI, &event, vesting.EventTypeClawback, *stateDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(dest, event.Dest)
+				s.Require().Equal(s.coins(big.NewInt(300)), event.Coins)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			vestingAddress = utiltx.GenerateAddress()
+			dest = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.caller), s.precompile.Address(), 200_000)
+			// the first period already ended, so only its coins are vested
+			s.createClawbackVestingAccount(ctx, vestingAddress, ctx.BlockTime().Unix()-150, vestingtypes.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100))},
+				{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 300))},
+			})
+
+			res, err := s.precompile.Clawback(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				coins, err := cmn.ToCoins(out[0])
+				s.Require().NoError(err)
+				tc.postCheck(coins)
+			}
+		})
+	}
+}
+
+// coins returns the given amount of the base denom as precompile coins.
+func (s *PrecompileTestSuite) coins(amount *big.Int) []cmn.Coin {
+	return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: amount}}
+}
@@ -6,16 +6,19 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 
+	rpcbackend "github.com/zenanetwork/zena/rpc/backend"
 	"github.com/zenanetwork/zena/rpc/backend/mocks"
 	rpctypes "github.com/zenanetwork/zena/rpc/types"
 	utiltx "github.com/zenanetwork/zena/testutil/tx"
 	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -297,6 +300,85 @@ func (s *TestSuite) TestGetStorageAt() {
 	}
 }
 
+func (s *TestSuite) TestStorageRangeAt() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := utiltx.GenerateAddress()
+	keys := []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}
+	value := common.BigToHash(big.NewInt(10))
+
+	testCases := []struct {
+		name         string
+		keyStart     hexutil.Bytes
+		maxResult    int
+		registerMock func()
+		expPass      bool
+		expResult    rpctypes.StorageRangeResult
+	}{
+		{
+			"fail - invalid max result",
+			nil,
+			0,
+			func() {},
+			false,
+			rpctypes.StorageRangeResult{},
+		},
+		{
+			"fail - max result above the limit",
+			nil,
+			rpcbackend.StorageRangeMaxResults + 1,
+			func() {},
+			false,
+			rpctypes.StorageRangeResult{},
+		},
+		{
+			"fail - query client errors on getting the storage range",
+			nil,
+			1,
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageRangeError(QueryClient, addr, &query.PageRequest{Limit: 1})
+			},
+			false,
+			rpctypes.StorageRangeResult{},
+		},
+		{
+			"pass - storage range with next key",
+			hexutil.Bytes{0x1},
+			1,
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageRange(
+					QueryClient, addr,
+					&query.PageRequest{Key: keys[0].Bytes(), Limit: 1},
+					[]evmtypes.State{evmtypes.NewState(keys[0], value)},
+					keys[1].Bytes(),
+				)
+			},
+			true,
+			rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					crypto.Keccak256Hash(keys[0].Bytes()): {Key: &keys[0], Value: value},
+				},
+				NextKey: &keys[1],
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest()
+			tc.registerMock()
+
+			result, err := s.backend.StorageRangeAt(addr, tc.keyStart, tc.maxResult, blockNr)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
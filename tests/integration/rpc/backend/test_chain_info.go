@@ -431,6 +431,7 @@ func (s *TestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeMarketBlockGas(fQueryClient, 1, 0, 0)
 			},
 			1,
 			1,
@@ -446,6 +447,30 @@ func (s *TestSuite) TestFeeHistory() {
 			true,
 			nil,
 		},
+		{
+			"fail - block gas query error",
+			func(validator sdk.AccAddress) {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterValidatorAccount(queryClient, validator)
+				RegisterConsensusParams(client, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeMarketBlockGasError(fQueryClient, 1)
+			},
+			1,
+			1,
+			nil,
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			false,
+			nil,
+		},
 		{
 			"pass - Valid FeeHistoryResults object",
 			func(validator sdk.AccAddress) {
@@ -461,6 +486,7 @@ func (s *TestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeMarketBlockGas(fQueryClient, 1, 0, 0)
 			},
 			1,
 			1,
@@ -491,6 +517,7 @@ func (s *TestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeMarketBlockGas(fQueryClient, 1, 0, 0)
 			},
 			1,
 			1,
@@ -523,6 +550,7 @@ func (s *TestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeMarketBlockGas(fQueryClient, 1, 0, 0)
 			},
 			1,
 			0,
@@ -553,6 +581,7 @@ func (s *TestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeMarketBlockGas(fQueryClient, 1, 0, 0)
 			},
 			1,
 			ethrpc.EarliestBlockNumber,
@@ -601,3 +630,68 @@ func (s *TestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (s *TestSuite) TestBurnedBaseFee() {
+	blockNr := rpc.BlockNumber(1)
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+		expResult    *rpc.BurnedBaseFeeResult
+	}{
+		{
+			"fail - CometBFT header fetching error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				height := blockNr.Int64()
+				RegisterHeaderError(client, &height)
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - feemarket query error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				height := blockNr.Int64()
+				RegisterHeader(client, &height, nil)
+				RegisterFeeMarketBurnedBaseFeeError(fQueryClient, height)
+			},
+			false,
+			nil,
+		},
+		{
+			"pass",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				height := blockNr.Int64()
+				RegisterHeader(client, &height, nil)
+				RegisterFeeMarketBurnedBaseFee(fQueryClient, height, sdkmath.NewInt(100), sdkmath.NewInt(1_000_000_000_001))
+			},
+			true,
+			&rpc.BurnedBaseFeeResult{
+				BlockNumber:  (*hexutil.Big)(big.NewInt(1)),
+				Burned:       (*hexutil.Big)(big.NewInt(100)),
+				TotalBurned:  (*hexutil.Big)(big.NewInt(1_000_000_000_001)),
+				BurnFraction: sdkmath.LegacyNewDecWithPrec(5, 1).String(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := s.backend.BurnedBaseFee(rpc.BlockNumberOrHash{BlockNumber: &blockNr})
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
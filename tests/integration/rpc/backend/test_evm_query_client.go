@@ -28,6 +28,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func MatchByProto(exp proto.Message) any {
@@ -283,6 +284,16 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, addr common.Address, pagination *query.PageRequest, storage []evmtypes.State, nextKey []byte) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRangeRequest{Address: addr.String(), Pagination: pagination}).
+		Return(&evmtypes.QueryStorageRangeResponse{Storage: storage, Pagination: &query.PageResponse{NextKey: nextKey}}, nil)
+}
+
+func RegisterStorageRangeError(queryClient *mocks.EVMQueryClient, addr common.Address, pagination *query.PageRequest) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRangeRequest{Address: addr.String(), Pagination: pagination}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
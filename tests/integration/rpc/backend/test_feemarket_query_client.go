@@ -4,7 +4,11 @@ import (
 	"github.com/zenanetwork/zena/rpc/backend/mocks"
 	rpc "github.com/zenanetwork/zena/rpc/types"
 	feemarkettypes "github.com/zenanetwork/zena/x/feemarket/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -20,3 +24,30 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BlockGas
+func RegisterFeeMarketBlockGas(feeMarketClient *mocks.FeeMarketQueryClient, height int64, gas, gasUsed int64) {
+	feeMarketClient.On("BlockGas", rpc.ContextWithHeight(height), &feemarkettypes.QueryBlockGasRequest{}).
+		Return(&feemarkettypes.QueryBlockGasResponse{Gas: gas, GasUsed: gasUsed}, nil)
+}
+
+func RegisterFeeMarketBlockGasError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("BlockGas", rpc.ContextWithHeight(height), &feemarkettypes.QueryBlockGasRequest{}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
+
+// BurnedBaseFee
+func RegisterFeeMarketBurnedBaseFee(feeMarketClient *mocks.FeeMarketQueryClient, height int64, block, total sdkmath.Int) {
+	denom := evmtypes.GetEVMCoinExtendedDenom()
+	feeMarketClient.On("BurnedBaseFee", rpc.ContextWithHeight(height), &feemarkettypes.QueryBurnedBaseFeeRequest{}).
+		Return(&feemarkettypes.QueryBurnedBaseFeeResponse{
+			Block:    sdk.NewCoin(denom, block),
+			Total:    sdk.NewCoin(denom, total),
+			Fraction: sdkmath.LegacyNewDecWithPrec(5, 1),
+		}, nil)
+}
+
+func RegisterFeeMarketBurnedBaseFeeError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("BurnedBaseFee", rpc.ContextWithHeight(height), &feemarkettypes.QueryBurnedBaseFeeRequest{}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
@@ -2,6 +2,8 @@ package erc20
 
 import (
 	"fmt"
+	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
@@ -14,6 +16,8 @@ import (
 	erc20mocks "github.com/zenanetwork/zena/x/erc20/types/mocks"
 	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -331,3 +335,212 @@ func (s *KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDeregisterTokenPair() {
+	var (
+		ctx          sdk.Context
+		contractAddr common.Address
+		pair         types.TokenPair
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	holderAcc := s.keyring.GetAccAddr(0)
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		authority       string
+		migrateBalances bool
+		expPass         bool
+	}{
+		{
+			"fail - token not registered",
+			func() {
+				contractAddr = common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7")
+			},
+			authority,
+			false,
+			false,
+		},
+		{
+			"fail - invalid authority",
+			func() {
+				var err error
+				contractAddr, err = s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err, "failed to register pair")
+			},
+			holderAcc.String(),
+			false,
+			false,
+		},
+		{
+			"pass - deregister without migration",
+			func() {
+				var err error
+				contractAddr, err = s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err, "failed to register pair")
+			},
+			authority,
+			false,
+			true,
+		},
+		{
+			"pass - deregister with migration",
+			func() {
+				var err error
+				contractAddr, err = s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err, "failed to register pair")
+			},
+			authority,
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			tc.malleate()
+			ctx = s.network.GetContext()
+			erc20Keeper := s.network.App.GetErc20Keeper()
+			pair, _ = erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetTokenPairID(ctx, contractAddr.String()))
+
+			_, err := erc20Keeper.DeregisterTokenPair(ctx, &types.MsgDeregisterTokenPair{
+				Authority:       tc.authority,
+				Token:           contractAddr.String(),
+				MigrateBalances: tc.migrateBalances,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().False(erc20Keeper.IsERC20Registered(ctx, contractAddr))
+			s.Require().False(erc20Keeper.IsDenomRegistered(ctx, pair.Denom))
+			s.Require().True(erc20Keeper.IsTokenPairDeregistered(ctx, pair.GetID()))
+
+			if tc.migrateBalances {
+				s.Require().Equal(pair.GetID(), erc20Keeper.GetBalanceMigration(ctx, contractAddr))
+			} else {
+				s.Require().Nil(erc20Keeper.GetBalanceMigration(ctx, contractAddr))
+			}
+
+			event := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1]
+			s.Require().Equal(types.EventTypeDeregisterTokenPair, event.Type)
+			migrate, found := event.GetAttribute(types.AttributeKeyMigrateBalances)
+			s.Require().True(found)
+			s.Require().Equal(strconv.FormatBool(tc.migrateBalances), migrate.Value)
+
+			// the token pair can't be registered again
+			err = erc20Keeper.SetToken(ctx, pair)
+			s.Require().ErrorIs(err, types.ErrTokenPairDeregistered)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrateBalance() {
+	var (
+		ctx          sdk.Context
+		contractAddr common.Address
+		sender       sdk.AccAddress
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	holder := s.keyring.GetAddr(0)
+	holderAcc := s.keyring.GetAccAddr(0)
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		migrateBalances bool
+		expPass         bool
+		expMigrated     int64
+	}{
+		{
+			"fail - balance migration not enabled",
+			func() {},
+			false,
+			false,
+			0,
+		},
+		{
+			"fail - module account can't migrate",
+			func() {
+				sender = authtypes.NewModuleAddress(types.ModuleName)
+			},
+			true,
+			false,
+			0,
+		},
+		{
+			"fail - no coins to migrate",
+			func() {
+				sender = s.keyring.GetAccAddr(1)
+			},
+			true,
+			false,
+			0,
+		},
+		{
+			"pass - convert the coins back to ERC20 tokens",
+			func() {},
+			true,
+			true,
+			60,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			sender = holderAcc
+
+			var err error
+			contractAddr, err = s.setupRegisterERC20Pair(contractMinterBurner)
+			s.Require().NoError(err, "failed to register pair")
+
+			// the holder has 40 tokens and 60 coins converted from tokens
+			// escrowed by the module
+			_, err = s.MintERC20Token(contractAddr, holder, big.NewInt(40))
+			s.Require().NoError(err, "failed to mint tokens")
+			_, err = s.MintERC20Token(contractAddr, types.ModuleAddress, big.NewInt(60))
+			s.Require().NoError(err, "failed to mint tokens")
+
+			ctx = s.network.GetContext()
+			denom := types.CreateDenom(contractAddr.String())
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 60))
+			err = s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, holderAcc, coins)
+			s.Require().NoError(err, "failed to send coins")
+
+			erc20Keeper := s.network.App.GetErc20Keeper()
+			_, err = erc20Keeper.DeregisterTokenPair(ctx, &types.MsgDeregisterTokenPair{
+				Authority:       authority,
+				Token:           contractAddr.String(),
+				MigrateBalances: tc.migrateBalances,
+			})
+			s.Require().NoError(err)
+
+			tc.malleate()
+
+			res, err := erc20Keeper.MigrateBalance(ctx, &types.MsgMigrateBalance{
+				Sender: sender.String(),
+				Token:  denom,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(math.NewInt(tc.expMigrated), res.Amount)
+
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, holderAcc, denom)
+			s.Require().True(balance.IsZero())
+
+			abi := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			s.Require().Equal(big.NewInt(100), erc20Keeper.BalanceOf(ctx, abi, contractAddr, holder))
+
+			event := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1]
+			s.Require().Equal(types.EventTypeMigrateBalance, event.Type)
+		})
+	}
+}
@@ -1,11 +1,21 @@
 package feemarket
 
 import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
 	"github.com/zenanetwork/zena/testutil/integration/evm/network"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *KeeperTestSuite) TestEndBlock() {
@@ -19,12 +29,14 @@ func (s *KeeperTestSuite) TestEndBlock() {
 		NoBaseFee    bool
 		malleate     func()
 		expGasWanted uint64
+		expGasUsed   uint64
 	}{
 		{
 			"baseFee nil",
 			true,
 			func() {},
 			uint64(0),
+			uint64(0),
 		},
 		{
 			"pass",
@@ -35,6 +47,19 @@ func (s *KeeperTestSuite) TestEndBlock() {
 				nw.App.GetFeeMarketKeeper().SetTransientBlockGasWanted(ctx, 5000000)
 			},
 			uint64(2500000),
+			uint64(0),
+		},
+		{
+			"pass - gas used above the limited gas wanted",
+			false,
+			func() {
+				meter := storetypes.NewGasMeter(uint64(1000000000))
+				meter.ConsumeGas(3000000, "test")
+				ctx = ctx.WithBlockGasMeter(meter)
+				nw.App.GetFeeMarketKeeper().SetTransientBlockGasWanted(ctx, 5000000)
+			},
+			uint64(3000000),
+			uint64(3000000),
 		},
 	}
 	for _, tc := range testCases {
@@ -56,6 +81,162 @@ func (s *KeeperTestSuite) TestEndBlock() {
 
 			gasWanted := nw.App.GetFeeMarketKeeper().GetBlockGasWanted(ctx)
 			s.Equal(tc.expGasWanted, gasWanted, tc.name)
+
+			gasUsed := nw.App.GetFeeMarketKeeper().GetBlockGasUsed(ctx)
+			s.Equal(tc.expGasUsed, gasUsed, tc.name)
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestEndBlockBurnBaseFee() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name        string
+		fraction    math.LegacyDec
+		baseFeePaid math.Int
+		expBurned   math.Int
+	}{
+		{
+			"burn disabled",
+			math.LegacyZeroDec(),
+			math.NewInt(1_000_000),
+			math.ZeroInt(),
+		},
+		{
+			"no base fee paid",
+			math.LegacyNewDecWithPrec(5, 1),
+			math.ZeroInt(),
+			math.ZeroInt(),
+		},
+		{
+			"pass - half of the base fee is burned",
+			math.LegacyNewDecWithPrec(5, 1),
+			math.NewInt(1_000_000),
+			math.NewInt(500_000),
+		},
+		{
+			"pass - fractional amount is burned",
+			math.LegacyOneDec(),
+			math.NewInt(1_000_000_000_123),
+			math.NewInt(1_000_000_000_123),
+		},
+		{
+			"pass - burned amount is truncated",
+			math.LegacyNewDecWithPrec(5, 1),
+			math.NewInt(3),
+			math.NewInt(1),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+			denom := evmtypes.GetEVMCoinExtendedDenom()
+
+			params := fmk.GetParams(ctx)
+			params.BaseFeeBurnFraction = tc.fraction
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			// the fee collector holds the fees paid by the transactions
+			fees := sdk.NewCoins(sdk.NewCoin(denom, tc.baseFeePaid))
+			s.Require().NoError(nw.App.GetPreciseBankKeeper().MintCoins(ctx, evmtypes.ModuleName, fees))
+			s.Require().NoError(nw.App.GetPreciseBankKeeper().SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+			fmk.AddTransientBaseFeePaid(ctx, tc.baseFeePaid)
+
+			feeCollector := nw.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+			balanceBefore := nw.App.GetPreciseBankKeeper().GetBalance(ctx, feeCollector, denom)
+			totalBefore := fmk.GetBurnedBaseFee(ctx)
+
+			s.Require().NoError(fmk.EndBlock(ctx))
+
+			s.Require().Equal(tc.expBurned, fmk.GetBlockBurnedBaseFee(ctx))
+			s.Require().Equal(totalBefore.Add(tc.expBurned), fmk.GetBurnedBaseFee(ctx))
+
+			balanceAfter := nw.App.GetPreciseBankKeeper().GetBalance(ctx, feeCollector, denom)
+			s.Require().True(balanceBefore.Amount.Sub(tc.expBurned).Equal(balanceAfter.Amount))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockBurnBaseFeeWithRevenue() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	fmk := nw.App.GetFeeMarketKeeper()
+	rk := nw.App.GetRevenueKeeper()
+	denom := evmtypes.GetEVMCoinExtendedDenom()
+
+	params := fmk.GetParams(ctx)
+	params.BaseFeeBurnFraction = math.LegacyOneDec()
+	s.Require().NoError(fmk.SetParams(ctx, params))
+
+	revenueParams := revenuetypes.DefaultParams()
+	revenueParams.EnableRevenue = true
+	revenueParams.DeveloperShares = math.LegacyNewDecWithPrec(5, 1)
+	s.Require().NoError(rk.SetParams(ctx, revenueParams))
+
+	contract := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	deployer := sdk.AccAddress(common.HexToAddress("0x6666666666666666666666666666666666666666").Bytes())
+	rk.SetRevenue(ctx, revenuetypes.NewRevenue(contract, deployer, nil))
+
+	// a transaction to the contract pays the base fee and a priority fee of 1
+	baseFee := nw.App.GetEVMKeeper().GetBaseFee(ctx)
+	s.Require().True(baseFee.Sign() > 0)
+	gasPrice := new(big.Int).Add(baseFee, big.NewInt(1))
+	gasUsed := uint64(21_000)
+	baseFeePaid := math.NewIntFromBigInt(baseFee).MulRaw(int64(gasUsed))
+	txFee := math.NewIntFromBigInt(gasPrice).MulRaw(int64(gasUsed))
+
+	fees := sdk.NewCoins(sdk.NewCoin(denom, txFee))
+	s.Require().NoError(nw.App.GetPreciseBankKeeper().MintCoins(ctx, evmtypes.ModuleName, fees))
+	s.Require().NoError(nw.App.GetPreciseBankKeeper().SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+	fmk.AddTransientBaseFeePaid(ctx, baseFeePaid)
+
+	msg := core.Message{From: common.BytesToAddress(deployer), To: &contract, GasPrice: gasPrice}
+	s.Require().NoError(rk.Hooks().PostTxProcessing(ctx, msg.From, msg, &ethtypes.Receipt{GasUsed: gasUsed}))
+
+	// the developer receives half of the fees, and only the other half of the
+	// base fee is burned
+	developerFee := txFee.QuoRaw(2)
+	s.Require().True(developerFee.Equal(nw.App.GetPreciseBankKeeper().GetBalance(ctx, deployer, denom).Amount))
+
+	s.Require().NoError(fmk.EndBlock(ctx))
+
+	expBurned := baseFeePaid.Sub(baseFeePaid.QuoRaw(2))
+	s.Require().True(expBurned.Equal(fmk.GetBlockBurnedBaseFee(ctx)), "expected %s, got %s", expBurned, fmk.GetBlockBurnedBaseFee(ctx))
+
+	feeCollector := nw.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+	balance := nw.App.GetPreciseBankKeeper().GetBalance(ctx, feeCollector, denom)
+	s.Require().True(txFee.Sub(developerFee).Sub(expBurned).Equal(balance.Amount))
+}
+
+func (s *KeeperTestSuite) TestEndBlockBurnBaseFeeCapped() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	fmk := nw.App.GetFeeMarketKeeper()
+	denom := evmtypes.GetEVMCoinExtendedDenom()
+
+	params := fmk.GetParams(ctx)
+	params.BaseFeeBurnFraction = math.LegacyOneDec()
+	s.Require().NoError(fmk.SetParams(ctx, params))
+
+	feeCollector := nw.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+	available := nw.App.GetPreciseBankKeeper().GetBalance(ctx, feeCollector, denom).Amount
+
+	// the fee collector holds less than the base fees paid
+	fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(400)))
+	s.Require().NoError(nw.App.GetPreciseBankKeeper().MintCoins(ctx, evmtypes.ModuleName, fees))
+	s.Require().NoError(nw.App.GetPreciseBankKeeper().SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+	available = available.AddRaw(400)
+	fmk.AddTransientBaseFeePaid(ctx, available.AddRaw(600))
+
+	s.Require().NoError(fmk.EndBlock(ctx))
+
+	s.Require().True(available.Equal(fmk.GetBlockBurnedBaseFee(ctx)))
+	s.Require().True(nw.App.GetPreciseBankKeeper().GetBalance(ctx, feeCollector, denom).Amount.IsZero())
+}
@@ -136,6 +136,100 @@ func (s *KeeperTestSuite) TestCalculateBaseFee() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCalculateBaseFeeAlgorithms() {
+	var (
+		nw             *network.UnitTestNetwork
+		ctx            sdk.Context
+		initialBaseFee math.LegacyDec
+	)
+
+	testCases := []struct {
+		name       string
+		algorithm  feemarkettypes.BaseFeeAlgorithm
+		maxBaseFee math.LegacyDec
+		gasWanted  uint64
+		gasUsed    uint64
+		expFee     func() math.LegacyDec
+	}{
+		{
+			"gas wanted - uses the parent block gas wanted",
+			feemarkettypes.BaseFeeAlgorithmGasWanted,
+			math.LegacyZeroDec(),
+			100,
+			25,
+			func() math.LegacyDec { return initialBaseFee.Add(math.LegacyNewDec(109375000)) },
+		},
+		{
+			"EIP-1559 - uses the parent block gas used",
+			feemarkettypes.BaseFeeAlgorithmEIP1559,
+			math.LegacyZeroDec(),
+			100,
+			25,
+			func() math.LegacyDec { return initialBaseFee.Sub(math.LegacyNewDec(54687500)) },
+		},
+		{
+			"exponential - parent block used more gas than its target",
+			feemarkettypes.BaseFeeAlgorithmExponential,
+			math.LegacyZeroDec(),
+			25,
+			100,
+			func() math.LegacyDec {
+				return initialBaseFee.Mul(math.LegacyMustNewDecFromStr("1.133148453066826316"))
+			},
+		},
+		{
+			"exponential - parent block used less gas than its target",
+			feemarkettypes.BaseFeeAlgorithmExponential,
+			math.LegacyZeroDec(),
+			100,
+			25,
+			func() math.LegacyDec {
+				return initialBaseFee.Quo(math.LegacyMustNewDecFromStr("1.064494458917859429"))
+			},
+		},
+		{
+			"max base fee - caps the increase",
+			feemarkettypes.BaseFeeAlgorithmGasWanted,
+			math.LegacyNewDec(900000000),
+			100,
+			25,
+			func() math.LegacyDec { return math.LegacyNewDec(900000000) },
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+			params.BaseFeeAlgorithm = tc.algorithm
+			params.MaxBaseFee = tc.maxBaseFee
+			err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+			s.NoError(err)
+
+			initialBaseFee = params.BaseFee
+
+			ctx = ctx.WithBlockHeight(1)
+
+			// Set parent block gas
+			nw.App.GetFeeMarketKeeper().SetBlockGasWanted(ctx, tc.gasWanted)
+			nw.App.GetFeeMarketKeeper().SetBlockGasUsed(ctx, tc.gasUsed)
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			ctx = ctx.WithConsensusParams(consParams)
+
+			fee := nw.App.GetFeeMarketKeeper().CalculateBaseFee(ctx)
+			s.Equal(tc.expFee(), fee, tc.name)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestCalculateBaseFeeEdgeCases() {
 	var (
 		nw  *network.UnitTestNetwork
@@ -3,6 +3,7 @@ package feemarket
 import (
 	"github.com/zenanetwork/zena/testutil/integration/evm/network"
 	"github.com/zenanetwork/zena/x/feemarket/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -75,6 +76,22 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 			},
 			true,
 		},
+		{
+			"pass - base fee algorithm and max base fee",
+			func() {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.BaseFeeAlgorithm = types.BaseFeeAlgorithmExponential
+				params.MaxBaseFee = sdkmath.LegacyNewDec(5_000_000_000)
+				s.Require().NoError(nw.App.GetFeeMarketKeeper().SetParams(ctx, params))
+
+				expRes = &types.QueryBaseFeeResponse{
+					BaseFee:    &initialBaseFee,
+					Algorithm:  types.BaseFeeAlgorithmExponential,
+					MaxBaseFee: &params.MaxBaseFee,
+				}
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -120,7 +137,8 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 			qc := nw.GetFeeMarketClient()
 
 			gas := nw.App.GetFeeMarketKeeper().GetBlockGasWanted(ctx)
-			exp := &types.QueryBlockGasResponse{Gas: int64(gas)} //#nosec G115
+			gasUsed := nw.App.GetFeeMarketKeeper().GetBlockGasUsed(ctx)
+			exp := &types.QueryBlockGasResponse{Gas: int64(gas), GasUsed: int64(gasUsed)} //#nosec G115
 
 			res, err := qc.BlockGas(ctx.Context(), &types.QueryBlockGasRequest{})
 			if tc.expPass {
@@ -132,3 +150,49 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryBurnedBaseFee() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	testCases := []struct {
+		name     string
+		malleate func()
+		expBlock sdkmath.Int
+		expTotal sdkmath.Int
+	}{
+		{
+			"pass - nothing burned",
+			func() {},
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"pass - burned base fee",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetBlockBurnedBaseFee(ctx, sdkmath.NewInt(100))
+				nw.App.GetFeeMarketKeeper().SetBurnedBaseFee(ctx, sdkmath.NewInt(1_000_000_000_001))
+			},
+			sdkmath.NewInt(100),
+			sdkmath.NewInt(1_000_000_000_001),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+			denom := evmtypes.GetEVMCoinExtendedDenom()
+
+			tc.malleate()
+
+			res, err := qc.BurnedBaseFee(ctx.Context(), &types.QueryBurnedBaseFeeRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoin(denom, tc.expBlock), res.Block)
+			s.Require().Equal(sdk.NewCoin(denom, tc.expTotal), res.Total)
+			s.Require().Equal(nw.App.GetFeeMarketKeeper().GetParams(ctx).BaseFeeBurnFraction, res.Fraction)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/zenanetwork/zena/testutil/keyring"
 	"github.com/zenanetwork/zena/x/ibc/callbacks/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	cbtypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -61,6 +62,20 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			cbtypes.ErrInvalidCallbackData,
 		},
+		{
+			"packet data is protobuf encoded (IBC v2 payload)",
+			func() {
+				packet.Data = mustMarshalPacketData(transferData, transfertypes.EncodingProtobuf)
+			},
+			types.ErrContractHasNoCode,
+		},
+		{
+			"packet data is ABI encoded (IBC v2 payload)",
+			func() {
+				packet.Data = mustMarshalPacketData(transferData, transfertypes.EncodingABI)
+			},
+			types.ErrContractHasNoCode,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -148,6 +163,45 @@ func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet data is protobuf encoded (IBC v2 payload) and custom calldata is set",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata"))
+				packet.Data = mustMarshalPacketData(transferData, transfertypes.EncodingProtobuf)
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"packet data is ABI encoded (IBC v2 payload) and custom calldata is set",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata"))
+				packet.Data = mustMarshalPacketData(transferData, transfertypes.EncodingABI)
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"packet sent from ICA controller port",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = mustMarshalICAPacketData(fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.Hex()))
+			},
+			types.ErrCallbackFailed,
+		},
+		{
+			"packet sent from ICA controller port and custom calldata is set",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = mustMarshalICAPacketData(fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata")))
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"packet sent from ICA controller port with transfer packet data",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+			},
+			ibcerrors.ErrInvalidType,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -235,6 +289,45 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet data is protobuf encoded (IBC v2 payload) and custom calldata is set",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata"))
+				packet.Data = mustMarshalPacketData(transferData, transfertypes.EncodingProtobuf)
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"packet data is ABI encoded (IBC v2 payload) and custom calldata is set",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata"))
+				packet.Data = mustMarshalPacketData(transferData, transfertypes.EncodingABI)
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"packet sent from ICA controller port",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = mustMarshalICAPacketData(fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.Hex()))
+			},
+			types.ErrCallbackFailed,
+		},
+		{
+			"packet sent from ICA controller port and custom calldata is set",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = mustMarshalICAPacketData(fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata")))
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"packet sent from ICA controller port with transfer packet data",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+			},
+			ibcerrors.ErrInvalidType,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -276,3 +369,24 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 		}
 	}
 }
+
+// mustMarshalPacketData encodes the given transfer packet data with the given
+// IBC v2 payload encoding.
+func mustMarshalPacketData(data transfertypes.FungibleTokenPacketData, encoding string) []byte {
+	bz, err := transfertypes.MarshalPacketData(data, transfertypes.V1, encoding)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// mustMarshalICAPacketData returns the JSON encoded ICS27 packet data of a
+// transaction with the given memo.
+func mustMarshalICAPacketData(memo string) []byte {
+	data := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("cosmos tx"),
+		Memo: memo,
+	}
+	return data.GetBytes()
+}
@@ -206,7 +206,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoins() {
 
 			// Check fractinoal balance change event
 			if !fracBalAfter.Sub(fracBalBefore).Equal(sdkmath.ZeroInt()) {
-				expEvent := types.NewEventFractionalBalanceChange(recipientAddr, fracBalBefore, fracBalAfter)
+				expEvent := types.NewEventFractionalBalanceChange(recipientAddr, types.ExtendedCoinDenom(), fracBalBefore, fracBalAfter)
 				events := s.network.GetContext().EventManager().Events()
 				s.Require().Contains(events, expEvent)
 			}
@@ -3,11 +3,15 @@ package precisebank
 import (
 	"context"
 
+	"github.com/zenanetwork/zena/x/precisebank/keeper"
 	"github.com/zenanetwork/zena/x/precisebank/types"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -94,3 +98,141 @@ func (s *KeeperIntegrationTestSuite) TestQueryFractionalBalance() {
 		})
 	}
 }
+
+func (s *KeeperIntegrationTestSuite) TestQueryExtendedDenom() {
+	pbk := s.network.App.GetPreciseBankKeeper()
+	ctx := s.network.GetContext()
+
+	usdc := types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_000))
+	s.Require().NoError(pbk.SetParams(ctx, types.NewParams(usdc)))
+
+	paramsRes, err := s.network.GetPreciseBankClient().Params(context.Background(), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewParams(usdc), paramsRes.Params)
+
+	// Mint and send fractional coins of the extended denom
+	addr := sdk.AccAddress([]byte("test"))
+	amt := sdk.NewCoins(sdk.NewCoin("ausdc", usdc.ConversionFactor.MulRaw(2).AddRaw(300)))
+	s.Require().NoError(pbk.MintCoins(ctx, minttypes.ModuleName, amt))
+	s.Require().NoError(pbk.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amt))
+	s.Require().Equal(amt[0], pbk.GetBalance(ctx, addr, "ausdc"))
+	s.Require().Equal(amt[0], pbk.GetSupply(ctx, "ausdc"))
+
+	// The metadata of the extended denom is derived with 18 decimals
+	s.network.App.GetBankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uusdc", Exponent: 0}, {Denom: "usdc", Exponent: 6}},
+		Base:       "uusdc",
+		Display:    "usdc",
+		Name:       "USD Coin",
+		Symbol:     "USDC",
+	})
+	metadata, found := pbk.GetDenomMetaData(ctx, "ausdc")
+	s.Require().True(found)
+	s.Require().Equal("ausdc", metadata.Base)
+	s.Require().Equal(&banktypes.DenomUnit{Denom: "usdc", Exponent: 18}, metadata.DenomUnits[len(metadata.DenomUnits)-1])
+
+	balRes, err := s.network.GetPreciseBankClient().FractionalBalance(
+		context.Background(),
+		&types.QueryFractionalBalanceRequest{Address: addr.String(), Denom: "ausdc"},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin("ausdc", sdkmath.NewInt(300)), balRes.FractionalBalance)
+
+	remainderRes, err := s.network.GetPreciseBankClient().Remainder(
+		context.Background(),
+		&types.QueryRemainderRequest{Denom: "ausdc"},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin("ausdc", usdc.ConversionFactor.SubRaw(300)), remainderRes.Remainder)
+
+	// The EVM coin state is unaffected
+	remainderRes, err = s.network.GetPreciseBankClient().Remainder(
+		context.Background(),
+		&types.QueryRemainderRequest{},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(types.ExtendedCoinDenom(), sdkmath.ZeroInt()), remainderRes.Remainder)
+
+	_, err = s.network.GetPreciseBankClient().Remainder(
+		context.Background(),
+		&types.QueryRemainderRequest{Denom: "uusdc"},
+	)
+	s.Require().ErrorContains(err, "denom uusdc is not an extended denom")
+}
+
+func (s *KeeperIntegrationTestSuite) TestQueryFractionalBalances() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("test1")),
+		sdk.AccAddress([]byte("test2")),
+		sdk.AccAddress([]byte("test3")),
+	}
+
+	for i, addr := range addrs {
+		coin := sdk.NewCoin(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(int64(i+1)))
+		s.MintToAccount(addr, sdk.NewCoins(coin))
+	}
+
+	var balances []types.FractionalBalance
+	pageReq := &query.PageRequest{Limit: 2}
+	for {
+		res, err := s.network.GetPreciseBankClient().FractionalBalances(
+			context.Background(),
+			&types.QueryFractionalBalancesRequest{Pagination: pageReq},
+		)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Balances), 2)
+
+		balances = append(balances, res.Balances...)
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+
+	s.Require().Len(balances, len(addrs))
+	for i, addr := range addrs {
+		s.Require().Contains(balances, types.NewFractionalBalance(addr.String(), sdkmath.NewInt(int64(i+1))))
+	}
+
+	_, err := s.network.GetPreciseBankClient().FractionalBalances(
+		context.Background(),
+		&types.QueryFractionalBalancesRequest{Denom: "uusdc"},
+	)
+	s.Require().ErrorContains(err, "denom uusdc is not an extended denom")
+}
+
+func (s *KeeperIntegrationTestSuite) TestInvariants() {
+	pbk := s.network.App.GetPreciseBankKeeper()
+	ctx := s.network.GetContext()
+
+	// Fund the reserve from fractional sends and mints of an extended denom
+	usdc := types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_000))
+	s.Require().NoError(pbk.SetParams(ctx, types.NewParams(usdc)))
+
+	addr := sdk.AccAddress([]byte("test"))
+	amt := sdk.NewCoins(sdk.NewCoin("ausdc", usdc.ConversionFactor.MulRaw(2).AddRaw(300)))
+	s.Require().NoError(pbk.MintCoins(ctx, minttypes.ModuleName, amt))
+	s.Require().NoError(pbk.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amt))
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.ValidFractionalAmountsInvariant(*pbk),
+		keeper.ValidRemainderAmountInvariant(*pbk),
+		keeper.FractionalDenomNotInBankInvariant(*pbk),
+	} {
+		msg, broken := invariant(ctx)
+		s.Require().False(broken, msg)
+	}
+
+	// The reserve of the extended denom backs its fractional balances, unlike
+	// the EVM coin reserve which is funded by the network setup.
+	bal := s.network.App.GetBankKeeper().GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "uusdc")
+	total := pbk.GetDenomTotalSumFractionalBalances(ctx, usdc).Add(pbk.GetDenomRemainderAmount(ctx, usdc))
+	s.Require().Equal(bal.Amount.Mul(usdc.ConversionFactor), total)
+
+	// Breaking the reserve is reported
+	pbk.SetDenomRemainderAmount(ctx, usdc, sdkmath.NewInt(1))
+	msg, broken := keeper.ReserveBacksFractionsInvariant(*pbk)(ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "ausdc: reserve balance 1uusdc (1000000000000ausdc) mismatches fractional balances 300 + remainder 1 = 301ausdc")
+}
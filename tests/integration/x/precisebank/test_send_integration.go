@@ -456,14 +456,14 @@ func (s *KeeperIntegrationTestSuite) TestSendCoins() {
 
 			if !senderFracBalAfter.Sub(senderFracBalBefore).Equal(sdkmath.ZeroInt()) {
 				expSenderFracBalChangeEvent := types.NewEventFractionalBalanceChange(
-					sender, senderFracBalBefore, senderFracBalAfter,
+					sender, types.ExtendedCoinDenom(), senderFracBalBefore, senderFracBalAfter,
 				)
 				s.Require().Contains(targetEvents, expSenderFracBalChangeEvent)
 			}
 
 			if !recipientFracBalAfter.Sub(recipientFracBalBefore).Equal(sdkmath.ZeroInt()) {
 				expRecipientFracBalChangeEvent := types.NewEventFractionalBalanceChange(
-					recipient, recipientFracBalBefore, recipientFracBalAfter,
+					recipient, types.ExtendedCoinDenom(), recipientFracBalBefore, recipientFracBalAfter,
 				)
 				s.Require().Contains(targetEvents, expRecipientFracBalChangeEvent)
 			}
@@ -977,7 +977,7 @@ var maccPerms = map[string][]string{
 
 	// Cosmos EVM modules
 	evmtypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-	feemarkettypes.ModuleName:   nil,
+	feemarkettypes.ModuleName:   {authtypes.Burner},
 	erc20types.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	precisebanktypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 }
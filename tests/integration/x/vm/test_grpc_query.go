@@ -9,13 +9,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/zenanetwork/zena/rpc/types"
 	"github.com/zenanetwork/zena/server/config"
 	testconstants "github.com/zenanetwork/zena/testutil/constants"
 	"github.com/zenanetwork/zena/testutil/integration/evm/factory"
@@ -32,6 +36,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -380,6 +385,182 @@ func (s *KeeperTestSuite) TestQueryCode() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	keys := []common.Hash{
+		common.BigToHash(big.NewInt(1)),
+		common.BigToHash(big.NewInt(2)),
+		common.BigToHash(big.NewInt(3)),
+	}
+
+	testCases := []struct {
+		msg        string
+		pagination *query.PageRequest
+		expStorage []common.Hash
+		expNextKey []byte
+		expPass    bool
+	}{
+		{
+			"fail - invalid address",
+			nil,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"success - all the storage",
+			nil,
+			keys,
+			nil,
+			true,
+		},
+		{
+			"success - limited storage range",
+			&query.PageRequest{Key: keys[1].Bytes(), Limit: 1},
+			keys[1:2],
+			keys[2].Bytes(),
+			true,
+		},
+		{
+			"fail - offset pagination",
+			&query.PageRequest{Offset: 1},
+			nil,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+
+			stateDB := s.Network.GetStateDB()
+			for _, key := range keys {
+				stateDB.SetState(addr, key, key)
+			}
+			s.Require().NoError(stateDB.Commit())
+
+			req := &types.QueryStorageRangeRequest{
+				Address:    addr.String(),
+				Pagination: tc.pagination,
+			}
+			if !tc.expPass && tc.pagination == nil {
+				req.Address = invalidAddress
+			}
+
+			res, err := s.Network.GetEvmClient().StorageRange(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Len(res.Storage, len(tc.expStorage))
+			for i, key := range tc.expStorage {
+				s.Require().Equal(types.NewState(key, key), res.Storage[i])
+			}
+			s.Require().Equal(tc.expNextKey, res.Pagination.NextKey)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRange() {
+	code := []byte("code")
+	key := common.BigToHash(big.NewInt(1))
+
+	testCases := []struct {
+		msg         string
+		skipCode    bool
+		skipStorage bool
+	}{
+		{
+			"success - full account",
+			false,
+			false,
+		},
+		{
+			"success - account without code and storage",
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+
+			stateDB := s.Network.GetStateDB()
+			stateDB.SetCode(addr, code)
+			stateDB.SetNonce(addr, 3, tracing.NonceChangeUnspecified)
+			stateDB.SetState(addr, key, key)
+			s.Require().NoError(stateDB.Commit())
+
+			res, err := s.Network.GetEvmClient().AccountRange(s.Network.GetContext(), &types.QueryAccountRangeRequest{
+				Pagination:  &query.PageRequest{Key: addr.Bytes(), Limit: 1},
+				SkipCode:    tc.skipCode,
+				SkipStorage: tc.skipStorage,
+			})
+			s.Require().NoError(err)
+			s.Require().Len(res.Accounts, 1)
+
+			account := res.Accounts[0]
+			s.Require().Equal(addr.Hex(), account.Address)
+			s.Require().Equal(uint64(3), account.Nonce)
+			s.Require().Equal(crypto.Keccak256Hash(code).Hex(), account.CodeHash)
+
+			balance := s.Network.App.GetEVMKeeper().GetAccount(s.Network.GetContext(), addr).Balance
+			s.Require().Equal(balance.String(), account.Balance)
+
+			if tc.skipCode {
+				s.Require().Empty(account.Code)
+			} else {
+				s.Require().Equal(code, account.Code)
+			}
+
+			if tc.skipStorage {
+				s.Require().Empty(account.Storage)
+			} else {
+				s.Require().Equal([]types.State{types.NewState(key, key)}, account.Storage)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRangeEOA() {
+	addr := s.Keyring.GetAddr(0)
+
+	res, err := s.Network.GetEvmClient().AccountRange(s.Network.GetContext(), &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Key: addr.Bytes(), Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 1)
+
+	account := res.Accounts[0]
+	s.Require().Equal(addr.Hex(), account.Address)
+	s.Require().Equal(common.BytesToHash(types.EmptyCodeHash).Hex(), account.CodeHash)
+	s.Require().Empty(account.Code)
+	s.Require().Empty(account.Storage)
+
+	balance := s.Network.App.GetEVMKeeper().GetAccount(s.Network.GetContext(), addr).Balance
+	s.Require().Equal(balance.String(), account.Balance)
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRangePagination() {
+	res, err := s.Network.GetEvmClient().AccountRange(s.Network.GetContext(), &types.QueryAccountRangeRequest{
+		Pagination:  &query.PageRequest{Limit: 1_000_000},
+		SkipStorage: true,
+	})
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(len(res.Accounts), 256)
+	for _, account := range res.Accounts {
+		s.Require().True(common.IsHexAddress(account.Address))
+	}
+
+	_, err = s.Network.GetEvmClient().AccountRange(s.Network.GetContext(), &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	s.Require().ErrorContains(err, "only key based pagination is supported")
+}
+
 // TODO: Fix this one
 func (s *KeeperTestSuite) TestQueryTxLogs() {
 	expLogs := []*types.Log{}
@@ -455,6 +636,78 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	s.Require().Equal(expParams, res.Params)
 }
 
+func (s *KeeperTestSuite) TestQueryConfigOpcodeGasOverrides() {
+	addr := s.Keyring.GetAddr(0)
+	// init code: PUSH1 0x01 PUSH1 0x01 ADD STOP
+	data := hexutil.Bytes{byte(vm.PUSH1), 0x01, byte(vm.PUSH1), 0x01, byte(vm.ADD), byte(vm.STOP)}
+	args, err := json.Marshal(&types.TransactionArgs{From: &addr, Data: &data})
+	s.Require().NoError(err)
+
+	estimateGas := func() uint64 {
+		res, err := s.Network.GetEvmClient().EstimateGas(s.Network.GetContext(), &types.EthCallRequest{
+			Args:            args,
+			GasCap:          config.DefaultGasCap,
+			ProposerAddress: s.Network.GetContext().BlockHeader().ProposerAddress,
+		})
+		s.Require().NoError(err)
+		return res.Gas
+	}
+
+	gasBefore := estimateGas()
+
+	overrides := []types.OpcodeGasOverride{
+		{Opcode: "PUSH1", ConstantGas: 30},
+		{Opcode: "ADD", ConstantGas: 30},
+	}
+	params := s.Network.App.GetEVMKeeper().GetParams(s.Network.GetContext())
+	// the constant gas is bounded by the default of the active fork
+	params.OpcodeGasOverrides = []types.OpcodeGasOverride{{Opcode: "ADD", ConstantGas: 31}}
+	s.Require().ErrorContains(s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params), "out of the range")
+
+	params.OpcodeGasOverrides = overrides
+	s.Require().NoError(s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params))
+
+	res, err := s.Network.GetEvmClient().Config(s.Network.GetContext(), &types.QueryConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(overrides, res.OpcodeGasOverrides)
+
+	// the two PUSH1 and the ADD opcodes cost 27 gas more each
+	s.Require().Equal(gasBefore+3*27, estimateGas())
+}
+
+func (s *KeeperTestSuite) TestQueryEIP712Types() {
+	testCases := []struct {
+		msg        string
+		typeURL    string
+		expMsgType string
+		expPass    bool
+	}{
+		{"empty type URL", "", "", false},
+		{"unknown type URL", "/cosmos.bank.v1beta1.MsgUnknown", "", false},
+		{"success", "/cosmos.bank.v1beta1.MsgSend", "CosmosBankV1beta1MsgSend", true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().EIP712Types(ctx, &types.QueryEIP712TypesRequest{TypeUrl: tc.typeURL})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal("Tx", res.PrimaryType)
+			s.Require().Equal(tc.expMsgType, res.MessageType)
+
+			var eip712Types apitypes.Types
+			s.Require().NoError(json.Unmarshal([]byte(res.Types), &eip712Types))
+			s.Require().Contains(eip712Types, res.PrimaryType)
+			s.Require().Contains(eip712Types, res.MessageType)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryValidatorAccount() {
 	testCases := []struct {
 		msg           string
@@ -1583,6 +1836,47 @@ func (s *KeeperTestSuite) TestTraceCall() {
 			expPass:       true,
 			traceResponse: "\"balance\":",
 		},
+		{
+			msg: "cosmosCallTracer with contract call",
+			getCallArgs: func() []byte {
+				callArgs := testutiltypes.CallArgs{
+					ContractABI: erc20Contract.ABI,
+					MethodName:  "balanceOf",
+					Args:        []interface{}{senderKey.Addr},
+				}
+				input, err := factory.GenerateContractCallArgs(callArgs)
+				s.Require().NoError(err)
+				return input
+			},
+			getTraceConfig: func() *types.TraceConfig {
+				return &types.TraceConfig{
+					Tracer: "cosmosCallTracer",
+				}
+			},
+			expPass:       true,
+			traceResponse: "\"type\":\"CALL\"",
+		},
+		{
+			msg: "prestateTracer with cosmos frames",
+			getCallArgs: func() []byte {
+				callArgs := testutiltypes.CallArgs{
+					ContractABI: erc20Contract.ABI,
+					MethodName:  "balanceOf",
+					Args:        []interface{}{senderKey.Addr},
+				}
+				input, err := factory.GenerateContractCallArgs(callArgs)
+				s.Require().NoError(err)
+				return input
+			},
+			getTraceConfig: func() *types.TraceConfig {
+				return &types.TraceConfig{
+					Tracer:           "prestateTracer",
+					TracerJsonConfig: `{"withCosmos":true}`,
+				}
+			},
+			expPass:       true,
+			traceResponse: "\"cosmos\":[]",
+		},
 		{
 			msg: "trace with filtered options",
 			getCallArgs: func() []byte {
@@ -2032,6 +2326,205 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	funded := tx.GenerateAddress()
+	value := (*hexutil.Big)(big.NewInt(1000))
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+
+	testCases := []struct {
+		name      string
+		getOpts   func() rpctypes.SimOpts
+		expPass   bool
+		expErr    string
+		expBlocks int
+		postCheck func(blocks []simulatedBlock)
+	}{
+		{
+			"fail - empty input",
+			func() rpctypes.SimOpts {
+				return rpctypes.SimOpts{}
+			},
+			false,
+			"empty input",
+			0,
+			nil,
+		},
+		{
+			"fail - block numbers out of order",
+			func() rpctypes.SimOpts {
+				number := (*hexutil.Big)(big.NewInt(s.Network.GetContext().BlockHeight() + 2))
+				return rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: number}},
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: number}},
+				}}
+			},
+			false,
+			"block numbers must be in order",
+			0,
+			nil,
+		},
+		{
+			"pass - value transfer with traced transfers",
+			func() rpctypes.SimOpts {
+				return rpctypes.SimOpts{
+					TraceTransfers: true,
+					BlockStateCalls: []rpctypes.SimBlock{
+						{
+							StateOverrides: &rpctypes.StateOverride{sender: rpctypes.OverrideAccount{Balance: &balance}},
+							Calls:          []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}},
+						},
+					},
+				}
+			},
+			true,
+			"",
+			1,
+			func(blocks []simulatedBlock) {
+				calls := blocks[0].Calls
+				s.Require().Len(calls, 1)
+				s.Require().Nil(calls[0].Error)
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), calls[0].Status)
+				s.Require().Equal(hexutil.Uint64(ethparams.TxGas), calls[0].GasUsed)
+				s.Require().Len(calls[0].Logs, 1)
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), calls[0].Logs[0].Topics[2])
+			},
+		},
+		{
+			"pass - state overrides and changes carried across blocks",
+			func() rpctypes.SimOpts {
+				return rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+					{
+						StateOverrides: &rpctypes.StateOverride{funded: rpctypes.OverrideAccount{Balance: &balance}},
+						Calls:          []types.TransactionArgs{{From: &funded, To: &recipient, Value: value}},
+					},
+					{
+						Calls: []types.TransactionArgs{{From: &recipient, To: &funded, Value: value}},
+					},
+				}}
+			},
+			true,
+			"",
+			2,
+			func(blocks []simulatedBlock) {
+				s.Require().Equal(blocks[0].Number.ToInt().Int64()+1, blocks[1].Number.ToInt().Int64())
+				for _, block := range blocks {
+					s.Require().Len(block.Calls, 1)
+					s.Require().Nil(block.Calls[0].Error)
+					s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), block.Calls[0].Status)
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			opts, err := json.Marshal(tc.getOpts())
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+				Opts:    opts,
+				GasCap:  config.DefaultGasCap,
+				ChainId: s.Network.GetEIP155ChainID().Int64(),
+			})
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			var blocks []simulatedBlock
+			s.Require().NoError(json.Unmarshal(res.Result, &blocks))
+			s.Require().Len(blocks, tc.expBlocks)
+			tc.postCheck(blocks)
+		})
+	}
+
+	s.Run("fail - chain id mismatch", func() {
+		s.SetupTest()
+
+		opts, err := json.Marshal(rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+			{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+		}})
+		s.Require().NoError(err)
+
+		_, err = s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+			Opts:    opts,
+			GasCap:  config.DefaultGasCap,
+			ChainId: s.Network.GetEIP155ChainID().Int64() + 1,
+		})
+		s.Require().ErrorContains(err, "invalid chain id")
+	})
+
+	s.Run("fail - too many calls", func() {
+		s.SetupTest()
+
+		calls := make([]types.TransactionArgs, 1001)
+		for i := range calls {
+			calls[i] = types.TransactionArgs{From: &sender, To: &recipient}
+		}
+		opts, err := json.Marshal(rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{{Calls: calls}}})
+		s.Require().NoError(err)
+
+		_, err = s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+			Opts:    opts,
+			GasCap:  config.DefaultGasCap,
+			ChainId: s.Network.GetEIP155ChainID().Int64(),
+		})
+		s.Require().ErrorContains(err, "too many calls")
+	})
+
+	s.Run("gas cap shared by the calls of all the blocks", func() {
+		s.SetupTest()
+
+		gasCap := 2 * ethparams.TxGas
+		gasLimit := hexutil.Uint64(10 * gasCap)
+		call := types.TransactionArgs{From: &sender, To: &recipient, Value: value}
+		simulate := func(blocks ...rpctypes.SimBlock) ([]simulatedBlock, error) {
+			opts, err := json.Marshal(rpctypes.SimOpts{BlockStateCalls: blocks})
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+				Opts:    opts,
+				GasCap:  gasCap,
+				ChainId: s.Network.GetEIP155ChainID().Int64(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			var simulated []simulatedBlock
+			s.Require().NoError(json.Unmarshal(res.Result, &simulated))
+			return simulated, nil
+		}
+
+		blocks, err := simulate(
+			rpctypes.SimBlock{
+				BlockOverrides: &rpctypes.BlockOverrides{GasLimit: &gasLimit},
+				Calls:          []types.TransactionArgs{call},
+			},
+			rpctypes.SimBlock{Calls: []types.TransactionArgs{call}},
+		)
+		s.Require().NoError(err)
+		s.Require().Len(blocks, 2)
+		s.Require().Equal(hexutil.Uint64(gasCap), blocks[0].GasLimit)
+
+		_, err = simulate(
+			rpctypes.SimBlock{Calls: []types.TransactionArgs{call, call}},
+			rpctypes.SimBlock{Calls: []types.TransactionArgs{call}},
+		)
+		s.Require().ErrorContains(err, "gas budget exhausted")
+	})
+}
+
+// simulatedBlock is the subset of an eth_simulateV1 block checked by the tests.
+type simulatedBlock struct {
+	Number   hexutil.Big              `json:"number"`
+	GasLimit hexutil.Uint64           `json:"gasLimit"`
+	Calls    []rpctypes.SimCallResult `json:"calls"`
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -1,3 +1,5 @@
+//go:build test
+
 package vm
 
 import (
@@ -650,7 +652,7 @@ func (s *KeeperTestSuite) TestApplyTransactionWithTxPostProcessing() {
 		{
 			"pass - evm tx succeeds, post processing is called, the balance is changed",
 			func(s *KeeperTestSuite) {
-				s.Network.App.GetEVMKeeper().SetHooks(
+				s.Network.App.GetEVMKeeper().CleanHooks().SetHooks(
 					keeper.NewMultiEvmHooks(
 						&testHooks{
 							postProcessing: func(ctx sdk.Context, sender common.Address, msg core.Message, receipt *gethtypes.Receipt) error {
@@ -690,7 +692,7 @@ func (s *KeeperTestSuite) TestApplyTransactionWithTxPostProcessing() {
 		{
 			"pass - evm tx succeeds, post processing is called but fails, the balance is unchanged",
 			func(s *KeeperTestSuite) {
-				s.Network.App.GetEVMKeeper().SetHooks(
+				s.Network.App.GetEVMKeeper().CleanHooks().SetHooks(
 					keeper.NewMultiEvmHooks(
 						&testHooks{
 							postProcessing: func(ctx sdk.Context, sender common.Address, msg core.Message, receipt *gethtypes.Receipt) error {
@@ -730,7 +732,7 @@ func (s *KeeperTestSuite) TestApplyTransactionWithTxPostProcessing() {
 		{
 			"evm tx fails, post processing is called and persisted, the balance is not changed",
 			func(s *KeeperTestSuite) {
-				s.Network.App.GetEVMKeeper().SetHooks(
+				s.Network.App.GetEVMKeeper().CleanHooks().SetHooks(
 					keeper.NewMultiEvmHooks(
 						&testHooks{
 							postProcessing: func(ctx sdk.Context, sender common.Address, msg core.Message, receipt *gethtypes.Receipt) error {
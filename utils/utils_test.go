@@ -766,6 +766,58 @@ func TestCalcBaseFee(t *testing.T) {
 						require.True(t, result.Cmp(expectedMinGasPrice) >= 0, "Result should be at least min gas price")
 					},
 				},
+				{
+					name:   "exponential algorithm - full block increases by e^(1/8)",
+					config: config,
+					parent: &ethtypes.Header{
+						Number:   big.NewInt(10),
+						BaseFee:  big.NewInt(1000000000),
+						GasLimit: 30000000,
+						GasUsed:  30000000,
+					},
+					params: feemarkettypes.Params{
+						ElasticityMultiplier:     2,
+						BaseFeeChangeDenominator: 8,
+						MinGasPrice:              sdkmath.LegacyZeroDec(),
+						BaseFeeAlgorithm:         feemarkettypes.BaseFeeAlgorithmExponential,
+					},
+					expectedResult: big.NewInt(1133148453),
+				},
+				{
+					name:   "exponential algorithm - empty block decreases by e^(1/8)",
+					config: config,
+					parent: &ethtypes.Header{
+						Number:   big.NewInt(10),
+						BaseFee:  big.NewInt(1000000000),
+						GasLimit: 30000000,
+						GasUsed:  0,
+					},
+					params: feemarkettypes.Params{
+						ElasticityMultiplier:     2,
+						BaseFeeChangeDenominator: 8,
+						MinGasPrice:              sdkmath.LegacyZeroDec(),
+						BaseFeeAlgorithm:         feemarkettypes.BaseFeeAlgorithmExponential,
+					},
+					expectedResult: big.NewInt(882496902),
+				},
+				{
+					name:   "max base fee caps the increase",
+					config: config,
+					parent: &ethtypes.Header{
+						Number:   big.NewInt(10),
+						BaseFee:  big.NewInt(1000000000),
+						GasLimit: 30000000,
+						GasUsed:  30000000,
+					},
+					params: feemarkettypes.Params{
+						ElasticityMultiplier:     2,
+						BaseFeeChangeDenominator: 8,
+						MinGasPrice:              sdkmath.LegacyZeroDec(),
+						BaseFeeAlgorithm:         feemarkettypes.BaseFeeAlgorithmEIP1559,
+						MaxBaseFee:               sdkmath.LegacyNewDec(1050000000).QuoInt(evmtypes.GetEVMCoinDecimals().ConversionFactor()),
+					},
+					expectedResult: big.NewInt(1050000000),
+				},
 			}
 
 			for _, tc := range testCases {
@@ -113,7 +113,13 @@ func (e CosmosEVMSECP256K1) SignSECP256K1(hdPath []uint32, signDocBytes []byte,
 
 	typedData, err := eip712.GetEIP712TypedDataForMsg(signDocBytes)
 	if err != nil {
-		return nil, err
+		// Fall back to the protobuf-typed encoding for payloads the flattened
+		// encoding cannot represent, e.g. sign docs with a timeout height.
+		var protoErr error
+		typedData, protoErr = eip712.GetProtoEIP712TypedDataForMsg(signDocBytes)
+		if protoErr != nil {
+			return nil, errors.Join(err, protoErr)
+		}
 	}
 
 	// Display EIP-712 message hash for user to verify
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/zenanetwork/zena/x/clawback/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the clawback module. It has no state of its own, as the clawback
+// vesting accounts are stored by the x/auth module.
+type Keeper struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates new instances of the clawback Keeper
+func NewKeeper(ak types.AccountKeeper, bk types.BankKeeper) Keeper {
+	return Keeper{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
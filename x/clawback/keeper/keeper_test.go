@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zenanetwork/zena/x/clawback/keeper"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	testDenom     = "azena"
+	disabledDenom = "disabled"
+	startTime     = int64(1_000)
+)
+
+var (
+	funder  = sdk.AccAddress([]byte("funder______________"))
+	other   = sdk.AccAddress([]byte("other_______________"))
+	vesting = sdk.AccAddress([]byte("vesting_____________"))
+	blocked = sdk.AccAddress([]byte("blocked_____________"))
+)
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+}
+
+// periods returns three vesting periods of 100 seconds with a total of 60 coins.
+func periods() vestingtypes.Periods {
+	return vestingtypes.Periods{
+		{Length: 100, Amount: coins(10)},
+		{Length: 100, Amount: coins(20)},
+		{Length: 100, Amount: coins(30)},
+	}
+}
+
+// fakeAccountKeeper implements the account keeper expected by the clawback
+// keeper.
+type fakeAccountKeeper struct {
+	accounts      map[string]sdk.AccountI
+	accountNumber uint64
+}
+
+func (f *fakeAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+func (f *fakeAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return f.accounts[addr.String()]
+}
+
+func (f *fakeAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	f.accounts[acc.GetAddress().String()] = acc
+}
+
+func (f *fakeAccountKeeper) NewAccount(_ context.Context, acc sdk.AccountI) sdk.AccountI {
+	if err := acc.SetAccountNumber(f.accountNumber); err != nil {
+		panic(err)
+	}
+	f.accountNumber++
+	return acc
+}
+
+// fakeBank implements the bank keepers expected by the clawback keeper and by
+// the x/staking module. The x/staking methods that aren't used panic.
+type fakeBank struct {
+	stakingtypes.BankKeeper
+
+	balances  map[string]sdk.Coins
+	delegated sdk.Coins
+}
+
+func (f *fakeBank) IsSendEnabledCoins(_ context.Context, coins ...sdk.Coin) error {
+	for _, coin := range coins {
+		if coin.Denom == disabledDenom {
+			return banktypes.ErrSendDisabled
+		}
+	}
+	return nil
+}
+
+func (f *fakeBank) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := f.balances[fromAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "%s is smaller than %s", f.balances[fromAddr.String()], amt)
+	}
+	f.balances[fromAddr.String()] = balance
+	f.balances[toAddr.String()] = f.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (f *fakeBank) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(blocked)
+}
+
+func (f *fakeBank) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, f.balances[addr.String()].AmountOf(denom))
+}
+
+func (f *fakeBank) DelegateCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, _ string, amt sdk.Coins) error {
+	f.balances[senderAddr.String()] = f.balances[senderAddr.String()].Sub(amt...)
+	f.delegated = f.delegated.Add(amt...)
+	return nil
+}
+
+// testData defines the keeper and its fake dependencies, without full app
+// setup.
+type testData struct {
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	accountKeeper *fakeAccountKeeper
+	bank          *fakeBank
+}
+
+func newTestData(t *testing.T) testData {
+	t.Helper()
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("clawback"), storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockTime(time.Unix(startTime, 0))
+
+	ak := &fakeAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+	bank := &fakeBank{
+		balances: map[string]sdk.Coins{
+			funder.String(): coins(1_000),
+			other.String():  coins(1_000),
+		},
+	}
+
+	return testData{
+		ctx:           ctx,
+		keeper:        keeper.NewKeeper(ak, bank),
+		accountKeeper: ak,
+		bank:          bank,
+	}
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/zenanetwork/zena/x/clawback/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateClawbackVestingAccount creates a new clawback vesting account at the
+// given address and funds it with the total amount of the vesting periods.
+func (k Keeper) CreateClawbackVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgCreateClawbackVestingAccount,
+) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	// NOTE: the msg is validated here as well, since the vesting precompile
+	// calls the msg server directly.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	if k.bankKeeper.BlockedAddr(vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", msg.VestingAddress)
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, vestingAddr); acc != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists", msg.VestingAddress)
+	}
+
+	totalCoins := msg.VestingPeriods.TotalAmount()
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	baseAccount = k.accountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount, err := types.NewClawbackVestingAccount(baseAccount, msg.FunderAddress, msg.StartTime, msg.VestingPeriods)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := k.bankKeeper.SendCoins(ctx, funder, vestingAddr, totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateClawbackVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyVestingAccount, msg.VestingAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(vestingAccount.StartTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(vestingAccount.EndTime, 10)),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// FundVestingAccount appends the given vesting periods to the schedule of a
+// clawback vesting account and transfers their total amount from the funder.
+// Only the funder of the account can add funds to it.
+func (k Keeper) FundVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgFundVestingAccount,
+) (*types.MsgFundVestingAccountResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	vestingAccount, err := k.getFundedVestingAccount(ctx, funder, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCoins := msg.VestingPeriods.TotalAmount()
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	vestingAccount.AddVestingPeriods(ctx.BlockTime().Unix(), msg.VestingPeriods)
+	if err := vestingAccount.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := k.bankKeeper.SendCoins(ctx, funder, vestingAddr, totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyVestingAccount, msg.VestingAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(vestingAccount.EndTime, 10)),
+		),
+	)
+
+	return &types.MsgFundVestingAccountResponse{}, nil
+}
+
+// Clawback removes the vesting periods of a clawback vesting account that
+// haven't ended yet and transfers their unvested coins to the destination, or
+// to the funder if no destination is given. Only the funder of the account can
+// claw back its coins.
+func (k Keeper) Clawback(
+	goCtx context.Context,
+	msg *types.MsgClawback,
+) (*types.MsgClawbackResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest = sdk.MustAccAddressFromBech32(msg.DestAddress)
+	}
+
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	vestingAccount, err := k.getFundedVestingAccount(ctx, funder, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the account is updated before the transfer, so that the unvested
+	// coins are no longer locked when they are sent.
+	clawedBack := vestingAccount.ComputeClawback(ctx.BlockTime().Unix())
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	if !clawedBack.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, vestingAddr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyVestingAccount, msg.VestingAddress),
+			sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{Coins: clawedBack}, nil
+}
+
+// getFundedVestingAccount returns the clawback vesting account stored at the
+// given address, checking that it is funded by the given funder.
+func (k Keeper) getFundedVestingAccount(ctx sdk.Context, funder, vestingAddr sdk.AccAddress) (*types.ClawbackVestingAccount, error) {
+	vestingAccount, ok := k.accountKeeper.GetAccount(ctx, vestingAddr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotClawbackVestingAccount, "address %s", vestingAddr)
+	}
+
+	if !sdk.MustAccAddressFromBech32(vestingAccount.FunderAddress).Equals(funder) {
+		return nil, errorsmod.Wrapf(types.ErrNotFunder, "expected %s, got %s", vestingAccount.FunderAddress, funder)
+	}
+
+	return vestingAccount, nil
+}
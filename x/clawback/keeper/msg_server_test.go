@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/clawback/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func newMsgCreate(funder, vesting sdk.AccAddress, periods vestingtypes.Periods) *types.MsgCreateClawbackVestingAccount {
+	return &types.MsgCreateClawbackVestingAccount{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// createVestingAccount creates the clawback vesting account funded by the
+// funder with the default periods.
+func (td testData) createVestingAccount(t *testing.T) {
+	t.Helper()
+
+	_, err := td.keeper.CreateClawbackVestingAccount(td.ctx, newMsgCreate(funder, vesting, periods()))
+	require.NoError(t, err)
+}
+
+func (td testData) getVestingAccount(t *testing.T) *types.ClawbackVestingAccount {
+	t.Helper()
+
+	va, ok := td.accountKeeper.GetAccount(td.ctx, vesting).(*types.ClawbackVestingAccount)
+	require.True(t, ok)
+	return va
+}
+
+func TestCreateClawbackVestingAccount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(td testData)
+		msg      *types.MsgCreateClawbackVestingAccount
+		expErr   error
+	}{
+		{
+			"fail - invalid periods",
+			func(testData) {},
+			newMsgCreate(funder, vesting, nil),
+			types.ErrInvalidVestingPeriods,
+		},
+		{
+			"fail - blocked vesting address",
+			func(testData) {},
+			newMsgCreate(funder, blocked, periods()),
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"fail - account already exists",
+			func(td testData) {
+				td.accountKeeper.SetAccount(td.ctx, authtypes.NewBaseAccountWithAddress(vesting))
+			},
+			newMsgCreate(funder, vesting, periods()),
+			errortypes.ErrInvalidRequest,
+		},
+		{
+			"fail - send disabled",
+			func(testData) {},
+			newMsgCreate(funder, vesting, vestingtypes.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(disabledDenom, 10))},
+			}),
+			banktypes.ErrSendDisabled,
+		},
+		{
+			"fail - insufficient funder balance",
+			func(testData) {},
+			newMsgCreate(funder, vesting, vestingtypes.Periods{{Length: 100, Amount: coins(1_001)}}),
+			errortypes.ErrInsufficientFunds,
+		},
+		{
+			"pass",
+			func(testData) {},
+			newMsgCreate(funder, vesting, periods()),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			tc.malleate(td)
+
+			_, err := td.keeper.CreateClawbackVestingAccount(td.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			va := td.getVestingAccount(t)
+			require.Equal(t, funder.String(), va.FunderAddress)
+			require.Equal(t, startTime, va.StartTime)
+			require.Equal(t, startTime+300, va.EndTime)
+			require.Equal(t, coins(60), va.OriginalVesting)
+			require.Equal(t, coins(60), td.bank.balances[vesting.String()])
+			require.Equal(t, coins(940), td.bank.balances[funder.String()])
+		})
+	}
+}
+
+func TestFundVestingAccount(t *testing.T) {
+	td := newTestData(t)
+
+	msg := &types.MsgFundVestingAccount{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		VestingPeriods: vestingtypes.Periods{{Length: 100, Amount: coins(40)}},
+	}
+	_, err := td.keeper.FundVestingAccount(td.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNotClawbackVestingAccount)
+
+	td.createVestingAccount(t)
+
+	msg.FunderAddress = other.String()
+	_, err = td.keeper.FundVestingAccount(td.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNotFunder)
+
+	msg.FunderAddress = funder.String()
+	_, err = td.keeper.FundVestingAccount(td.ctx, msg)
+	require.NoError(t, err)
+
+	va := td.getVestingAccount(t)
+	require.Len(t, va.VestingPeriods, 4)
+	require.Equal(t, startTime+400, va.EndTime)
+	require.Equal(t, coins(100), va.OriginalVesting)
+	require.Equal(t, coins(100), td.bank.balances[vesting.String()])
+}
+
+func TestClawback(t *testing.T) {
+	testCases := []struct {
+		name          string
+		malleate      func(td testData)
+		msg           *types.MsgClawback
+		blockTime     int64
+		expErr        error
+		expClawedBack sdk.Coins
+	}{
+		{
+			"fail - not a clawback vesting account",
+			func(testData) {},
+			&types.MsgClawback{FunderAddress: funder.String(), VestingAddress: other.String()},
+			startTime,
+			types.ErrNotClawbackVestingAccount,
+			nil,
+		},
+		{
+			"fail - not the funder",
+			func(td testData) { td.createVestingAccount(t) },
+			&types.MsgClawback{FunderAddress: other.String(), VestingAddress: vesting.String()},
+			startTime,
+			types.ErrNotFunder,
+			nil,
+		},
+		{
+			"fail - blocked destination",
+			func(td testData) { td.createVestingAccount(t) },
+			&types.MsgClawback{FunderAddress: funder.String(), VestingAddress: vesting.String(), DestAddress: blocked.String()},
+			startTime,
+			errortypes.ErrUnauthorized,
+			nil,
+		},
+		{
+			"pass - unvested coins sent to the funder",
+			func(td testData) { td.createVestingAccount(t) },
+			&types.MsgClawback{FunderAddress: funder.String(), VestingAddress: vesting.String()},
+			startTime + 100,
+			nil,
+			coins(50),
+		},
+		{
+			"pass - unvested coins sent to the destination",
+			func(td testData) { td.createVestingAccount(t) },
+			&types.MsgClawback{FunderAddress: funder.String(), VestingAddress: vesting.String(), DestAddress: other.String()},
+			startTime + 100,
+			nil,
+			coins(50),
+		},
+		{
+			"pass - nothing to claw back after the schedule ended",
+			func(td testData) { td.createVestingAccount(t) },
+			&types.MsgClawback{FunderAddress: funder.String(), VestingAddress: vesting.String()},
+			startTime + 300,
+			nil,
+			sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			tc.malleate(td)
+
+			dest := funder
+			if tc.msg.DestAddress != "" {
+				dest = sdk.MustAccAddressFromBech32(tc.msg.DestAddress)
+			}
+			destBalance := td.bank.balances[dest.String()]
+
+			td.ctx = td.ctx.WithBlockTime(time.Unix(tc.blockTime, 0))
+			res, err := td.keeper.Clawback(td.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, tc.expClawedBack.Equal(res.Coins))
+			require.True(t, destBalance.Add(tc.expClawedBack...).Equal(td.bank.balances[dest.String()]))
+
+			// the vested coins are left in the account
+			va := td.getVestingAccount(t)
+			vested := coins(60).Sub(tc.expClawedBack...)
+			require.True(t, vested.Equal(td.bank.balances[vesting.String()]))
+			require.True(t, vested.Equal(va.OriginalVesting))
+			require.True(t, va.GetVestingCoins(td.ctx.BlockTime()).IsZero())
+		})
+	}
+}
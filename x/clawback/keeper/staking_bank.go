@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/zenanetwork/zena/x/clawback/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.BankKeeper = StakingBankKeeper{}
+
+// StakingBankKeeper wraps the bank keeper of the x/staking module to reject the
+// delegations of unvested coins of clawback vesting accounts with an error. The
+// account itself panics on such delegations, as the x/bank keeper doesn't
+// expect an error when tracking them.
+type StakingBankKeeper struct {
+	stakingtypes.BankKeeper
+	accountKeeper types.AccountKeeper
+}
+
+// NewStakingBankKeeper returns the bank keeper to be used by the x/staking
+// module.
+func NewStakingBankKeeper(bk stakingtypes.BankKeeper, ak types.AccountKeeper) StakingBankKeeper {
+	return StakingBankKeeper{
+		BankKeeper:    bk,
+		accountKeeper: ak,
+	}
+}
+
+// DelegateCoinsFromAccountToModule checks that the delegation of a clawback
+// vesting account is covered by its vested coins before delegating them.
+func (k StakingBankKeeper) DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if va, ok := k.accountKeeper.GetAccount(ctx, senderAddr).(*types.ClawbackVestingAccount); ok {
+		balance := sdk.NewCoins()
+		for _, coin := range amt {
+			balance = balance.Add(k.GetBalance(ctx, senderAddr, coin.Denom))
+		}
+		if err := va.ValidateDelegation(sdk.UnwrapSDKContext(ctx).BlockTime(), balance, amt); err != nil {
+			return err
+		}
+	}
+
+	return k.BankKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/clawback/keeper"
+	"github.com/zenanetwork/zena/x/clawback/types"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStakingBankKeeperDelegation(t *testing.T) {
+	td := newTestData(t)
+	td.createVestingAccount(t)
+	bk := keeper.NewStakingBankKeeper(td.bank, td.accountKeeper)
+
+	// only the 10 coins of the first period are vested
+	ctx := td.ctx.WithBlockTime(time.Unix(startTime+100, 0))
+	err := bk.DelegateCoinsFromAccountToModule(ctx, vesting, stakingtypes.NotBondedPoolName, coins(11))
+	require.ErrorIs(t, err, types.ErrDelegateUnvestedCoins)
+	require.True(t, td.bank.delegated.IsZero())
+
+	require.NoError(t, bk.DelegateCoinsFromAccountToModule(ctx, vesting, stakingtypes.NotBondedPoolName, coins(10)))
+	require.Equal(t, coins(10), td.bank.delegated)
+
+	// the delegations of other accounts are not checked
+	require.NoError(t, bk.DelegateCoinsFromAccountToModule(ctx, funder, stakingtypes.NotBondedPoolName, coins(100)))
+	require.Equal(t, coins(110), td.bank.delegated)
+}
@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/clawback/v1/clawback.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// coins at the end of each period like a periodic vesting account, and lets
+// its funder add vesting periods and reclaim the coins that have not vested
+// yet.
+type ClawbackVestingAccount struct {
+	// base_vesting_account implements the VestingAccount interface. It contains
+	// all the necessary fields needed for any vesting account implementation
+	*types.BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address specifies the account that funds the vesting account and
+	// can reclaim its unvested coins
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start_time defines the time at which the vesting periods begin
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()         { *m = ClawbackVestingAccount{} }
+func (m *ClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ClawbackVestingAccount) ProtoMessage()    {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a4a199039c2bfe8, []int{0}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.evm.clawback.v1.ClawbackVestingAccount")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/clawback/v1/clawback.proto", fileDescriptor_1a4a199039c2bfe8)
+}
+
+var fileDescriptor_1a4a199039c2bfe8 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xce, 0x98, 0x22, 0x34, 0xc5, 0x8a, 0xa1, 0x94, 0x58, 0x30, 0x09, 0xa2, 0x10, 0x4a, 0x9b,
+	0x21, 0x15, 0x0f, 0x7a, 0x91, 0x46, 0xe8, 0x59, 0xa2, 0x78, 0xf0, 0x12, 0x26, 0xc9, 0x98, 0x86,
+	0x9a, 0x4c, 0xc9, 0x4c, 0xd2, 0xea, 0x2f, 0x10, 0x2f, 0xfa, 0x13, 0xbc, 0x08, 0xe2, 0xa9, 0x07,
+	0x7f, 0x44, 0x8f, 0x65, 0x4f, 0x7b, 0xea, 0x2e, 0xed, 0xa1, 0x7f, 0x63, 0xd9, 0xcc, 0xb4, 0xdb,
+	0x65, 0x77, 0x2f, 0xc9, 0x7b, 0xdf, 0xf7, 0xf1, 0xbd, 0x8f, 0x79, 0x4f, 0x79, 0x1e, 0x12, 0x9a,
+	0x12, 0x0a, 0x71, 0x99, 0xc2, 0xf0, 0x0b, 0x9a, 0x05, 0x28, 0x9c, 0xc0, 0xd2, 0x39, 0xd4, 0xf6,
+	0x34, 0x27, 0x8c, 0xa8, 0x6d, 0x2e, 0xb3, 0x71, 0x99, 0xda, 0x07, 0xaa, 0x74, 0x3a, 0x8f, 0x50,
+	0x9a, 0x64, 0x04, 0x56, 0x5f, 0x2e, 0xed, 0x3c, 0x13, 0x8e, 0x25, 0xa6, 0x2c, 0xc9, 0x62, 0x58,
+	0x3a, 0x01, 0x66, 0xc8, 0xd9, 0xf7, 0x42, 0xf5, 0x98, 0xab, 0xfc, 0xaa, 0x83, 0xc2, 0x9d, 0x53,
+	0xad, 0x98, 0xc4, 0x84, 0xe3, 0x97, 0x15, 0x47, 0x9f, 0xfe, 0x91, 0x95, 0xf6, 0x5b, 0x31, 0xf9,
+	0x23, 0xb7, 0x1a, 0x86, 0x21, 0x29, 0x32, 0xa6, 0x06, 0x4a, 0x2b, 0x40, 0x14, 0xfb, 0x62, 0x82,
+	0x8f, 0x38, 0xae, 0x01, 0x13, 0x58, 0x8d, 0x41, 0xd7, 0x16, 0xee, 0xfb, 0x00, 0x22, 0x90, 0xed,
+	0x22, 0x8a, 0xaf, 0x3b, 0xb9, 0xb5, 0xd5, 0xda, 0x00, 0x9e, 0x1a, 0xdc, 0x60, 0xd4, 0x37, 0x4a,
+	0xf3, 0x73, 0x91, 0x45, 0x38, 0xf7, 0x51, 0x14, 0xe5, 0x98, 0x52, 0xed, 0x9e, 0x09, 0xac, 0xba,
+	0xab, 0x9d, 0xfc, 0xef, 0xb7, 0xc4, 0x80, 0x21, 0x67, 0xde, 0xb3, 0x3c, 0xc9, 0x62, 0xef, 0x01,
+	0xd7, 0x0b, 0x50, 0x7d, 0xa2, 0x28, 0x94, 0xa1, 0x9c, 0xf9, 0x2c, 0x49, 0xb1, 0x26, 0x9b, 0xc0,
+	0x92, 0xbd, 0x7a, 0x85, 0x7c, 0x48, 0x52, 0xac, 0xfe, 0x04, 0xca, 0xc3, 0x7d, 0xfe, 0x29, 0xce,
+	0x13, 0x12, 0x51, 0xad, 0x66, 0xca, 0x56, 0x63, 0xa0, 0xdf, 0x95, 0xff, 0x5d, 0x25, 0x73, 0x47,
+	0xcb, 0xb5, 0x21, 0xfd, 0x3b, 0x33, 0x5e, 0xc5, 0x09, 0x1b, 0x17, 0x81, 0x1d, 0x92, 0x54, 0xbc,
+	0xa7, 0xf8, 0xf5, 0x69, 0x34, 0x81, 0x73, 0x88, 0x0a, 0x36, 0x3e, 0x2c, 0x85, 0x7d, 0x9d, 0x62,
+	0x2a, 0x1c, 0xe8, 0xdf, 0xdd, 0xa2, 0x0b, 0xbc, 0xa6, 0xe0, 0x04, 0xf8, 0xfa, 0xe5, 0xf7, 0xdf,
+	0x86, 0xf4, 0x63, 0xb7, 0xe8, 0xf6, 0x8e, 0x4e, 0x64, 0x7e, 0x75, 0x24, 0xb7, 0x2f, 0xc3, 0x1d,
+	0x2d, 0x37, 0x3a, 0x58, 0x6d, 0x74, 0x70, 0xbe, 0xd1, 0xc1, 0xaf, 0xad, 0x2e, 0xad, 0xb6, 0xba,
+	0x74, 0xba, 0xd5, 0xa5, 0x4f, 0xbd, 0xa3, 0x80, 0xdf, 0x70, 0x86, 0x32, 0xcc, 0x66, 0x24, 0x9f,
+	0x54, 0xf5, 0xb1, 0x71, 0x95, 0x2d, 0xb8, 0x5f, 0xad, 0xfd, 0xc5, 0x45, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0xd5, 0x7c, 0xb4, 0x2e, 0xa1, 0x02, 0x00, 0x00,
+}
+
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClawback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintClawback(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintClawback(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClawback(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClawback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClawback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovClawback(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovClawback(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovClawback(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovClawback(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovClawback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClawback(x uint64) (n int) {
+	return sovClawback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClawback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClawback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClawback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClawback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &types.BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClawback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClawback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClawback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClawback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClawback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClawback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClawback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClawback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClawback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClawback(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClawback
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClawback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClawback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClawback
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClawback
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClawback
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClawback        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClawback          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClawback = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,187 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ vestingexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount       = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount whose original
+// vesting coins are the total amount of the given vesting periods.
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount,
+	funderAddress string,
+	startTime int64,
+	periods vestingtypes.Periods,
+) (*ClawbackVestingAccount, error) {
+	baseVestingAcc := &vestingtypes.BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	va := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funderAddress,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+
+	return va, va.Validate()
+}
+
+// GetVestedCoins returns the total number of vested coins, which are the coins
+// of the vesting periods that ended before the given block time.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	// NOTE: the vesting schedule is the same as the one of a periodic vesting account
+	pva := vestingtypes.NewPeriodicVestingAccountRaw(va.BaseVestingAccount, va.StartTime, va.VestingPeriods)
+	return pva.GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount. The unvested coins of a
+// clawback vesting account can't be delegated, as the funder couldn't reclaim
+// them, so the delegation must be covered by the vested coins of the balance
+// and is always tracked as delegated free coins.
+//
+// NOTE: this panics like the other vesting accounts do on invalid delegations,
+// since the x/bank keeper doesn't expect an error when tracking delegations.
+// The delegations of unvested coins are rejected with an error beforehand by
+// ValidateDelegation, see the StakingBankKeeper of the x/clawback keeper.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	if err := va.ValidateDelegation(blockTime, balance, amount); err != nil {
+		panic(err)
+	}
+
+	va.BaseVestingAccount.TrackDelegation(balance, sdk.NewCoins(), amount)
+}
+
+// ValidateDelegation returns an error if the given delegation amount isn't
+// covered by the vested coins of the balance.
+func (va ClawbackVestingAccount) ValidateDelegation(blockTime time.Time, balance, amount sdk.Coins) error {
+	vestingCoins := va.GetVestingCoins(blockTime)
+	for _, coin := range amount {
+		vested := balance.AmountOf(coin.Denom).Sub(vestingCoins.AmountOf(coin.Denom))
+		if vested.LT(coin.Amount) {
+			return errorsmod.Wrapf(ErrDelegateUnvestedCoins, "vested %s%s is smaller than %s", vested, coin.Denom, coin)
+		}
+	}
+	return nil
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetVestingPeriods returns the vesting periods of the clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() vestingtypes.Periods {
+	return va.VestingPeriods
+}
+
+// AddVestingPeriods appends the given periods to the vesting schedule. If the
+// schedule already ended, the first period is extended so that the new periods
+// start at the given block time instead of vesting immediately.
+func (va *ClawbackVestingAccount) AddVestingPeriods(blockTime int64, periods vestingtypes.Periods) {
+	newPeriods := make(vestingtypes.Periods, len(periods))
+	copy(newPeriods, periods)
+	if len(newPeriods) > 0 && va.EndTime < blockTime {
+		newPeriods[0].Length += blockTime - va.EndTime
+	}
+
+	va.VestingPeriods = append(va.VestingPeriods, newPeriods...)
+	va.OriginalVesting = va.OriginalVesting.Add(periods.TotalAmount()...)
+	va.EndTime = va.StartTime + va.VestingPeriods.TotalLength()
+}
+
+// ComputeClawback removes the vesting periods that haven't ended at the given
+// block time from the schedule and returns the unvested coins they held. The
+// vested coins are left untouched.
+func (va *ClawbackVestingAccount) ComputeClawback(blockTime int64) sdk.Coins {
+	unvested := va.GetVestingCoins(time.Unix(blockTime, 0))
+
+	// keep the periods that already ended, i.e. the ones counted as vested
+	passed := 0
+	periodStartTime := va.StartTime
+	for _, period := range va.VestingPeriods {
+		if blockTime-periodStartTime < period.Length {
+			break
+		}
+		periodStartTime += period.Length
+		passed++
+	}
+
+	va.VestingPeriods = va.VestingPeriods[:passed]
+	va.OriginalVesting = va.OriginalVesting.Sub(unvested...)
+	va.EndTime = va.StartTime + va.VestingPeriods.TotalLength()
+
+	return unvested
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if va.EndTime < va.StartTime {
+		return errors.New("vesting end-time cannot be before start-time")
+	}
+
+	endTime := va.StartTime
+	originalVesting := sdk.NewCoins()
+	for i, p := range va.VestingPeriods {
+		if p.Length < 0 {
+			return fmt.Errorf("period #%d has a negative length: %d", i, p.Length)
+		}
+		endTime += p.Length
+
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return fmt.Errorf("period #%d has invalid coins: %s", i, p.Amount.String())
+		}
+
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if endTime < va.GetStartTime() {
+		return errors.New("cumulative endTime overflowed, and/or is less than startTime")
+	}
+	if !originalVesting.Equal(va.OriginalVesting) {
+		return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in vesting periods (%v)", va.OriginalVesting, originalVesting)
+	}
+
+	// NOTE: the base vesting account validation is not used, as it rejects
+	// empty original vesting coins, which is the case once all the vesting
+	// periods of the account were clawed back.
+	if !va.DelegatedVesting.IsAllLTE(va.OriginalVesting) {
+		return errors.New("delegated vesting amount cannot be greater than original vesting amount")
+	}
+
+	return va.BaseAccount.Validate()
+}
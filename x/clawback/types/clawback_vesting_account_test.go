@@ -0,0 +1,205 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	testDenom = "azena"
+	startTime = int64(1_000)
+)
+
+func newTestAccount(t *testing.T) *ClawbackVestingAccount {
+	t.Helper()
+
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	periods := vestingtypes.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(testDenom, 20))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(testDenom, 30))},
+	}
+
+	va, err := NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), funder.String(), startTime, periods)
+	require.NoError(t, err)
+	return va
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+}
+
+func TestClawbackVestingAccount_VestedCoins(t *testing.T) {
+	va := newTestAccount(t)
+	require.Equal(t, startTime+300, va.EndTime)
+	require.Equal(t, coins(60), va.OriginalVesting)
+
+	testCases := []struct {
+		name        string
+		blockTime   int64
+		expVested   sdk.Coins
+		expUnvested sdk.Coins
+	}{
+		{"before start", startTime - 10, coins(0), coins(60)},
+		{"first period not ended", startTime + 99, coins(0), coins(60)},
+		{"first period ended", startTime + 100, coins(10), coins(50)},
+		{"two periods ended", startTime + 250, coins(30), coins(30)},
+		{"all periods ended", startTime + 300, coins(60), coins(0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blockTime := time.Unix(tc.blockTime, 0)
+			require.True(t, tc.expVested.Equal(va.GetVestedCoins(blockTime)))
+			require.True(t, tc.expUnvested.Equal(va.GetVestingCoins(blockTime)))
+			require.True(t, tc.expUnvested.Equal(va.LockedCoins(blockTime)))
+		})
+	}
+}
+
+func TestClawbackVestingAccount_TrackDelegation(t *testing.T) {
+	blockTime := time.Unix(startTime+100, 0)
+
+	// only the 10 vested coins can be delegated
+	va := newTestAccount(t)
+	require.ErrorIs(t, va.ValidateDelegation(blockTime, coins(60), coins(11)), ErrDelegateUnvestedCoins)
+	require.NoError(t, va.ValidateDelegation(blockTime, coins(60), coins(10)))
+	require.Panics(t, func() {
+		va.TrackDelegation(blockTime, coins(60), coins(11))
+	})
+
+	va.TrackDelegation(blockTime, coins(60), coins(10))
+	require.Equal(t, coins(10), va.DelegatedFree)
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.True(t, coins(50).Equal(va.LockedCoins(blockTime)))
+
+	va.TrackUndelegation(coins(10))
+	require.True(t, va.DelegatedFree.IsZero())
+}
+
+func TestClawbackVestingAccount_ComputeClawback(t *testing.T) {
+	testCases := []struct {
+		name          string
+		blockTime     int64
+		expClawedBack sdk.Coins
+		expPeriods    int
+		expEndTime    int64
+	}{
+		{"before start", startTime - 10, coins(60), 0, startTime},
+		{"first period ended", startTime + 150, coins(50), 1, startTime + 100},
+		{"all periods ended", startTime + 300, coins(0), 3, startTime + 300},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			va := newTestAccount(t)
+			vested := va.GetVestedCoins(time.Unix(tc.blockTime, 0))
+
+			clawedBack := va.ComputeClawback(tc.blockTime)
+			require.True(t, tc.expClawedBack.Equal(clawedBack))
+			require.Len(t, va.VestingPeriods, tc.expPeriods)
+			require.Equal(t, tc.expEndTime, va.EndTime)
+			require.NoError(t, va.Validate())
+
+			// the vested coins are left untouched and nothing is locked anymore
+			blockTime := time.Unix(tc.blockTime, 0)
+			require.True(t, vested.Equal(va.GetVestedCoins(blockTime)))
+			require.True(t, va.LockedCoins(blockTime).IsZero())
+		})
+	}
+}
+
+func TestClawbackVestingAccount_AddVestingPeriods(t *testing.T) {
+	newPeriods := vestingtypes.Periods{
+		{Length: 50, Amount: coins(5)},
+	}
+
+	testCases := []struct {
+		name       string
+		blockTime  int64
+		expEndTime int64
+	}{
+		{"schedule not ended", startTime + 150, startTime + 350},
+		{"schedule ended", startTime + 400, startTime + 450},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			va := newTestAccount(t)
+			vested := va.GetVestedCoins(time.Unix(tc.blockTime, 0))
+
+			va.AddVestingPeriods(tc.blockTime, newPeriods)
+			require.NoError(t, va.Validate())
+			require.Equal(t, coins(65), va.OriginalVesting)
+			require.Equal(t, tc.expEndTime, va.EndTime)
+			require.Equal(t, int64(50), newPeriods[0].Length, "input periods must not be modified")
+
+			// the new coins are not vested at the current block time
+			require.True(t, vested.Equal(va.GetVestedCoins(time.Unix(tc.blockTime, 0))))
+			require.True(t, coins(65).Equal(va.GetVestedCoins(time.Unix(tc.expEndTime, 0))))
+		})
+	}
+}
+
+func TestClawbackVestingAccount_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(va *ClawbackVestingAccount)
+		expPass  bool
+	}{
+		{
+			"valid",
+			func(_ *ClawbackVestingAccount) {},
+			true,
+		},
+		{
+			"valid - all periods clawed back",
+			func(va *ClawbackVestingAccount) {
+				va.ComputeClawback(startTime)
+			},
+			true,
+		},
+		{
+			"invalid funder",
+			func(va *ClawbackVestingAccount) {
+				va.FunderAddress = "invalid"
+			},
+			false,
+		},
+		{
+			"end time mismatch",
+			func(va *ClawbackVestingAccount) {
+				va.EndTime++
+			},
+			false,
+		},
+		{
+			"original vesting mismatch",
+			func(va *ClawbackVestingAccount) {
+				va.OriginalVesting = coins(1)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			va := newTestAccount(t)
+			tc.malleate(va)
+
+			err := va.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global clawback module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to modules/clawback and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewLegacyAmino()
+)
+
+const (
+	// Amino names
+	clawbackVestingAccountName       = "cosmos/evm/x/clawback/ClawbackVestingAccount"
+	createClawbackVestingAccountName = "cosmos/evm/x/clawback/MsgCreateClawbackVestingAccount"
+	fundVestingAccountName           = "cosmos/evm/x/clawback/MsgFundVestingAccount"
+	clawbackName                     = "cosmos/evm/x/clawback/MsgClawback"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*vestingexported.VestingAccount)(nil),
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.AccountI)(nil),
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.GenesisAccount)(nil),
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateClawbackVestingAccount{},
+		&MsgFundVestingAccount{},
+		&MsgClawback{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/clawback interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, clawbackVestingAccountName, nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, createClawbackVestingAccountName, nil)
+	cdc.RegisterConcrete(&MsgFundVestingAccount{}, fundVestingAccountName, nil)
+	cdc.RegisterConcrete(&MsgClawback{}, clawbackName, nil)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrNotClawbackVestingAccount = errorsmod.Register(ModuleName, 2, "account is not a clawback vesting account")
+	ErrNotFunder                 = errorsmod.Register(ModuleName, 3, "sender is not the funder of the clawback vesting account")
+	ErrInvalidVestingPeriods     = errorsmod.Register(ModuleName, 4, "invalid vesting periods")
+	ErrDelegateUnvestedCoins     = errorsmod.Register(ModuleName, 5, "cannot delegate unvested coins of a clawback vesting account")
+)
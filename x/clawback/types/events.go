@@ -0,0 +1,14 @@
+package types
+
+// clawback events
+const (
+	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	EventTypeFundVestingAccount           = "fund_vesting_account"
+	EventTypeClawback                     = "clawback"
+
+	AttributeKeyFunder         = "funder"
+	AttributeKeyVestingAccount = "vesting_account"
+	AttributeKeyDestination    = "destination"
+	AttributeKeyStartTime      = "start_time"
+	AttributeKeyEndTime        = "end_time"
+)
@@ -0,0 +1,10 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "clawback"
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
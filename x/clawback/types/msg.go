@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg              = &MsgFundVestingAccount{}
+	_ sdk.Msg              = &MsgClawback{}
+	_ sdk.HasValidateBasic = &MsgCreateClawbackVestingAccount{}
+	_ sdk.HasValidateBasic = &MsgFundVestingAccount{}
+	_ sdk.HasValidateBasic = &MsgClawback{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address %s", msg.FunderAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address %s", msg.VestingAddress)
+	}
+
+	if msg.StartTime < 1 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	return ValidatePeriods(msg.VestingPeriods)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgFundVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address %s", msg.FunderAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address %s", msg.VestingAddress)
+	}
+
+	return ValidatePeriods(msg.VestingPeriods)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address %s", msg.FunderAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address %s", msg.VestingAddress)
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid destination address %s", msg.DestAddress)
+		}
+	}
+
+	return nil
+}
+
+// ValidatePeriods checks that the vesting periods are not empty and that each
+// of them has a positive length and amount.
+func ValidatePeriods(periods vestingtypes.Periods) error {
+	if len(periods) == 0 {
+		return errorsmod.Wrap(ErrInvalidVestingPeriods, "vesting periods cannot be empty")
+	}
+
+	for i, period := range periods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(ErrInvalidVestingPeriods, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount in period %d: %s", i, period.Amount)
+		}
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ func NewTxCmd() *cobra.Command {
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
 		NewMsgRegisterERC20Cmd(),
+		NewMigrateBalanceCmd(),
 	)
 	return txCmd
 }
@@ -158,3 +159,33 @@ func NewMsgRegisterERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMigrateBalanceCmd returns a CLI command handler for converting the coins
+// of a deregistered native ERC20 token pair back to ERC20 tokens
+func NewMigrateBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-balance TOKEN",
+		Short: "Convert the coins of a deregistered native ERC20 token pair back to ERC20 tokens, sent to the hex address of the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMigrateBalance{
+				Sender: cliCtx.GetFromAddress().String(),
+				Token:  args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
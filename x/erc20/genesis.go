@@ -37,6 +37,18 @@ func InitGenesis(
 		}
 	}
 
+	for _, pair := range data.DeregisteredTokenPairs {
+		k.SetDeregisteredTokenPair(ctx, pair)
+	}
+
+	for _, contract := range data.BalanceMigrations {
+		for _, pair := range data.DeregisteredTokenPairs {
+			if common.HexToAddress(pair.Erc20Address) == common.HexToAddress(contract) {
+				k.SetBalanceMigration(ctx, common.HexToAddress(contract), pair.GetID())
+			}
+		}
+	}
+
 	for _, precompile := range data.NativePrecompiles {
 		if err := k.EnableNativePrecompile(ctx, common.HexToAddress(precompile)); err != nil {
 			panic(fmt.Errorf("error registering native precompiles %s", err))
@@ -63,10 +75,12 @@ func InitGenesis(
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:             k.GetParams(ctx),
-		TokenPairs:         k.GetTokenPairs(ctx),
-		Allowances:         k.GetAllowances(ctx),
-		NativePrecompiles:  k.GetNativePrecompiles(ctx),
-		DynamicPrecompiles: k.GetDynamicPrecompiles(ctx),
+		Params:                 k.GetParams(ctx),
+		TokenPairs:             k.GetTokenPairs(ctx),
+		Allowances:             k.GetAllowances(ctx),
+		NativePrecompiles:      k.GetNativePrecompiles(ctx),
+		DynamicPrecompiles:     k.GetDynamicPrecompiles(ctx),
+		DeregisteredTokenPairs: k.GetDeregisteredTokenPairs(ctx),
+		BalanceMigrations:      k.GetBalanceMigrations(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -243,6 +244,64 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// DeregisterTokenPair implements the gRPC MsgServer interface.
+//
+// After a successful governance vote it retires the token pair, enabling the
+// migration of the outstanding balances if requested.
+func (k *Keeper) DeregisterTokenPair(goCtx context.Context, req *types.MsgDeregisterTokenPair) (*types.MsgDeregisterTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.deregisterTokenPair(ctx, req.Token, req.MigrateBalances)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyMigrateBalances, strconv.FormatBool(req.MigrateBalances)),
+		),
+	)
+
+	return &types.MsgDeregisterTokenPairResponse{}, nil
+}
+
+// MigrateBalance implements the gRPC MsgServer interface.
+//
+// It converts the coins of the sender of a deregistered native ERC20 token pair
+// back to ERC20 tokens.
+func (k *Keeper) MigrateBalance(goCtx context.Context, req *types.MsgMigrateBalance) (*types.MsgMigrateBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, amount, err := k.migrateBalance(ctx, sender, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateBalance,
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgMigrateBalanceResponse{Amount: amount}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
@@ -3,13 +3,17 @@
 package keeper
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/zenanetwork/zena/x/erc20/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -136,3 +140,118 @@ func (k Keeper) toggleConversion(
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// deregisterTokenPair retires the token pair of the given token. It disables
+// the conversions, removes the ERC20 precompile of the pair if any and deletes
+// the pair with its allowances. The pair is recorded as deregistered so that it
+// can't be registered again. If migrateBalances is set, the holders of the
+// coins of a native ERC20 token pair can then convert them back to ERC20 tokens
+// with MsgMigrateBalance.
+func (k Keeper) deregisterTokenPair(
+	ctx sdk.Context,
+	token string,
+	migrateBalances bool,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	// the ERC20 representation of native coins is a precompile backed by the
+	// bank balances, so there is nothing to migrate for them
+	if migrateBalances && !pair.IsNativeERC20() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrNativeConversionDisabled, "balances of native coin token pair %s can't be migrated", pair.Denom,
+		)
+	}
+
+	contract := pair.GetERC20Contract()
+	isNative := k.IsNativePrecompileAvailable(ctx, contract)
+	isDynamic := k.IsDynamicPrecompileAvailable(ctx, contract)
+	if isNative || isDynamic {
+		k.DeleteNativePrecompile(ctx, contract)
+		k.DeleteDynamicPrecompile(ctx, contract)
+		if err := k.UnRegisterERC20CodeHash(ctx, contract); err != nil {
+			return types.TokenPair{}, err
+		}
+	}
+
+	pair.Enabled = false
+	k.DeleteTokenPair(ctx, pair)
+	k.SetDeregisteredTokenPair(ctx, pair)
+	if migrateBalances {
+		k.SetBalanceMigration(ctx, contract, pair.GetID())
+	}
+
+	return pair, nil
+}
+
+// migrateBalance converts the spendable coins of the sender of a deregistered
+// native ERC20 token pair back to ERC20 tokens, which are sent to its hex
+// address. Module accounts and blocked addresses, which hold the coins on
+// behalf of other accounts, can't migrate their coins. The IBC escrow accounts
+// can't sign the message, so the escrowed coins can still be unescrowed or
+// refunded. It returns the amount of converted coins.
+func (k Keeper) migrateBalance(ctx sdk.Context, sender sdk.AccAddress, token string) (types.TokenPair, math.Int, error) {
+	var contract common.Address
+	switch {
+	case common.IsHexAddress(token):
+		contract = common.HexToAddress(token)
+	case strings.HasPrefix(token, types.Erc20NativeCoinDenomPrefix) &&
+		common.IsHexAddress(strings.TrimPrefix(token, types.Erc20NativeCoinDenomPrefix)):
+		contract = common.HexToAddress(strings.TrimPrefix(token, types.Erc20NativeCoinDenomPrefix))
+	default:
+		return types.TokenPair{}, math.ZeroInt(), errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "invalid native ERC20 token '%s'", token,
+		)
+	}
+
+	pair, found := k.GetDeregisteredTokenPair(ctx, k.GetBalanceMigration(ctx, contract))
+	if !found {
+		return types.TokenPair{}, math.ZeroInt(), errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "balances of token '%s' can't be migrated", token,
+		)
+	}
+
+	if k.bankKeeper.BlockedAddr(sender) {
+		return types.TokenPair{}, math.ZeroInt(), errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "%s is not allowed to migrate balances", sender,
+		)
+	}
+	if acc := k.accountKeeper.GetAccount(ctx, sender); acc != nil && types.IsModuleAccount(acc) {
+		return types.TokenPair{}, math.ZeroInt(), errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "module account %s is not allowed to migrate balances", sender,
+		)
+	}
+
+	// the coins can't be converted if the contract is self-destructed
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.HasCodeHash() {
+		return types.TokenPair{}, math.ZeroInt(), errorsmod.Wrapf(
+			types.ErrContractSelfDestructed, "contract %s", pair.Erc20Address,
+		)
+	}
+
+	// locked coins, e.g. of vesting accounts, are left as they are
+	amount := k.bankKeeper.SpendableCoin(ctx, sender, pair.Denom).Amount
+	if !amount.IsPositive() {
+		return types.TokenPair{}, math.ZeroInt(), errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds, "no spendable %s coins to migrate", pair.Denom,
+		)
+	}
+
+	if err := k.ConvertCoinNativeERC20(ctx, pair, amount, common.BytesToAddress(sender), sender, false); err != nil {
+		return types.TokenPair{}, math.ZeroInt(), err
+	}
+
+	return pair, amount, nil
+}
@@ -32,6 +32,9 @@ func (k Keeper) CreateNewTokenPair(ctx sdk.Context, denom string) (types.TokenPa
 
 // SetToken stores a token pair, denom map and erc20 map.
 func (k *Keeper) SetToken(ctx sdk.Context, pair types.TokenPair) error {
+	if k.IsTokenPairDeregistered(ctx, pair.GetID()) {
+		return errorsmod.Wrapf(types.ErrTokenPairDeregistered, "token pair %s - %s cannot be registered again", pair.Erc20Address, pair.Denom)
+	}
 	if k.IsDenomRegistered(ctx, pair.Denom) {
 		return errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "token already exists for denom %s", pair.Denom)
 	}
@@ -122,6 +125,86 @@ func (k Keeper) deleteTokenPair(ctx sdk.Context, id []byte) {
 	store.Delete(id)
 }
 
+// GetDeregisteredTokenPairs gets all the token pairs retired by governance.
+func (k Keeper) GetDeregisteredTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDeregisteredTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+		tokenPairs = append(tokenPairs, tokenPair)
+	}
+
+	return tokenPairs
+}
+
+// SetDeregisteredTokenPair stores a token pair retired by governance.
+func (k Keeper) SetDeregisteredTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeregisteredTokenPair)
+	bz := k.cdc.MustMarshal(&tokenPair)
+	store.Set(tokenPair.GetID(), bz)
+}
+
+// IsTokenPairDeregistered checks if the token pair for the given id has been
+// retired by governance.
+func (k Keeper) IsTokenPairDeregistered(ctx sdk.Context, id []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeregisteredTokenPair)
+	return store.Has(id)
+}
+
+// GetDeregisteredTokenPair gets the token pair retired by governance for the
+// given id.
+func (k Keeper) GetDeregisteredTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeregisteredTokenPair)
+	var tokenPair types.TokenPair
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// GetBalanceMigrations gets the ERC20 contracts of the deregistered token pairs
+// whose coins can still be converted back to ERC20 tokens.
+func (k Keeper) GetBalanceMigrations(ctx sdk.Context) []string {
+	var contracts []string
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBalanceMigration)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixBalanceMigration):])
+		contracts = append(contracts, contract.String())
+	}
+
+	return contracts
+}
+
+// SetBalanceMigration enables the conversion of the coins of the deregistered
+// token pair with the given id back to ERC20 tokens.
+func (k Keeper) SetBalanceMigration(ctx sdk.Context, erc20 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBalanceMigration)
+	store.Set(erc20.Bytes(), id)
+}
+
+// GetBalanceMigration returns the id of the deregistered token pair of the
+// given ERC20 contract, if its coins can be converted back to ERC20 tokens.
+func (k Keeper) GetBalanceMigration(ctx sdk.Context, erc20 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBalanceMigration)
+	return store.Get(erc20.Bytes())
+}
+
 // GetERC20Map returns the token pair id for the given address.
 func (k Keeper) GetERC20Map(ctx sdk.Context, erc20 common.Address) []byte {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
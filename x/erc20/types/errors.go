@@ -26,4 +26,5 @@ var (
 	ErrNegativeToken            = errorsmod.Register(ModuleName, 19, "token amount is negative")
 	ErrExpectedEvent            = errorsmod.Register(ModuleName, 20, "expected event")
 	ErrContractSelfDestructed   = errorsmod.Register(ModuleName, 21, "token pair contract has been self-destructed")
+	ErrTokenPairDeregistered    = errorsmod.Register(ModuleName, 22, "token pair has been deregistered")
 )
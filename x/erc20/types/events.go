@@ -12,13 +12,16 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeDeregisterTokenPair    = "deregister_token_pair"
+	EventTypeMigrateBalance         = "migrate_balance"
 
 	EventTypeFailedConvertERC20 = "failed_convert_erc20"
 
-	AttributeCoinSourceChannel = "source_channel"
-	AttributeKeyCosmosCoin     = "cosmos_coin"
-	AttributeKeyERC20Token     = "erc20_token" // #nosec
-	AttributeKeyReceiver       = "receiver"
+	AttributeCoinSourceChannel  = "source_channel"
+	AttributeKeyCosmosCoin      = "cosmos_coin"
+	AttributeKeyERC20Token      = "erc20_token" // #nosec
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyMigrateBalances = "migrate_balances"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
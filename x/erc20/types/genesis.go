@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // NewGenesisState creates a new genesis state.
@@ -17,9 +19,10 @@ func NewGenesisState(params Params, pairs []TokenPair, allowances []Allowance) G
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		TokenPairs: []TokenPair{},
-		Allowances: []Allowance{},
+		Params:                 DefaultParams(),
+		TokenPairs:             []TokenPair{},
+		Allowances:             []Allowance{},
+		DeregisteredTokenPairs: []TokenPair{},
 	}
 }
 
@@ -46,6 +49,52 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	// Check that the deregistered token pairs aren't registered again. The
+	// pairs are keyed by id, as done when registering a token pair.
+	seenIDs := make(map[string]bool)
+	for _, b := range gs.TokenPairs {
+		seenIDs[string(b.GetID())] = true
+	}
+
+	seenDeregistered := make(map[string]bool)
+	deregisteredERC20 := make(map[common.Address]TokenPair)
+	for _, b := range gs.DeregisteredTokenPairs {
+		id := string(b.GetID())
+		if seenIDs[id] {
+			return fmt.Errorf("deregistered token pair is registered on genesis: '%s'", b.Erc20Address)
+		}
+		if seenDeregistered[id] {
+			return fmt.Errorf("deregistered token pair duplicated on genesis: '%s'", b.Erc20Address)
+		}
+
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("invalid deregistered token pair on genesis: %w", err)
+		}
+
+		seenDeregistered[id] = true
+		deregisteredERC20[common.HexToAddress(b.Erc20Address)] = b
+	}
+
+	// Check that the balance migrations have a deregistered native ERC20 token pair
+	seenMigration := make(map[common.Address]bool)
+	for _, m := range gs.BalanceMigrations {
+		if !common.IsHexAddress(m) {
+			return fmt.Errorf("invalid balance migration contract on genesis: '%s'", m)
+		}
+
+		contract := common.HexToAddress(m)
+		if seenMigration[contract] {
+			return fmt.Errorf("balance migration duplicated on genesis: '%s'", m)
+		}
+
+		pair, found := deregisteredERC20[contract]
+		if !found || !pair.IsNativeERC20() {
+			return fmt.Errorf("balance migration has no corresponding deregistered native ERC20 token pair on genesis: '%s'", m)
+		}
+
+		seenMigration[contract] = true
+	}
+
 	// Check if active precompiles have a corresponding token pair
 	if err := validatePrecompiles(gs.TokenPairs, gs.DynamicPrecompiles); err != nil {
 		return fmt.Errorf("invalid dynamic precompiles on genesis: %w", err)
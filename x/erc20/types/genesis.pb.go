@@ -36,6 +36,13 @@ type GenesisState struct {
 	NativePrecompiles []string `protobuf:"bytes,4,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 	// dynamic_precompiles is a slice of registered dynamic precompiles at genesis
 	DynamicPrecompiles []string `protobuf:"bytes,5,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// deregistered_token_pairs is a slice of the token pairs retired by
+	// governance, which can't be registered again
+	DeregisteredTokenPairs []TokenPair `protobuf:"bytes,6,rep,name=deregistered_token_pairs,json=deregisteredTokenPairs,proto3" json:"deregistered_token_pairs"`
+	// balance_migrations is a slice of the ERC20 contract hex addresses of the
+	// deregistered token pairs whose coins can still be converted back to ERC20
+	// tokens
+	BalanceMigrations []string `protobuf:"bytes,7,rep,name=balance_migrations,json=balanceMigrations,proto3" json:"balance_migrations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +113,20 @@ func (m *GenesisState) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDeregisteredTokenPairs() []TokenPair {
+	if m != nil {
+		return m.DeregisteredTokenPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceMigrations() []string {
+	if m != nil {
+		return m.BalanceMigrations
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -171,33 +192,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0xe6, 0xde, 0xd2, 0x4e, 0xba, 0xb0, 0x53, 0x17, 0xa1, 0x85, 0xf4, 0x8f, 0x9b,
-	0xe2, 0x22, 0xb1, 0x75, 0x23, 0x82, 0x8a, 0x05, 0x11, 0xbb, 0x2a, 0xd5, 0x95, 0x9b, 0x30, 0x8d,
-	0x87, 0x38, 0x98, 0x99, 0x09, 0x33, 0x63, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec,
-	0x52, 0x10, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x69, 0x69, 0x2a, 0xe5, 0x6e, 0xc2, 0xf0, 0xf1,
-	0xfb, 0x7d, 0x39, 0x1c, 0x0e, 0x1a, 0xa6, 0x42, 0x31, 0xa1, 0x62, 0x28, 0x59, 0x0c, 0x32, 0x9d,
-	0x3e, 0x8a, 0xcb, 0x49, 0x9c, 0x01, 0x07, 0x45, 0x55, 0x54, 0x48, 0xa1, 0x05, 0xee, 0x58, 0x24,
-	0x82, 0x92, 0x45, 0x06, 0x89, 0xca, 0x49, 0xb7, 0x4d, 0x18, 0xe5, 0x22, 0x36, 0x5f, 0xcb, 0x75,
-	0xfb, 0xd7, 0xaa, 0xac, 0x60, 0x81, 0xfb, 0x99, 0xc8, 0x84, 0x79, 0xc6, 0x87, 0x97, 0x4d, 0x47,
-	0xbf, 0x6a, 0xa8, 0xf5, 0xda, 0xfe, 0xf0, 0xad, 0x26, 0x1a, 0xf0, 0x73, 0x54, 0x2f, 0x88, 0x24,
-	0x4c, 0x05, 0xee, 0xc0, 0x1d, 0xfb, 0xd3, 0x5e, 0x74, 0x65, 0x80, 0x68, 0x61, 0x90, 0x59, 0x73,
-	0xf3, 0xbb, 0xef, 0x7c, 0xff, 0xfb, 0xe3, 0xa1, 0xbb, 0x3c, 0x5a, 0x78, 0x8e, 0x7c, 0x2d, 0x3e,
-	0x01, 0x4f, 0x0a, 0x42, 0xa5, 0x0a, 0x6a, 0x03, 0x6f, 0xec, 0x4f, 0xc3, 0xab, 0x25, 0xef, 0x0e,
-	0xdc, 0x82, 0x50, 0x59, 0xed, 0x41, 0xfa, 0x94, 0x2a, 0xfc, 0x06, 0x21, 0x92, 0xe7, 0xe2, 0x0b,
-	0xe1, 0x29, 0xa8, 0xc0, 0xbb, 0xa3, 0xea, 0xe5, 0x09, 0xbb, 0xa8, 0x3a, 0xcb, 0xf8, 0x09, 0xc2,
-	0x9c, 0x68, 0x5a, 0x42, 0x52, 0x48, 0x48, 0x05, 0x2b, 0x68, 0x0e, 0x2a, 0xb8, 0x19, 0x78, 0xe3,
-	0xa6, 0x51, 0x5c, 0xab, 0xb4, 0x2d, 0xb4, 0x38, 0x33, 0xf8, 0x29, 0xea, 0x7c, 0x58, 0x73, 0xc2,
-	0x68, 0x7a, 0xa1, 0xde, 0xfe, 0xaf, 0xe2, 0x23, 0x55, 0x71, 0x47, 0x12, 0xd5, 0xed, 0xa6, 0xf0,
-	0x10, 0xb5, 0x80, 0x93, 0x55, 0x0e, 0x89, 0x99, 0xd9, 0x2c, 0xb7, 0xb1, 0xf4, 0x6d, 0xf6, 0xea,
-	0x10, 0xe1, 0x17, 0xa8, 0x57, 0x80, 0x64, 0x54, 0x29, 0x2a, 0x78, 0x0e, 0x4a, 0x25, 0x12, 0x32,
-	0xaa, 0xb4, 0x24, 0x9a, 0x0a, 0x1e, 0xdc, 0x1a, 0xa3, 0x7b, 0x89, 0x2c, 0x2b, 0xc4, 0xfc, 0xa6,
-	0x51, 0xbb, 0xe7, 0xcd, 0x9e, 0x6d, 0x76, 0xa1, 0xbb, 0xdd, 0x85, 0xee, 0x9f, 0x5d, 0xe8, 0x7e,
-	0xdb, 0x87, 0xce, 0x76, 0x1f, 0x3a, 0x3f, 0xf7, 0xa1, 0xf3, 0xfe, 0x41, 0x46, 0xf5, 0xc7, 0xcf,
-	0xab, 0x28, 0x15, 0x2c, 0xae, 0x5c, 0xcb, 0xd7, 0xe3, 0xbd, 0xe8, 0x75, 0x01, 0x6a, 0x55, 0x37,
-	0x77, 0xf1, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x48, 0x19, 0xb7, 0x9b, 0x02, 0x00,
-	0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x65, 0x2b, 0x9b, 0xbb, 0x03, 0xf5, 0x10, 0x8a, 0x3a, 0x29, 0xeb, 0x76, 0x2a,
+	0x48, 0xc4, 0xac, 0x5c, 0x10, 0x07, 0x10, 0x43, 0x08, 0x31, 0x09, 0xa9, 0x2a, 0x3b, 0x71, 0x89,
+	0xdc, 0xf4, 0x29, 0x58, 0x8b, 0xed, 0xc8, 0xcf, 0x64, 0x8c, 0x4f, 0xc1, 0xc7, 0xe0, 0xc8, 0xc7,
+	0xd8, 0x71, 0x47, 0x4e, 0x08, 0xb5, 0x07, 0x8e, 0x7c, 0x05, 0x14, 0xbb, 0xa3, 0x29, 0xaa, 0x90,
+	0xb8, 0x44, 0xd6, 0xf3, 0xef, 0xf7, 0xf7, 0xcb, 0xb3, 0xc9, 0x61, 0xa6, 0x51, 0x6a, 0x64, 0x50,
+	0x49, 0x06, 0x26, 0x1b, 0x3e, 0x64, 0xd5, 0x31, 0xcb, 0x41, 0x01, 0x0a, 0x4c, 0x4a, 0xa3, 0xad,
+	0xa6, 0x7b, 0x1e, 0x49, 0xa0, 0x92, 0x89, 0x43, 0x92, 0xea, 0xb8, 0xd7, 0xe5, 0x52, 0x28, 0xcd,
+	0xdc, 0xd7, 0x73, 0xbd, 0x83, 0x75, 0x51, 0x5e, 0xf0, 0xc0, 0x9d, 0x5c, 0xe7, 0xda, 0x2d, 0x59,
+	0xbd, 0xf2, 0xd5, 0xa3, 0x5f, 0x21, 0xd9, 0x7d, 0xe5, 0x0f, 0x7c, 0x6b, 0xb9, 0x05, 0xfa, 0x94,
+	0xb4, 0x4b, 0x6e, 0xb8, 0xc4, 0x28, 0xe8, 0x07, 0x83, 0xce, 0x70, 0x3f, 0x59, 0xd3, 0x40, 0x32,
+	0x72, 0xc8, 0xc9, 0xce, 0xd5, 0xf7, 0x83, 0xd6, 0x97, 0x9f, 0x5f, 0xef, 0x07, 0xe3, 0x85, 0x45,
+	0x4f, 0x49, 0xc7, 0xea, 0x73, 0x50, 0x69, 0xc9, 0x85, 0xc1, 0x68, 0xa3, 0x1f, 0x0e, 0x3a, 0xc3,
+	0x78, 0x6d, 0xc8, 0x59, 0xcd, 0x8d, 0xb8, 0x30, 0xcd, 0x1c, 0x62, 0x6f, 0xaa, 0x48, 0x5f, 0x13,
+	0xc2, 0x8b, 0x42, 0x5f, 0x70, 0x95, 0x01, 0x46, 0xe1, 0x3f, 0xa2, 0x9e, 0xdf, 0x60, 0x2b, 0x51,
+	0x4b, 0x99, 0x3e, 0x26, 0x54, 0x71, 0x2b, 0x2a, 0x48, 0x4b, 0x03, 0x99, 0x96, 0xa5, 0x28, 0x00,
+	0xa3, 0xcd, 0x7e, 0x38, 0xd8, 0x71, 0x4a, 0xe0, 0x95, 0xae, 0x87, 0x46, 0x4b, 0x86, 0x3e, 0x21,
+	0x7b, 0xd3, 0x4b, 0xc5, 0xa5, 0xc8, 0x56, 0xd4, 0xad, 0xbf, 0x55, 0xba, 0xa0, 0x9a, 0x6e, 0x46,
+	0xa2, 0x29, 0x18, 0xc8, 0x05, 0x5a, 0x30, 0x30, 0x4d, 0x9b, 0x93, 0x69, 0xff, 0xef, 0x64, 0xee,
+	0x36, 0xa3, 0xce, 0x96, 0x53, 0x7a, 0x40, 0xe8, 0x84, 0x17, 0xf5, 0x6f, 0xa6, 0x52, 0xe4, 0x86,
+	0x5b, 0xa1, 0x15, 0x46, 0xb7, 0xea, 0xfe, 0xc6, 0xdd, 0xc5, 0xce, 0x9b, 0x3f, 0x1b, 0x47, 0x86,
+	0xb4, 0xfd, 0xed, 0xd1, 0x43, 0xb2, 0x0b, 0x8a, 0x4f, 0x0a, 0x48, 0xdd, 0xc1, 0xee, 0xc2, 0xb7,
+	0xc7, 0x1d, 0x5f, 0x7b, 0x59, 0x97, 0xe8, 0x33, 0xb2, 0x5f, 0x82, 0x91, 0x02, 0x51, 0x68, 0x55,
+	0x00, 0x62, 0xea, 0x5b, 0xf0, 0x61, 0xd1, 0x96, 0x33, 0x7a, 0xab, 0xc8, 0xb8, 0x41, 0x9c, 0x6e,
+	0x6e, 0x6f, 0xdc, 0x0e, 0x4f, 0x5e, 0x5c, 0xcd, 0xe2, 0xe0, 0x7a, 0x16, 0x07, 0x3f, 0x66, 0x71,
+	0xf0, 0x79, 0x1e, 0xb7, 0xae, 0xe7, 0x71, 0xeb, 0xdb, 0x3c, 0x6e, 0xbd, 0xbb, 0x97, 0x0b, 0xfb,
+	0xfe, 0xc3, 0x24, 0xc9, 0xb4, 0x64, 0x9f, 0x40, 0x71, 0x05, 0xf6, 0x42, 0x9b, 0x73, 0xb7, 0x66,
+	0x1f, 0x17, 0x2f, 0xd9, 0x5e, 0x96, 0x80, 0x93, 0xb6, 0x7b, 0xb1, 0x8f, 0x7e, 0x07, 0x00, 0x00,
+	0xff, 0xff, 0xad, 0xc7, 0x1d, 0x33, 0x35, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -220,6 +244,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BalanceMigrations) > 0 {
+		for iNdEx := len(m.BalanceMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BalanceMigrations[iNdEx])
+			copy(dAtA[i:], m.BalanceMigrations[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BalanceMigrations[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DeregisteredTokenPairs) > 0 {
+		for iNdEx := len(m.DeregisteredTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeregisteredTokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -365,6 +412,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DeregisteredTokenPairs) > 0 {
+		for _, e := range m.DeregisteredTokenPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalanceMigrations) > 0 {
+		for _, s := range m.BalanceMigrations {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -583,6 +642,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregisteredTokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeregisteredTokenPairs = append(m.DeregisteredTokenPairs, TokenPair{})
+			if err := m.DeregisteredTokenPairs[len(m.DeregisteredTokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceMigrations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceMigrations = append(m.BalanceMigrations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
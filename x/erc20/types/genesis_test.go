@@ -311,6 +311,126 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with deregistered token pairs",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - deregistered token pair is registered",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated deregistered token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+					},
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with balance migrations",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "erc20:0xdac17f958d2ee523a2206206994597c13d831ec7",
+						ContractOwner: types.OWNER_EXTERNAL,
+					},
+				},
+				BalanceMigrations: []string{"0xdAC17F958D2ee523a2206206994597C13D831ec7"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - balance migration without deregistered token pair",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				BalanceMigrations: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - balance migration of native coin token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+				BalanceMigrations: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated balance migration",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "erc20:0xdac17f958d2ee523a2206206994597c13d831ec7",
+						ContractOwner: types.OWNER_EXTERNAL,
+					},
+				},
+				BalanceMigrations: []string{
+					"0xdac17f958d2ee523a2206206994597c13d831ec7",
+					"0xdac17f958d2ee523a2206206994597c13d831ec7",
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid deregistered token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeregisteredTokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xinvalid",
+						Denom:        "usdt",
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
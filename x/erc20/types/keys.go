@@ -34,17 +34,21 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixDeregisteredTokenPair
+	prefixBalanceMigration
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair          = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20   = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom   = []byte{prefixTokenPairByDenom}
-	KeyPrefixSTRv2Addresses     = []byte{prefixSTRv2Addresses}
-	KeyPrefixAllowance          = []byte{prefixAllowance}
-	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
-	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixTokenPair             = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20      = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom      = []byte{prefixTokenPairByDenom}
+	KeyPrefixSTRv2Addresses        = []byte{prefixSTRv2Addresses}
+	KeyPrefixAllowance             = []byte{prefixAllowance}
+	KeyPrefixNativePrecompiles     = []byte{prefixNativePrecompiles}
+	KeyPrefixDynamicPrecompiles    = []byte{prefixDynamicPrecompiles}
+	KeyPrefixDeregisteredTokenPair = []byte{prefixDeregisteredTokenPair}
+	KeyPrefixBalanceMigration      = []byte{prefixBalanceMigration}
 )
 
 func AllowanceKey(
@@ -19,11 +19,15 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgDeregisterTokenPair{}
+	_ sdk.Msg              = &MsgMigrateBalance{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgConvertCoin{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgDeregisterTokenPair{}
+	_ sdk.HasValidateBasic = &MsgMigrateBalance{}
 )
 
 const (
@@ -121,6 +125,32 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeregisterTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if m.Token == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMigrateBalance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "Invalid sender address")
+	}
+
+	if m.Token == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	return nil
+}
+
 // Route should return the name of the module
 func (msg MsgConvertCoin) Route() string { return RouterKey }
 
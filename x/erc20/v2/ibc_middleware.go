@@ -16,7 +16,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ ibcapi.IBCModule = &IBCMiddleware{}
+var (
+	_ ibcapi.IBCModule             = &IBCMiddleware{}
+	_ ibcapi.PacketDataUnmarshaler = &IBCMiddleware{}
+)
 
 // IBCMiddleware implements the ICS26 callbacks for the transfer middleware given
 // the erc20 keeper and the underlying application.
@@ -159,6 +162,17 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	return im.keeper.OnTimeoutPacket(ctx, packet, data)
 }
 
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface.
+// It defers to the underlying application, which allows the callbacks
+// middleware to wrap the erc20 middleware.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	pd, ok := im.app.(ibcapi.PacketDataUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("underlying application does not implement %T", (*ibcapi.PacketDataUnmarshaler)(nil))
+	}
+	return pd.UnmarshalPacketData(payload)
+}
+
 func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) (channeltypes.Packet, error) {
 	transferRepresentation, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
 	if err != nil {
@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetBurnedBaseFeeCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -114,3 +115,33 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBurnedBaseFeeCmd queries the base fees burned at a given height
+func GetBurnedBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-base-fee",
+		Short: "Get the base fees burned at a given block height",
+		Long: `Get the base fees burned at the end of the block and since genesis at a given block height.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.BurnedBaseFee(ctx, &types.QueryBurnedBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
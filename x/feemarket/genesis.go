@@ -23,6 +23,8 @@ func InitGenesis(
 	}
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
+	k.SetBlockGasUsed(ctx, data.BlockGasUsed)
+	k.SetBurnedBaseFee(ctx, data.BurnedBaseFee)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -30,7 +32,9 @@ func InitGenesis(
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:        k.GetParams(ctx),
+		BlockGas:      k.GetBlockGasWanted(ctx),
+		BlockGasUsed:  k.GetBlockGasUsed(ctx),
+		BurnedBaseFee: k.GetBurnedBaseFee(ctx),
 	}
 }
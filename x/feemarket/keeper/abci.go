@@ -44,7 +44,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and burns the base fees.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -77,15 +77,19 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
+	k.SetBlockGasUsed(ctx, gasUsed.Uint64())
+	k.BurnBaseFee(ctx)
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
+		telemetry.SetGauge(float32(gasUsed.Int64()), "feemarket", "block_gas_used")
 	}()
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		"block_gas",
 		sdk.NewAttribute("height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
+		sdk.NewAttribute("gas_used", fmt.Sprintf("%d", gasUsed.Uint64())),
 	))
 
 	return nil
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/zenanetwork/zena/x/feemarket/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BurnBaseFee burns the base_fee_burn_fraction of the base fees paid by the
+// EVM transactions of the block. The fees are held by the fee collector until
+// they are distributed at the beginning of the next block, so the remaining
+// base fees and all the priority fees are still paid to the validators.
+//
+// The base fees already distributed during the transactions, i.e. the base fee
+// part of the x/revenue developer shares, are deducted from the base fees paid
+// and are never burned. The burned amount is capped by the EVM coin balance of
+// the fee collector.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) BurnBaseFee(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	baseFeePaid := k.GetTransientBaseFeePaid(ctx)
+
+	burned := sdkmath.ZeroInt()
+	if params.IsBaseFeeBurnEnabled() {
+		burned = params.BaseFeeBurnFraction.MulInt(baseFeePaid).TruncateInt()
+	}
+
+	if burned.IsPositive() {
+		denom := evmtypes.GetEVMCoinExtendedDenom()
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		if available := k.bankKeeper.GetBalance(ctx, feeCollector, denom).Amount; burned.GT(available) {
+			k.Logger(ctx).Error("base fee to burn exceeds the fee collector balance", "amount", burned, "available", available)
+			burned = available
+		}
+	}
+
+	if burned.IsPositive() {
+		// a failed burn must not halt the chain, the fees are then distributed
+		// to the validators
+		if err := k.burnFeeCollectorCoins(ctx, burned); err != nil {
+			k.Logger(ctx).Error("failed to burn base fee", "amount", burned, "error", err)
+			burned = sdkmath.ZeroInt()
+		}
+	}
+
+	k.SetBlockBurnedBaseFee(ctx, burned)
+	if burned.IsZero() {
+		return
+	}
+
+	k.SetBurnedBaseFee(ctx, k.GetBurnedBaseFee(ctx).Add(burned))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnBaseFee,
+		sdk.NewAttribute(types.AttributeKeyBaseFeePaid, baseFeePaid.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+	))
+}
+
+// burnFeeCollectorCoins burns the given amount of the extended EVM coin from
+// the fee collector, through the fee market module account. Both steps are
+// reverted if any of them fails.
+func (k Keeper) burnFeeCollectorCoins(ctx sdk.Context, amount sdkmath.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), amount))
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
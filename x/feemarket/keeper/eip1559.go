@@ -11,8 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
-// block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// CalculateBaseFee calculates the base fee for the current block with the base fee algorithm set in
+// the parameters, capped by the max base fee. This is only calculated once per block during BeginBlock.
+// If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
@@ -29,6 +30,9 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
 	if ctx.BlockHeight() == params.EnableHeight {
+		if params.IsMaxBaseFeeEnabled() {
+			return sdkmath.LegacyMinDec(params.BaseFee, params.MaxBaseFee)
+		}
 		return params.BaseFee
 	}
 
@@ -41,7 +45,9 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
+	// the parent block gas is either the gas wanted or the gas used, depending
+	// on the base fee algorithm.
+	parentGas := params.ParentBlockGas(k.GetBlockGasWanted(ctx), k.GetBlockGasUsed(ctx))
 
 	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
@@ -58,12 +64,14 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	}
 
 	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
-	return types.CalcGasBaseFee(
-		parentGasUsed,
+	return types.CalcBaseFee(
+		params.BaseFeeAlgorithm,
+		parentGas,
 		parentGasTargetInt.Uint64(),
 		uint64(params.BaseFeeChangeDenominator),
 		parentBaseFee,
 		sdkmath.LegacyOneDec().QuoInt(factor),
 		params.MinGasPrice,
+		params.MaxBaseFee,
 	)
 }
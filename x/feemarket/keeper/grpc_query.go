@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zenanetwork/zena/x/feemarket/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -27,9 +28,15 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	params := k.GetParams(ctx)
+
 	res := &types.QueryBaseFeeResponse{}
 	baseFee := k.GetBaseFee(ctx)
 	res.BaseFee = &baseFee
+	res.Algorithm = params.BaseFeeAlgorithm
+	if params.IsMaxBaseFeeEnabled() {
+		res.MaxBaseFee = &params.MaxBaseFee
+	}
 
 	return res, nil
 }
@@ -38,12 +45,35 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	gas := sdkmath.NewIntFromUint64(k.GetBlockGasWanted(ctx))
+	gasUsed := sdkmath.NewIntFromUint64(k.GetBlockGasUsed(ctx))
 
 	if !gas.IsInt64() {
 		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block gas %s is higher than MaxInt64", gas)
 	}
 
+	if !gasUsed.IsInt64() {
+		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block gas used %s is higher than MaxInt64", gasUsed)
+	}
+
 	return &types.QueryBlockGasResponse{
-		Gas: gas.Int64(),
+		Gas:     gas.Int64(),
+		GasUsed: gasUsed.Int64(),
+	}, nil
+}
+
+// BurnedBaseFee implements the Query/BurnedBaseFee gRPC method
+func (k Keeper) BurnedBaseFee(c context.Context, _ *types.QueryBurnedBaseFeeRequest) (*types.QueryBurnedBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	denom := evmtypes.GetEVMCoinExtendedDenom()
+
+	fraction := k.GetParams(ctx).BaseFeeBurnFraction
+	if fraction.IsNil() {
+		fraction = sdkmath.LegacyZeroDec()
+	}
+
+	return &types.QueryBurnedBaseFeeResponse{
+		Block:    sdk.NewCoin(denom, k.GetBlockBurnedBaseFee(ctx)),
+		Total:    sdk.NewCoin(denom, k.GetBurnedBaseFee(ctx)),
+		Fraction: fraction,
 	}, nil
 }
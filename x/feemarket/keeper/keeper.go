@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/zenanetwork/zena/x/feemarket/types"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,11 +22,15 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// bank keeper used to burn the base fees. It must handle the extended EVM
+	// denom, e.g. the x/precisebank keeper.
+	bankKeeper types.BankKeeper
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -35,6 +42,7 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+		bankKeeper:   bankKeeper,
 	}
 }
 
@@ -62,6 +70,20 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixBlockGasWanted))
 }
 
+// SetBlockGasUsed sets the block gas used to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	gasBz := sdk.Uint64ToBigEndian(gas)
+	store.Set(types.KeyPrefixBlockGasUsed, gasBz)
+}
+
+// GetBlockGasUsed returns the last block gas used value from the store.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixBlockGasUsed))
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
@@ -81,3 +103,85 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	k.SetTransientBlockGasWanted(ctx, result)
 	return result, nil
 }
+
+// ----------------------------------------------------------------------------
+// Burned Base Fee
+// The amounts are in the 18 decimals representation of the EVM coin.
+// ----------------------------------------------------------------------------
+
+// GetTransientBaseFeePaid returns the base fees paid by the EVM transactions of
+// the current block from the transient store.
+func (k Keeper) GetTransientBaseFeePaid(ctx sdk.Context) sdkmath.Int {
+	store := ctx.TransientStore(k.transientKey)
+	return getInt(store.Get(types.KeyTransientBaseFeePaid))
+}
+
+// AddTransientBaseFeePaid adds the base fees paid by an EVM transaction to the
+// transient store.
+func (k Keeper) AddTransientBaseFeePaid(ctx sdk.Context, amount sdkmath.Int) {
+	store := ctx.TransientStore(k.transientKey)
+	setInt(store, types.KeyTransientBaseFeePaid, k.GetTransientBaseFeePaid(ctx).Add(amount))
+}
+
+// DeductTransientBaseFeePaid removes the base fees distributed by an EVM
+// transaction, e.g. the developer share of x/revenue, from the base fees paid
+// of the transient store, so that they are not burned at the end of the block.
+func (k Keeper) DeductTransientBaseFeePaid(ctx sdk.Context, amount sdkmath.Int) {
+	store := ctx.TransientStore(k.transientKey)
+	baseFeePaid := k.GetTransientBaseFeePaid(ctx)
+	setInt(store, types.KeyTransientBaseFeePaid, baseFeePaid.Sub(sdkmath.MinInt(baseFeePaid, amount)))
+}
+
+// GetBurnedBaseFee returns the cumulative base fees burned from the store.
+func (k Keeper) GetBurnedBaseFee(ctx sdk.Context) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	return getInt(store.Get(types.KeyBurnedBaseFee))
+}
+
+// SetBurnedBaseFee sets the cumulative base fees burned to the store.
+func (k Keeper) SetBurnedBaseFee(ctx sdk.Context, amount sdkmath.Int) {
+	setInt(ctx.KVStore(k.storeKey), types.KeyBurnedBaseFee, amount)
+}
+
+// GetBlockBurnedBaseFee returns the base fees burned at the end of the last
+// block from the store.
+func (k Keeper) GetBlockBurnedBaseFee(ctx sdk.Context) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	return getInt(store.Get(types.KeyBlockBurnedBaseFee))
+}
+
+// SetBlockBurnedBaseFee sets the base fees burned at the end of the block to
+// the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockBurnedBaseFee(ctx sdk.Context, amount sdkmath.Int) {
+	setInt(ctx.KVStore(k.storeKey), types.KeyBlockBurnedBaseFee, amount)
+}
+
+// getInt decodes an amount, returning zero if it is not set.
+func getInt(bz []byte) sdkmath.Int {
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal amount: %w", err))
+	}
+
+	return amount
+}
+
+// setInt stores an amount, deleting the key if it is zero.
+func setInt(store storetypes.KVStore, key []byte, amount sdkmath.Int) {
+	if amount.IsNil() || amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal amount: %w", err))
+	}
+
+	store.Set(key, bz)
+}
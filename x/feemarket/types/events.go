@@ -2,7 +2,9 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket   = "fee_market"
+	EventTypeBurnBaseFee = "burn_base_fee"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee     = "base_fee"
+	AttributeKeyBaseFeePaid = "base_fee_paid"
 )
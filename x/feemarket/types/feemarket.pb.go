@@ -25,6 +25,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines the algorithms adjusting the base fee from the gas
+// of the parent block.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_GAS_WANTED applies the EIP-1559 adjustment to the gas
+	// wanted of the parent block, bounded below by min_gas_multiplier times the
+	// gas wanted and by the gas used.
+	BaseFeeAlgorithmGasWanted BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_EIP1559 applies the EIP-1559 adjustment to the gas used
+	// of the parent block.
+	BaseFeeAlgorithmEIP1559 BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_EXPONENTIAL multiplies the base fee by
+	// e^((gas_used - gas_target) / (gas_target * base_fee_change_denominator)),
+	// the EIP-4844 blob fee adjustment, using the gas used of the parent block.
+	BaseFeeAlgorithmExponential BaseFeeAlgorithm = 2
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_GAS_WANTED",
+	1: "BASE_FEE_ALGORITHM_EIP1559",
+	2: "BASE_FEE_ALGORITHM_EXPONENTIAL",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_GAS_WANTED":  0,
+	"BASE_FEE_ALGORITHM_EIP1559":     1,
+	"BASE_FEE_ALGORITHM_EXPONENTIAL": 2,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -46,6 +84,14 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_algorithm selects how the base fee is adjusted between blocks.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,9,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=cosmos.evm.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// max_base_fee caps the base fee. A zero value disables the cap.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee"`
+	// base_fee_burn_fraction is the fraction of the base fee portion of the EVM
+	// transaction fees that is burned at the end of the block. The priority fees
+	// are always paid to the validators. A zero value disables the burn.
+	BaseFeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=base_fee_burn_fraction,json=baseFeeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,7 +155,15 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmGasWanted
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
 }
 
@@ -118,34 +172,47 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0xf9, 0x0d, 0x03, 0x7c, 0xf2, 0x37, 0xa5, 0xc5, 0x4d, 0x84, 0xb1, 0xe8, 0xa2, 0x29,
+	0x52, 0x6d, 0x51, 0xc4, 0xa2, 0xad, 0x2a, 0x35, 0x81, 0x10, 0x40, 0x01, 0x22, 0x83, 0x4a, 0x55,
+	0xa9, 0xb2, 0xc6, 0xe6, 0xe2, 0x8c, 0xc8, 0xcc, 0x44, 0xf6, 0x24, 0x0d, 0x7d, 0x82, 0x2a, 0xab,
+	0xbe, 0x00, 0xab, 0x6e, 0xba, 0xe4, 0x31, 0x58, 0xb2, 0xac, 0xaa, 0x0a, 0x55, 0xb0, 0x60, 0xd3,
+	0x87, 0xa8, 0xb0, 0xf3, 0xd7, 0x94, 0x2e, 0xb2, 0x89, 0x6e, 0xee, 0xb9, 0xe7, 0xe8, 0xdc, 0x9f,
+	0x31, 0x7a, 0xec, 0x89, 0x90, 0x89, 0xd0, 0x82, 0x3a, 0xb3, 0x8e, 0x00, 0x18, 0x09, 0x8e, 0x41,
+	0x5a, 0xf5, 0xa5, 0xee, 0x1f, 0xb3, 0x1a, 0x08, 0x29, 0xf0, 0x6c, 0x5c, 0x68, 0x42, 0x9d, 0x99,
+	0x5d, 0xac, 0xbe, 0x94, 0xfa, 0x9f, 0x30, 0xca, 0x85, 0x15, 0xfd, 0xc6, 0xb5, 0xa9, 0x19, 0x5f,
+	0xf8, 0x22, 0x0a, 0xad, 0xdb, 0x28, 0xce, 0x2e, 0xfc, 0x1a, 0x45, 0x63, 0x25, 0x12, 0x10, 0x16,
+	0x62, 0x1d, 0x4d, 0x72, 0xe1, 0xb8, 0x24, 0x04, 0xe7, 0x08, 0x40, 0x53, 0x0c, 0x25, 0x93, 0xb4,
+	0x27, 0xb8, 0xc8, 0x91, 0x10, 0xd6, 0x01, 0xf0, 0x2b, 0x94, 0x6e, 0x83, 0x8e, 0x57, 0x26, 0xdc,
+	0x07, 0xe7, 0x10, 0xb8, 0x60, 0x94, 0x13, 0x29, 0x02, 0x6d, 0xc8, 0x50, 0x32, 0xd3, 0xb6, 0xe6,
+	0xc6, 0xd5, 0xab, 0x51, 0xc1, 0x5a, 0x17, 0xc7, 0xcb, 0xe8, 0x3e, 0x54, 0x48, 0x28, 0xa9, 0x47,
+	0xe5, 0x89, 0xc3, 0x6a, 0x15, 0x49, 0xab, 0x15, 0x0a, 0x81, 0x36, 0x1c, 0x11, 0x67, 0xba, 0xe0,
+	0x76, 0x07, 0xc3, 0x8f, 0xd0, 0x34, 0x70, 0xe2, 0x56, 0xc0, 0x29, 0x03, 0xf5, 0xcb, 0x52, 0x1b,
+	0x35, 0x94, 0xcc, 0xb0, 0x3d, 0x15, 0x27, 0x37, 0xa2, 0x1c, 0x5e, 0x45, 0xc9, 0x8e, 0xeb, 0x31,
+	0x43, 0xc9, 0x4c, 0xe4, 0x32, 0xe7, 0x97, 0xf3, 0x89, 0xef, 0x97, 0xf3, 0xe9, 0x78, 0x3e, 0xe1,
+	0xe1, 0xb1, 0x49, 0x85, 0xc5, 0x88, 0x2c, 0x9b, 0x45, 0xf0, 0x89, 0x77, 0xb2, 0x06, 0xde, 0xd7,
+	0x9b, 0xb3, 0x45, 0xc5, 0x1e, 0x6f, 0xf9, 0xc5, 0x45, 0x34, 0xcd, 0x28, 0x77, 0x7c, 0x12, 0x3a,
+	0xd5, 0x80, 0x7a, 0xa0, 0x8d, 0x0f, 0xa8, 0x34, 0xc9, 0x28, 0x2f, 0x90, 0xb0, 0x74, 0x4b, 0xc6,
+	0x6f, 0x10, 0x6e, 0xab, 0xf5, 0x74, 0x9a, 0x1c, 0x50, 0x52, 0x8d, 0x25, 0x7b, 0xe6, 0x71, 0x80,
+	0x70, 0x67, 0x07, 0xa4, 0xe2, 0x8b, 0x80, 0xca, 0x32, 0xd3, 0x26, 0x0c, 0x25, 0xf3, 0xdf, 0xb3,
+	0x27, 0xe6, 0x3f, 0xae, 0xc1, 0x6c, 0x6d, 0x30, 0xdb, 0x26, 0xd8, 0xaa, 0xdb, 0x97, 0xc1, 0x5b,
+	0x68, 0x8a, 0x91, 0x46, 0x77, 0xfb, 0x68, 0x40, 0xab, 0x88, 0x91, 0x46, 0xfb, 0x50, 0xde, 0xa3,
+	0x07, 0x1d, 0x93, 0x6e, 0x2d, 0xe0, 0xce, 0x51, 0x40, 0x3c, 0x49, 0x05, 0xd7, 0x26, 0x07, 0x54,
+	0xbd, 0xd7, 0xf2, 0x99, 0xab, 0x05, 0x7c, 0xbd, 0x25, 0xf2, 0x62, 0xa1, 0x79, 0x73, 0xb6, 0x38,
+	0xd7, 0xf3, 0x44, 0x1a, 0x3d, 0x8f, 0x24, 0xbe, 0xe5, 0xad, 0x91, 0xe4, 0x88, 0x3a, 0x6a, 0xab,
+	0x94, 0x53, 0x49, 0x49, 0xa5, 0xd3, 0xd6, 0xe2, 0x0f, 0x05, 0xa9, 0xfd, 0xd3, 0xc0, 0xaf, 0xd1,
+	0x5c, 0x2e, 0xbb, 0x97, 0x77, 0xd6, 0xf3, 0x79, 0x27, 0x5b, 0x2c, 0xec, 0xda, 0x9b, 0xfb, 0x1b,
+	0xdb, 0x4e, 0x21, 0xbb, 0xe7, 0x1c, 0x64, 0x77, 0xf6, 0xf3, 0x6b, 0x6a, 0x22, 0x35, 0xd7, 0x3c,
+	0x35, 0x1e, 0xf6, 0x13, 0x0b, 0x24, 0x3c, 0x20, 0x5c, 0xc2, 0x21, 0x7e, 0x89, 0x52, 0x77, 0x28,
+	0xe4, 0x37, 0x4b, 0x4b, 0x2b, 0x2b, 0xcf, 0x55, 0x25, 0x95, 0x6e, 0x9e, 0x1a, 0xb3, 0xfd, 0xf4,
+	0x16, 0x8c, 0x57, 0x91, 0x7e, 0x17, 0xf9, 0x6d, 0x69, 0x77, 0x27, 0xbf, 0xb3, 0xbf, 0x99, 0x2d,
+	0xaa, 0x43, 0xa9, 0xf9, 0xe6, 0xa9, 0x91, 0xfe, 0x4b, 0xa0, 0x51, 0x15, 0x1c, 0xf8, 0x6d, 0x87,
+	0xa9, 0x91, 0x4f, 0x5f, 0xf4, 0x44, 0xae, 0x70, 0x7e, 0xa5, 0x2b, 0x17, 0x57, 0xba, 0xf2, 0xf3,
+	0x4a, 0x57, 0x3e, 0x5f, 0xeb, 0x89, 0x8b, 0x6b, 0x3d, 0xf1, 0xed, 0x5a, 0x4f, 0xbc, 0x7b, 0xea,
+	0x53, 0x59, 0xae, 0xb9, 0xa6, 0x27, 0x98, 0xf5, 0x11, 0x38, 0xe1, 0x20, 0x3f, 0x88, 0xe0, 0x38,
+	0x8a, 0xff, 0x18, 0xa0, 0x3c, 0xa9, 0x42, 0xe8, 0x8e, 0x45, 0x5f, 0x87, 0xe5, 0xdf, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0xa2, 0x9e, 0x64, 0x9f, 0x8a, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +235,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFeeBurnFraction.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -261,6 +353,13 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeBurnFraction.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
 	return n
 }
 
@@ -478,6 +577,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,12 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// block_gas_used is the amount of gas used on the last block before the
+	// upgrade. Zero by default.
+	BlockGasUsed uint64 `protobuf:"varint,4,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+	// burned_base_fee is the cumulative amount of base fees burned, in the 18
+	// decimals representation of the EVM coin.
+	BurnedBaseFee cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=burned_base_fee,json=burnedBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"burned_base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +87,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.feemarket.v1.GenesisState")
 }
@@ -89,24 +103,29 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
-	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x3b, 0x82, 0x04, 0x2a, 0xfe, 0x35, 0x1a, 0x1b, 0x4c, 0x0a, 0x21, 0x1a, 0x89, 0x89,
+	0x33, 0x41, 0xdf, 0xa0, 0x0b, 0x89, 0xac, 0x0c, 0xc6, 0x8d, 0x9b, 0x66, 0x4a, 0x2f, 0xa5, 0xa9,
+	0xd3, 0x21, 0x9d, 0xa1, 0xfe, 0x3c, 0x85, 0x8f, 0xe1, 0xd2, 0xc7, 0x60, 0xc9, 0xd2, 0xb8, 0x20,
+	0x06, 0x16, 0xae, 0x7d, 0x03, 0xc3, 0x8c, 0xa0, 0x1b, 0x36, 0x93, 0x93, 0x3b, 0xdf, 0xb9, 0xf7,
+	0xe4, 0x98, 0xc7, 0x5d, 0x2e, 0x18, 0x17, 0x04, 0x32, 0x46, 0x7a, 0x00, 0x8c, 0xa6, 0x31, 0x48,
+	0x92, 0x35, 0x49, 0x08, 0x09, 0x88, 0x48, 0xe0, 0x41, 0xca, 0x25, 0xb7, 0x0e, 0x34, 0x86, 0x21,
+	0x63, 0x78, 0x89, 0xe1, 0xac, 0x59, 0xd9, 0xa5, 0x2c, 0x4a, 0x38, 0x51, 0xaf, 0x66, 0x2b, 0x27,
+	0xab, 0x56, 0xfe, 0x19, 0x35, 0xb8, 0x17, 0xf2, 0x90, 0x2b, 0x49, 0xe6, 0x4a, 0x4f, 0xeb, 0xdf,
+	0xc8, 0x2c, 0xb7, 0xf4, 0xf1, 0x1b, 0x49, 0x25, 0x58, 0xae, 0x59, 0x18, 0xd0, 0x94, 0x32, 0x61,
+	0xa3, 0x1a, 0x6a, 0x6c, 0x9c, 0x57, 0xf1, 0x8a, 0x30, 0xf8, 0x5a, 0x61, 0x6e, 0x69, 0x34, 0xa9,
+	0x1a, 0xaf, 0x5f, 0x6f, 0xa7, 0xa8, 0xf3, 0xeb, 0xb4, 0x0e, 0xcd, 0x92, 0x7f, 0xcf, 0xbb, 0xb1,
+	0x17, 0x52, 0x61, 0xe7, 0x6a, 0xa8, 0x91, 0xef, 0x14, 0xd5, 0xa0, 0x45, 0x85, 0x75, 0x64, 0x6e,
+	0x2d, 0x3f, 0xbd, 0xa1, 0x80, 0xc0, 0xce, 0x2b, 0xa2, 0xbc, 0x20, 0x6e, 0x05, 0x04, 0x56, 0xdb,
+	0xdc, 0xf6, 0x87, 0x69, 0x02, 0x81, 0xe7, 0x53, 0x01, 0x5e, 0x0f, 0xc0, 0x5e, 0xaf, 0xa1, 0x46,
+	0xc9, 0xad, 0xcf, 0xcf, 0x7d, 0x4c, 0xaa, 0xfb, 0x3a, 0x96, 0x08, 0x62, 0x1c, 0x71, 0xc2, 0xa8,
+	0xec, 0xe3, 0xab, 0x44, 0xea, 0x1c, 0x9b, 0xda, 0xea, 0x52, 0x01, 0x97, 0x00, 0xed, 0x7c, 0x71,
+	0x6d, 0x27, 0xd7, 0x29, 0x2e, 0x16, 0xb9, 0xad, 0xd1, 0xd4, 0x41, 0xe3, 0xa9, 0x83, 0x3e, 0xa7,
+	0x0e, 0x7a, 0x99, 0x39, 0xc6, 0x78, 0xe6, 0x18, 0xef, 0x33, 0xc7, 0xb8, 0x3b, 0x0b, 0x23, 0xd9,
+	0x1f, 0xfa, 0xb8, 0xcb, 0x19, 0x79, 0x86, 0x84, 0x26, 0x20, 0x1f, 0x78, 0x1a, 0x2b, 0x4d, 0x1e,
+	0xff, 0xf5, 0x2b, 0x9f, 0x06, 0x20, 0xfc, 0x82, 0xea, 0xf0, 0xe2, 0x27, 0x00, 0x00, 0xff, 0xff,
+	0x17, 0xe5, 0xd4, 0xd5, 0xd7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +148,21 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BurnedBaseFee.Size()
+		i -= size
+		if _, err := m.BurnedBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +203,11 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockGasUsed))
+	}
+	l = m.BurnedBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -259,6 +298,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
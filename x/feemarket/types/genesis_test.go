@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
 )
 
 type GenesisTestSuite struct {
@@ -30,6 +32,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				uint64(1),
+				math.NewInt(1),
 			},
 			true,
 		},
@@ -41,6 +45,14 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"invalid: negative burned base fee",
+			&GenesisState{
+				Params:        DefaultParams(),
+				BurnedBaseFee: math.NewInt(-1),
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
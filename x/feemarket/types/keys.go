@@ -20,18 +20,26 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockGasUsed
+	prefixBurnedBaseFee
+	prefixBlockBurnedBaseFee
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBaseFeePaid
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasUsed   = []byte{prefixBlockGasUsed}
+	KeyBurnedBaseFee        = []byte{prefixBurnedBaseFee}
+	KeyBlockBurnedBaseFee   = []byte{prefixBlockBurnedBaseFee}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyTransientBaseFeePaid          = []byte{prefixTransientBaseFeePaid}
 )
@@ -19,6 +19,12 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeAlgorithm is the EIP-1559 adjustment over the gas wanted
+	DefaultBaseFeeAlgorithm = BaseFeeAlgorithmGasWanted
+	// DefaultMaxBaseFee is 0 (i.e disabled)
+	DefaultMaxBaseFee = math.LegacyZeroDec()
+	// DefaultBaseFeeBurnFraction is 0 (i.e disabled)
+	DefaultBaseFeeBurnFraction = math.LegacyZeroDec()
 
 	ParamsKey = []byte("Params")
 )
@@ -54,6 +60,9 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		BaseFeeBurnFraction:      DefaultBaseFeeBurnFraction,
 	}
 }
 
@@ -79,13 +88,89 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateBaseFeeAlgorithm(p.BaseFeeAlgorithm); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateMaxBaseFee(p.MaxBaseFee, p.MinGasPrice); err != nil {
+		return err
+	}
+
+	return validateBaseFeeBurnFraction(p.BaseFeeBurnFraction)
 }
 
 func (p Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// IsMaxBaseFeeEnabled returns true if the base fee is capped.
+func (p Params) IsMaxBaseFeeEnabled() bool {
+	return !p.MaxBaseFee.IsNil() && p.MaxBaseFee.IsPositive()
+}
+
+// IsBaseFeeBurnEnabled returns true if a fraction of the base fees is burned.
+func (p Params) IsBaseFeeBurnEnabled() bool {
+	return !p.BaseFeeBurnFraction.IsNil() && p.BaseFeeBurnFraction.IsPositive()
+}
+
+// ParentBlockGas returns the gas of the parent block the base fee algorithm
+// adjusts the base fee from.
+func (p Params) ParentBlockGas(gasWanted, gasUsed uint64) uint64 {
+	if p.BaseFeeAlgorithm == BaseFeeAlgorithmGasWanted {
+		return gasWanted
+	}
+
+	return gasUsed
+}
+
+func validateBaseFeeAlgorithm(algorithm BaseFeeAlgorithm) error {
+	if _, ok := BaseFeeAlgorithm_name[int32(algorithm)]; !ok {
+		return fmt.Errorf("invalid base fee algorithm: %d", algorithm)
+	}
+
+	return nil
+}
+
+// validateMaxBaseFee validates the base fee cap, where a nil or zero value
+// disables the cap.
+func validateMaxBaseFee(maxBaseFee, minGasPrice math.LegacyDec) error {
+	if maxBaseFee.IsNil() || maxBaseFee.IsZero() {
+		return nil
+	}
+
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", maxBaseFee)
+	}
+
+	if maxBaseFee.LT(minGasPrice) {
+		return fmt.Errorf("max base fee %s cannot be lower than the min gas price %s", maxBaseFee, minGasPrice)
+	}
+
+	return nil
+}
+
+// validateBaseFeeBurnFraction validates the fraction of the base fees burned,
+// where a nil or zero value disables the burn.
+func validateBaseFeeBurnFraction(fraction math.LegacyDec) error {
+	if fraction.IsNil() {
+		return nil
+	}
+
+	if fraction.IsNegative() {
+		return fmt.Errorf("base fee burn fraction cannot be negative: %s", fraction)
+	}
+
+	if fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn fraction cannot be greater than 1: %s", fraction)
+	}
+
+	return nil
+}
+
 func validateMinGasPrice(gasPrice math.LegacyDec) error {
 	if gasPrice.IsNil() {
 		return fmt.Errorf("invalid parameter: nil")
@@ -73,6 +73,97 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, math.LegacyNewDec(2000000000), int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: exponential base fee algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmExponential
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: unknown base fee algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithm(3)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: max base fee",
+			func() Params {
+				p := DefaultParams()
+				p.MaxBaseFee = math.LegacyNewDec(5_000_000_000)
+				return p
+			}(),
+			false,
+		},
+		{
+			"valid: nil max base fee",
+			func() Params {
+				p := DefaultParams()
+				p.MaxBaseFee = math.LegacyDec{}
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: max base fee negative",
+			func() Params {
+				p := DefaultParams()
+				p.MaxBaseFee = math.LegacyNewDec(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: max base fee lower than min gas price",
+			func() Params {
+				p := DefaultParams()
+				p.MinGasPrice = math.LegacyNewDec(10)
+				p.MaxBaseFee = math.LegacyNewDec(5)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: base fee burn fraction",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeBurnFraction = math.LegacyOneDec()
+				return p
+			}(),
+			false,
+		},
+		{
+			"valid: nil base fee burn fraction",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeBurnFraction = math.LegacyDec{}
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: base fee burn fraction negative",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeBurnFraction = math.LegacyNewDecWithPrec(-1, 1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: base fee burn fraction greater than 1",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeBurnFraction = math.LegacyNewDecWithPrec(11, 1)
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -156,6 +157,10 @@ var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
 type QueryBaseFeeResponse struct {
 	// base_fee is the EIP1559 base fee
 	BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee,omitempty"`
+	// algorithm is the algorithm adjusting the base fee between blocks
+	Algorithm BaseFeeAlgorithm `protobuf:"varint,2,opt,name=algorithm,proto3,enum=cosmos.evm.feemarket.v1.BaseFeeAlgorithm" json:"algorithm,omitempty"`
+	// max_base_fee is the cap of the base fee, zero if disabled
+	MaxBaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee,omitempty"`
 }
 
 func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
@@ -191,6 +196,13 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
+func (m *QueryBaseFeeResponse) GetAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return BaseFeeAlgorithmGasWanted
+}
+
 // QueryBlockGasRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBlockGasRequest struct {
@@ -231,8 +243,11 @@ var xxx_messageInfo_QueryBlockGasRequest proto.InternalMessageInfo
 
 // QueryBlockGasResponse returns block gas used for a given height.
 type QueryBlockGasResponse struct {
-	// gas is the returned block gas
+	// gas is the returned block gas wanted, bounded below by the min gas
+	// multiplier and the gas used
 	Gas int64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// gas_used is the gas actually used by the block
+	GasUsed int64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
@@ -275,6 +290,109 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+func (m *QueryBlockGasResponse) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// QueryBurnedBaseFeeRequest defines the request type for querying the burned
+// base fees.
+type QueryBurnedBaseFeeRequest struct {
+}
+
+func (m *QueryBurnedBaseFeeRequest) Reset()         { *m = QueryBurnedBaseFeeRequest{} }
+func (m *QueryBurnedBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBurnedBaseFeeResponse returns the burned base fees, in the 18 decimals
+// representation of the EVM coin.
+type QueryBurnedBaseFeeResponse struct {
+	// block is the amount burned at the end of the block
+	Block types.Coin `protobuf:"bytes,1,opt,name=block,proto3" json:"block"`
+	// total is the cumulative amount burned since genesis
+	Total types.Coin `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	// fraction is the fraction of the base fees burned
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+}
+
+func (m *QueryBurnedBaseFeeResponse) Reset()         { *m = QueryBurnedBaseFeeResponse{} }
+func (m *QueryBurnedBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedBaseFeeResponse) GetBlock() types.Coin {
+	if m != nil {
+		return m.Block
+	}
+	return types.Coin{}
+}
+
+func (m *QueryBurnedBaseFeeResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
@@ -282,6 +400,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QueryBurnedBaseFeeRequest")
+	proto.RegisterType((*QueryBurnedBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBurnedBaseFeeResponse")
 }
 
 func init() {
@@ -289,36 +409,49 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xd0, 0x1f, 0x50, 0xe6, 0xa7, 0x46, 0x47, 0x50, 0x58, 0xcc, 0x16, 0x16, 0x13, 0xfe,
+	0x28, 0x3b, 0xb6, 0xdc, 0xbc, 0x18, 0x2a, 0x91, 0x8b, 0x07, 0x6d, 0xe2, 0x41, 0x2f, 0xcd, 0x74,
+	0xfb, 0xb2, 0x6c, 0xda, 0xdd, 0x29, 0x3b, 0xd3, 0x0a, 0x1e, 0x3d, 0x7b, 0xd0, 0x18, 0xbf, 0x83,
+	0xf1, 0xe4, 0xc7, 0xe0, 0x48, 0x62, 0x4c, 0x8c, 0x07, 0x62, 0xa8, 0x89, 0x47, 0xbf, 0x82, 0xd9,
+	0x99, 0xe9, 0x42, 0x91, 0x4d, 0xeb, 0xa5, 0x99, 0xbc, 0xf3, 0x3e, 0xef, 0xf3, 0xbc, 0xcf, 0x3c,
+	0x5b, 0xbc, 0xe4, 0x71, 0x11, 0x72, 0x41, 0xa1, 0x1b, 0xd2, 0x1d, 0x80, 0x90, 0xc5, 0x4d, 0x90,
+	0xb4, 0x5b, 0xa2, 0x7b, 0x1d, 0x88, 0x0f, 0xdc, 0x76, 0xcc, 0x25, 0x27, 0x37, 0x75, 0x93, 0x0b,
+	0xdd, 0xd0, 0x4d, 0x9b, 0xdc, 0x6e, 0xc9, 0xba, 0xc6, 0xc2, 0x20, 0xe2, 0x54, 0xfd, 0xea, 0x5e,
+	0xcb, 0x36, 0x03, 0xeb, 0x4c, 0x00, 0xed, 0x96, 0xea, 0x20, 0x59, 0x89, 0x7a, 0x3c, 0x88, 0xcc,
+	0xfd, 0x72, 0x16, 0xe1, 0xe9, 0x60, 0xdd, 0x38, 0xed, 0x73, 0x9f, 0xab, 0x23, 0x4d, 0x4e, 0xa6,
+	0x7a, 0xcb, 0xe7, 0xdc, 0x6f, 0x01, 0x65, 0xed, 0x80, 0xb2, 0x28, 0xe2, 0x92, 0xc9, 0x80, 0x47,
+	0x42, 0xdf, 0x3a, 0xd3, 0x98, 0x3c, 0x4d, 0x74, 0x3f, 0x61, 0x31, 0x0b, 0x45, 0x15, 0xf6, 0x3a,
+	0x20, 0xa4, 0xf3, 0x1c, 0x5f, 0x1f, 0xa8, 0x8a, 0x36, 0x8f, 0x04, 0x90, 0x0a, 0x9e, 0x68, 0xab,
+	0xca, 0x2c, 0x5a, 0x40, 0x2b, 0xff, 0x97, 0x8b, 0x6e, 0xc6, 0x9a, 0xae, 0x06, 0x56, 0xa6, 0x0e,
+	0x8f, 0x8b, 0xb9, 0x8f, 0xbf, 0x3e, 0xaf, 0xa1, 0xaa, 0x41, 0x3a, 0x33, 0x66, 0x74, 0x85, 0x09,
+	0x78, 0x04, 0xd0, 0x67, 0x3c, 0x46, 0x78, 0x7a, 0xb0, 0x6e, 0x38, 0xef, 0xe3, 0x42, 0x62, 0x4c,
+	0x6d, 0x07, 0x40, 0xb1, 0x4e, 0x55, 0x8a, 0xdf, 0x8f, 0x8b, 0xf3, 0x9a, 0x58, 0x34, 0x9a, 0x6e,
+	0xc0, 0x69, 0xc8, 0xe4, 0xae, 0xfb, 0x18, 0x7c, 0xe6, 0x1d, 0x6c, 0x81, 0x57, 0x9d, 0xac, 0xeb,
+	0x19, 0x64, 0x1b, 0x4f, 0xb1, 0x96, 0xcf, 0xe3, 0x40, 0xee, 0x86, 0xb3, 0x63, 0x0b, 0x68, 0xe5,
+	0x4a, 0x79, 0x35, 0x53, 0xb2, 0x21, 0xde, 0xec, 0x03, 0xaa, 0xa7, 0x58, 0xb2, 0x89, 0x2f, 0x85,
+	0x6c, 0xbf, 0x96, 0x0a, 0xc9, 0x8f, 0x26, 0x04, 0x87, 0x6c, 0xdf, 0x8c, 0x75, 0x6e, 0xf4, 0xf7,
+	0x6b, 0x71, 0xaf, 0xb9, 0xcd, 0x52, 0xab, 0xb7, 0xf0, 0xcc, 0xb9, 0xba, 0x59, 0xfc, 0x2a, 0xce,
+	0xfb, 0x4c, 0x3b, 0x9d, 0xaf, 0x26, 0x47, 0x32, 0x87, 0x0b, 0x3e, 0x13, 0xb5, 0x8e, 0x80, 0x86,
+	0xda, 0x26, 0x5f, 0x9d, 0xf4, 0x99, 0x78, 0x26, 0xa0, 0xe1, 0xcc, 0xe3, 0x39, 0x3d, 0xa5, 0x13,
+	0x47, 0xd0, 0x38, 0xe7, 0xed, 0x57, 0x84, 0xad, 0x8b, 0x6e, 0x53, 0x87, 0xc7, 0xeb, 0x09, 0xb9,
+	0x79, 0xd4, 0xb9, 0xbe, 0x43, 0xc9, 0xb6, 0xae, 0xc9, 0xa3, 0xfb, 0x90, 0x07, 0xd1, 0xd9, 0xe7,
+	0xd4, 0x90, 0x04, 0x2b, 0xb9, 0x64, 0x2d, 0xa5, 0x67, 0x64, 0xac, 0x82, 0x90, 0x07, 0xb8, 0xb0,
+	0x13, 0x33, 0x2f, 0x49, 0xa3, 0x31, 0x74, 0x29, 0xe9, 0x19, 0x66, 0x6a, 0x0a, 0x2a, 0xff, 0xfe,
+	0x0f, 0x8f, 0xab, 0xbd, 0xc8, 0x1b, 0x84, 0x27, 0x74, 0xe4, 0xc8, 0x9d, 0xcc, 0x07, 0xfe, 0x3b,
+	0xe7, 0xd6, 0xdd, 0xd1, 0x9a, 0xb5, 0x51, 0xce, 0xf2, 0xeb, 0x2f, 0x3f, 0xdf, 0x8f, 0x2d, 0x92,
+	0x22, 0xcd, 0xfa, 0x22, 0x75, 0xc6, 0xc9, 0x3b, 0x84, 0x27, 0x8d, 0xcb, 0x64, 0x08, 0xc5, 0xe0,
+	0x53, 0x59, 0xeb, 0x23, 0x76, 0x1b, 0x45, 0xab, 0x4a, 0xd1, 0x12, 0x59, 0xcc, 0x54, 0xd4, 0x8f,
+	0x2c, 0xf9, 0x80, 0x70, 0xa1, 0x9f, 0x31, 0x32, 0x8c, 0x66, 0x30, 0xa3, 0x96, 0x3b, 0x6a, 0xbb,
+	0x91, 0xb5, 0xa6, 0x64, 0xdd, 0x26, 0x4e, 0xb6, 0xac, 0x04, 0x52, 0x4b, 0x42, 0xfd, 0x09, 0xe1,
+	0xcb, 0x03, 0xb9, 0x24, 0xe5, 0x21, 0x6c, 0x17, 0x44, 0xdc, 0xda, 0xf8, 0x27, 0x8c, 0x91, 0x79,
+	0x4f, 0xc9, 0x5c, 0x23, 0x2b, 0xd9, 0x32, 0x15, 0x2e, 0xfd, 0xee, 0x2b, 0xdb, 0x87, 0x27, 0x36,
+	0x3a, 0x3a, 0xb1, 0xd1, 0x8f, 0x13, 0x1b, 0xbd, 0xed, 0xd9, 0xb9, 0xa3, 0x9e, 0x9d, 0xfb, 0xd6,
+	0xb3, 0x73, 0x2f, 0xd6, 0xfd, 0x40, 0xee, 0x76, 0xea, 0xae, 0xc7, 0x43, 0xfa, 0x0a, 0x22, 0x16,
+	0x81, 0x7c, 0xc9, 0xe3, 0xa6, 0x3a, 0xd3, 0xfd, 0x33, 0x53, 0xe5, 0x41, 0x1b, 0x44, 0x7d, 0x42,
+	0xfd, 0xfb, 0x6e, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x3b, 0x28, 0x29, 0x4d, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +472,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the base fees burned at the end of the block and
+	// since genesis.
+	BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +512,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error) {
+	out := new(QueryBurnedBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +529,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the base fees burned at the end of the block and
+	// since genesis.
+	BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +547,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedBaseFee(ctx context.Context, req *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +609,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBaseFee(ctx, req.(*QueryBurnedBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +643,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedBaseFee",
+			Handler:    _Query_BurnedBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -578,6 +751,23 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBaseFee != nil {
+		{
+			size := m.MaxBaseFee.Size()
+			i -= size
+			if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.BaseFee != nil {
 		{
 			size := m.BaseFee.Size()
@@ -636,6 +826,11 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Gas != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
 		i--
@@ -644,6 +839,82 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -694,6 +965,13 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 		l = m.BaseFee.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Algorithm != 0 {
+		n += 1 + sovQuery(uint64(m.Algorithm))
+	}
+	if m.MaxBaseFee != nil {
+		l = m.MaxBaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -715,6 +993,33 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	if m.Gas != 0 {
 		n += 1 + sovQuery(uint64(m.Gas))
 	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *QueryBurnedBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Block.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -972,6 +1277,61 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxBaseFee = &v
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1091,6 +1451,225 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
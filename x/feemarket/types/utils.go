@@ -1,6 +1,33 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// CalcBaseFee calculates the base fee of a block from the gas of its parent
+// block according to the base fee algorithm. The result is capped by
+// maxBaseFee if it is positive.
+func CalcBaseFee(
+	algorithm BaseFeeAlgorithm,
+	parentGas, gasTarget, baseFeeChangeDenom uint64,
+	parentBaseFee, minUnitGas, minGasPrice, maxBaseFee math.LegacyDec,
+) math.LegacyDec {
+	var baseFee math.LegacyDec
+	switch algorithm {
+	case BaseFeeAlgorithmExponential:
+		baseFee = CalcExponentialBaseFee(parentGas, gasTarget, baseFeeChangeDenom, parentBaseFee, minUnitGas, minGasPrice)
+	default:
+		baseFee = CalcGasBaseFee(parentGas, gasTarget, baseFeeChangeDenom, parentBaseFee, minUnitGas, minGasPrice)
+	}
+
+	if !maxBaseFee.IsNil() && maxBaseFee.IsPositive() {
+		return math.LegacyMinDec(baseFee, maxBaseFee)
+	}
+
+	return baseFee
+}
 
 func CalcGasBaseFee(gasUsed, gasTarget, baseFeeChangeDenom uint64, baseFee, minUnitGas, minGasPrice math.LegacyDec) math.LegacyDec {
 	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
@@ -28,3 +55,58 @@ func CalcGasBaseFee(gasUsed, gasTarget, baseFeeChangeDenom uint64, baseFee, minU
 	// max(minGasPrice, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
 	return math.LegacyMaxDec(baseFee.Sub(num), minGasPrice)
 }
+
+// CalcExponentialBaseFee calculates the base fee as in the EIP-4844 blob fee
+// adjustment, multiplying the parent base fee by
+// e^((gasUsed - gasTarget) / (gasTarget * baseFeeChangeDenom)). The exponent is
+// bounded to [-1, 1], so the base fee changes at most by a factor of e between
+// blocks.
+func CalcExponentialBaseFee(gasUsed, gasTarget, baseFeeChangeDenom uint64, baseFee, minUnitGas, minGasPrice math.LegacyDec) math.LegacyDec {
+	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
+	if gasUsed == gasTarget {
+		return baseFee
+	}
+
+	if gasTarget == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	numerator := new(big.Int).SetUint64(gasUsed)
+	numerator.Sub(numerator, new(big.Int).SetUint64(gasTarget)).Abs(numerator)
+	denominator := new(big.Int).Mul(new(big.Int).SetUint64(gasTarget), new(big.Int).SetUint64(baseFeeChangeDenom))
+	if numerator.Cmp(denominator) > 0 {
+		numerator = denominator
+	}
+
+	factor := math.LegacyNewDecFromBigIntWithPrec(
+		fakeExponential(math.LegacyOneDec().BigInt(), numerator, denominator),
+		math.LegacyPrecision,
+	)
+
+	if gasUsed > gasTarget {
+		// If the parent block used more gas than its target, the baseFee should increase.
+		// max(parentBaseFee + minUnitGas, parentBaseFee * e^(gasUsedDelta / parentGasTarget / baseFeeChangeDenominator))
+		return math.LegacyMaxDec(baseFee.Mul(factor), baseFee.Add(minUnitGas))
+	}
+
+	// Otherwise if the parent block used less gas than its target, the baseFee should decrease.
+	// max(minGasPrice, parentBaseFee / e^(gasUsedDelta / parentGasTarget / baseFeeChangeDenominator))
+	return math.LegacyMaxDec(baseFee.Quo(factor), minGasPrice)
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using
+// Taylor expansion, as defined in EIP-4844.
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	var (
+		output = new(big.Int)
+		accum  = new(big.Int).Mul(factor, denominator)
+	)
+	for i := 1; accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+
+		accum.Mul(accum, numerator)
+		accum.Div(accum, denominator)
+		accum.Div(accum, big.NewInt(int64(i)))
+	}
+	return output.Div(output, denominator)
+}
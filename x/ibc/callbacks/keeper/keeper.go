@@ -109,7 +109,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	contractAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalTransferPacketData(packet.GetData(), version)
 	if err != nil {
 		return err
 	}
@@ -253,7 +253,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 // allowing contracts to react to successful or failed packet delivery.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data (ICS20 or ICS27 packet data)
 // 2. Extracts callback data from the packet (source-side callback)
 // 3. Validates that no calldata is present (acknowledgement callbacks should not contain calldata)
 // 4. Verifies the target contract exists and contains code
@@ -283,7 +283,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalSourcePacketData(packet.GetSourcePort(), packet.GetData(), version)
 	if err != nil {
 		return err
 	}
@@ -353,7 +353,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 // allowing contracts to handle timeout scenarios and perform cleanup or rollback operations.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data (ICS20 or ICS27 packet data)
 // 2. Extracts callback data from the packet (source-side callback)
 // 3. Validates that no calldata is present (timeout callbacks should not contain calldata)
 // 4. Sets up a cached context with proper gas metering for EVM execution
@@ -384,7 +384,7 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalSourcePacketData(packet.GetSourcePort(), packet.GetData(), version)
 	if err != nil {
 		return err
 	}
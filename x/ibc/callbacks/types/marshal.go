@@ -1,8 +1,14 @@
 package types
 
 import (
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+
+	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,3 +29,46 @@ func (u Unmarshaler) UnmarshalPacketData(ctx sdk.Context, portID, channelID stri
 	}
 	return transferData, transfertypes.V1, nil
 }
+
+// supportedEncodings lists the ICS20 payload encodings in the order they are
+// tried when decoding packet data of unknown encoding.
+var supportedEncodings = []string{
+	transfertypes.EncodingJSON,
+	transfertypes.EncodingProtobuf,
+	transfertypes.EncodingABI,
+}
+
+// UnmarshalTransferPacketData unmarshals ICS20 packet data of unknown encoding.
+// IBC v1 packets are always JSON encoded, whereas IBC v2 payloads can also be
+// protobuf or ABI encoded. The v2 callbacks middleware only forwards the raw
+// payload value to the contract keeper, so every supported encoding is tried
+// in turn and the error of the JSON attempt is returned if none succeeds.
+func UnmarshalTransferPacketData(bz []byte, version string) (transfertypes.InternalTransferRepresentation, error) {
+	var firstErr error
+	for _, encoding := range supportedEncodings {
+		data, err := transfertypes.UnmarshalPacketData(bz, version, encoding)
+		if err == nil {
+			return data, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return transfertypes.InternalTransferRepresentation{}, firstErr
+}
+
+// UnmarshalSourcePacketData unmarshals the data of a packet sent by this chain,
+// as passed to the source callbacks. Packets sent from an interchain accounts
+// controller port carry ICS27 packet data, while every other packet is expected
+// to carry ICS20 packet data.
+func UnmarshalSourcePacketData(sourcePort string, bz []byte, version string) (any, error) {
+	if !strings.HasPrefix(sourcePort, icatypes.ControllerPortPrefix) {
+		return UnmarshalTransferPacketData(bz, version)
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS27 interchain account packet data: %v", err)
+	}
+	return data, nil
+}
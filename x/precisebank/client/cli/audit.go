@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zenanetwork/zena/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// auditPageLimit is the number of fractional balances fetched per page.
+const auditPageLimit = 1000
+
+// AuditReport is the result of auditing the x/precisebank state at a height.
+type AuditReport struct {
+	// Height is the height the state was audited at.
+	Height int64 `json:"height"`
+	// Ok is true if no discrepancy was found for any extended denom.
+	Ok bool `json:"ok"`
+	// Denoms are the audit results of every extended denom.
+	Denoms []DenomAudit `json:"denoms"`
+}
+
+// DenomAudit is the audit result of a single extended denom.
+type DenomAudit struct {
+	ExtendedDenom    string      `json:"extended_denom"`
+	IntegerDenom     string      `json:"integer_denom"`
+	ConversionFactor sdkmath.Int `json:"conversion_factor"`
+	// Accounts is the number of accounts with a non-zero fractional balance.
+	Accounts uint64 `json:"accounts"`
+	// FractionalBalances is the sum of all fractional balances.
+	FractionalBalances sdkmath.Int `json:"fractional_balances"`
+	Remainder          sdkmath.Int `json:"remainder"`
+	// Reserve is the integer balance of the reserve account.
+	Reserve sdkmath.Int `json:"reserve"`
+	// Discrepancy is the reserve balance in extended units minus the sum of
+	// the fractional balances and the remainder. It is zero if the reserve
+	// exactly backs the fractional balances.
+	Discrepancy sdkmath.Int `json:"discrepancy"`
+	// InvalidBalances lists the fractional balances that are out of range.
+	InvalidBalances []string `json:"invalid_balances,omitempty"`
+	// InvalidRemainder is set if the remainder is out of range.
+	InvalidRemainder string `json:"invalid_remainder,omitempty"`
+}
+
+// Ok returns true if no discrepancy was found for the extended denom.
+func (da DenomAudit) Ok() bool {
+	return da.Discrepancy.IsZero() && len(da.InvalidBalances) == 0 && da.InvalidRemainder == ""
+}
+
+// GetAuditCmd audits the fractional balances of all extended denoms
+func GetAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit the fractional balances against the reserve",
+		Long: `Walk all fractional balances of every extended denom at a height and check
+that their sum plus the remainder equals the integer balance of the reserve
+account times the conversion factor. All queries are pinned to the same height,
+which defaults to the latest one and can be set with --height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Pin all subsequent queries to the height of the first one
+			var header metadata.MD
+			paramsRes, err := queryClient.Params(ctx, &types.QueryParamsRequest{}, grpc.Header(&header))
+			if err != nil {
+				return err
+			}
+
+			height := clientCtx.Height
+			if values := header.Get(grpctypes.GRPCBlockHeightHeader); len(values) == 1 {
+				height, err = strconv.ParseInt(values[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("failed to parse block height: %w", err)
+				}
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
+			queryClient = types.NewQueryClient(clientCtx)
+			bankClient := banktypes.NewQueryClient(clientCtx)
+
+			reserveAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+
+			denoms := append(
+				[]types.ExtendedDenom{paramsRes.EvmExtendedDenom},
+				paramsRes.Params.ExtendedDenoms...,
+			)
+
+			report := AuditReport{
+				Height: height,
+				Ok:     true,
+			}
+
+			for _, ed := range denoms {
+				audit := DenomAudit{
+					ExtendedDenom:      ed.ExtendedDenom,
+					IntegerDenom:       ed.IntegerDenom,
+					ConversionFactor:   ed.ConversionFactor,
+					FractionalBalances: sdkmath.ZeroInt(),
+				}
+
+				pageReq := &query.PageRequest{Limit: auditPageLimit}
+				for {
+					res, err := queryClient.FractionalBalances(ctx, &types.QueryFractionalBalancesRequest{
+						Denom:      ed.ExtendedDenom,
+						Pagination: pageReq,
+					})
+					if err != nil {
+						return err
+					}
+
+					for _, bal := range res.Balances {
+						audit.Accounts++
+						audit.FractionalBalances = audit.FractionalBalances.Add(bal.Amount)
+
+						if err := ed.ValidateFractionalAmount(bal.Amount); err != nil {
+							audit.InvalidBalances = append(audit.InvalidBalances, fmt.Sprintf("%s: %s", bal.Address, err))
+						}
+					}
+
+					if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+						break
+					}
+
+					pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: auditPageLimit}
+				}
+
+				remainderRes, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{
+					Denom: ed.ExtendedDenom,
+				})
+				if err != nil {
+					return err
+				}
+
+				audit.Remainder = remainderRes.Remainder.Amount
+				if !audit.Remainder.IsZero() {
+					if err := ed.ValidateFractionalAmount(audit.Remainder); err != nil {
+						audit.InvalidRemainder = err.Error()
+					}
+				}
+
+				reserveRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+					Address: reserveAddr,
+					Denom:   ed.IntegerDenom,
+				})
+				if err != nil {
+					return err
+				}
+
+				audit.Reserve = reserveRes.Balance.Amount
+				audit.Discrepancy = audit.Reserve.Mul(ed.ConversionFactor).
+					Sub(audit.FractionalBalances).
+					Sub(audit.Remainder)
+
+				if !audit.Ok() {
+					report.Ok = false
+				}
+
+				report.Denoms = append(report.Denoms, audit)
+			}
+
+			bz, err := json.Marshal(report)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+// FlagDenom is the flag selecting the extended denom of a query. Defaults to
+// the EVM extended denom.
+const FlagDenom = "denom"
+
 // GetQueryCmd returns the parent command for all x/precisebank CLI query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,18 +24,48 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetParamsCmd(),
 		GetRemainderCmd(),
 		GetFractionalBalanceCmd(),
+		GetAuditCmd(),
 	)
 	return cmd
 }
 
+// GetParamsCmd queries the precise bank params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the precise bank params",
+		Long:  "Get the precise bank parameter values.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetRemainderCmd queries the remainder amount
 func GetRemainderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remainder",
 		Short: "Get the remainder amount",
-		Long:  "Get the remainder amount in the precise bank module",
+		Long:  "Get the remainder amount of an extended denom in the precise bank module",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -41,8 +75,15 @@ func GetRemainderCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			ctx := cmd.Context()
-			res, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{})
+			res, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{
+				Denom: denom,
+			})
 			if err != nil {
 				return err
 			}
@@ -51,6 +92,7 @@ func GetRemainderCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "extended denom to query, defaults to the EVM extended denom")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -60,7 +102,7 @@ func GetFractionalBalanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fractional-balance [address]",
 		Short: "Get the fractional balance of an account",
-		Long:  "Get the fractional balance of an account at the specified address",
+		Long:  "Get the fractional balance of an extended denom of an account at the specified address",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -70,9 +112,15 @@ func GetFractionalBalanceCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			ctx := cmd.Context()
 			res, err := queryClient.FractionalBalance(ctx, &types.QueryFractionalBalanceRequest{
 				Address: args[0],
+				Denom:   denom,
 			})
 			if err != nil {
 				return err
@@ -82,6 +130,7 @@ func GetFractionalBalanceCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "extended denom to query, defaults to the EVM extended denom")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -67,6 +67,42 @@ func InitGenesis(
 
 	// Set remainder amount in state
 	keeper.SetRemainderAmount(ctx, gs.Remainder)
+
+	if err := keeper.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Sprintf("failed to set %s params: %s", types.ModuleName, err))
+	}
+
+	// Initialize the state of every registered extended denom, which is empty
+	// if not present in the genesis state.
+	denomStates := make(map[string]types.DenomGenesisState, len(gs.Denoms))
+	for _, ds := range gs.Denoms {
+		denomStates[ds.ExtendedDenom] = ds
+	}
+
+	for _, ed := range gs.Params.ExtendedDenoms {
+		ds, found := denomStates[ed.ExtendedDenom]
+		if !found {
+			ds = types.NewDenomGenesisState(ed.ExtendedDenom, types.FractionalBalances{}, sdkmath.ZeroInt())
+		}
+
+		// The reserve must fully back the fractional balances and remainder
+		totalAmt := ds.TotalAmountWithRemainder()
+		moduleBal := bk.GetBalance(ctx, moduleAddr, ed.IntegerDenom)
+		if !totalAmt.Equal(moduleBal.Amount.Mul(ed.ConversionFactor)) {
+			panic(fmt.Sprintf("module account balance does not match sum of fractional balances and remainder, balance is %s but expected %v%s (%v%s)",
+				moduleBal,
+				totalAmt, ed.ExtendedDenom,
+				totalAmt.Quo(ed.ConversionFactor), ed.IntegerDenom,
+			))
+		}
+
+		for _, bal := range ds.Balances {
+			addr := sdk.MustAccAddressFromBech32(bal.Address)
+			keeper.SetDenomFractionalBalance(ctx, ed, addr, bal.Amount)
+		}
+
+		keeper.SetDenomRemainderAmount(ctx, ed, ds.Remainder)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -80,5 +116,23 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 
 	remainder := keeper.GetRemainderAmount(ctx)
 
-	return types.NewGenesisState(balances, remainder)
+	gs := types.NewGenesisState(balances, remainder)
+	gs.Params = keeper.GetParams(ctx)
+
+	for _, ed := range gs.Params.ExtendedDenoms {
+		denomBalances := types.FractionalBalances{}
+		keeper.IterateDenomFractionalBalances(ctx, ed, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+			denomBalances = append(denomBalances, types.NewFractionalBalance(addr.String(), amount))
+
+			return false
+		})
+
+		gs.Denoms = append(gs.Denoms, types.NewDenomGenesisState(
+			ed.ExtendedDenom,
+			denomBalances,
+			keeper.GetDenomRemainderAmount(ctx, ed),
+		))
+	}
+
+	return gs
 }
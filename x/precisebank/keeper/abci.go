@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock asserts all invariants if invariant assertions are enabled, and
+// panics if any of them is broken.
+func (k Keeper) EndBlock(ctx sdk.Context) error {
+	if !k.assertInvariants {
+		return nil
+	}
+
+	if msg, broken := AllInvariants(k)(ctx); broken {
+		panic(fmt.Errorf("invariant broken at height %d: %s", ctx.BlockHeight(), msg))
+	}
+
+	return nil
+}
@@ -12,9 +12,52 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetFractionalBalance returns the fractional balance for an address.
+// GetFractionalBalance returns the EVM coin fractional balance for an address.
 func (k *Keeper) GetFractionalBalance(ctx sdk.Context, address sdk.AccAddress) sdkmath.Int {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	return k.GetDenomFractionalBalance(ctx, types.EVMExtendedDenom(), address)
+}
+
+// SetFractionalBalance sets the EVM coin fractional balance for an address.
+func (k *Keeper) SetFractionalBalance(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	amount sdkmath.Int,
+) {
+	k.SetDenomFractionalBalance(ctx, types.EVMExtendedDenom(), address, amount)
+}
+
+// DeleteFractionalBalance deletes the EVM coin fractional balance for an
+// address.
+func (k *Keeper) DeleteFractionalBalance(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+) {
+	k.DeleteDenomFractionalBalance(ctx, types.EVMExtendedDenom(), address)
+}
+
+// IterateFractionalBalances iterates over all EVM coin fractional balances in
+// the store and performs a callback function.
+func (k *Keeper) IterateFractionalBalances(
+	ctx sdk.Context,
+	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
+) {
+	k.IterateDenomFractionalBalances(ctx, types.EVMExtendedDenom(), cb)
+}
+
+// GetTotalSumFractionalBalances returns the sum of all EVM coin fractional
+// balances.
+func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+	return k.GetDenomTotalSumFractionalBalances(ctx, types.EVMExtendedDenom())
+}
+
+// GetDenomFractionalBalance returns the fractional balance of the extended
+// denom for an address.
+func (k *Keeper) GetDenomFractionalBalance(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
+	address sdk.AccAddress,
+) sdkmath.Int {
+	store := k.fractionalBalanceStore(ctx, ed)
 
 	bz := store.Get(types.FractionalBalanceKey(address))
 	if bz == nil {
@@ -29,9 +72,11 @@ func (k *Keeper) GetFractionalBalance(ctx sdk.Context, address sdk.AccAddress) s
 	return bal
 }
 
-// SetFractionalBalance sets the fractional balance for an address.
-func (k *Keeper) SetFractionalBalance(
+// SetDenomFractionalBalance sets the fractional balance of the extended denom
+// for an address.
+func (k *Keeper) SetDenomFractionalBalance(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	address sdk.AccAddress,
 	amount sdkmath.Int,
 ) {
@@ -40,17 +85,17 @@ func (k *Keeper) SetFractionalBalance(
 	}
 
 	if amount.IsZero() {
-		k.DeleteFractionalBalance(ctx, address)
+		k.DeleteDenomFractionalBalance(ctx, ed, address)
 		return
 	}
 
 	// Ensure the fractional balance is valid before setting it. Use the
 	// ValidateFractionalAmount function to validate the amount.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := ed.ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("amount is invalid: %w", err))
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.fractionalBalanceStore(ctx, ed)
 
 	amountBytes, err := amount.Marshal()
 	if err != nil {
@@ -60,22 +105,25 @@ func (k *Keeper) SetFractionalBalance(
 	store.Set(types.FractionalBalanceKey(address), amountBytes)
 }
 
-// DeleteFractionalBalance deletes the fractional balance for an address.
-func (k *Keeper) DeleteFractionalBalance(
+// DeleteDenomFractionalBalance deletes the fractional balance of the extended
+// denom for an address.
+func (k *Keeper) DeleteDenomFractionalBalance(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	address sdk.AccAddress,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.fractionalBalanceStore(ctx, ed)
 	store.Delete(types.FractionalBalanceKey(address))
 }
 
-// IterateFractionalBalances iterates over all fractional balances in the store
-// and performs a callback function.
-func (k *Keeper) IterateFractionalBalances(
+// IterateDenomFractionalBalances iterates over all fractional balances of the
+// extended denom in the store and performs a callback function.
+func (k *Keeper) IterateDenomFractionalBalances(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.fractionalBalanceStore(ctx, ed)
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
@@ -94,14 +142,26 @@ func (k *Keeper) IterateFractionalBalances(
 	}
 }
 
-// GetTotalSumFractionalBalances returns the sum of all fractional balances.
-func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+// GetDenomTotalSumFractionalBalances returns the sum of all fractional
+// balances of the extended denom.
+func (k *Keeper) GetDenomTotalSumFractionalBalances(ctx sdk.Context, ed types.ExtendedDenom) sdkmath.Int {
 	sum := sdkmath.ZeroInt()
 
-	k.IterateFractionalBalances(ctx, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
+	k.IterateDenomFractionalBalances(ctx, ed, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
 		sum = sum.Add(amount)
 		return false
 	})
 
 	return sum
 }
+
+// fractionalBalanceStore returns the prefix store of the fractional balances
+// of the extended denom. The EVM coin balances are kept under their original
+// prefix.
+func (k *Keeper) fractionalBalanceStore(ctx sdk.Context, ed types.ExtendedDenom) prefix.Store {
+	if ed.IsEVMCoin() {
+		return prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	}
+
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomFractionalBalancePrefixKey(ed.ExtendedDenom))
+}
@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/zenanetwork/zena/x/precisebank/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type queryServer struct {
@@ -19,22 +25,42 @@ func NewQueryServerImpl(k Keeper) types.QueryServer {
 
 var _ types.QueryServer = queryServer{}
 
-// Remainder returns the remainder amount in x/precisebank.
+// Params returns the parameters of x/precisebank.
+func (s queryServer) Params(
+	goCtx context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params:           s.keeper.GetParams(ctx),
+		EvmExtendedDenom: types.EVMExtendedDenom(),
+	}, nil
+}
+
+// Remainder returns the remainder amount of an extended denom in
+// x/precisebank.
 func (s queryServer) Remainder(
 	goCtx context.Context,
 	req *types.QueryRemainderRequest,
 ) (*types.QueryRemainderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	remainder := s.keeper.GetRemainderAmount(ctx)
-	remainderCoin := sdk.NewCoin(types.ExtendedCoinDenom(), remainder)
+	ed, err := s.extendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	remainder := s.keeper.GetDenomRemainderAmount(ctx, ed)
+	remainderCoin := sdk.NewCoin(ed.ExtendedDenom, remainder)
 
 	return &types.QueryRemainderResponse{
 		Remainder: remainderCoin,
 	}, nil
 }
 
-// FractionalBalance returns the fractional balance of an account.
+// FractionalBalance returns the fractional balance of an extended denom of an
+// account.
 func (s queryServer) FractionalBalance(
 	goCtx context.Context,
 	req *types.QueryFractionalBalanceRequest,
@@ -46,10 +72,69 @@ func (s queryServer) FractionalBalance(
 		return nil, err
 	}
 
-	amt := s.keeper.GetFractionalBalance(ctx, address)
-	fractionalBalance := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	ed, err := s.extendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := s.keeper.GetDenomFractionalBalance(ctx, ed, address)
+	fractionalBalance := sdk.NewCoin(ed.ExtendedDenom, amt)
 
 	return &types.QueryFractionalBalanceResponse{
 		FractionalBalance: fractionalBalance,
 	}, nil
 }
+
+// FractionalBalances returns all non-zero fractional balances of an extended
+// denom.
+func (s queryServer) FractionalBalances(
+	goCtx context.Context,
+	req *types.QueryFractionalBalancesRequest,
+) (*types.QueryFractionalBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ed, err := s.extendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	var balances types.FractionalBalances
+	store := s.keeper.fractionalBalanceStore(ctx, ed)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+
+		balances = append(balances, types.NewFractionalBalance(sdk.AccAddress(key).String(), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFractionalBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
+
+// extendedDenom returns the ExtendedDenom of the queried denom, defaulting to
+// the EVM extended denom if empty.
+func (s queryServer) extendedDenom(ctx sdk.Context, denom string) (types.ExtendedDenom, error) {
+	if denom == "" {
+		return types.EVMExtendedDenom(), nil
+	}
+
+	ed, found := s.keeper.GetExtendedDenom(ctx, denom)
+	if !found {
+		return types.ExtendedDenom{}, status.Errorf(codes.InvalidArgument, "denom %s is not an extended denom", denom)
+	}
+
+	return ed, nil
+}
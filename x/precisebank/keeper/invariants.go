@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/zenanetwork/zena/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers the x/precisebank module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reserve-backs-fractions", ReserveBacksFractionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-fractional-balances", ValidFractionalAmountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-remainder-amount", ValidRemainderAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "fractional-denom-not-in-bank", FractionalDenomNotInBankInvariant(k))
+}
+
+// AllInvariants runs all invariants of the x/precisebank module and returns
+// the first broken one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, broken := ReserveBacksFractionsInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+
+		res, broken = ValidFractionalAmountsInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+
+		res, broken = ValidRemainderAmountInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+
+		return FractionalDenomNotInBankInvariant(k)(ctx)
+	}
+}
+
+// ReserveBacksFractionsInvariant checks that the sum of all fractional
+// balances and the remainder of every extended denom is equal to the integer
+// balance of the reserve account times the conversion factor.
+func ReserveBacksFractionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		reserveAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+		for _, ed := range k.GetExtendedDenoms(ctx) {
+			fractionalSum := k.GetDenomTotalSumFractionalBalances(ctx, ed)
+			remainder := k.GetDenomRemainderAmount(ctx, ed)
+			total := fractionalSum.Add(remainder)
+
+			reserve := k.bk.GetBalance(ctx, reserveAddr, ed.IntegerDenom)
+			reserveExtended := reserve.Amount.Mul(ed.ConversionFactor)
+
+			if !total.Equal(reserveExtended) {
+				broken = true
+				msg += fmt.Sprintf(
+					"\t%s: reserve balance %s (%s%s) mismatches fractional balances %s + remainder %s = %s%s\n",
+					ed.ExtendedDenom,
+					reserve, reserveExtended, ed.ExtendedDenom,
+					fractionalSum, remainder, total, ed.ExtendedDenom,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "reserve-backs-fractions",
+			msg,
+		), broken
+	}
+}
+
+// ValidFractionalAmountsInvariant checks that all fractional balances are
+// positive and less than the conversion factor of their extended denom.
+func ValidFractionalAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, ed := range k.GetExtendedDenoms(ctx) {
+			k.IterateDenomFractionalBalances(ctx, ed, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+				if err := ed.ValidateFractionalAmount(amount); err != nil {
+					count++
+					msg += fmt.Sprintf("\t%s: %s has an invalid fractional balance: %s\n", ed.ExtendedDenom, addr, err)
+				}
+
+				return false
+			})
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "valid-fractional-balances",
+			fmt.Sprintf("amount of invalid fractional balances found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ValidRemainderAmountInvariant checks that the remainder of every extended
+// denom is non-negative and less than its conversion factor.
+func ValidRemainderAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, ed := range k.GetExtendedDenoms(ctx) {
+			remainder := k.GetDenomRemainderAmount(ctx, ed)
+
+			// The remainder is not stored when zero
+			if remainder.IsZero() {
+				continue
+			}
+
+			if err := ed.ValidateFractionalAmount(remainder); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s: invalid remainder %s: %s\n", ed.ExtendedDenom, remainder, err)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "valid-remainder-amount",
+			msg,
+		), broken
+	}
+}
+
+// FractionalDenomNotInBankInvariant checks that no extended denom that differs
+// from its integer denom is tracked by x/bank, i.e. that x/precisebank never
+// passes extended amounts through to x/bank.
+func FractionalDenomNotInBankInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, ed := range k.GetExtendedDenoms(ctx) {
+			if ed.IntegerDenom == ed.ExtendedDenom {
+				continue
+			}
+
+			supply := k.bk.GetSupply(ctx, ed.ExtendedDenom)
+			if !supply.Amount.IsZero() {
+				broken = true
+				msg += fmt.Sprintf("\t%s: x/bank supply is non-zero: %s\n", ed.ExtendedDenom, supply)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "fractional-denom-not-in-bank",
+			msg,
+		), broken
+	}
+}
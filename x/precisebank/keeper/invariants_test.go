@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/precisebank/keeper"
+	"github.com/zenanetwork/zena/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var reserveAddr = authtypes.NewModuleAddress(types.ModuleName)
+
+func TestReserveBacksFractionsInvariant(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupFn    func(td testData)
+		wantBroken bool
+		wantMsg    string
+	}{
+		{
+			"pass - empty state",
+			func(td testData) {
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, types.IntegerCoinDenom()).
+					Return(c(types.IntegerCoinDenom(), 0)).
+					Once()
+			},
+			false,
+			"",
+		},
+		{
+			"pass - reserve backs fractional balances and remainder",
+			func(td testData) {
+				td.keeper.SetFractionalBalance(td.ctx, sdk.AccAddress{1}, types.ConversionFactor().QuoRaw(2))
+				td.keeper.SetFractionalBalance(td.ctx, sdk.AccAddress{2}, types.ConversionFactor().QuoRaw(4))
+				td.keeper.SetRemainderAmount(td.ctx, types.ConversionFactor().QuoRaw(4))
+
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, types.IntegerCoinDenom()).
+					Return(c(types.IntegerCoinDenom(), 1)).
+					Once()
+			},
+			false,
+			"",
+		},
+		{
+			"pass - reserve backs extended denom",
+			func(td testData) {
+				setExtendedDenoms(t, td, usdcDenom)
+				td.keeper.SetDenomFractionalBalance(td.ctx, usdcDenom, sdk.AccAddress{1}, sdkmath.NewInt(100))
+				td.keeper.SetDenomRemainderAmount(td.ctx, usdcDenom, usdcDenom.ConversionFactor.SubRaw(100))
+
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, types.IntegerCoinDenom()).
+					Return(c(types.IntegerCoinDenom(), 0)).
+					Once()
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, usdcDenom.IntegerDenom).
+					Return(c(usdcDenom.IntegerDenom, 1)).
+					Once()
+			},
+			false,
+			"",
+		},
+		{
+			"fail - reserve does not back fractional balances",
+			func(td testData) {
+				td.keeper.SetFractionalBalance(td.ctx, sdk.AccAddress{1}, types.ConversionFactor().QuoRaw(2))
+				td.keeper.SetRemainderAmount(td.ctx, types.ConversionFactor().QuoRaw(2))
+
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, types.IntegerCoinDenom()).
+					Return(c(types.IntegerCoinDenom(), 2)).
+					Once()
+			},
+			true,
+			"mismatches fractional balances 500000000000 + remainder 500000000000 = 1000000000000" + types.ExtendedCoinDenom(),
+		},
+		{
+			"fail - reserve does not back extended denom",
+			func(td testData) {
+				setExtendedDenoms(t, td, usdcDenom)
+				td.keeper.SetDenomFractionalBalance(td.ctx, usdcDenom, sdk.AccAddress{1}, sdkmath.NewInt(100))
+
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, types.IntegerCoinDenom()).
+					Return(c(types.IntegerCoinDenom(), 0)).
+					Once()
+				td.bk.EXPECT().
+					GetBalance(td.ctx, reserveAddr, usdcDenom.IntegerDenom).
+					Return(c(usdcDenom.IntegerDenom, 0)).
+					Once()
+			},
+			true,
+			"ausdc: reserve balance 0uusdc (0ausdc) mismatches fractional balances 100 + remainder 0 = 100ausdc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newMockedTestData(t)
+			tc.setupFn(td)
+
+			msg, broken := keeper.ReserveBacksFractionsInvariant(td.keeper)(td.ctx)
+			require.Equal(t, tc.wantBroken, broken)
+			if tc.wantBroken {
+				require.Contains(t, msg, tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestValidFractionalAmountsInvariant(t *testing.T) {
+	td := newMockedTestData(t)
+	setExtendedDenoms(t, td, usdcDenom)
+
+	td.keeper.SetFractionalBalance(td.ctx, sdk.AccAddress{1}, sdkmath.NewInt(100))
+	td.keeper.SetDenomFractionalBalance(td.ctx, usdcDenom, sdk.AccAddress{1}, sdkmath.NewInt(100))
+
+	_, broken := keeper.ValidFractionalAmountsInvariant(td.keeper)(td.ctx)
+	require.False(t, broken)
+
+	// Bypass the keeper validation by writing directly to the store
+	store := prefix.NewStore(td.ctx.KVStore(td.storeKey), types.FractionalBalancePrefix)
+	bz, err := types.ConversionFactor().Marshal()
+	require.NoError(t, err)
+	store.Set(types.FractionalBalanceKey(sdk.AccAddress{2}), bz)
+
+	msg, broken := keeper.ValidFractionalAmountsInvariant(td.keeper)(td.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "amount of invalid fractional balances found 1")
+	require.Contains(t, msg, "amount 1000000000000 exceeds max of 999999999999")
+}
+
+func TestValidRemainderAmountInvariant(t *testing.T) {
+	td := newMockedTestData(t)
+
+	_, broken := keeper.ValidRemainderAmountInvariant(td.keeper)(td.ctx)
+	require.False(t, broken)
+
+	td.keeper.SetRemainderAmount(td.ctx, sdkmath.NewInt(100))
+
+	_, broken = keeper.ValidRemainderAmountInvariant(td.keeper)(td.ctx)
+	require.False(t, broken)
+
+	// Bypass the keeper validation by writing directly to the store
+	bz, err := sdkmath.NewInt(-1).Marshal()
+	require.NoError(t, err)
+	td.ctx.KVStore(td.storeKey).Set(types.RemainderBalanceKey, bz)
+
+	msg, broken := keeper.ValidRemainderAmountInvariant(td.keeper)(td.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "invalid remainder -1: non-positive amount -1")
+}
+
+func TestFractionalDenomNotInBankInvariant(t *testing.T) {
+	td := newMockedTestData(t)
+	setExtendedDenoms(t, td, usdcDenom)
+
+	td.bk.EXPECT().
+		GetSupply(td.ctx, types.ExtendedCoinDenom()).
+		Return(c(types.ExtendedCoinDenom(), 0)).
+		Once()
+	td.bk.EXPECT().
+		GetSupply(td.ctx, usdcDenom.ExtendedDenom).
+		Return(c(usdcDenom.ExtendedDenom, 0)).
+		Once()
+
+	_, broken := keeper.FractionalDenomNotInBankInvariant(td.keeper)(td.ctx)
+	require.False(t, broken)
+
+	td.bk.EXPECT().
+		GetSupply(td.ctx, types.ExtendedCoinDenom()).
+		Return(c(types.ExtendedCoinDenom(), 0)).
+		Once()
+	td.bk.EXPECT().
+		GetSupply(td.ctx, usdcDenom.ExtendedDenom).
+		Return(c(usdcDenom.ExtendedDenom, 10)).
+		Once()
+
+	msg, broken := keeper.FractionalDenomNotInBankInvariant(td.keeper)(td.ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "ausdc: x/bank supply is non-zero: 10ausdc")
+}
+
+func TestEndBlock(t *testing.T) {
+	td := newMockedTestData(t)
+
+	// Invariants are not asserted by default
+	td.keeper.SetRemainderAmount(td.ctx, sdkmath.NewInt(100))
+	require.NoError(t, td.keeper.EndBlock(td.ctx))
+
+	td.keeper.SetAssertInvariants(true)
+
+	td.bk.EXPECT().
+		GetBalance(td.ctx, reserveAddr, types.IntegerCoinDenom()).
+		Return(c(types.IntegerCoinDenom(), 0)).
+		Once()
+
+	require.PanicsWithError(
+		t,
+		"invariant broken at height 0: precisebank: reserve-backs-fractions invariant\n\t"+
+			types.ExtendedCoinDenom()+": reserve balance 0"+types.IntegerCoinDenom()+" (0"+types.ExtendedCoinDenom()+
+			") mismatches fractional balances 0 + remainder 100 = 100"+types.ExtendedCoinDenom()+"\n\n",
+		func() {
+			_ = td.keeper.EndBlock(td.ctx)
+		},
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/zenanetwork/zena/x/precisebank/types"
 	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,6 +23,13 @@ type Keeper struct {
 
 	bk types.BankKeeper
 	ak types.AccountKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	// assertInvariants enables asserting all invariants at the end of every
+	// block.
+	assertInvariants bool
 }
 
 // NewKeeper creates a new keeper
@@ -30,21 +38,81 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		bk:       bk,
-		ak:       ak,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		bk:        bk,
+		ak:        ak,
+		authority: authority,
 	}
 }
 
+// SetAssertInvariants enables or disables asserting all invariants at the end
+// of every block. Enabling it halts the chain on a broken invariant, so it is
+// intended for testnets only.
+func (k *Keeper) SetAssertInvariants(assert bool) {
+	k.assertInvariants = assert
+}
+
+// extendedCoin is an amount of an extended denom managed by x/precisebank.
+type extendedCoin struct {
+	denom  types.ExtendedDenom
+	amount sdkmath.Int
+}
+
+// splitExtendedCoins splits the coins into the ones passed through to x/bank
+// and the positive amounts of the extended denoms managed by x/precisebank.
+func (k Keeper) splitExtendedCoins(ctx sdk.Context, amt sdk.Coins) (sdk.Coins, []extendedCoin) {
+	passthroughCoins := amt
+	var extendedCoins []extendedCoin
+
+	for _, coin := range amt {
+		ed, found := k.GetExtendedDenom(ctx, coin.Denom)
+		if !found || !coin.Amount.IsPositive() {
+			continue
+		}
+
+		// Remove the extended coin amount from the passthrough coins
+		passthroughCoins = passthroughCoins.Sub(coin)
+		extendedCoins = append(extendedCoins, extendedCoin{denom: ed, amount: coin.Amount})
+	}
+
+	return passthroughCoins, extendedCoins
+}
+
 // BANK KEEPER INTERFACE PASSTHROUGHS
 
 func (k Keeper) IterateTotalSupply(ctx context.Context, cb func(coin sdk.Coin) bool) {
 	k.bk.IterateTotalSupply(ctx, cb)
 }
 
-func (k Keeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
-	return k.bk.GetSupply(ctx, denom)
+// GetSupply returns the supply of the denom. The supply of the extended denoms
+// registered in the params is the integer supply converted to the extended
+// denom, minus the remainder that is held by the reserve without backing any
+// fractional balance.
+func (k Keeper) GetSupply(goCtx context.Context, denom string) sdk.Coin {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The EVM coin keeps passing through to x/bank, as its extended denom is
+	// the integer denom on chains with 18 decimals.
+	ed, found := k.GetExtendedDenom(ctx, denom)
+	if !found || ed.IsEVMCoin() {
+		return k.bk.GetSupply(ctx, denom)
+	}
+
+	integerSupply := k.bk.GetSupply(ctx, ed.IntegerDenom)
+
+	// (Integer * ConversionFactor) - Remainder
+	amount := integerSupply.Amount.
+		Mul(ed.ConversionFactor).
+		Sub(k.GetDenomRemainderAmount(ctx, ed))
+
+	return sdk.NewCoin(ed.ExtendedDenom, amount)
 }
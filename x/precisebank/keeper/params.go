@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/zenanetwork/zena/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the total set of precisebank parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the precisebank params in a single key. The extended denoms
+// cannot extend the EVM coin, which is always extended, a new extended denom
+// cannot have a supply in x/bank and an extended denom with outstanding
+// fractional balances cannot be removed or changed.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	evmDenom := types.EVMExtendedDenom()
+	for _, ed := range params.ExtendedDenoms {
+		for _, denom := range []string{ed.IntegerDenom, ed.ExtendedDenom} {
+			if denom == evmDenom.IntegerDenom || denom == evmDenom.ExtendedDenom {
+				return fmt.Errorf("denom %s is reserved for the EVM coin", denom)
+			}
+		}
+	}
+
+	prevParams := k.GetParams(ctx)
+	for _, ed := range params.ExtendedDenoms {
+		if _, found := prevParams.GetExtendedDenom(ed.ExtendedDenom); found {
+			continue
+		}
+
+		// x/bank balances of the extended denom would be shadowed by the extended balances
+		if !k.bk.GetSupply(ctx, ed.ExtendedDenom).IsZero() {
+			return fmt.Errorf("extended denom %s already has a supply in x/bank", ed.ExtendedDenom)
+		}
+	}
+
+	for _, prev := range prevParams.ExtendedDenoms {
+		ed, found := params.GetExtendedDenom(prev.ExtendedDenom)
+		if found && ed.IntegerDenom == prev.IntegerDenom && ed.ConversionFactor.Equal(prev.ConversionFactor) {
+			continue
+		}
+
+		if k.hasDenomState(ctx, prev) {
+			return fmt.Errorf("extended denom %s with outstanding fractional balances cannot be removed or changed", prev.ExtendedDenom)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetExtendedDenom returns the ExtendedDenom of the given extended denom, if it
+// is either the EVM extended denom or registered in the params. The params are
+// read without consuming gas, as this is called for every coin transferred.
+func (k Keeper) GetExtendedDenom(ctx sdk.Context, denom string) (types.ExtendedDenom, bool) {
+	evmDenom := types.EVMExtendedDenom()
+	if denom == evmDenom.ExtendedDenom {
+		return evmDenom, true
+	}
+
+	params := k.GetParams(ctx.WithKVGasConfig(storetypes.GasConfig{}))
+	return params.GetExtendedDenom(denom)
+}
+
+// GetExtendedDenoms returns all the extended denoms, starting with the EVM
+// extended denom followed by the ones registered in the params.
+func (k Keeper) GetExtendedDenoms(ctx sdk.Context) []types.ExtendedDenom {
+	return append([]types.ExtendedDenom{types.EVMExtendedDenom()}, k.GetParams(ctx).ExtendedDenoms...)
+}
+
+// hasDenomState returns true if the extended denom has any fractional balance
+// or remainder in the store.
+func (k Keeper) hasDenomState(ctx sdk.Context, ed types.ExtendedDenom) bool {
+	if !k.GetDenomRemainderAmount(ctx, ed).IsZero() {
+		return true
+	}
+
+	found := false
+	k.IterateDenomFractionalBalances(ctx, ed, func(sdk.AccAddress, sdkmath.Int) bool {
+		found = true
+		return true
+	})
+
+	return found
+}
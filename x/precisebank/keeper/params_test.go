@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var usdcDenom = types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_000))
+
+func TestSetParams(t *testing.T) {
+	addr := sdk.AccAddress([]byte("test-address"))
+
+	testCases := []struct {
+		name     string
+		malleate func(td testData)
+		params   types.Params
+		wantErr  string
+	}{
+		{
+			"pass - register extended denom",
+			func(td testData) {
+				td.bk.EXPECT().
+					GetSupply(td.ctx, usdcDenom.ExtendedDenom).
+					Return(sdk.NewInt64Coin(usdcDenom.ExtendedDenom, 0)).
+					Once()
+			},
+			types.NewParams(usdcDenom),
+			"",
+		},
+		{
+			"fail - extended denom with x/bank supply",
+			func(td testData) {
+				td.bk.EXPECT().
+					GetSupply(td.ctx, usdcDenom.ExtendedDenom).
+					Return(sdk.NewInt64Coin(usdcDenom.ExtendedDenom, 10)).
+					Once()
+			},
+			types.NewParams(usdcDenom),
+			"extended denom ausdc already has a supply in x/bank",
+		},
+		{
+			"pass - remove extended denom without state",
+			func(td testData) {
+				setExtendedDenoms(t, td, usdcDenom)
+			},
+			types.DefaultParams(),
+			"",
+		},
+		{
+			"fail - invalid params",
+			func(testData) {},
+			types.NewParams(usdcDenom, usdcDenom),
+			"duplicate denom uusdc",
+		},
+		{
+			"fail - EVM extended denom",
+			func(testData) {},
+			types.NewParams(types.NewExtendedDenom("uusdc", types.ExtendedCoinDenom(), sdkmath.NewInt(10))),
+			"denom " + types.ExtendedCoinDenom() + " is reserved for the EVM coin",
+		},
+		{
+			"fail - EVM integer denom",
+			func(testData) {},
+			types.NewParams(types.NewExtendedDenom(types.IntegerCoinDenom(), "ausdc", sdkmath.NewInt(10))),
+			"denom " + types.IntegerCoinDenom() + " is reserved for the EVM coin",
+		},
+		{
+			"fail - remove extended denom with fractional balances",
+			func(td testData) {
+				setExtendedDenoms(t, td, usdcDenom)
+				td.keeper.SetDenomFractionalBalance(td.ctx, usdcDenom, addr, sdkmath.NewInt(100))
+			},
+			types.DefaultParams(),
+			"extended denom ausdc with outstanding fractional balances cannot be removed or changed",
+		},
+		{
+			"fail - change conversion factor of extended denom with remainder",
+			func(td testData) {
+				setExtendedDenoms(t, td, usdcDenom)
+				td.keeper.SetDenomRemainderAmount(td.ctx, usdcDenom, sdkmath.NewInt(100))
+			},
+			types.NewParams(types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000))),
+			"extended denom ausdc with outstanding fractional balances cannot be removed or changed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newMockedTestData(t)
+			tc.malleate(td)
+
+			err := td.keeper.SetParams(td.ctx, tc.params)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.params, td.keeper.GetParams(td.ctx))
+		})
+	}
+}
+
+// setExtendedDenoms registers the extended denoms in the params, without any
+// supply in x/bank.
+func setExtendedDenoms(t *testing.T, td testData, eds ...types.ExtendedDenom) {
+	t.Helper()
+
+	for _, ed := range eds {
+		td.bk.EXPECT().
+			GetSupply(td.ctx, ed.ExtendedDenom).
+			Return(sdk.NewInt64Coin(ed.ExtendedDenom, 0)).
+			Once()
+	}
+
+	require.NoError(t, td.keeper.SetParams(td.ctx, types.NewParams(eds...)))
+}
+
+func TestGetExtendedDenom(t *testing.T) {
+	td := newMockedTestData(t)
+	setExtendedDenoms(t, td, usdcDenom)
+
+	ed, found := td.keeper.GetExtendedDenom(td.ctx, types.ExtendedCoinDenom())
+	require.True(t, found)
+	require.Equal(t, types.EVMExtendedDenom(), ed)
+
+	ed, found = td.keeper.GetExtendedDenom(td.ctx, "ausdc")
+	require.True(t, found)
+	require.Equal(t, usdcDenom, ed)
+
+	_, found = td.keeper.GetExtendedDenom(td.ctx, "uusdc")
+	require.False(t, found)
+
+	// Registered denoms are looked up without consuming gas
+	gasBefore := td.ctx.GasMeter().GasConsumed()
+	td.keeper.GetExtendedDenom(td.ctx, "ausdc")
+	require.Equal(t, gasBefore, td.ctx.GasMeter().GasConsumed())
+
+	require.Equal(t, []types.ExtendedDenom{types.EVMExtendedDenom(), usdcDenom}, td.keeper.GetExtendedDenoms(td.ctx))
+}
+
+func TestExtendedDenom_GetBalanceAndSend(t *testing.T) {
+	td := newMockedTestData(t)
+	setExtendedDenoms(t, td, usdcDenom)
+
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	// Fractional balances are kept per extended denom
+	td.keeper.SetDenomFractionalBalance(td.ctx, usdcDenom, sender, sdkmath.NewInt(600))
+	require.Equal(t, sdkmath.ZeroInt(), td.keeper.GetFractionalBalance(td.ctx, sender))
+
+	td.ak.EXPECT().GetModuleAddress(types.ModuleName).
+		Return(authtypes.NewModuleAddress(types.ModuleName))
+	td.bk.EXPECT().GetBalance(td.ctx, sender, "uusdc").
+		Return(sdk.NewInt64Coin("uusdc", 2)).
+		Once()
+
+	bal := td.keeper.GetBalance(td.ctx, sender, "ausdc")
+	require.Equal(t, sdk.NewCoin("ausdc", sdkmath.NewInt(2_000_000_000_600)), bal)
+
+	// Fractional only send without borrow or carry, no x/bank calls required
+	err := td.keeper.SendCoins(td.ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("ausdc", 500)))
+	require.NoError(t, err)
+
+	require.Equal(t, sdkmath.NewInt(100), td.keeper.GetDenomFractionalBalance(td.ctx, usdcDenom, sender))
+	require.Equal(t, sdkmath.NewInt(500), td.keeper.GetDenomFractionalBalance(td.ctx, usdcDenom, recipient))
+	require.Equal(t, sdkmath.ZeroInt(), td.keeper.GetFractionalBalance(td.ctx, recipient))
+
+	events := td.ctx.EventManager().Events()
+	require.Contains(t, events, types.NewEventFractionalBalanceChange(recipient, "ausdc", sdkmath.ZeroInt(), sdkmath.NewInt(500)))
+}
+
+func TestExtendedDenom_GetSupply(t *testing.T) {
+	td := newMockedTestData(t)
+	setExtendedDenoms(t, td, usdcDenom)
+	td.keeper.SetDenomRemainderAmount(td.ctx, usdcDenom, sdkmath.NewInt(300))
+
+	td.bk.EXPECT().
+		GetSupply(td.ctx, usdcDenom.IntegerDenom).
+		Return(sdk.NewInt64Coin(usdcDenom.IntegerDenom, 2)).
+		Once()
+
+	// (Integer * ConversionFactor) - Remainder
+	supply := td.keeper.GetSupply(td.ctx, usdcDenom.ExtendedDenom)
+	require.Equal(t, sdk.NewCoin("ausdc", sdkmath.NewInt(1_999_999_999_700)), supply)
+
+	// Other denoms pass through to x/bank
+	td.bk.EXPECT().
+		GetSupply(td.ctx, usdcDenom.IntegerDenom).
+		Return(sdk.NewInt64Coin(usdcDenom.IntegerDenom, 2)).
+		Once()
+
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 2), td.keeper.GetSupply(td.ctx, usdcDenom.IntegerDenom))
+}
+
+func TestExtendedDenom_GetDenomMetaData(t *testing.T) {
+	td := newMockedTestData(t)
+	setExtendedDenoms(t, td, usdcDenom)
+
+	usdcMetadata := banktypes.Metadata{
+		Description: "USD Coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uusdc", Exponent: 0},
+			{Denom: "usdc", Exponent: 6},
+		},
+		Base:    "uusdc",
+		Display: "usdc",
+		Name:    "USD Coin",
+		Symbol:  "USDC",
+	}
+
+	td.bk.EXPECT().
+		GetDenomMetaData(td.ctx, "ausdc").
+		Return(banktypes.Metadata{}, false).
+		Once()
+	td.bk.EXPECT().
+		GetDenomMetaData(td.ctx, "uusdc").
+		Return(usdcMetadata, true).
+		Once()
+
+	// The metadata is derived from the integer denom with 18 decimals
+	metadata, found := td.keeper.GetDenomMetaData(td.ctx, "ausdc")
+	require.True(t, found)
+	require.Equal(t, banktypes.Metadata{
+		Description: "USD Coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ausdc", Exponent: 0},
+			{Denom: "uusdc", Exponent: 12},
+			{Denom: "usdc", Exponent: 18},
+		},
+		Base:    "ausdc",
+		Display: "usdc",
+		Name:    "USD Coin",
+		Symbol:  "USDC",
+	}, metadata)
+
+	// Without metadata of the integer denom, the extended denom has none
+	td.bk.EXPECT().
+		GetDenomMetaData(td.ctx, "ausdc").
+		Return(banktypes.Metadata{}, false).
+		Once()
+	td.bk.EXPECT().
+		GetDenomMetaData(td.ctx, "uusdc").
+		Return(banktypes.Metadata{}, false).
+		Once()
+
+	_, found = td.keeper.GetDenomMetaData(td.ctx, "ausdc")
+	require.False(t, found)
+}
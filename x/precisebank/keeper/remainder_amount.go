@@ -10,13 +10,37 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetRemainderAmount returns the internal remainder amount.
+// GetRemainderAmount returns the internal remainder amount of the EVM coin.
 func (k *Keeper) GetRemainderAmount(
 	ctx sdk.Context,
+) sdkmath.Int {
+	return k.GetDenomRemainderAmount(ctx, types.EVMExtendedDenom())
+}
+
+// SetRemainderAmount sets the internal remainder amount of the EVM coin.
+func (k *Keeper) SetRemainderAmount(
+	ctx sdk.Context,
+	amount sdkmath.Int,
+) {
+	k.SetDenomRemainderAmount(ctx, types.EVMExtendedDenom(), amount)
+}
+
+// DeleteRemainderAmount deletes the internal remainder amount of the EVM coin.
+func (k *Keeper) DeleteRemainderAmount(
+	ctx sdk.Context,
+) {
+	k.DeleteDenomRemainderAmount(ctx, types.EVMExtendedDenom())
+}
+
+// GetDenomRemainderAmount returns the internal remainder amount of the
+// extended denom.
+func (k *Keeper) GetDenomRemainderAmount(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
 ) sdkmath.Int {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(types.RemainderBalanceKey)
+	bz := store.Get(remainderKey(ed))
 	if bz == nil {
 		return sdkmath.ZeroInt()
 	}
@@ -29,22 +53,24 @@ func (k *Keeper) GetRemainderAmount(
 	return bal
 }
 
-// SetRemainderAmount sets the internal remainder amount.
-func (k *Keeper) SetRemainderAmount(
+// SetDenomRemainderAmount sets the internal remainder amount of the extended
+// denom.
+func (k *Keeper) SetDenomRemainderAmount(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	amount sdkmath.Int,
 ) {
 	// Prevent storing zero amounts. In practice, the remainder amount should
 	// only be non-zero during transactions as mint and burns should net zero
 	// due to only being used for EVM transfers.
 	if amount.IsZero() {
-		k.DeleteRemainderAmount(ctx)
+		k.DeleteDenomRemainderAmount(ctx, ed)
 		return
 	}
 
 	// Ensure the remainder is valid before setting it. Follows the same
 	// validation as FractionalBalance with the same value range.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := ed.ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("remainder amount is invalid: %w", err))
 	}
 
@@ -55,13 +81,25 @@ func (k *Keeper) SetRemainderAmount(
 		panic(fmt.Errorf("failed to marshal remainder amount: %w", err))
 	}
 
-	store.Set(types.RemainderBalanceKey, amountBytes)
+	store.Set(remainderKey(ed), amountBytes)
 }
 
-// DeleteRemainderAmount deletes the internal remainder amount.
-func (k *Keeper) DeleteRemainderAmount(
+// DeleteDenomRemainderAmount deletes the internal remainder amount of the
+// extended denom.
+func (k *Keeper) DeleteDenomRemainderAmount(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 ) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.RemainderBalanceKey)
+	store.Delete(remainderKey(ed))
+}
+
+// remainderKey returns the store key of the remainder of the extended denom.
+// The EVM coin remainder is kept under its original key.
+func remainderKey(ed types.ExtendedDenom) []byte {
+	if ed.IsEVMCoin() {
+		return types.RemainderBalanceKey
+	}
+
+	return types.DenomRemainderKey(ed.ExtendedDenom)
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	"github.com/zenanetwork/zena/x/precisebank/types"
-	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -39,20 +38,19 @@ func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	return nil
 }
 
-// IsSendEnabledCoin checks whether the sent coin is the extended denom, in which case
-// it also checks for the SendEnabled status on the EVM denom. The rest pass through the
-// regular bank keeper implementation.
+// IsSendEnabledCoin checks whether the sent coin is an extended denom, in which case
+// it also checks for the SendEnabled status on its integer denom. The rest pass through
+// the regular bank keeper implementation.
 func (k Keeper) IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool {
-	if coin.Denom == evmtypes.GetEVMCoinExtendedDenom() {
-		return k.bk.IsSendEnabledCoin(ctx, sdk.NewCoin(evmtypes.GetEVMCoinDenom(), coin.Amount.Quo(types.ConversionFactor())))
+	if ed, found := k.GetExtendedDenom(sdk.UnwrapSDKContext(ctx), coin.Denom); found {
+		return k.bk.IsSendEnabledCoin(ctx, sdk.NewCoin(ed.IntegerDenom, coin.Amount.Quo(ed.ConversionFactor)))
 	}
 	return k.bk.IsSendEnabledCoin(ctx, coin)
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure. This handles transfers including
-// ExtendedCoinDenom and supports non-ExtendedCoinDenom transfers by passing
-// through to x/bank.
+// An error is returned upon failure. This handles transfers including the
+// extended denoms and supports other transfers by passing through to x/bank.
 func (k Keeper) SendCoins(
 	goCtx context.Context,
 	from, to sdk.AccAddress,
@@ -67,14 +65,7 @@ func (k Keeper) SendCoins(
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	passthroughCoins := amt
-	extendedCoinAmount := amt.AmountOf(types.ExtendedCoinDenom())
-
-	// Remove the extended coin amount from the passthrough coins
-	if extendedCoinAmount.IsPositive() {
-		subCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedCoinAmount)
-		passthroughCoins = amt.Sub(subCoin)
-	}
+	passthroughCoins, extendedCoins := k.splitExtendedCoins(ctx, amt)
 
 	// Send the passthrough coins through x/bank
 	if passthroughCoins.IsAllPositive() {
@@ -83,9 +74,9 @@ func (k Keeper) SendCoins(
 		}
 	}
 
-	// Send the extended coin amount through x/precisebank
-	if extendedCoinAmount.IsPositive() {
-		if err := k.sendExtendedCoins(ctx, from, to, extendedCoinAmount); err != nil {
+	// Send the extended coin amounts through x/precisebank
+	for _, coin := range extendedCoins {
+		if err := k.sendExtendedCoins(ctx, coin.denom, from, to, coin.amount); err != nil {
 			return err
 		}
 	}
@@ -124,6 +115,7 @@ func (k Keeper) SendCoins(
 // | F             | F              | F               |
 func (k Keeper) sendExtendedCoins(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	from, to sdk.AccAddress,
 	amt sdkmath.Int,
 ) error {
@@ -132,11 +124,11 @@ func (k Keeper) sendExtendedCoins(
 	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
 	if from.Equals(moduleAddr) || to.Equals(moduleAddr) {
 		// For transfers involving the precisebank module account, just do the integer transfer
-		integerAmt := amt.Quo(types.ConversionFactor())
+		integerAmt := amt.Quo(ed.ConversionFactor)
 		if integerAmt.IsPositive() {
-			transferCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerAmt)
+			transferCoin := sdk.NewCoin(ed.IntegerDenom, integerAmt)
 			if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
-				return k.updateInsufficientFundsError(ctx, from, amt, err)
+				return k.updateInsufficientFundsError(ctx, from, sdk.NewCoin(ed.ExtendedDenom, amt), err)
 			}
 		}
 		return nil
@@ -161,17 +153,17 @@ func (k Keeper) sendExtendedCoins(
 	// sender does not have sufficient integer balance.
 
 	// Load required state: Account old balances
-	senderFracBal := k.GetFractionalBalance(ctx, from)
-	recipientFracBal := k.GetFractionalBalance(ctx, to)
+	senderFracBal := k.GetDenomFractionalBalance(ctx, ed, from)
+	recipientFracBal := k.GetDenomFractionalBalance(ctx, ed, to)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
-	integerAmt := amt.Quo(types.ConversionFactor())
-	fractionalAmt := amt.Mod(types.ConversionFactor())
+	integerAmt := amt.Quo(ed.ConversionFactor)
+	fractionalAmt := amt.Mod(ed.ConversionFactor)
 
 	// Account new fractional balances
-	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt)
-	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt)
+	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt, ed.ConversionFactor)
+	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt, ed.ConversionFactor)
 
 	// Case #1: Sender borrow, recipient carry
 	if senderNeedsBorrow && recipientNeedsCarry {
@@ -186,9 +178,9 @@ func (k Keeper) sendExtendedCoins(
 	// Full integer amount transfer, including direct transfer of borrow/carry
 	// if any.
 	if integerAmt.IsPositive() {
-		transferCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerAmt)
+		transferCoin := sdk.NewCoin(ed.IntegerDenom, integerAmt)
 		if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, from, sdk.NewCoin(ed.ExtendedDenom, amt), err)
 		}
 	}
 
@@ -196,14 +188,14 @@ func (k Keeper) sendExtendedCoins(
 	// Sender borrows by transferring 1 integer amount to reserve to account for
 	// lack of fractional balance.
 	if senderNeedsBorrow && !recipientNeedsCarry {
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		borrowCoin := sdk.NewCoin(ed.IntegerDenom, sdkmath.NewInt(1))
 		if err := k.bk.SendCoinsFromAccountToModule(
 			ctx,
 			from, // sender borrowing
 			types.ModuleName,
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, from, sdk.NewCoin(ed.ExtendedDenom, amt), err)
 		}
 	}
 
@@ -219,17 +211,17 @@ func (k Keeper) sendExtendedCoins(
 		// a SendCoins operation. Only SendCoinsFromModuleToAccount should check
 		// blocked addrs which is done by the parent SendCoinsFromModuleToAccount
 		// method.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		carryCoin := sdk.NewCoin(ed.IntegerDenom, sdkmath.NewInt(1))
 
 		// Pre-validation: check reserve balance before SendCoins to avoid
 		// panic on insufficient reserve. Reserve insufficiency is a detectable
 		// state condition, not a programming error.
-		reserveBalance := k.bk.GetBalance(ctx, reserveAddr, types.IntegerCoinDenom())
+		reserveBalance := k.bk.GetBalance(ctx, reserveAddr, ed.IntegerDenom)
 		if reserveBalance.Amount.LT(sdkmath.NewInt(1)) {
 			return errorsmod.Wrapf(
 				types.ErrInsufficientReserve,
 				"reserve has %s but needs 1%s for carry operation",
-				reserveBalance, types.IntegerCoinDenom(),
+				reserveBalance, ed.IntegerDenom,
 			)
 		}
 
@@ -251,12 +243,12 @@ func (k Keeper) sendExtendedCoins(
 	// already calculated and just need to be set.
 
 	// Persist new fractional balances to store.
-	k.SetFractionalBalance(ctx, from, senderNewFracBal)
-	k.SetFractionalBalance(ctx, to, recipientNewFracBal)
+	k.SetDenomFractionalBalance(ctx, ed, from, senderNewFracBal)
+	k.SetDenomFractionalBalance(ctx, ed, to, recipientNewFracBal)
 
 	// Emit event for fractional balance change
-	types.EmitEventFractionalBalanceChange(ctx, from, senderFracBal, senderNewFracBal)
-	types.EmitEventFractionalBalanceChange(ctx, to, recipientFracBal, recipientNewFracBal)
+	types.EmitEventFractionalBalanceChange(ctx, from, ed.ExtendedDenom, senderFracBal, senderNewFracBal)
+	types.EmitEventFractionalBalanceChange(ctx, to, ed.ExtendedDenom, recipientFracBal, recipientNewFracBal)
 
 	return nil
 }
@@ -267,13 +259,14 @@ func (k Keeper) sendExtendedCoins(
 func subFromFractionalBalance(
 	currentFractionalBalance sdkmath.Int,
 	amountToSub sdkmath.Int,
+	conversionFactor sdkmath.Int,
 ) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToSub.GTE(types.ConversionFactor()) {
+	if amountToSub.GTE(conversionFactor) {
 		panic("amountToSub must be less than ConversionFactor")
 	}
 
@@ -286,7 +279,7 @@ func subFromFractionalBalance(
 		// Borrowing 1 integer equivalent amount of fractional coins. We need to
 		// add 1 integer equivalent amount to the fractional balance otherwise
 		// the new fractional balance will be negative.
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 	}
 
 	return newFractionalBalance, borrowRequired
@@ -295,13 +288,17 @@ func subFromFractionalBalance(
 // addToFractionalBalance adds a fractional amount to the provided current
 // fractional balance, returning the new fractional balance and true if a carry
 // is required.
-func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sdkmath.Int) (sdkmath.Int, bool) {
+func addToFractionalBalance(
+	currentFractionalBalance sdkmath.Int,
+	amountToAdd sdkmath.Int,
+	conversionFactor sdkmath.Int,
+) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToAdd.GTE(types.ConversionFactor()) {
+	if amountToAdd.GTE(conversionFactor) {
 		panic("amountToAdd must be less than ConversionFactor")
 	}
 
@@ -309,11 +306,11 @@ func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sd
 
 	// New balance exceeds max fractional balance, so we need to carry it over
 	// to the integer balance.
-	carryRequired := newFractionalBalance.GTE(types.ConversionFactor())
+	carryRequired := newFractionalBalance.GTE(conversionFactor)
 
 	if carryRequired {
 		// Carry over to integer amount
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	return newFractionalBalance, carryRequired
@@ -415,7 +412,7 @@ func (k Keeper) SendCoinsFromModuleToModule(
 func (k Keeper) updateInsufficientFundsError(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
-	amt sdkmath.Int,
+	coin sdk.Coin,
 	err error,
 ) error {
 	if !errors.Is(err, sdkerrors.ErrInsufficientFunds) {
@@ -423,8 +420,7 @@ func (k Keeper) updateInsufficientFundsError(
 	}
 
 	// Check balance is sufficient
-	bal := k.SpendableCoin(ctx, addr, types.ExtendedCoinDenom())
-	coin := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	bal := k.SpendableCoin(ctx, addr, coin.Denom)
 
 	// TODO: This checks spendable coins and returns error with spendable
 	// coins, not full balance. If GetBalance() is modified to return the
@@ -501,8 +497,42 @@ func (k Keeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.bk.BlockedAddr(addr)
 }
 
-func (k Keeper) GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool) {
-	return k.bk.GetDenomMetaData(ctx, denom)
+// GetDenomMetaData returns the metadata of the denom. If the metadata of an
+// extended denom registered in the params is not set in x/bank, it is derived
+// from the metadata of its integer denom, so that the ERC20 representation of
+// the extended denom has the extended decimals.
+func (k Keeper) GetDenomMetaData(goCtx context.Context, denom string) (banktypes.Metadata, bool) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata, found := k.bk.GetDenomMetaData(ctx, denom)
+	if found {
+		return metadata, true
+	}
+
+	ed, found := k.GetExtendedDenom(ctx, denom)
+	if !found || ed.IsEVMCoin() {
+		return banktypes.Metadata{}, false
+	}
+
+	metadata, found = k.bk.GetDenomMetaData(ctx, ed.IntegerDenom)
+	if !found {
+		return banktypes.Metadata{}, false
+	}
+
+	// The extended denom is the new base unit and the exponents of the integer
+	// denom units are increased by the decimals of the conversion factor.
+	denomUnits := []*banktypes.DenomUnit{{Denom: ed.ExtendedDenom, Exponent: 0}}
+	for _, unit := range metadata.DenomUnits {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent + ed.ExtraDecimals(),
+			Aliases:  unit.Aliases,
+		})
+	}
+	metadata.Base = ed.ExtendedDenom
+	metadata.DenomUnits = denomUnits
+
+	return metadata, true
 }
 
 func (k Keeper) SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata) {
@@ -12,11 +12,13 @@ const (
 
 	// Attribute keys
 	AttributeKeyAddress = "address"
+	AttributeKeyDenom   = "denom"
 	AttributeKeyDelta   = "delta"
 )
 
 func NewEventFractionalBalanceChange(
 	address sdk.AccAddress,
+	denom string,
 	beforeAmount sdkmath.Int,
 	afterAmount sdkmath.Int,
 ) sdk.Event {
@@ -25,6 +27,7 @@ func NewEventFractionalBalanceChange(
 	return sdk.NewEvent(
 		EventTypeFractionalBalanceChange,
 		sdk.NewAttribute(AttributeKeyAddress, address.String()),
+		sdk.NewAttribute(AttributeKeyDenom, denom),
 		sdk.NewAttribute(AttributeKeyDelta, delta.String()),
 	)
 }
@@ -32,10 +35,11 @@ func NewEventFractionalBalanceChange(
 func EmitEventFractionalBalanceChange(
 	ctx sdk.Context,
 	address sdk.AccAddress,
+	denom string,
 	beforeAmount sdkmath.Int,
 	afterAmount sdkmath.Int,
 ) {
 	ctx.EventManager().EmitEvent(
-		NewEventFractionalBalanceChange(address, beforeAmount, afterAmount),
+		NewEventFractionalBalanceChange(address, denom, beforeAmount, afterAmount),
 	)
 }
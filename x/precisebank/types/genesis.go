@@ -6,7 +6,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 )
 
-// NewGenesisState creates a new genesis state.
+// NewGenesisState creates a new genesis state with the EVM coin balances and
+// remainder, and the default params.
 func NewGenesisState(
 	balances FractionalBalances,
 	remainder sdkmath.Int,
@@ -14,6 +15,8 @@ func NewGenesisState(
 	return &GenesisState{
 		Balances:  balances,
 		Remainder: remainder,
+		Params:    DefaultParams(),
+		Denoms:    []DenomGenesisState{},
 	}
 }
 
@@ -22,6 +25,19 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(FractionalBalances{}, sdkmath.ZeroInt())
 }
 
+// NewDenomGenesisState creates a new genesis state of an extended denom.
+func NewDenomGenesisState(
+	extendedDenom string,
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+) DenomGenesisState {
+	return DenomGenesisState{
+		ExtendedDenom: extendedDenom,
+		Balances:      balances,
+		Remainder:     remainder,
+	}
+}
+
 // Validate performs basic validation of genesis data returning an  error for
 // any failed validation criteria.
 func (gs *GenesisState) Validate() error {
@@ -30,32 +46,71 @@ func (gs *GenesisState) Validate() error {
 		return fmt.Errorf("invalid balances: %w", err)
 	}
 
-	if gs.Remainder.IsNil() {
+	if err := validateRemainder(gs.Balances, gs.Remainder, ConversionFactor()); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for _, ds := range gs.Denoms {
+		ed, found := gs.Params.GetExtendedDenom(ds.ExtendedDenom)
+		if !found {
+			return fmt.Errorf("extended denom %s is not registered in params", ds.ExtendedDenom)
+		}
+
+		if _, found := seenDenoms[ds.ExtendedDenom]; found {
+			return fmt.Errorf("duplicate state for extended denom %s", ds.ExtendedDenom)
+		}
+		seenDenoms[ds.ExtendedDenom] = struct{}{}
+
+		if err := ds.Balances.validate(ed.ConversionFactor); err != nil {
+			return fmt.Errorf("invalid balances for extended denom %s: %w", ds.ExtendedDenom, err)
+		}
+
+		if err := validateRemainder(ds.Balances, ds.Remainder, ed.ConversionFactor); err != nil {
+			return fmt.Errorf("invalid state for extended denom %s: %w", ds.ExtendedDenom, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRemainder checks that 0 <= remainder < conversionFactor and that the
+// fractional balances and the remainder sum up to a whole integer amount.
+func validateRemainder(
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+	conversionFactor sdkmath.Int,
+) error {
+	if remainder.IsNil() {
 		return fmt.Errorf("nil remainder amount")
 	}
 
 	// Validate remainder, 0 <= remainder <= maxFractionalAmount
-	if gs.Remainder.IsNegative() {
-		return fmt.Errorf("negative remainder amount %s", gs.Remainder)
+	if remainder.IsNegative() {
+		return fmt.Errorf("negative remainder amount %s", remainder)
 	}
 
-	if gs.Remainder.GTE(ConversionFactor()) {
-		return fmt.Errorf("remainder %v exceeds max of %v", gs.Remainder, ConversionFactor().SubRaw(1))
+	if remainder.GTE(conversionFactor) {
+		return fmt.Errorf("remainder %v exceeds max of %v", remainder, conversionFactor.SubRaw(1))
 	}
 
 	// Determine if sum(fractionalBalances) + remainder = whole integer value
 	// i.e total of all fractional balances + remainder == 0 fractional digits
-	sum := gs.Balances.SumAmount()
-	sumWithRemainder := sum.Add(gs.Remainder)
+	sum := balances.SumAmount()
+	sumWithRemainder := sum.Add(remainder)
 
-	offBy := sumWithRemainder.Mod(ConversionFactor())
+	offBy := sumWithRemainder.Mod(conversionFactor)
 
 	if !offBy.IsZero() {
 		return fmt.Errorf(
 			"sum of fractional balances %v + remainder %v is not a multiple of %v",
 			sum,
-			gs.Remainder,
-			ConversionFactor(),
+			remainder,
+			conversionFactor,
 		)
 	}
 
@@ -68,3 +123,9 @@ func (gs *GenesisState) Validate() error {
 func (gs *GenesisState) TotalAmountWithRemainder() sdkmath.Int {
 	return gs.Balances.SumAmount().Add(gs.Remainder)
 }
+
+// TotalAmountWithRemainder returns the total amount of all balances of the
+// extended denom, including both fractional balances and the remainder.
+func (ds DenomGenesisState) TotalAmountWithRemainder() sdkmath.Int {
+	return ds.Balances.SumAmount().Add(ds.Remainder)
+}
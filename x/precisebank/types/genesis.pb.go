@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,11 +28,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the precisebank module's genesis state.
 type GenesisState struct {
-	// balances is a list of all the balances in the precisebank module.
+	// balances is a list of all the EVM coin fractional balances in the
+	// precisebank module.
 	Balances FractionalBalances `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=FractionalBalances" json:"balances"`
-	// remainder is an internal value of how much extra fractional digits are
-	// still backed by the reserve, but not assigned to any account.
+	// remainder is an internal value of how much extra fractional digits of the
+	// EVM coin are still backed by the reserve, but not assigned to any account.
 	Remainder cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remainder,proto3,customtype=cosmossdk.io/math.Int" json:"remainder"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// denoms is the state of the extended denoms registered in the params.
+	Denoms []DenomGenesisState `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,13 +80,85 @@ func (m *GenesisState) GetBalances() FractionalBalances {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDenoms() []DenomGenesisState {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// DenomGenesisState defines the fractional balances and remainder of an
+// extended denom registered in the params.
+type DenomGenesisState struct {
+	// extended_denom is the extended denom of the state.
+	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
+	// balances is a list of all the fractional balances of the extended denom.
+	Balances FractionalBalances `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=FractionalBalances" json:"balances"`
+	// remainder is the amount of the extended denom backed by the reserve, but
+	// not assigned to any account.
+	Remainder cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remainder,proto3,customtype=cosmossdk.io/math.Int" json:"remainder"`
+}
+
+func (m *DenomGenesisState) Reset()         { *m = DenomGenesisState{} }
+func (m *DenomGenesisState) String() string { return proto.CompactTextString(m) }
+func (*DenomGenesisState) ProtoMessage()    {}
+func (*DenomGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59d417e91f4d8e9, []int{1}
+}
+func (m *DenomGenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGenesisState.Merge(m, src)
+}
+func (m *DenomGenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGenesisState proto.InternalMessageInfo
+
+func (m *DenomGenesisState) GetExtendedDenom() string {
+	if m != nil {
+		return m.ExtendedDenom
+	}
+	return ""
+}
+
+func (m *DenomGenesisState) GetBalances() FractionalBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 // FractionalBalance defines the fractional portion of an account balance
 type FractionalBalance struct {
 	// address is the address of the balance holder.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// amount indicates amount of only the fractional balance owned by the
-	// address. FractionalBalance currently only supports tracking 1 single asset,
-	// e.g. fractional balances of uatom.
+	// address. The denom of the balance is given by the state it belongs to.
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
@@ -88,7 +166,7 @@ func (m *FractionalBalance) Reset()         { *m = FractionalBalance{} }
 func (m *FractionalBalance) String() string { return proto.CompactTextString(m) }
 func (*FractionalBalance) ProtoMessage()    {}
 func (*FractionalBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e59d417e91f4d8e9, []int{1}
+	return fileDescriptor_e59d417e91f4d8e9, []int{2}
 }
 func (m *FractionalBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,6 +197,7 @@ var xxx_messageInfo_FractionalBalance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.precisebank.v1.GenesisState")
+	proto.RegisterType((*DenomGenesisState)(nil), "cosmos.evm.precisebank.v1.DenomGenesisState")
 	proto.RegisterType((*FractionalBalance)(nil), "cosmos.evm.precisebank.v1.FractionalBalance")
 }
 
@@ -127,29 +206,37 @@ func init() {
 }
 
 var fileDescriptor_e59d417e91f4d8e9 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x3f, 0x4f, 0x32, 0x31,
-	0x18, 0xbf, 0x7b, 0xdf, 0x84, 0x17, 0xfa, 0xba, 0x78, 0xc1, 0x04, 0x19, 0xee, 0x08, 0x8b, 0x18,
-	0xa5, 0x0d, 0xb8, 0xb9, 0x79, 0x24, 0x1a, 0x56, 0xd8, 0x5c, 0x4c, 0xef, 0xae, 0x39, 0x1a, 0x68,
-	0x4b, 0xda, 0x72, 0xd1, 0x6f, 0xe0, 0xe8, 0xe4, 0xcc, 0xec, 0xcc, 0xee, 0xca, 0x48, 0x98, 0x8c,
-	0x03, 0x1a, 0x58, 0xfc, 0x18, 0xe6, 0xe8, 0xa9, 0x18, 0xe2, 0xe2, 0xd6, 0x3e, 0xbf, 0x7f, 0xcf,
-	0x93, 0x1f, 0x38, 0x08, 0x85, 0x62, 0x42, 0x21, 0x92, 0x30, 0x34, 0x94, 0x24, 0xa4, 0x8a, 0x04,
-	0x98, 0xf7, 0x51, 0xd2, 0x40, 0x31, 0xe1, 0x44, 0x51, 0x05, 0x87, 0x52, 0x68, 0xe1, 0xec, 0x1b,
-	0x22, 0x24, 0x09, 0x83, 0x1b, 0x44, 0x98, 0x34, 0xca, 0x19, 0x74, 0xb5, 0x26, 0xa2, 0x8c, 0xb7,
-	0xfe, 0x94, 0x8b, 0xb1, 0x88, 0x85, 0x99, 0xa7, 0x2f, 0x33, 0xad, 0x3e, 0xda, 0x60, 0xe7, 0xc2,
-	0xb8, 0x77, 0x35, 0xd6, 0xc4, 0x89, 0x40, 0x3e, 0xc0, 0x03, 0xcc, 0x43, 0xa2, 0x4a, 0x76, 0xe5,
-	0x6f, 0xed, 0x7f, 0xf3, 0x18, 0xfe, 0x98, 0x07, 0xcf, 0x25, 0x0e, 0x35, 0x15, 0x1c, 0x0f, 0x7c,
-	0x23, 0xf2, 0xcb, 0xd3, 0x85, 0x67, 0x3d, 0xbc, 0x78, 0xce, 0x16, 0xa4, 0x3a, 0x9f, 0xce, 0x4e,
-	0x1b, 0x14, 0x24, 0x61, 0x98, 0xf2, 0x88, 0xc8, 0xd2, 0x9f, 0x8a, 0x5d, 0x2b, 0xf8, 0x47, 0xa9,
-	0xf0, 0x79, 0xe1, 0xed, 0x99, 0x34, 0x15, 0xf5, 0x21, 0x15, 0x88, 0x61, 0xdd, 0x83, 0x6d, 0xae,
-	0xe7, 0x93, 0x3a, 0xc8, 0xd6, 0x68, 0x73, 0xdd, 0xf9, 0x52, 0x57, 0xef, 0x6d, 0xb0, 0xbb, 0x95,
-	0xe5, 0x34, 0xc1, 0x3f, 0x1c, 0x45, 0x92, 0xa8, 0xf4, 0x8a, 0xd4, 0xbe, 0x34, 0x9f, 0xd4, 0x8b,
-	0x99, 0xc3, 0x99, 0x41, 0xba, 0x5a, 0x52, 0x1e, 0x77, 0x3e, 0x88, 0x4e, 0x0b, 0xe4, 0x30, 0x13,
-	0x23, 0xae, 0x7f, 0xb3, 0x51, 0x26, 0x3d, 0xcd, 0xdf, 0x8e, 0x3d, 0xeb, 0x6d, 0xec, 0x59, 0x7e,
-	0x6b, 0xba, 0x74, 0xed, 0xd9, 0xd2, 0xb5, 0x5f, 0x97, 0xae, 0x7d, 0xb7, 0x72, 0xad, 0xd9, 0xca,
-	0xb5, 0x9e, 0x56, 0xae, 0x75, 0x79, 0x18, 0x53, 0xdd, 0x1b, 0x05, 0x30, 0x14, 0x0c, 0x6d, 0x94,
-	0x7e, 0xfd, 0xad, 0x76, 0x7d, 0x33, 0x24, 0x2a, 0xc8, 0xad, 0x6b, 0x3a, 0x79, 0x0f, 0x00, 0x00,
-	0xff, 0xff, 0x0c, 0x88, 0x85, 0xa7, 0x1d, 0x02, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0xcf, 0x49, 0x15, 0x1a, 0x17, 0x90, 0x62, 0x15, 0xe9, 0xc8, 0x70, 0x17, 0x22, 0x21,
+	0x22, 0x4a, 0x6d, 0x35, 0x6c, 0x6c, 0x1c, 0x15, 0x28, 0x4c, 0xe8, 0xba, 0xb1, 0x54, 0xce, 0xdd,
+	0xab, 0xeb, 0x29, 0xb5, 0x1d, 0xd9, 0x6e, 0x28, 0x7c, 0x02, 0x46, 0x26, 0xe6, 0x8e, 0x88, 0x89,
+	0xa1, 0x1f, 0xa2, 0x63, 0xe9, 0x84, 0x18, 0x0a, 0x4a, 0x06, 0x90, 0xf8, 0x12, 0xe8, 0xce, 0x57,
+	0x48, 0x15, 0x15, 0x24, 0x90, 0xba, 0x9c, 0x7c, 0xef, 0xfb, 0x7b, 0x9e, 0xf7, 0x8f, 0x6c, 0x7c,
+	0x27, 0x51, 0x46, 0x28, 0xc3, 0x60, 0x22, 0xd8, 0x58, 0x43, 0x92, 0x1b, 0x18, 0x72, 0x39, 0x62,
+	0x93, 0x0d, 0x96, 0x81, 0x04, 0x93, 0x1b, 0x3a, 0xd6, 0xca, 0x2a, 0x72, 0xd3, 0x81, 0x14, 0x26,
+	0x82, 0xce, 0x81, 0x74, 0xb2, 0xd1, 0x6e, 0x71, 0x91, 0x4b, 0xc5, 0xca, 0xaf, 0xa3, 0xdb, 0x6b,
+	0x17, 0xdb, 0xce, 0x8b, 0x1d, 0x5c, 0x59, 0x6f, 0x97, 0x7f, 0xac, 0xaa, 0xe3, 0x52, 0xab, 0x99,
+	0xca, 0x94, 0x8b, 0x17, 0x27, 0x17, 0xed, 0x7e, 0xac, 0xe1, 0xab, 0x4f, 0x5c, 0x77, 0x5b, 0x96,
+	0x5b, 0x20, 0x29, 0x5e, 0x1e, 0xf2, 0x5d, 0x2e, 0x13, 0x30, 0x3e, 0xea, 0xd4, 0x7b, 0x2b, 0xfd,
+	0x7b, 0xf4, 0xc2, 0x7e, 0xe9, 0x63, 0xcd, 0x13, 0x9b, 0x2b, 0xc9, 0x77, 0x23, 0x27, 0x8a, 0xda,
+	0x47, 0xa7, 0xa1, 0xf7, 0xfe, 0x4b, 0x48, 0x16, 0x52, 0x26, 0xfe, 0xe5, 0x4c, 0x06, 0xb8, 0xa9,
+	0x41, 0xf0, 0x5c, 0xa6, 0xa0, 0xfd, 0x5a, 0x07, 0xf5, 0x9a, 0xd1, 0x5a, 0x21, 0xfc, 0x7c, 0x1a,
+	0xde, 0x70, 0xd5, 0x4c, 0x3a, 0xa2, 0xb9, 0x62, 0x82, 0xdb, 0x1d, 0x3a, 0x90, 0xf6, 0xe4, 0x70,
+	0x1d, 0x57, 0x6d, 0x0c, 0xa4, 0x8d, 0x7f, 0xab, 0xc9, 0x26, 0x6e, 0x8c, 0xb9, 0xe6, 0xc2, 0xf8,
+	0xf5, 0x0e, 0xea, 0xad, 0xf4, 0x6f, 0xfd, 0xa1, 0xdd, 0x67, 0x25, 0x18, 0x35, 0x8b, 0x52, 0xef,
+	0xbe, 0x7d, 0xb8, 0x8b, 0xe2, 0x4a, 0x4b, 0x9e, 0xe2, 0x46, 0x0a, 0x52, 0x09, 0xe3, 0x2f, 0xfd,
+	0x75, 0xe8, 0xcd, 0x02, 0x9c, 0x5f, 0x5a, 0xb4, 0x54, 0x18, 0xc6, 0x95, 0x43, 0xf7, 0x07, 0xc2,
+	0xad, 0x05, 0x86, 0xdc, 0xc6, 0xd7, 0x61, 0xdf, 0x82, 0x4c, 0x21, 0xdd, 0x2e, 0x41, 0x1f, 0x15,
+	0x73, 0xc7, 0xd7, 0xce, 0xa2, 0xa5, 0xe4, 0xdc, 0xfe, 0x6b, 0x97, 0xb3, 0xff, 0xfa, 0xff, 0xec,
+	0xbf, 0xfb, 0x16, 0xe1, 0xd6, 0x42, 0x2d, 0xd2, 0xc7, 0x57, 0x78, 0x9a, 0x6a, 0x30, 0xc6, 0x8d,
+	0x19, 0xf9, 0x27, 0x87, 0xeb, 0xab, 0x95, 0xc3, 0x43, 0x97, 0xd9, 0xb2, 0x3a, 0x97, 0x59, 0x7c,
+	0x06, 0x92, 0x47, 0xb8, 0xc1, 0x85, 0xda, 0x93, 0xf6, 0x5f, 0x6e, 0x44, 0x25, 0x7d, 0xb0, 0xfc,
+	0xfa, 0x20, 0xf4, 0xbe, 0x1f, 0x84, 0x5e, 0x34, 0x38, 0x9a, 0x06, 0xe8, 0x78, 0x1a, 0xa0, 0xaf,
+	0xd3, 0x00, 0xbd, 0x99, 0x05, 0xde, 0xf1, 0x2c, 0xf0, 0x3e, 0xcd, 0x02, 0xef, 0x39, 0xcb, 0x72,
+	0xbb, 0xb3, 0x37, 0xa4, 0x89, 0x12, 0xec, 0x15, 0x48, 0x2e, 0xc1, 0xbe, 0x50, 0x7a, 0x54, 0x9e,
+	0xd9, 0xfe, 0xb9, 0x57, 0x66, 0x5f, 0x8e, 0xc1, 0x0c, 0x1b, 0xe5, 0x63, 0xb9, 0xff, 0x33, 0x00,
+	0x00, 0xff, 0xff, 0x2b, 0xf4, 0xec, 0xc7, 0xe3, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -172,6 +259,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Remainder.Size()
 		i -= size
@@ -199,6 +310,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remainder.Size()
+		i -= size
+		if _, err := m.Remainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExtendedDenom) > 0 {
+		i -= len(m.ExtendedDenom)
+		copy(dAtA[i:], m.ExtendedDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExtendedDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FractionalBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -264,6 +429,35 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Remainder.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Remainder.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -385,6 +579,223 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomGenesisState{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, FractionalBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -124,6 +125,109 @@ func TestGenesisStateValidate_Basic(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidate_Denoms(t *testing.T) {
+	ed := types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_000))
+	params := types.NewParams(ed)
+
+	testCases := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		wantErr  string
+	}{
+		{
+			"valid - registered denom without state",
+			func(gs *types.GenesisState) {},
+			"",
+		},
+		{
+			"valid - balances and remainder add up",
+			func(gs *types.GenesisState) {
+				gs.Denoms = []types.DenomGenesisState{
+					types.NewDenomGenesisState(
+						"ausdc",
+						types.FractionalBalances{
+							types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(400_000_000_000)),
+						},
+						sdkmath.NewInt(600_000_000_000),
+					),
+				}
+			},
+			"",
+		},
+		{
+			"invalid - params",
+			func(gs *types.GenesisState) {
+				gs.Params = types.NewParams(ed, ed)
+			},
+			"invalid params: duplicate denom uusdc",
+		},
+		{
+			"invalid - unregistered denom",
+			func(gs *types.GenesisState) {
+				gs.Denoms = []types.DenomGenesisState{
+					types.NewDenomGenesisState("aeur", nil, sdkmath.ZeroInt()),
+				}
+			},
+			"extended denom aeur is not registered in params",
+		},
+		{
+			"invalid - duplicate denom state",
+			func(gs *types.GenesisState) {
+				gs.Denoms = []types.DenomGenesisState{
+					types.NewDenomGenesisState("ausdc", nil, sdkmath.ZeroInt()),
+					types.NewDenomGenesisState("ausdc", nil, sdkmath.ZeroInt()),
+				}
+			},
+			"duplicate state for extended denom ausdc",
+		},
+		{
+			"invalid - balance exceeds the denom conversion factor",
+			func(gs *types.GenesisState) {
+				gs.Denoms = []types.DenomGenesisState{
+					types.NewDenomGenesisState(
+						"ausdc",
+						types.FractionalBalances{
+							types.NewFractionalBalance(sdk.AccAddress{1}.String(), ed.ConversionFactor),
+						},
+						sdkmath.ZeroInt(),
+					),
+				}
+			},
+			fmt.Sprintf("invalid balances for extended denom ausdc: invalid fractional balance for %s: amount 1000000000000 exceeds max of 999999999999", sdk.AccAddress{1}),
+		},
+		{
+			"invalid - balances and remainder do not add up",
+			func(gs *types.GenesisState) {
+				gs.Denoms = []types.DenomGenesisState{
+					types.NewDenomGenesisState(
+						"ausdc",
+						types.FractionalBalances{
+							types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(1)),
+						},
+						sdkmath.ZeroInt(),
+					),
+				}
+			},
+			"invalid state for extended denom ausdc: sum of fractional balances 1 + remainder 0 is not a multiple of 1000000000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.Params = params
+			tc.malleate(gs)
+
+			err := gs.Validate()
+			if tc.wantErr == "" {
+				require.NoError(tt, err)
+			} else {
+				require.EqualError(tt, err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestGenesisStateValidate_Total(t *testing.T) {
 	testCases := []struct {
 		name              string
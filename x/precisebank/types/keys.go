@@ -14,15 +14,36 @@ const (
 
 // key prefixes for store
 var (
-	FractionalBalancePrefix = []byte{0x01} // address -> fractional balance
+	FractionalBalancePrefix      = []byte{0x01} // address -> fractional balance
+	DenomFractionalBalancePrefix = []byte{0x04} // denom | address -> fractional balance
+	DenomRemainderPrefix         = []byte{0x05} // denom -> fractional balance remainder
 )
 
 // Keys for store that are not prefixed
 var (
 	RemainderBalanceKey = []byte{0x02} // fractional balance remainder
+	ParamsKey           = []byte{0x03} // module parameters
 )
 
 // FractionalBalanceKey returns a key from an address
 func FractionalBalanceKey(address sdk.AccAddress) []byte {
 	return address.Bytes()
 }
+
+// DenomFractionalBalancePrefixKey returns the prefix of the fractional
+// balances of an extended denom registered in the params. The denom is length
+// prefixed, so that no denom prefix overlaps with the prefix of another one.
+func DenomFractionalBalancePrefixKey(denom string) []byte {
+	key := make([]byte, 0, len(DenomFractionalBalancePrefix)+1+len(denom))
+	key = append(key, DenomFractionalBalancePrefix...)
+	key = append(key, byte(len(denom)))
+	return append(key, denom...)
+}
+
+// DenomRemainderKey returns the key of the remainder of an extended denom
+// registered in the params.
+func DenomRemainderKey(denom string) []byte {
+	key := make([]byte, 0, len(DenomRemainderPrefix)+len(denom))
+	key = append(key, DenomRemainderPrefix...)
+	return append(key, denom...)
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -17,3 +18,11 @@ func TestFractionalBalanceKey(t *testing.T) {
 	require.Equal(t, addr.Bytes(), key)
 	require.Equal(t, addr, sdk.AccAddress(key), "key should be able to be converted back to address")
 }
+
+func TestDenomFractionalBalancePrefixKey(t *testing.T) {
+	key := types.DenomFractionalBalancePrefixKey("ausdc")
+	require.Equal(t, append([]byte{0x04, 5}, "ausdc"...), key)
+
+	// A denom prefixing another denom does not share its key prefix
+	require.False(t, bytes.HasPrefix(types.DenomFractionalBalancePrefixKey("ausdcx"), key))
+}
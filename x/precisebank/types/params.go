@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(extendedDenoms ...ExtendedDenom) Params {
+	return Params{
+		ExtendedDenoms: extendedDenoms,
+	}
+}
+
+// DefaultParams returns the default params, where only the EVM coin is
+// extended.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate performs a stateless validation of the params fields.
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]struct{})
+
+	for _, ed := range p.ExtendedDenoms {
+		if err := ed.Validate(); err != nil {
+			return fmt.Errorf("invalid extended denom %s: %w", ed.ExtendedDenom, err)
+		}
+
+		// Integer and extended denoms must be unique across all entries, so
+		// that every coin is routed to a single extended denom.
+		for _, denom := range []string{ed.IntegerDenom, ed.ExtendedDenom} {
+			if _, found := seenDenoms[denom]; found {
+				return fmt.Errorf("duplicate denom %s", denom)
+			}
+			seenDenoms[denom] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// GetExtendedDenom returns the registered extended denom with the given
+// extended denom name.
+func (p Params) GetExtendedDenom(denom string) (ExtendedDenom, bool) {
+	for _, ed := range p.ExtendedDenoms {
+		if ed.ExtendedDenom == denom {
+			return ed, true
+		}
+	}
+
+	return ExtendedDenom{}, false
+}
+
+// NewExtendedDenom creates a new ExtendedDenom instance.
+func NewExtendedDenom(integerDenom, extendedDenom string, conversionFactor sdkmath.Int) ExtendedDenom {
+	return ExtendedDenom{
+		IntegerDenom:     integerDenom,
+		ExtendedDenom:    extendedDenom,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// EVMExtendedDenom returns the ExtendedDenom of the EVM coin, which is always
+// extended by x/precisebank and is not part of the params.
+func EVMExtendedDenom() ExtendedDenom {
+	return NewExtendedDenom(IntegerCoinDenom(), ExtendedCoinDenom(), ConversionFactor())
+}
+
+// IsEVMCoin returns true if the ExtendedDenom extends the EVM coin.
+func (ed ExtendedDenom) IsEVMCoin() bool {
+	return ed.ExtendedDenom == ExtendedCoinDenom()
+}
+
+// ExtraDecimals returns the number of decimals added by the extended denom to its
+// integer denom, i.e. the exponent of the conversion factor.
+func (ed ExtendedDenom) ExtraDecimals() uint32 {
+	return uint32(len(ed.ConversionFactor.String()) - 1) //#nosec G115 -- the conversion factor is a validated power of 10
+}
+
+// Validate returns an error if the denoms are invalid or if the conversion
+// factor is not a power of 10 greater than 1.
+func (ed ExtendedDenom) Validate() error {
+	if err := sdk.ValidateDenom(ed.IntegerDenom); err != nil {
+		return fmt.Errorf("invalid integer denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(ed.ExtendedDenom); err != nil {
+		return fmt.Errorf("invalid extended denom: %w", err)
+	}
+
+	if ed.IntegerDenom == ed.ExtendedDenom {
+		return fmt.Errorf("integer denom and extended denom must differ, got %s", ed.IntegerDenom)
+	}
+
+	if ed.ConversionFactor.IsNil() {
+		return fmt.Errorf("nil conversion factor")
+	}
+
+	factor := ed.ConversionFactor
+	if factor.LTE(sdkmath.OneInt()) {
+		return fmt.Errorf("conversion factor %v must be greater than 1", factor)
+	}
+
+	ten := sdkmath.NewInt(10)
+	for factor.GT(sdkmath.OneInt()) {
+		if !factor.Mod(ten).IsZero() {
+			return fmt.Errorf("conversion factor %v is not a power of 10", ed.ConversionFactor)
+		}
+		factor = factor.Quo(ten)
+	}
+
+	return nil
+}
+
+// ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
+// amount of the extended denom, ensuring it is positive and less than the
+// conversion factor.
+func (ed ExtendedDenom) ValidateFractionalAmount(amt sdkmath.Int) error {
+	return validateFractionalAmount(amt, ed.ConversionFactor)
+}
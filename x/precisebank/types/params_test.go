@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	usdc := types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_000))
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		wantErr string
+	}{
+		{
+			"valid - default params",
+			types.DefaultParams(),
+			"",
+		},
+		{
+			"valid - multiple extended denoms",
+			types.NewParams(
+				usdc,
+				types.NewExtendedDenom("ueur", "aeur", sdkmath.NewInt(1_000_000_000_000)),
+				types.NewExtendedDenom("cbtc", "sbtc", sdkmath.NewInt(10)),
+			),
+			"",
+		},
+		{
+			"invalid - integer denom",
+			types.NewParams(types.NewExtendedDenom("1usdc", "ausdc", sdkmath.NewInt(10))),
+			"invalid extended denom ausdc: invalid integer denom: invalid denom: 1usdc",
+		},
+		{
+			"invalid - extended denom",
+			types.NewParams(types.NewExtendedDenom("uusdc", "", sdkmath.NewInt(10))),
+			"invalid extended denom : invalid extended denom: invalid denom: ",
+		},
+		{
+			"invalid - same integer and extended denoms",
+			types.NewParams(types.NewExtendedDenom("uusdc", "uusdc", sdkmath.NewInt(10))),
+			"invalid extended denom uusdc: integer denom and extended denom must differ, got uusdc",
+		},
+		{
+			"invalid - nil conversion factor",
+			types.NewParams(types.NewExtendedDenom("uusdc", "ausdc", sdkmath.Int{})),
+			"invalid extended denom ausdc: nil conversion factor",
+		},
+		{
+			"invalid - conversion factor of 1",
+			types.NewParams(types.NewExtendedDenom("uusdc", "ausdc", sdkmath.OneInt())),
+			"invalid extended denom ausdc: conversion factor 1 must be greater than 1",
+		},
+		{
+			"invalid - conversion factor not a power of 10",
+			types.NewParams(types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_001))),
+			"invalid extended denom ausdc: conversion factor 1000000000001 is not a power of 10",
+		},
+		{
+			"invalid - duplicate extended denom",
+			types.NewParams(usdc, types.NewExtendedDenom("uusdt", "ausdc", sdkmath.NewInt(10))),
+			"duplicate denom ausdc",
+		},
+		{
+			"invalid - integer denom extended by another entry",
+			types.NewParams(usdc, types.NewExtendedDenom("ausdc", "xusdc", sdkmath.NewInt(10))),
+			"duplicate denom ausdc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestParamsGetExtendedDenom(t *testing.T) {
+	usdc := types.NewExtendedDenom("uusdc", "ausdc", sdkmath.NewInt(1_000_000_000_000))
+	params := types.NewParams(usdc)
+
+	ed, found := params.GetExtendedDenom("ausdc")
+	require.True(t, found)
+	require.Equal(t, usdc, ed)
+
+	// Lookups are done by the extended denom only
+	_, found = params.GetExtendedDenom("uusdc")
+	require.False(t, found)
+}
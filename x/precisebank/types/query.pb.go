@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,15 +32,99 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest defines the request type for querying x/precisebank
+// parameters.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for querying x/precisebank
+// parameters.
+type QueryParamsResponse struct {
+	// params define the precisebank module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// evm_extended_denom is the extended denom of the EVM coin, which is
+	// configured by x/vm instead of the module parameters.
+	EvmExtendedDenom ExtendedDenom `protobuf:"bytes,2,opt,name=evm_extended_denom,json=evmExtendedDenom,proto3" json:"evm_extended_denom"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
 // QueryRemainderRequest defines the request type for Query/Remainder method.
 type QueryRemainderRequest struct {
+	// denom is the extended denom to query the remainder for. Defaults to the
+	// EVM extended denom if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryRemainderRequest) Reset()         { *m = QueryRemainderRequest{} }
 func (m *QueryRemainderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRemainderRequest) ProtoMessage()    {}
 func (*QueryRemainderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{0}
+	return fileDescriptor_8c5456889057ce50, []int{2}
 }
 func (m *QueryRemainderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +164,7 @@ func (m *QueryRemainderResponse) Reset()         { *m = QueryRemainderResponse{}
 func (m *QueryRemainderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRemainderResponse) ProtoMessage()    {}
 func (*QueryRemainderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{1}
+	return fileDescriptor_8c5456889057ce50, []int{3}
 }
 func (m *QueryRemainderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,13 +198,16 @@ var xxx_messageInfo_QueryRemainderResponse proto.InternalMessageInfo
 type QueryFractionalBalanceRequest struct {
 	// address is the account address to query  fractional balance for.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the extended denom to query the fractional balance for. Defaults
+	// to the EVM extended denom if empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryFractionalBalanceRequest) Reset()         { *m = QueryFractionalBalanceRequest{} }
 func (m *QueryFractionalBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFractionalBalanceRequest) ProtoMessage()    {}
 func (*QueryFractionalBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{2}
+	return fileDescriptor_8c5456889057ce50, []int{4}
 }
 func (m *QueryFractionalBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +247,7 @@ func (m *QueryFractionalBalanceResponse) Reset()         { *m = QueryFractionalB
 func (m *QueryFractionalBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFractionalBalanceResponse) ProtoMessage()    {}
 func (*QueryFractionalBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{3}
+	return fileDescriptor_8c5456889057ce50, []int{5}
 }
 func (m *QueryFractionalBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,11 +276,100 @@ func (m *QueryFractionalBalanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFractionalBalanceResponse proto.InternalMessageInfo
 
+// QueryFractionalBalancesRequest defines the request type for
+// Query/FractionalBalances method.
+type QueryFractionalBalancesRequest struct {
+	// denom is the extended denom to query the fractional balances for. Defaults
+	// to the EVM extended denom if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFractionalBalancesRequest) Reset()         { *m = QueryFractionalBalancesRequest{} }
+func (m *QueryFractionalBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFractionalBalancesRequest) ProtoMessage()    {}
+func (*QueryFractionalBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{6}
+}
+func (m *QueryFractionalBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFractionalBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFractionalBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFractionalBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFractionalBalancesRequest.Merge(m, src)
+}
+func (m *QueryFractionalBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFractionalBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFractionalBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFractionalBalancesRequest proto.InternalMessageInfo
+
+// QueryFractionalBalancesResponse defines the response type for
+// Query/FractionalBalances method.
+type QueryFractionalBalancesResponse struct {
+	// balances are the non-zero fractional balances of the extended denom.
+	Balances []FractionalBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFractionalBalancesResponse) Reset()         { *m = QueryFractionalBalancesResponse{} }
+func (m *QueryFractionalBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFractionalBalancesResponse) ProtoMessage()    {}
+func (*QueryFractionalBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{7}
+}
+func (m *QueryFractionalBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFractionalBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFractionalBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFractionalBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFractionalBalancesResponse.Merge(m, src)
+}
+func (m *QueryFractionalBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFractionalBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFractionalBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFractionalBalancesResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.precisebank.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.precisebank.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryRemainderRequest)(nil), "cosmos.evm.precisebank.v1.QueryRemainderRequest")
 	proto.RegisterType((*QueryRemainderResponse)(nil), "cosmos.evm.precisebank.v1.QueryRemainderResponse")
 	proto.RegisterType((*QueryFractionalBalanceRequest)(nil), "cosmos.evm.precisebank.v1.QueryFractionalBalanceRequest")
 	proto.RegisterType((*QueryFractionalBalanceResponse)(nil), "cosmos.evm.precisebank.v1.QueryFractionalBalanceResponse")
+	proto.RegisterType((*QueryFractionalBalancesRequest)(nil), "cosmos.evm.precisebank.v1.QueryFractionalBalancesRequest")
+	proto.RegisterType((*QueryFractionalBalancesResponse)(nil), "cosmos.evm.precisebank.v1.QueryFractionalBalancesResponse")
 }
 
 func init() {
@@ -199,34 +377,51 @@ func init() {
 }
 
 var fileDescriptor_8c5456889057ce50 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x28, 0x4a, 0x4d, 0xce, 0x2c, 0x4e, 0x4d, 0x4a,
-	0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x84, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x43, 0x52, 0xa6, 0x57, 0x66, 0x28,
-	0x25, 0x07, 0x35, 0x21, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24, 0xd1, 0x50,
-	0x3f, 0x39, 0x3f, 0x33, 0x0f, 0xa2, 0x55, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07,
-	0xb1, 0xa0, 0xa2, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x10, 0x59, 0x25, 0x71, 0x2e, 0xd1,
-	0x40, 0x90, 0xed, 0x41, 0xa9, 0xb9, 0x89, 0x99, 0x79, 0x29, 0xa9, 0x45, 0x41, 0xa9, 0x85, 0xa5,
-	0xa9, 0xc5, 0x25, 0x4a, 0xe1, 0x5c, 0x62, 0xe8, 0x12, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
-	0xb6, 0x5c, 0x9c, 0x45, 0x30, 0x41, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0xa8,
-	0xab, 0x41, 0x4e, 0xd3, 0x83, 0x3a, 0x4d, 0xcf, 0x39, 0x3f, 0x33, 0xcf, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0x84, 0x0e, 0x25, 0x4b, 0x2e, 0x59, 0xb0, 0xc1, 0x6e, 0x45, 0x89, 0xc9, 0x20,
-	0x97, 0x24, 0xe6, 0x38, 0x25, 0xe6, 0x24, 0xe6, 0x25, 0xa7, 0x42, 0x6d, 0x16, 0x92, 0xe0, 0x62,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x06, 0x9b, 0xce, 0x19, 0x04, 0xe3, 0x2a, 0x15, 0x70,
-	0xc9, 0xe1, 0xd2, 0x0a, 0x75, 0x9b, 0x1f, 0x97, 0x50, 0x1a, 0x5c, 0x32, 0x3e, 0x09, 0x22, 0x4b,
-	0xac, 0x23, 0x05, 0xd3, 0xd0, 0xcd, 0x35, 0xfa, 0xc2, 0xc4, 0xc5, 0x0a, 0xb6, 0x52, 0x68, 0x1e,
-	0x23, 0x17, 0x27, 0x3c, 0x2c, 0x84, 0x0c, 0xf4, 0x70, 0x46, 0x93, 0x1e, 0xd6, 0xf0, 0x94, 0x32,
-	0x24, 0x41, 0x07, 0xc4, 0x33, 0x4a, 0x3a, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x52, 0x13, 0x52, 0xd1,
-	0xc7, 0x9d, 0x74, 0xe0, 0xe1, 0x2a, 0x74, 0x92, 0x91, 0x4b, 0x10, 0x23, 0x60, 0x84, 0x2c, 0x08,
-	0x59, 0x8b, 0x2b, 0x1a, 0xa4, 0x2c, 0xc9, 0xd0, 0x09, 0x75, 0xb8, 0x3d, 0xd8, 0xe1, 0x96, 0x42,
-	0xe6, 0x78, 0x1c, 0x8e, 0x19, 0x4d, 0xfa, 0xd5, 0xd0, 0x78, 0xae, 0x75, 0x72, 0x3f, 0xf1, 0x50,
-	0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0x91, 0x2d, 0xa8, 0x40, 0xb1, 0xa2, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x9c, 0xca, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x79, 0x05, 0x2f,
-	0x39, 0x7d, 0x03, 0x00, 0x00,
+	// 691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x28, 0xe8, 0x0e, 0x17, 0x19, 0xd1, 0xc0, 0x46, 0x8b, 0x54, 0x05, 0x44, 0xe8,
+	0xb0, 0x98, 0xa8, 0x98, 0x18, 0x93, 0x15, 0xf1, 0xa4, 0xe2, 0x7a, 0x30, 0xf1, 0x42, 0x66, 0xb7,
+	0x8f, 0xda, 0x40, 0x67, 0x4a, 0xa7, 0x54, 0xd0, 0xe8, 0xc1, 0xbf, 0xc0, 0xe8, 0xdd, 0xb3, 0x47,
+	0xff, 0x02, 0x3d, 0x78, 0xc1, 0x93, 0x24, 0x5e, 0x3c, 0x19, 0x05, 0x13, 0xff, 0x0d, 0xd3, 0x99,
+	0xe9, 0xd2, 0x65, 0x6d, 0xf9, 0x71, 0x21, 0xd3, 0x99, 0xef, 0x7b, 0xef, 0xf3, 0x9d, 0x79, 0x8f,
+	0x45, 0x17, 0x9b, 0x5c, 0xf8, 0x5c, 0x10, 0x88, 0x7d, 0x12, 0x84, 0xd0, 0xf4, 0x04, 0x34, 0x28,
+	0x5b, 0x22, 0x71, 0x95, 0xac, 0xac, 0x42, 0xb8, 0x6e, 0x07, 0x21, 0x8f, 0x38, 0x1e, 0x54, 0x32,
+	0x1b, 0x62, 0xdf, 0xce, 0xc8, 0xec, 0xb8, 0x5a, 0xe9, 0xa3, 0xbe, 0xc7, 0x38, 0x91, 0x7f, 0x95,
+	0xba, 0x32, 0xae, 0x93, 0x36, 0xa8, 0x00, 0x95, 0x86, 0xc4, 0xd5, 0x06, 0x44, 0xb4, 0x4a, 0x02,
+	0xea, 0x7a, 0x8c, 0x46, 0x1e, 0x67, 0x5a, 0x6b, 0x66, 0xb5, 0xa9, 0xaa, 0xc9, 0xbd, 0xf4, 0x7c,
+	0x34, 0x1f, 0xd0, 0x05, 0x06, 0xc2, 0x13, 0x5a, 0x78, 0x39, 0x5f, 0x98, 0x25, 0x56, 0xe2, 0x7e,
+	0x97, 0xbb, 0x5c, 0x2e, 0x49, 0xb2, 0xd2, 0xbb, 0x67, 0x5c, 0xce, 0xdd, 0x65, 0x20, 0x34, 0xf0,
+	0x08, 0x65, 0x8c, 0x47, 0x12, 0x54, 0x17, 0xb0, 0xfa, 0x11, 0x7e, 0x98, 0x78, 0x99, 0xa7, 0x21,
+	0xf5, 0x45, 0x1d, 0x56, 0x56, 0x41, 0x44, 0xd6, 0x27, 0x03, 0x9d, 0x6c, 0xdb, 0x16, 0x01, 0x67,
+	0x02, 0xf0, 0x2c, 0xea, 0x09, 0xe4, 0xce, 0x80, 0x71, 0xce, 0x18, 0xeb, 0x9d, 0x1e, 0xb6, 0x73,
+	0xaf, 0xd0, 0x56, 0xa1, 0xb5, 0xf2, 0xc6, 0xcf, 0xa1, 0xd2, 0x87, 0xbf, 0x1f, 0xc7, 0x8d, 0xba,
+	0x8e, 0xc5, 0x14, 0x61, 0x88, 0xfd, 0x05, 0x58, 0x8b, 0x80, 0x39, 0xe0, 0x2c, 0x38, 0xc0, 0xb8,
+	0x3f, 0xd0, 0x25, 0x33, 0x8e, 0x15, 0x64, 0xbc, 0xa3, 0x03, 0x66, 0x13, 0x7d, 0x36, 0xf1, 0x09,
+	0x88, 0xfd, 0xb6, 0x43, 0x6b, 0x12, 0x9d, 0x92, 0xfc, 0x75, 0xf0, 0xa9, 0xc7, 0x1c, 0x08, 0xb5,
+	0x33, 0xdc, 0x8f, 0xba, 0x55, 0xb9, 0xc4, 0x40, 0xb9, 0xae, 0x3e, 0xac, 0xc7, 0xe8, 0xf4, 0x6e,
+	0xb9, 0x76, 0x7c, 0x13, 0x95, 0xc3, 0x74, 0x53, 0x9b, 0x1e, 0x4c, 0x11, 0x93, 0xd7, 0xb5, 0xf5,
+	0xeb, 0xda, 0xb7, 0xb9, 0xc7, 0x6a, 0x47, 0x13, 0xa6, 0xfa, 0x4e, 0x84, 0xf5, 0x00, 0x9d, 0x95,
+	0x89, 0xe7, 0x42, 0xda, 0x4c, 0xae, 0x9d, 0x2e, 0xd7, 0xe8, 0x32, 0x65, 0x4d, 0x48, 0x79, 0x06,
+	0xd0, 0x31, 0xea, 0x38, 0x21, 0x08, 0xa1, 0x89, 0xd2, 0xcf, 0x1d, 0xd2, 0xae, 0x2c, 0x69, 0x80,
+	0xcc, 0xbc, 0x84, 0x9a, 0xf8, 0x3e, 0xc2, 0x8b, 0xad, 0xc3, 0x85, 0x86, 0x3a, 0xdd, 0x2f, 0x7a,
+	0xdf, 0xe2, 0xee, 0xbc, 0xd6, 0xab, 0xbc, 0x8a, 0xa2, 0xf0, 0x4e, 0xf1, 0x1c, 0x42, 0x3b, 0x73,
+	0xa1, 0x5f, 0x77, 0xa4, 0xad, 0xbe, 0x9a, 0xc5, 0x94, 0x62, 0x9e, 0xba, 0xe9, 0xad, 0xd4, 0x33,
+	0x91, 0xd6, 0x67, 0x03, 0x0d, 0xe5, 0x02, 0x68, 0xcf, 0x8f, 0xd0, 0x71, 0x6d, 0x34, 0xb9, 0xc6,
+	0x23, 0x63, 0xbd, 0xd3, 0x13, 0x05, 0x7d, 0xd4, 0x91, 0x28, 0xdb, 0x4b, 0xad, 0x44, 0xf8, 0xee,
+	0x7f, 0x0c, 0x8c, 0xee, 0x69, 0x40, 0x11, 0x65, 0x1d, 0x4c, 0x7f, 0xeb, 0x46, 0xdd, 0xd2, 0x01,
+	0x7e, 0x6b, 0xa0, 0x1e, 0x35, 0x17, 0x78, 0xb2, 0x00, 0xb0, 0x73, 0x22, 0x2b, 0xf6, 0x7e, 0xe5,
+	0xaa, 0xbe, 0x75, 0xe9, 0xf5, 0xf7, 0x3f, 0xef, 0xba, 0xce, 0xe3, 0x61, 0x52, 0xf0, 0x1f, 0x44,
+	0x91, 0xbc, 0x37, 0x50, 0xb9, 0xd5, 0xf8, 0x78, 0x6a, 0xaf, 0x42, 0xbb, 0x47, 0xaa, 0x52, 0x3d,
+	0x40, 0x84, 0xa6, 0x9b, 0x90, 0x74, 0x23, 0xf8, 0x42, 0x01, 0x5d, 0x6b, 0x88, 0xf0, 0x57, 0x03,
+	0xf5, 0x75, 0xbc, 0x19, 0xbe, 0xbe, 0x57, 0xd9, 0xbc, 0x99, 0xab, 0xcc, 0x1c, 0x22, 0x52, 0x83,
+	0xdf, 0x92, 0xe0, 0x33, 0xf8, 0x5a, 0x01, 0x78, 0xe7, 0xf4, 0x91, 0x17, 0x7a, 0xa8, 0x5f, 0xe2,
+	0x2f, 0x06, 0xc2, 0x9d, 0x8d, 0x8c, 0x0f, 0x8e, 0xd4, 0xea, 0x8c, 0x1b, 0x87, 0x09, 0xd5, 0x76,
+	0xae, 0x4a, 0x3b, 0x53, 0xd8, 0x3e, 0x90, 0x1d, 0x51, 0xbb, 0xb7, 0xf1, 0xdb, 0x2c, 0x6d, 0x6c,
+	0x99, 0xc6, 0xe6, 0x96, 0x69, 0xfc, 0xda, 0x32, 0x8d, 0x37, 0xdb, 0x66, 0x69, 0x73, 0xdb, 0x2c,
+	0xfd, 0xd8, 0x36, 0x4b, 0x4f, 0x88, 0xeb, 0x45, 0x4f, 0x57, 0x1b, 0x76, 0x93, 0xfb, 0xe4, 0x39,
+	0x30, 0xca, 0x20, 0x7a, 0xc6, 0xc3, 0x25, 0xb9, 0x26, 0x6b, 0x6d, 0xf9, 0xa3, 0xf5, 0x00, 0x44,
+	0xa3, 0x47, 0xfe, 0x16, 0x5d, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0x89, 0xa1, 0x91, 0xee, 0xb8,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -241,12 +436,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of x/precisebank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
 	// FractionalBalance returns only the fractional balance of an address. This
 	// does not include any integer balance.
 	FractionalBalance(ctx context.Context, in *QueryFractionalBalanceRequest, opts ...grpc.CallOption) (*QueryFractionalBalanceResponse, error)
+	// FractionalBalances returns all non-zero fractional balances of an extended
+	// denom.
+	FractionalBalances(ctx context.Context, in *QueryFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryFractionalBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -257,6 +457,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
 	out := new(QueryRemainderResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/Remainder", in, out, opts...)
@@ -275,31 +484,69 @@ func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractional
 	return out, nil
 }
 
+func (c *queryClient) FractionalBalances(ctx context.Context, in *QueryFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryFractionalBalancesResponse, error) {
+	out := new(QueryFractionalBalancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/FractionalBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries the parameters of x/precisebank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
 	// FractionalBalance returns only the fractional balance of an address. This
 	// does not include any integer balance.
 	FractionalBalance(context.Context, *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error)
+	// FractionalBalances returns all non-zero fractional balances of an extended
+	// denom.
+	FractionalBalances(context.Context, *QueryFractionalBalancesRequest) (*QueryFractionalBalancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) Remainder(ctx context.Context, req *QueryRemainderRequest) (*QueryRemainderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
 }
 func (*UnimplementedQueryServer) FractionalBalance(ctx context.Context, req *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalance not implemented")
 }
+func (*UnimplementedQueryServer) FractionalBalances(ctx context.Context, req *QueryFractionalBalancesRequest) (*QueryFractionalBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.precisebank.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRemainderRequest)
 	if err := dec(in); err != nil {
@@ -336,10 +583,32 @@ func _Query_FractionalBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FractionalBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFractionalBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FractionalBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.precisebank.v1.Query/FractionalBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FractionalBalances(ctx, req.(*QueryFractionalBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.precisebank.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "Remainder",
 			Handler:    _Query_Remainder_Handler,
@@ -348,11 +617,81 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FractionalBalance",
 			Handler:    _Query_FractionalBalance_Handler,
 		},
+		{
+			MethodName: "FractionalBalances",
+			Handler:    _Query_FractionalBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/precisebank/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EvmExtendedDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRemainderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -373,6 +712,13 @@ func (m *QueryRemainderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -429,6 +775,13 @@ func (m *QueryFractionalBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -472,68 +825,225 @@ func (m *QueryFractionalBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryRemainderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFractionalBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRemainderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Remainder.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFractionalBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFractionalBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFractionalBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFractionalBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryFractionalBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFractionalBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFractionalBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EvmExtendedDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remainder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFractionalBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFractionalBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.FractionalBalance.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
+func (m *QueryFractionalBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFractionalBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -556,10 +1066,10 @@ func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemainderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -583,7 +1093,7 @@ func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,15 +1116,15 @@ func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemainderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemainderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -641,7 +1151,40 @@ func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmExtendedDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvmExtendedDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -666,7 +1209,7 @@ func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -689,15 +1232,15 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFractionalBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFractionalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -725,7 +1268,7 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -748,7 +1291,7 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,15 +1314,15 @@ func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFractionalBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFractionalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FractionalBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -806,7 +1349,442 @@ func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FractionalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FractionalBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FractionalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, FractionalBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the parent command for all ratelimit CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ratelimit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetRateLimitsCmd(),
+		GetRateLimitCmd(),
+		GetRateLimitsByChannelOrClientCmd(),
+	)
+	return cmd
+}
+
+// GetRateLimitsCmd queries all the rate limits
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Gets all the rate limits",
+		Long:  "Gets all the rate limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits")
+	return cmd
+}
+
+// GetRateLimitCmd queries the rate limit of a denom and channel or client
+func GetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit DENOM CHANNEL_OR_CLIENT_ID",
+		Short: "Get the rate limit of a denom and channel or client",
+		Long:  "Get the rate limit of a denom and channel or client",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				Denom:             args[0],
+				ChannelOrClientId: args[1],
+			}
+
+			res, err := queryClient.RateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRateLimitsByChannelOrClientCmd queries the rate limits of a channel or client
+func GetRateLimitsByChannelOrClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits-by-channel-or-client CHANNEL_OR_CLIENT_ID",
+		Short: "Gets the rate limits of a channel or client",
+		Long:  "Gets the rate limits of a channel or client",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsByChannelOrClientRequest{
+				ChannelOrClientId: args[0],
+			}
+
+			res, err := queryClient.RateLimitsByChannelOrClient(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
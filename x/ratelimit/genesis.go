@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/zenanetwork/zena/x/ratelimit/keeper"
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	for _, rateLimit := range data.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, packet := range data.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the ratelimit module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		RateLimits:         k.GetAllRateLimits(ctx),
+		PendingSendPackets: k.GetAllPendingSendPackets(ctx),
+	}
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"errors"
+
+	"github.com/zenanetwork/zena/ibc"
+	"github.com/zenanetwork/zena/x/ratelimit/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ porttypes.IBCModule             = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the rate limit middleware given
+// the ratelimit keeper and the underlying application. The outflow of the sent
+// packets is accounted by the keeper, which wraps the ICS4Wrapper of the transfer
+// keeper.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It accounts the inflow of the received tokens and returns an error
+// acknowledgement without calling the underlying application if the receive
+// quota is exceeded.
+// NOTE: if the underlying application returns an error acknowledgement, core IBC
+// discards the state changes of the packet, including the inflow.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		// let the underlying application return the error acknowledgement
+		return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet, data.Token); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It reverts the outflow of the packet if the acknowledgement is an error, as the
+// tokens are refunded to the sender.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	if err := im.Module.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.AcknowledgeRateLimitedPacket(ctx, packet.SourceChannel, packet.Sequence, data.Token, ack.Success())
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It reverts the outflow of the packet, as the tokens are refunded to the sender.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	if err := im.Module.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.TimeoutRateLimitedPacket(ctx, packet.SourceChannel, packet.Sequence, data.Token)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(
+	ctx sdk.Context,
+	portID, channelID string,
+	data []byte,
+) (any, string, error) {
+	return im.Module.UnmarshalPacketData(ctx, portID, channelID, data)
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlock removes the flow buckets that left the rolling window of the rate
+// limits, together with the pending send packets accounted in them.
+func (k Keeper) BeginBlock(ctx sdk.Context) error {
+	var pruned []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		if rateLimit.PruneFlow(ctx.BlockTime()) {
+			pruned = append(pruned, rateLimit)
+		}
+		return false
+	})
+
+	for _, rateLimit := range pruned {
+		k.SetRateLimit(ctx, rateLimit)
+		k.removeExpiredPendingSendPackets(ctx, rateLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimits returns all the rate limits
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit returns the rate limit of a given denom and channel or client
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewPath(req.Denom, req.ChannelOrClientId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelOrClientId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit with denom '%s' and channel or client '%s'", req.Denom, req.ChannelOrClientId)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: rateLimit}, nil
+}
+
+// RateLimitsByChannelOrClient returns the rate limits of a given channel or client
+func (k Keeper) RateLimitsByChannelOrClient(c context.Context, req *types.QueryRateLimitsByChannelOrClientRequest) (*types.QueryRateLimitsByChannelOrClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateChannelOrClientID(req.ChannelOrClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRateLimitsByChannelOrClientResponse{
+		RateLimits: k.GetRateLimitsByChannelOrClient(ctx, req.ChannelOrClientId),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. It sends the packet through the
+// underlying wrapper and then accounts the outflow of ICS20 transfers, so that
+// transfers exceeding the send quota are rejected. This covers the IBC v1
+// transfers of the transfer keeper, including the ones sent by the ICS20
+// precompile. IBC v2 transfers are accounted by the v2 middleware instead.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if sourcePort != transfertypes.PortID {
+		return sequence, nil
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(data, transfertypes.V1, "")
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.SendRateLimitedPacket(ctx, sourceChannel, sequence, packetData.Token); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
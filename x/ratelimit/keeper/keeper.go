@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the ratelimit module. It also implements the ICS4Wrapper interface
+// to account the outflow of the IBC v1 transfers sent by the transfer keeper.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing the rate limit governance messages. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	ics4Wrapper    porttypes.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	clientKeeperV2 types.ClientKeeperV2
+}
+
+// NewKeeper creates new instances of the ratelimit Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	clientKeeperV2 types.ClientKeeperV2,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		authority:      authority,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		clientKeeperV2: clientKeeperV2,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/ratelimit/keeper"
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	clienttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	testChannel = "channel-0"
+	testClient  = "07-tendermint-0"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+// fakeIBC implements the ICS4Wrapper and the channel and client keepers expected
+// by the ratelimit keeper.
+type fakeIBC struct {
+	sequence uint64
+}
+
+func (f *fakeIBC) SendPacket(sdk.Context, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	f.sequence++
+	return f.sequence, nil
+}
+
+func (f *fakeIBC) WriteAcknowledgement(sdk.Context, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+func (f *fakeIBC) GetAppVersion(sdk.Context, string, string) (string, bool) {
+	return transfertypes.V1, true
+}
+
+func (f *fakeIBC) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	return channeltypes.Channel{}, portID == transfertypes.PortID && channelID == testChannel
+}
+
+func (f *fakeIBC) GetClientCounterparty(_ sdk.Context, clientID string) (clienttypesv2.CounterpartyInfo, bool) {
+	return clienttypesv2.CounterpartyInfo{}, clientID == testClient
+}
+
+// testData defines necessary fields for testing keeper store methods without
+// full app setup.
+type testData struct {
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func newTestData(t *testing.T) testData {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ibc := &fakeIBC{}
+	k := keeper.NewKeeper(storeKey, cdc, authority, ibc, ibc, ibc)
+
+	return testData{
+		ctx:    ctx,
+		keeper: k,
+	}
+}
+
+func (td testData) addRateLimit(t *testing.T, denom, channelOrClientID string, maxSend, maxRecv int64) {
+	t.Helper()
+
+	_, err := td.keeper.AddRateLimit(td.ctx, &types.MsgAddRateLimit{
+		Authority: authority.String(),
+		Path:      types.NewPath(denom, channelOrClientID),
+		Quota:     types.NewQuota(math.NewInt(maxSend), math.NewInt(maxRecv), time.Hour),
+	})
+	require.NoError(t, err)
+}
+
+func transferData(denom string, amount int64) []byte {
+	return transfertypes.NewFungibleTokenPacketData(denom, math.NewInt(amount).String(), "sender", "receiver", "").GetBytes()
+}
+
+func token(denom string, amount int64) transfertypes.Token {
+	return transfertypes.Token{
+		Denom:  transfertypes.ExtractDenomFromPath(denom),
+		Amount: math.NewInt(amount).String(),
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// AddRateLimit implements the gRPC MsgServer interface. When an AddRateLimit
+// proposal passes, it creates the rate limit of the given denom and channel or
+// client. The update can only be performed if the requested authority is the
+// Cosmos SDK governance module account.
+func (k Keeper) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.addRateLimit(ctx, req.Path, req.Quota); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newQuotaEvent(types.EventTypeAddRateLimit, req.Path, req.Quota))
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit implements the gRPC MsgServer interface. When an UpdateRateLimit
+// proposal passes, it replaces the quota of an existing rate limit. The update can
+// only be performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.updateRateLimit(ctx, req.Path, req.Quota); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newQuotaEvent(types.EventTypeUpdateRateLimit, req.Path, req.Quota))
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the gRPC MsgServer interface. When a RemoveRateLimit
+// proposal passes, it removes an existing rate limit. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.removeRateLimit(ctx, req.Path); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newPathEvent(types.EventTypeRemoveRateLimit, req.Path))
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit implements the gRPC MsgServer interface. When a ResetRateLimit
+// proposal passes, it resets the flow of an existing rate limit. The update can
+// only be performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.resetRateLimit(ctx, req.Path); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newPathEvent(types.EventTypeResetRateLimit, req.Path))
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// validateAuthority is a helper function to validate that the provided authority
+// is the keeper's authority address
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+func newPathEvent(eventType string, path types.Path) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyDenom, path.Denom),
+		sdk.NewAttribute(types.AttributeKeyChannelOrClientID, path.ChannelOrClientId),
+	)
+}
+
+func newQuotaEvent(eventType string, path types.Path, quota types.Quota) sdk.Event {
+	return newPathEvent(eventType, path).AppendAttributes(
+		sdk.NewAttribute(types.AttributeKeyMaxSend, quota.MaxSend.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxRecv, quota.MaxRecv.String()),
+		sdk.NewAttribute(types.AttributeKeyDuration, quota.Duration.String()),
+	)
+}
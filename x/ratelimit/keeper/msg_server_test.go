@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	"cosmossdk.io/math"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestAddRateLimit(t *testing.T) {
+	quota := types.NewQuota(math.NewInt(100), math.NewInt(100), time.Hour)
+
+	testCases := []struct {
+		name     string
+		malleate func(td testData)
+		msg      *types.MsgAddRateLimit
+		expErr   error
+	}{
+		{
+			"fail - invalid authority",
+			func(testData) {},
+			&types.MsgAddRateLimit{Authority: "zena1invalid", Path: types.NewPath("azena", testChannel), Quota: quota},
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"fail - unknown channel",
+			func(testData) {},
+			&types.MsgAddRateLimit{Authority: authority.String(), Path: types.NewPath("azena", "channel-9"), Quota: quota},
+			types.ErrChannelOrClientNotFound,
+		},
+		{
+			"fail - already exists",
+			func(td testData) { td.addRateLimit(t, "azena", testChannel, 1, 1) },
+			&types.MsgAddRateLimit{Authority: authority.String(), Path: types.NewPath("azena", testChannel), Quota: quota},
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"pass - channel",
+			func(testData) {},
+			&types.MsgAddRateLimit{Authority: authority.String(), Path: types.NewPath("azena", testChannel), Quota: quota},
+			nil,
+		},
+		{
+			"pass - IBC v2 client",
+			func(testData) {},
+			&types.MsgAddRateLimit{Authority: authority.String(), Path: types.NewPath("azena", testClient), Quota: quota},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			tc.malleate(td)
+
+			_, err := td.keeper.AddRateLimit(td.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			rateLimit, found := td.keeper.GetRateLimit(td.ctx, tc.msg.Path.Denom, tc.msg.Path.ChannelOrClientId)
+			require.True(t, found)
+			require.Equal(t, types.NewRateLimit(tc.msg.Path, tc.msg.Quota), rateLimit)
+		})
+	}
+}
+
+func TestUpdateResetRemoveRateLimit(t *testing.T) {
+	td := newTestData(t)
+	path := types.NewPath("azena", testChannel)
+
+	_, err := td.keeper.UpdateRateLimit(td.ctx, &types.MsgUpdateRateLimit{
+		Authority: authority.String(),
+		Path:      path,
+		Quota:     types.NewQuota(math.NewInt(200), math.ZeroInt(), time.Hour),
+	})
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+
+	td.addRateLimit(t, "azena", testChannel, 100, 0)
+	require.NoError(t, td.keeper.SendRateLimitedPacket(td.ctx, testChannel, 1, token("azena", 100)))
+
+	// updating the quota keeps the flow of the rolling window
+	newQuota := types.NewQuota(math.NewInt(200), math.ZeroInt(), 2*time.Hour)
+	_, err = td.keeper.UpdateRateLimit(td.ctx, &types.MsgUpdateRateLimit{Authority: authority.String(), Path: path, Quota: newQuota})
+	require.NoError(t, err)
+	rateLimit, _ := td.keeper.GetRateLimit(td.ctx, "azena", testChannel)
+	require.Equal(t, newQuota, rateLimit.Quota)
+	require.Equal(t, math.NewInt(100), rateLimit.Flow.Outflow)
+
+	// resetting clears the flow and the pending packets
+	_, err = td.keeper.ResetRateLimit(td.ctx, &types.MsgResetRateLimit{Authority: authority.String(), Path: path})
+	require.NoError(t, err)
+	rateLimit, _ = td.keeper.GetRateLimit(td.ctx, "azena", testChannel)
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.Empty(t, td.keeper.GetAllPendingSendPackets(td.ctx))
+
+	// removing deletes the rate limit and its pending packets
+	require.NoError(t, td.keeper.SendRateLimitedPacket(td.ctx, testChannel, 2, token("azena", 100)))
+	_, err = td.keeper.RemoveRateLimit(td.ctx, &types.MsgRemoveRateLimit{Authority: authority.String(), Path: path})
+	require.NoError(t, err)
+	_, found := td.keeper.GetRateLimit(td.ctx, "azena", testChannel)
+	require.False(t, found)
+	require.Empty(t, td.keeper.GetAllPendingSendPackets(td.ctx))
+
+	_, err = td.keeper.RemoveRateLimit(td.ctx, &types.MsgRemoveRateLimit{Authority: authority.String(), Path: path})
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+}
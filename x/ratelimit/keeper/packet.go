@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"github.com/zenanetwork/zena/ibc"
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SendRateLimitedPacket accounts the outflow of a sent ICS20 packet in the rate
+// limit of its denom and source channel or client, if any. It returns an error if
+// the transfer exceeds the send quota. The packet is stored as pending so that its
+// outflow can be reverted if it's not successfully received by the counterparty.
+func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, token transfertypes.Token) error {
+	amount, err := tokenAmount(token)
+	if err != nil {
+		return err
+	}
+
+	// the denom on this chain, i.e. the native denom or the ibc voucher
+	denom := token.Denom.IBCDenom()
+
+	rateLimit, limited, err := k.updateFlow(ctx, denom, channelOrClientID, types.DirectionSend, amount)
+	if err != nil || !limited {
+		return err
+	}
+
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelOrClientId: channelOrClientID,
+		Sequence:          sequence,
+		Denom:             denom,
+		BucketStart:       rateLimit.LastBucketStart(),
+	})
+
+	return nil
+}
+
+// ReceiveRateLimitedPacket accounts the inflow of a received ICS20 packet in the
+// rate limit of its denom and destination channel or client, if any. It returns an
+// error if the transfer exceeds the receive quota.
+func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet, token transfertypes.Token) error {
+	amount, err := tokenAmount(token)
+	if err != nil {
+		return err
+	}
+
+	// the denom on this chain, after removing or adding the hop of the packet
+	coin := ibc.GetReceivedCoin(packet, token)
+
+	_, _, err = k.updateFlow(ctx, coin.Denom, packet.DestinationChannel, types.DirectionRecv, amount)
+	return err
+}
+
+// AcknowledgeRateLimitedPacket removes the pending send packet once acknowledged by
+// the counterparty. If the acknowledgement is an error, the tokens are refunded so
+// the outflow of the packet is reverted.
+func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, token transfertypes.Token, success bool) error {
+	pending, found := k.GetPendingSendPacket(ctx, channelOrClientID, sequence)
+	if !found {
+		// the packet was sent without a rate limit or its bucket left the window
+		return nil
+	}
+
+	k.DeletePendingSendPacket(ctx, channelOrClientID, sequence)
+	if success {
+		return nil
+	}
+
+	amount, err := tokenAmount(token)
+	if err != nil {
+		return err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pending.Denom, channelOrClientID)
+	if !found {
+		return nil
+	}
+
+	rateLimit.RevertOutflow(amount, pending.BucketStart)
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// TimeoutRateLimitedPacket reverts the outflow of a pending send packet that timed out.
+func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, token transfertypes.Token) error {
+	return k.AcknowledgeRateLimitedPacket(ctx, channelOrClientID, sequence, token, false)
+}
+
+// updateFlow adds the amount to the flow of the rate limit of the given path and
+// returns the updated rate limit and whether the path is rate limited. It's a
+// no-op if it isn't.
+func (k Keeper) updateFlow(ctx sdk.Context, denom, channelOrClientID, direction string, amount math.Int) (types.RateLimit, bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelOrClientID)
+	if !found {
+		return types.RateLimit{}, false, nil
+	}
+
+	if err := rateLimit.UpdateFlow(direction, amount, ctx.BlockTime()); err != nil {
+		quota, flow := rateLimit.Quota.MaxSend, rateLimit.Flow.Outflow
+		if direction == types.DirectionRecv {
+			quota, flow = rateLimit.Quota.MaxRecv, rateLimit.Flow.Inflow
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferDenied,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyChannelOrClientID, channelOrClientID),
+				sdk.NewAttribute(types.AttributeKeyDirection, direction),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyFlow, flow.String()),
+				sdk.NewAttribute(types.AttributeKeyQuota, quota.String()),
+			),
+		)
+
+		return rateLimit, true, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return rateLimit, true, nil
+}
+
+func tokenAmount(token transfertypes.Token) (math.Int, error) {
+	amount, ok := math.NewIntFromString(token.Amount)
+	if !ok {
+		return math.Int{}, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid token amount %s", token.Amount)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestSendPacket(t *testing.T) {
+	td := newTestData(t)
+	td.addRateLimit(t, "azena", testChannel, 100, 0)
+
+	// first transfer within the quota
+	seq, err := td.keeper.SendPacket(td.ctx, transfertypes.PortID, testChannel, clienttypes.ZeroHeight(), 0, transferData("azena", 60))
+	require.NoError(t, err)
+	_, found := td.keeper.GetPendingSendPacket(td.ctx, testChannel, seq)
+	require.True(t, found)
+
+	// second transfer exceeds the quota
+	_, err = td.keeper.SendPacket(td.ctx, transfertypes.PortID, testChannel, clienttypes.ZeroHeight(), 0, transferData("azena", 41))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	var denied bool
+	for _, event := range td.ctx.EventManager().Events() {
+		denied = denied || event.Type == types.EventTypeTransferDenied
+	}
+	require.True(t, denied)
+
+	rateLimit, found := td.keeper.GetRateLimit(td.ctx, "azena", testChannel)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(60), rateLimit.Flow.Outflow)
+
+	// denoms without a rate limit are not accounted
+	seq, err = td.keeper.SendPacket(td.ctx, transfertypes.PortID, testChannel, clienttypes.ZeroHeight(), 0, transferData("uatom", 1_000))
+	require.NoError(t, err)
+	_, found = td.keeper.GetPendingSendPacket(td.ctx, testChannel, seq)
+	require.False(t, found)
+
+	// packets of other ports are not transfers
+	_, err = td.keeper.SendPacket(td.ctx, "icacontroller-owner", testChannel, clienttypes.ZeroHeight(), 0, []byte("{}"))
+	require.NoError(t, err)
+}
+
+func TestReceiveRateLimitedPacket(t *testing.T) {
+	// the voucher of uatom received on channel-0
+	voucher := transfertypes.NewDenom("uatom", transfertypes.NewHop(transfertypes.PortID, testChannel)).IBCDenom()
+
+	testCases := []struct {
+		name      string
+		denom     string
+		packet    channeltypes.Packet
+		token     transfertypes.Token
+		expErr    bool
+		expInflow int64
+	}{
+		{
+			"pass - foreign token within quota",
+			voucher,
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-7", transfertypes.PortID, testChannel, clienttypes.ZeroHeight(), 0),
+			token("uatom", 100),
+			false,
+			100,
+		},
+		{
+			"fail - foreign token exceeds quota",
+			voucher,
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-7", transfertypes.PortID, testChannel, clienttypes.ZeroHeight(), 0),
+			token("uatom", 101),
+			true,
+			0,
+		},
+		{
+			"pass - native token returning",
+			"azena",
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-7", transfertypes.PortID, testChannel, clienttypes.ZeroHeight(), 0),
+			token("transfer/channel-7/azena", 50),
+			false,
+			50,
+		},
+		{
+			"pass - other channel is not rate limited",
+			voucher,
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-7", transfertypes.PortID, "channel-1", clienttypes.ZeroHeight(), 0),
+			token("uatom", 1_000),
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			td.addRateLimit(t, tc.denom, testChannel, 0, 100)
+
+			err := td.keeper.ReceiveRateLimitedPacket(td.ctx, tc.packet, tc.token)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrQuotaExceeded)
+			} else {
+				require.NoError(t, err)
+			}
+
+			rateLimit, found := td.keeper.GetRateLimit(td.ctx, tc.denom, testChannel)
+			require.True(t, found)
+			require.Equal(t, math.NewInt(tc.expInflow), rateLimit.Flow.Inflow)
+		})
+	}
+}
+
+func TestAcknowledgeRateLimitedPacket(t *testing.T) {
+	testCases := []struct {
+		name       string
+		malleate   func(td testData, sequence uint64) error
+		expOutflow int64
+	}{
+		{
+			"successful acknowledgement keeps the outflow",
+			func(td testData, sequence uint64) error {
+				return td.keeper.AcknowledgeRateLimitedPacket(td.ctx, testClient, sequence, token("azena", 60), true)
+			},
+			60,
+		},
+		{
+			"error acknowledgement reverts the outflow",
+			func(td testData, sequence uint64) error {
+				return td.keeper.AcknowledgeRateLimitedPacket(td.ctx, testClient, sequence, token("azena", 60), false)
+			},
+			0,
+		},
+		{
+			"timeout reverts the outflow",
+			func(td testData, sequence uint64) error {
+				return td.keeper.TimeoutRateLimitedPacket(td.ctx, testClient, sequence, token("azena", 60))
+			},
+			0,
+		},
+		{
+			"packet of a bucket that left the window doesn't revert the outflow",
+			func(td testData, sequence uint64) error {
+				td.keeper.DeletePendingSendPacket(td.ctx, testClient, sequence)
+				return td.keeper.TimeoutRateLimitedPacket(td.ctx, testClient, sequence, token("azena", 60))
+			},
+			60,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			td.addRateLimit(t, "azena", testClient, 100, 0)
+
+			sequence := uint64(1)
+			require.NoError(t, td.keeper.SendRateLimitedPacket(td.ctx, testClient, sequence, token("azena", 60)))
+			require.NoError(t, tc.malleate(td, sequence))
+
+			_, found := td.keeper.GetPendingSendPacket(td.ctx, testClient, sequence)
+			require.False(t, found)
+
+			rateLimit, found := td.keeper.GetRateLimit(td.ctx, "azena", testClient)
+			require.True(t, found)
+			require.Equal(t, math.NewInt(tc.expOutflow), rateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func TestBeginBlock(t *testing.T) {
+	td := newTestData(t)
+	td.addRateLimit(t, "azena", testChannel, 100, 100)
+	require.NoError(t, td.keeper.SendRateLimitedPacket(td.ctx, testChannel, 1, token("azena", 100)))
+
+	// the packet is accounted in the bucket of 6 minutes containing the block time
+	pending, found := td.keeper.GetPendingSendPacket(td.ctx, testChannel, 1)
+	require.True(t, found)
+	bucketEnd := pending.BucketStart.Add(6 * time.Minute)
+	require.True(t, bucketEnd.After(td.ctx.BlockTime()))
+
+	// the bucket is still in the window a window duration after the transfer
+	ctx := td.ctx.WithBlockTime(td.ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, td.keeper.BeginBlock(ctx))
+	rateLimit, _ := td.keeper.GetRateLimit(ctx, "azena", testChannel)
+	require.Equal(t, math.NewInt(100), rateLimit.Flow.Outflow)
+	require.ErrorIs(t, td.keeper.SendRateLimitedPacket(ctx, testChannel, 2, token("azena", 1)), types.ErrQuotaExceeded)
+
+	// the bucket and its pending packets are removed once it left the window
+	ctx = td.ctx.WithBlockTime(bucketEnd.Add(time.Hour))
+	require.NoError(t, td.keeper.BeginBlock(ctx))
+	rateLimit, _ = td.keeper.GetRateLimit(ctx, "azena", testChannel)
+	require.Equal(t, types.NewFlow(), rateLimit.Flow)
+	require.Empty(t, td.keeper.GetAllPendingSendPackets(ctx))
+	require.NoError(t, td.keeper.SendRateLimitedPacket(ctx, testChannel, 2, token("azena", 100)))
+}
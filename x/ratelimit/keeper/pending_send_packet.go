@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPendingSendPacket returns the pending send packet of the given channel or
+// client and sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelOrClientID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	bz := store.Get(types.PendingSendPacketKey(channelOrClientID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetPendingSendPacket stores a pending send packet.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Set(types.PendingSendPacketKey(packet.ChannelOrClientId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// DeletePendingSendPacket removes the pending send packet of the given channel or
+// client and sequence.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelOrClientID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Delete(types.PendingSendPacketKey(channelOrClientID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending send packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	packets := []types.PendingSendPacket{}
+	k.iteratePendingSendPackets(ctx, nil, func(packet types.PendingSendPacket) (stop bool) {
+		packets = append(packets, packet)
+		return false
+	})
+
+	return packets
+}
+
+// removePendingSendPackets removes the pending send packets accounted in the
+// rate limit of the given path.
+func (k Keeper) removePendingSendPackets(ctx sdk.Context, path types.Path) {
+	k.removePendingSendPacketsIf(ctx, path, func(types.PendingSendPacket) bool {
+		return true
+	})
+}
+
+// removeExpiredPendingSendPackets removes the pending send packets accounted in
+// the buckets that left the rolling window of the given rate limit.
+func (k Keeper) removeExpiredPendingSendPackets(ctx sdk.Context, rateLimit types.RateLimit) {
+	k.removePendingSendPacketsIf(ctx, rateLimit.Path, func(packet types.PendingSendPacket) bool {
+		return !rateLimit.HasBucket(packet.BucketStart)
+	})
+}
+
+func (k Keeper) removePendingSendPacketsIf(ctx sdk.Context, path types.Path, remove func(packet types.PendingSendPacket) bool) {
+	var sequences []uint64
+	k.iteratePendingSendPackets(ctx, types.ChannelOrClientKey(path.ChannelOrClientId), func(packet types.PendingSendPacket) (stop bool) {
+		if packet.Denom == path.Denom && remove(packet) {
+			sequences = append(sequences, packet.Sequence)
+		}
+		return false
+	})
+
+	for _, sequence := range sequences {
+		k.DeletePendingSendPacket(ctx, path.ChannelOrClientId, sequence)
+	}
+}
+
+func (k Keeper) iteratePendingSendPackets(ctx sdk.Context, keyPrefix []byte, cb func(packet types.PendingSendPacket) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		if cb(packet) {
+			break
+		}
+	}
+}
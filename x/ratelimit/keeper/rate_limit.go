@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"github.com/zenanetwork/zena/x/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimit returns the rate limit of the given denom and channel or client.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelOrClientID string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.RateLimitKey(channelOrClientID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	key := types.RateLimitKey(rateLimit.Path.ChannelOrClientId, rateLimit.Path.Denom)
+	store.Set(key, k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit removes the rate limit of the given denom and channel or client.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelOrClientID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete(types.RateLimitKey(channelOrClientID, denom))
+}
+
+// IterateRateLimits iterates over all the rate limits.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	k.iterateRateLimits(ctx, nil, cb)
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// GetRateLimitsByChannelOrClient returns all the rate limits of the given channel or client.
+func (k Keeper) GetRateLimitsByChannelOrClient(ctx sdk.Context, channelOrClientID string) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.iterateRateLimits(ctx, types.ChannelOrClientKey(channelOrClientID), func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+func (k Keeper) iterateRateLimits(ctx sdk.Context, keyPrefix []byte, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// addRateLimit creates a rate limit with an empty flow for the given path. The
+// channel (IBC v1) or client (IBC v2) must exist.
+func (k Keeper) addRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId); found {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s, channel or client %s", path.Denom, path.ChannelOrClientId)
+	}
+
+	if err := k.validateChannelOrClient(ctx, path.ChannelOrClientId); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota))
+	return nil
+}
+
+// updateRateLimit replaces the quota of an existing rate limit. The flow of the
+// rolling window is kept.
+func (k Keeper) updateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", path.Denom, path.ChannelOrClientId)
+	}
+
+	rateLimit.Quota = quota
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// removeRateLimit removes an existing rate limit and its pending send packets.
+func (k Keeper) removeRateLimit(ctx sdk.Context, path types.Path) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", path.Denom, path.ChannelOrClientId)
+	}
+
+	k.DeleteRateLimit(ctx, path.Denom, path.ChannelOrClientId)
+	k.removePendingSendPackets(ctx, path)
+	return nil
+}
+
+// resetRateLimit resets the flow of an existing rate limit.
+func (k Keeper) resetRateLimit(ctx sdk.Context, path types.Path) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", path.Denom, path.ChannelOrClientId)
+	}
+
+	k.resetFlow(ctx, rateLimit)
+	return nil
+}
+
+// resetFlow empties the flow of the rate limit. The packets sent before are no
+// longer pending, so they don't revert the new outflow.
+func (k Keeper) resetFlow(ctx sdk.Context, rateLimit types.RateLimit) {
+	rateLimit.Flow = types.NewFlow()
+	k.SetRateLimit(ctx, rateLimit)
+	k.removePendingSendPackets(ctx, rateLimit.Path)
+}
+
+// validateChannelOrClient checks that the identifier is an existing IBC v1
+// transfer channel or an IBC v2 client with a registered counterparty.
+func (k Keeper) validateChannelOrClient(ctx sdk.Context, channelOrClientID string) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelOrClientID); found {
+		return nil
+	}
+
+	if _, found := k.clientKeeperV2.GetClientCounterparty(ctx, channelOrClientID); found {
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrChannelOrClientNotFound, "%s is neither a transfer channel nor an IBC v2 client", channelOrClientID)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global ratelimit module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to modules/ratelimit and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewLegacyAmino()
+)
+
+const (
+	// Amino names
+	addRateLimitName    = "cosmos/evm/ratelimit/MsgAddRateLimit"
+	updateRateLimitName = "cosmos/evm/ratelimit/MsgUpdateRateLimit"
+	removeRateLimitName = "cosmos/evm/ratelimit/MsgRemoveRateLimit"
+	resetRateLimitName  = "cosmos/evm/ratelimit/MsgResetRateLimit"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgAddRateLimit{},
+		&MsgUpdateRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimit{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/ratelimit interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddRateLimit{}, addRateLimitName, nil)
+	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, updateRateLimitName, nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, removeRateLimitName, nil)
+	cdc.RegisterConcrete(&MsgResetRateLimit{}, resetRateLimitName, nil)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRateLimitAlreadyExists   = errorsmod.Register(ModuleName, 2, "rate limit already exists")
+	ErrRateLimitNotFound        = errorsmod.Register(ModuleName, 3, "rate limit not found")
+	ErrQuotaExceeded            = errorsmod.Register(ModuleName, 4, "rate limit quota exceeded")
+	ErrInvalidPath              = errorsmod.Register(ModuleName, 5, "invalid rate limit path")
+	ErrInvalidQuota             = errorsmod.Register(ModuleName, 6, "invalid rate limit quota")
+	ErrInvalidFlow              = errorsmod.Register(ModuleName, 7, "invalid rate limit flow")
+	ErrChannelOrClientNotFound  = errorsmod.Register(ModuleName, 8, "channel or client not found")
+	ErrInvalidPendingSendPacket = errorsmod.Register(ModuleName, 9, "invalid pending send packet")
+)
@@ -0,0 +1,20 @@
+package types
+
+// ratelimit events
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+	EventTypeTransferDenied  = "transfer_denied"
+
+	AttributeKeyDenom             = "denom"
+	AttributeKeyChannelOrClientID = "channel_or_client_id"
+	AttributeKeyMaxSend           = "max_send"
+	AttributeKeyMaxRecv           = "max_recv"
+	AttributeKeyDuration          = "duration"
+	AttributeKeyDirection         = "direction"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyFlow              = "flow"
+	AttributeKeyQuota             = "quota"
+)
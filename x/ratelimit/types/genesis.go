@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) GenesisState {
+	return GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState sets default ratelimit genesis state with no rate limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for _, rl := range gs.RateLimits {
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RateLimitKey(rl.Path.ChannelOrClientId, rl.Path.Denom))
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicated rate limit for denom %s and channel or client %s", rl.Path.Denom, rl.Path.ChannelOrClientId)
+		}
+		seenRateLimits[key] = true
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packet := range gs.PendingSendPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		if !seenRateLimits[string(RateLimitKey(packet.ChannelOrClientId, packet.Denom))] {
+			return fmt.Errorf("no rate limit for the pending send packet %s/%d of denom %s", packet.ChannelOrClientId, packet.Sequence, packet.Denom)
+		}
+
+		key := string(PendingSendPacketKey(packet.ChannelOrClientId, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicated pending send packet %s/%d", packet.ChannelOrClientId, packet.Sequence)
+		}
+		seenPackets[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	quota := NewQuota(math.NewInt(100), math.NewInt(100), time.Hour)
+	rateLimit := NewRateLimit(NewPath("azena", "channel-0"), quota)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&GenesisState{
+				RateLimits: []RateLimit{
+					rateLimit,
+					NewRateLimit(NewPath("azena", "07-tendermint-0"), quota),
+				},
+				PendingSendPackets: []PendingSendPacket{
+					{ChannelOrClientId: "channel-0", Sequence: 1, Denom: "azena"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid rate limit",
+			&GenesisState{
+				RateLimits: []RateLimit{
+					NewRateLimit(NewPath("azena", "channel-0"), Quota{}),
+				},
+			},
+			false,
+		},
+		{
+			"duplicated rate limit",
+			&GenesisState{
+				RateLimits: []RateLimit{rateLimit, rateLimit},
+			},
+			false,
+		},
+		{
+			"pending send packet without rate limit",
+			&GenesisState{
+				RateLimits: []RateLimit{rateLimit},
+				PendingSendPackets: []PendingSendPacket{
+					{ChannelOrClientId: "channel-1", Sequence: 1, Denom: "azena"},
+				},
+			},
+			false,
+		},
+		{
+			"invalid pending send packet",
+			&GenesisState{
+				RateLimits: []RateLimit{rateLimit},
+				PendingSendPackets: []PendingSendPacket{
+					{ChannelOrClientId: "channel-0", Sequence: 0, Denom: "azena"},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated pending send packet",
+			&GenesisState{
+				RateLimits: []RateLimit{rateLimit},
+				PendingSendPackets: []PendingSendPacket{
+					{ChannelOrClientId: "channel-0", Sequence: 1, Denom: "azena"},
+					{ChannelOrClientId: "channel-0", Sequence: 1, Denom: "azena"},
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	clienttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper used to verify the
+// channels of the IBC v1 rate limits.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
+
+// ClientKeeperV2 defines the expected IBC v2 client keeper used to verify the
+// clients of the IBC v2 rate limits.
+type ClientKeeperV2 interface {
+	GetClientCounterparty(ctx sdk.Context, clientID string) (clienttypesv2.CounterpartyInfo, bool)
+}
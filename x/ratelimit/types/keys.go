@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the rate limit persistent store
+const (
+	prefixRateLimit = iota + 1
+	prefixPendingSendPacket
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRateLimit         = []byte{prefixRateLimit}
+	KeyPrefixPendingSendPacket = []byte{prefixPendingSendPacket}
+)
+
+// ChannelOrClientKey returns the length prefixed channel or client identifier
+// the rate limits and pending send packets are stored under.
+func ChannelOrClientKey(channelOrClientID string) []byte {
+	return address.MustLengthPrefix([]byte(channelOrClientID))
+}
+
+// RateLimitKey returns the key of the rate limit of the given denom and channel or client
+// within the rate limit prefix store.
+func RateLimitKey(channelOrClientID, denom string) []byte {
+	return append(ChannelOrClientKey(channelOrClientID), []byte(denom)...)
+}
+
+// PendingSendPacketKey returns the key of a pending send packet within the
+// pending send packet prefix store.
+func PendingSendPacketKey(channelOrClientID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(ChannelOrClientKey(channelOrClientID), sequence)
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgAddRateLimit{}
+	_ sdk.Msg              = &MsgUpdateRateLimit{}
+	_ sdk.Msg              = &MsgRemoveRateLimit{}
+	_ sdk.Msg              = &MsgResetRateLimit{}
+	_ sdk.HasValidateBasic = &MsgAddRateLimit{}
+	_ sdk.HasValidateBasic = &MsgUpdateRateLimit{}
+	_ sdk.HasValidateBasic = &MsgRemoveRateLimit{}
+	_ sdk.HasValidateBasic = &MsgResetRateLimit{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := m.Path.Validate(); err != nil {
+		return err
+	}
+
+	return m.Quota.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := m.Path.Validate(); err != nil {
+		return err
+	}
+
+	return m.Quota.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Path.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Path.Validate()
+}
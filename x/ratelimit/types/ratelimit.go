@@ -0,0 +1,294 @@
+package types
+
+import (
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Flow directions of a rate limited transfer
+const (
+	DirectionSend = "send"
+	DirectionRecv = "recv"
+)
+
+const (
+	// BucketsPerWindow is the number of buckets the rolling window of a rate
+	// limit is split into. The flow of a bucket is accounted until the whole
+	// bucket left the window, so the quota is never exceeded over any period
+	// of the window duration.
+	BucketsPerWindow = 10
+
+	// MinDuration is the minimum duration of the rolling window of a rate limit.
+	MinDuration = time.Second
+)
+
+// NewPath returns a new rate limit path for the given denom and channel or client.
+func NewPath(denom, channelOrClientID string) Path {
+	return Path{
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// Validate performs a stateless validation of the rate limit path.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidPath, err.Error())
+	}
+
+	return ValidateChannelOrClientID(p.ChannelOrClientId)
+}
+
+// ValidateChannelOrClientID checks that the identifier is either a valid IBC v1
+// channel identifier or a valid IBC v2 client identifier.
+func ValidateChannelOrClientID(channelOrClientID string) error {
+	if channeltypes.IsValidChannelID(channelOrClientID) {
+		return nil
+	}
+
+	if err := host.ClientIdentifierValidator(channelOrClientID); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPath, "invalid channel or client identifier %s", channelOrClientID)
+	}
+
+	return nil
+}
+
+// NewQuota returns a new quota with the given maximum send and receive amounts per window.
+func NewQuota(maxSend, maxRecv math.Int, duration time.Duration) Quota {
+	return Quota{
+		MaxSend:  maxSend,
+		MaxRecv:  maxRecv,
+		Duration: duration,
+	}
+}
+
+// Validate performs a stateless validation of the quota. A zero amount disables the
+// limit of the corresponding direction, but at least one of them must be set.
+func (q Quota) Validate() error {
+	if q.MaxSend.IsNil() || q.MaxSend.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max send must be non-negative, got %s", q.MaxSend)
+	}
+	if q.MaxRecv.IsNil() || q.MaxRecv.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max recv must be non-negative, got %s", q.MaxRecv)
+	}
+	if q.MaxSend.IsZero() && q.MaxRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max send and max recv cannot both be zero")
+	}
+	if q.Duration < MinDuration {
+		return errorsmod.Wrapf(ErrInvalidQuota, "duration must be at least %s, got %s", MinDuration, q.Duration)
+	}
+
+	return nil
+}
+
+// BucketDuration returns the length of the buckets of the rolling window.
+func (q Quota) BucketDuration() time.Duration {
+	return q.Duration / BucketsPerWindow
+}
+
+// BucketStart returns the start time of the bucket containing the given time.
+func (q Quota) BucketStart(blockTime time.Time) time.Time {
+	return blockTime.Truncate(q.BucketDuration()).UTC()
+}
+
+// NewFlow returns an empty flow.
+func NewFlow() Flow {
+	return Flow{
+		Inflow:  math.ZeroInt(),
+		Outflow: math.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of the flow. The inflow and outflow
+// must be the sums of the ones of the buckets.
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFlow, "inflow must be non-negative, got %s", f.Inflow)
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFlow, "outflow must be non-negative, got %s", f.Outflow)
+	}
+
+	inflow, outflow := math.ZeroInt(), math.ZeroInt()
+	for i, bucket := range f.Buckets {
+		if bucket.Inflow.IsNil() || bucket.Inflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFlow, "inflow of bucket %d must be non-negative, got %s", i, bucket.Inflow)
+		}
+		if bucket.Outflow.IsNil() || bucket.Outflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFlow, "outflow of bucket %d must be non-negative, got %s", i, bucket.Outflow)
+		}
+		if i > 0 && !bucket.Start.After(f.Buckets[i-1].Start) {
+			return errorsmod.Wrapf(ErrInvalidFlow, "buckets must be ordered by start time, got %s after %s", bucket.Start, f.Buckets[i-1].Start)
+		}
+
+		inflow = inflow.Add(bucket.Inflow)
+		outflow = outflow.Add(bucket.Outflow)
+	}
+
+	if !inflow.Equal(f.Inflow) {
+		return errorsmod.Wrapf(ErrInvalidFlow, "inflow %s doesn't match the inflow of the buckets %s", f.Inflow, inflow)
+	}
+	if !outflow.Equal(f.Outflow) {
+		return errorsmod.Wrapf(ErrInvalidFlow, "outflow %s doesn't match the outflow of the buckets %s", f.Outflow, outflow)
+	}
+
+	return nil
+}
+
+// NewRateLimit returns a new rate limit with an empty flow.
+func NewRateLimit(path Path, quota Quota) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  NewFlow(),
+	}
+}
+
+// Validate performs a stateless validation of the rate limit.
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+	return rl.Flow.Validate()
+}
+
+// PruneFlow removes the buckets that entirely left the rolling window ending at
+// the given block time from the flow. It returns whether any bucket was removed.
+func (rl *RateLimit) PruneFlow(blockTime time.Time) bool {
+	windowStart := blockTime.Add(-rl.Quota.Duration)
+	bucketDuration := rl.Quota.BucketDuration()
+
+	expired := 0
+	for _, bucket := range rl.Flow.Buckets {
+		if bucket.Start.Add(bucketDuration).After(windowStart) {
+			break
+		}
+
+		rl.Flow.Inflow = rl.Flow.Inflow.Sub(bucket.Inflow)
+		rl.Flow.Outflow = rl.Flow.Outflow.Sub(bucket.Outflow)
+		expired++
+	}
+
+	rl.Flow.Buckets = rl.Flow.Buckets[expired:]
+	return expired > 0
+}
+
+// LastBucketStart returns the start time of the last bucket of the flow, which
+// is the one the flow of the current block is accounted in.
+func (rl RateLimit) LastBucketStart() time.Time {
+	if len(rl.Flow.Buckets) == 0 {
+		return time.Time{}
+	}
+
+	return rl.Flow.Buckets[len(rl.Flow.Buckets)-1].Start
+}
+
+// HasBucket returns whether the flow has a bucket starting at the given time.
+func (rl RateLimit) HasBucket(bucketStart time.Time) bool {
+	_, found := rl.findBucket(bucketStart)
+	return found
+}
+
+// UpdateFlow adds the amount to the flow of the given direction at the given
+// block time, after removing the buckets that left the rolling window. It returns
+// an error if the flow of the rolling window exceeds the quota of that direction.
+func (rl *RateLimit) UpdateFlow(direction string, amount math.Int, blockTime time.Time) error {
+	rl.PruneFlow(blockTime)
+
+	switch direction {
+	case DirectionSend:
+		outflow := rl.Flow.Outflow.Add(amount)
+		if !rl.Quota.MaxSend.IsZero() && outflow.GT(rl.Quota.MaxSend) {
+			return errorsmod.Wrapf(
+				ErrQuotaExceeded,
+				"outflow %s of %s over %s exceeds the max send quota %s",
+				outflow, rl.Path.Denom, rl.Path.ChannelOrClientId, rl.Quota.MaxSend,
+			)
+		}
+		rl.Flow.Outflow = outflow
+		bucket := rl.currentBucket(blockTime)
+		bucket.Outflow = bucket.Outflow.Add(amount)
+	case DirectionRecv:
+		inflow := rl.Flow.Inflow.Add(amount)
+		if !rl.Quota.MaxRecv.IsZero() && inflow.GT(rl.Quota.MaxRecv) {
+			return errorsmod.Wrapf(
+				ErrQuotaExceeded,
+				"inflow %s of %s over %s exceeds the max recv quota %s",
+				inflow, rl.Path.Denom, rl.Path.ChannelOrClientId, rl.Quota.MaxRecv,
+			)
+		}
+		rl.Flow.Inflow = inflow
+		bucket := rl.currentBucket(blockTime)
+		bucket.Inflow = bucket.Inflow.Add(amount)
+	default:
+		return errorsmod.Wrapf(ErrInvalidFlow, "invalid flow direction %s", direction)
+	}
+
+	return nil
+}
+
+// RevertOutflow subtracts the amount of a failed send packet from the outflow of
+// the bucket it was accounted in. It's a no-op if the bucket left the window.
+func (rl *RateLimit) RevertOutflow(amount math.Int, bucketStart time.Time) {
+	i, found := rl.findBucket(bucketStart)
+	if !found {
+		return
+	}
+
+	bucket := &rl.Flow.Buckets[i]
+	reverted := math.MinInt(amount, bucket.Outflow)
+	bucket.Outflow = bucket.Outflow.Sub(reverted)
+	rl.Flow.Outflow = rl.Flow.Outflow.Sub(reverted)
+}
+
+// currentBucket returns the bucket containing the given block time, appending
+// it to the flow if it doesn't exist yet.
+//
+// NOTE: the last bucket is returned if it doesn't start before the current one,
+// which happens after the duration of the quota was updated. The flow is then
+// accounted for longer than the duration, which never exceeds the quota.
+func (rl *RateLimit) currentBucket(blockTime time.Time) *FlowBucket {
+	bucketStart := rl.Quota.BucketStart(blockTime)
+	if n := len(rl.Flow.Buckets); n > 0 && !rl.Flow.Buckets[n-1].Start.Before(bucketStart) {
+		return &rl.Flow.Buckets[n-1]
+	}
+
+	rl.Flow.Buckets = append(rl.Flow.Buckets, FlowBucket{
+		Start:   bucketStart,
+		Inflow:  math.ZeroInt(),
+		Outflow: math.ZeroInt(),
+	})
+	return &rl.Flow.Buckets[len(rl.Flow.Buckets)-1]
+}
+
+func (rl RateLimit) findBucket(bucketStart time.Time) (int, bool) {
+	for i, bucket := range rl.Flow.Buckets {
+		if bucket.Start.Equal(bucketStart) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Validate performs a stateless validation of the pending send packet.
+func (p PendingSendPacket) Validate() error {
+	if err := NewPath(p.Denom, p.ChannelOrClientId).Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, err.Error())
+	}
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, "sequence cannot be zero")
+	}
+
+	return nil
+}
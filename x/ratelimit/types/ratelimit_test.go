@@ -0,0 +1,228 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestPathValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		path    Path
+		expPass bool
+	}{
+		{"pass - native denom and channel", NewPath("azena", "channel-0"), true},
+		{"pass - ibc denom and client", NewPath("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "07-tendermint-0"), true},
+		{"pass - erc20 denom", NewPath("erc20:0xD4949664cD82660AaE99bEdc034a0deA8A0bd517", "channel-1"), true},
+		{"fail - empty denom", NewPath("", "channel-0"), false},
+		{"fail - invalid denom", NewPath("1azena", "channel-0"), false},
+		{"fail - empty channel or client", NewPath("azena", ""), false},
+		{"fail - invalid channel or client", NewPath("azena", "channel/0"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.path.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidPath)
+			}
+		})
+	}
+}
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   Quota
+		expPass bool
+	}{
+		{"pass - send and recv", NewQuota(math.NewInt(100), math.NewInt(200), time.Hour), true},
+		{"pass - send only", NewQuota(math.NewInt(100), math.ZeroInt(), time.Hour), true},
+		{"pass - recv only", NewQuota(math.ZeroInt(), math.NewInt(100), time.Hour), true},
+		{"fail - both unlimited", NewQuota(math.ZeroInt(), math.ZeroInt(), time.Hour), false},
+		{"fail - negative send", NewQuota(math.NewInt(-1), math.NewInt(100), time.Hour), false},
+		{"fail - negative recv", NewQuota(math.NewInt(100), math.NewInt(-1), time.Hour), false},
+		{"fail - nil amount", Quota{MaxRecv: math.NewInt(100), Duration: time.Hour}, false},
+		{"fail - zero duration", NewQuota(math.NewInt(100), math.NewInt(100), 0), false},
+		{"fail - duration below minimum", NewQuota(math.NewInt(100), math.NewInt(100), time.Millisecond), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidQuota)
+			}
+		})
+	}
+}
+
+func TestRateLimitUpdateFlow(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	path := NewPath("azena", "channel-0")
+
+	testCases := []struct {
+		name       string
+		quota      Quota
+		direction  string
+		amounts    []int64
+		expErr     bool
+		expInflow  int64
+		expOutflow int64
+	}{
+		{
+			"pass - send within quota",
+			NewQuota(math.NewInt(100), math.NewInt(100), time.Hour),
+			DirectionSend,
+			[]int64{40, 60},
+			false, 0, 100,
+		},
+		{
+			"fail - send exceeds quota",
+			NewQuota(math.NewInt(100), math.NewInt(100), time.Hour),
+			DirectionSend,
+			[]int64{40, 61},
+			true, 0, 40,
+		},
+		{
+			"pass - recv within quota",
+			NewQuota(math.NewInt(100), math.NewInt(100), time.Hour),
+			DirectionRecv,
+			[]int64{100},
+			false, 100, 0,
+		},
+		{
+			"fail - recv exceeds quota",
+			NewQuota(math.NewInt(100), math.NewInt(100), time.Hour),
+			DirectionRecv,
+			[]int64{101},
+			true, 0, 0,
+		},
+		{
+			"pass - unlimited send",
+			NewQuota(math.ZeroInt(), math.NewInt(100), time.Hour),
+			DirectionSend,
+			[]int64{1_000, 1_000},
+			false, 0, 2_000,
+		},
+		{
+			"fail - invalid direction",
+			NewQuota(math.NewInt(100), math.NewInt(100), time.Hour),
+			"both",
+			[]int64{1},
+			true, 0, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit := NewRateLimit(path, tc.quota)
+
+			var err error
+			for _, amount := range tc.amounts {
+				if err = rateLimit.UpdateFlow(tc.direction, math.NewInt(amount), blockTime); err != nil {
+					break
+				}
+			}
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, math.NewInt(tc.expInflow), rateLimit.Flow.Inflow)
+			require.Equal(t, math.NewInt(tc.expOutflow), rateLimit.Flow.Outflow)
+			require.NoError(t, rateLimit.Flow.Validate())
+		})
+	}
+}
+
+func TestRateLimitRollingWindow(t *testing.T) {
+	// buckets of 6 minutes, the block time is the start of a bucket
+	blockTime := time.Unix(1_699_999_920, 0).UTC()
+	rateLimit := NewRateLimit(NewPath("azena", "channel-0"), NewQuota(math.NewInt(100), math.ZeroInt(), time.Hour))
+
+	// the whole quota is used at the end of the first bucket
+	require.NoError(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(100), blockTime.Add(5*time.Minute)))
+	require.Len(t, rateLimit.Flow.Buckets, 1)
+	require.Equal(t, blockTime, rateLimit.LastBucketStart())
+
+	// a fixed window ending in between would let the quota be sent again
+	require.ErrorIs(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(1), blockTime.Add(50*time.Minute)), ErrQuotaExceeded)
+	require.ErrorIs(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(1), blockTime.Add(65*time.Minute)), ErrQuotaExceeded)
+
+	// the bucket leaves the window once its end is a window duration ago
+	require.False(t, rateLimit.PruneFlow(blockTime.Add(66*time.Minute-time.Nanosecond)))
+	require.NoError(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(60), blockTime.Add(66*time.Minute)))
+	require.Equal(t, math.NewInt(60), rateLimit.Flow.Outflow)
+	require.Len(t, rateLimit.Flow.Buckets, 1)
+	require.Equal(t, blockTime.Add(66*time.Minute), rateLimit.LastBucketStart())
+
+	// the flow of a later bucket adds up to the one of the window
+	require.NoError(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(40), blockTime.Add(80*time.Minute)))
+	require.ErrorIs(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(1), blockTime.Add(80*time.Minute)), ErrQuotaExceeded)
+	require.Len(t, rateLimit.Flow.Buckets, 2)
+	require.NoError(t, rateLimit.Flow.Validate())
+
+	require.True(t, rateLimit.PruneFlow(blockTime.Add(132*time.Minute)))
+	require.Equal(t, math.NewInt(40), rateLimit.Flow.Outflow)
+	require.Len(t, rateLimit.Flow.Buckets, 1)
+}
+
+func TestRateLimitRevertOutflow(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := NewRateLimit(NewPath("azena", "channel-0"), NewQuota(math.NewInt(100), math.ZeroInt(), time.Hour))
+	require.NoError(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(60), blockTime))
+	bucketStart := rateLimit.LastBucketStart()
+
+	rateLimit.RevertOutflow(math.NewInt(40), bucketStart)
+	require.Equal(t, math.NewInt(20), rateLimit.Flow.Outflow)
+
+	// the outflow of the bucket can't go negative
+	rateLimit.RevertOutflow(math.NewInt(40), bucketStart)
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.NoError(t, rateLimit.Flow.Validate())
+
+	// packets of buckets that left the window don't revert the outflow
+	require.NoError(t, rateLimit.UpdateFlow(DirectionSend, math.NewInt(60), blockTime))
+	rateLimit.RevertOutflow(math.NewInt(60), bucketStart.Add(-time.Hour))
+	require.Equal(t, math.NewInt(60), rateLimit.Flow.Outflow)
+}
+
+func TestFlowValidate(t *testing.T) {
+	bucketStart := time.Unix(1_700_000_000, 0).UTC()
+	bucket := FlowBucket{Start: bucketStart, Inflow: math.NewInt(10), Outflow: math.NewInt(20)}
+	nextBucket := FlowBucket{Start: bucketStart.Add(time.Minute), Inflow: math.NewInt(1), Outflow: math.NewInt(2)}
+
+	testCases := []struct {
+		name    string
+		flow    Flow
+		expPass bool
+	}{
+		{"pass - empty", NewFlow(), true},
+		{"pass - buckets", Flow{Inflow: math.NewInt(11), Outflow: math.NewInt(22), Buckets: []FlowBucket{bucket, nextBucket}}, true},
+		{"fail - inflow mismatch", Flow{Inflow: math.NewInt(10), Outflow: math.NewInt(22), Buckets: []FlowBucket{bucket, nextBucket}}, false},
+		{"fail - outflow mismatch", Flow{Inflow: math.NewInt(11), Outflow: math.NewInt(20), Buckets: []FlowBucket{bucket, nextBucket}}, false},
+		{"fail - unordered buckets", Flow{Inflow: math.NewInt(11), Outflow: math.NewInt(22), Buckets: []FlowBucket{nextBucket, bucket}}, false},
+		{"fail - negative bucket", Flow{Inflow: math.NewInt(-1), Outflow: math.ZeroInt(), Buckets: []FlowBucket{{Start: bucketStart, Inflow: math.NewInt(-1), Outflow: math.ZeroInt()}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.flow.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidFlow)
+			}
+		})
+	}
+}
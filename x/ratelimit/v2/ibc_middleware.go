@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/zenanetwork/zena/x/ratelimit/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ ibcapi.IBCModule             = &IBCMiddleware{}
+	_ ibcapi.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the IBC v2 callbacks for the rate limit middleware
+// given the ratelimit keeper and the underlying application. The rate limits of
+// IBC v2 transfers are set on the client IDs.
+type IBCMiddleware struct {
+	app    ibcapi.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(
+	app ibcapi.IBCModule,
+	k keeper.Keeper,
+) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface.
+// It accounts the outflow of the sent tokens after the underlying application
+// escrows or burns them, and fails if the send quota is exceeded.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+
+	return im.keeper.SendRateLimitedPacket(ctx, sourceClient, sequence, data.Token)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It accounts the inflow of the received tokens and fails without calling the
+// underlying application if the receive quota is exceeded.
+// NOTE: if the underlying application fails, core IBC discards the state changes
+// of the packet, including the inflow.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		// let the underlying application fail the packet
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// the received denom is derived from the ports and clients, as for IBC v1 packets
+	packet := channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+	}
+	if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet, data.Token); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("ratelimit middleware OnRecvPacket denied the transfer: %s", err.Error()))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It reverts the outflow of the packet if the acknowledgement is an error, as the
+// tokens are refunded to the sender.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	success := !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:])
+	return im.keeper.AcknowledgeRateLimitedPacket(ctx, sourceClient, sequence, data.Token, success)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It reverts the outflow of the packet, as the tokens are refunded to the sender.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.TimeoutRateLimitedPacket(ctx, sourceClient, sequence, data.Token)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface.
+// It defers to the underlying application, which allows the callbacks
+// middleware to wrap the ratelimit middleware.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	pd, ok := im.app.(ibcapi.PacketDataUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("underlying application does not implement %T", (*ibcapi.PacketDataUnmarshaler)(nil))
+	}
+	return pd.UnmarshalPacketData(payload)
+}
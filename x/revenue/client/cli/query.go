@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenanetwork/zena/x/revenue/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the parent command for all revenue CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the revenue module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryRevenues(),
+		GetCmdQueryRevenue(),
+		GetCmdQueryParams(),
+		GetCmdQueryDeployerRevenues(),
+		GetCmdQueryWithdrawerRevenues(),
+	)
+	return cmd
+}
+
+// GetCmdQueryRevenues implements a command to return all registered contracts
+// for fee distribution
+func GetCmdQueryRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts",
+		Short: "Query all revenues",
+		Long:  "Query all revenues",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRevenuesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Revenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
+	return cmd
+}
+
+// GetCmdQueryRevenue implements a command to return a registered contract for
+// fee distribution
+func GetCmdQueryRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract CONTRACT_ADDRESS",
+		Short: "Query a registered contract for fee distribution by hex address",
+		Long:  "Query a registered contract for fee distribution by hex address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRevenueRequest{ContractAddress: args[0]}
+
+			res, err := queryClient.Revenue(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to return the current revenue
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current revenue module parameters",
+		Long:  "Query the current revenue module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDeployerRevenues implements a command that returns all contracts
+// that a deployer has registered for fee distribution
+func GetCmdQueryDeployerRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-contracts DEPLOYER_ADDRESS",
+		Short: "Query all contracts that a given deployer has registered for fee distribution",
+		Long:  "Query all contracts that a given deployer has registered for fee distribution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeployerRevenuesRequest{
+				DeployerAddress: args[0],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.DeployerRevenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployer-contracts")
+	return cmd
+}
+
+// GetCmdQueryWithdrawerRevenues implements a command that returns all
+// contracts that have registered for fee distribution with a given withdraw
+// address
+func GetCmdQueryWithdrawerRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawer-contracts WITHDRAWER_ADDRESS",
+		Short: "Query all contracts that have been registered for fee distribution with a given withdrawer address",
+		Long:  "Query all contracts that have been registered for fee distribution with a given withdrawer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryWithdrawerRevenuesRequest{
+				WithdrawerAddress: args[0],
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.WithdrawerRevenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawer-contracts")
+	return cmd
+}
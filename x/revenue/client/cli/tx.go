@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenanetwork/zena/x/revenue/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTxCmd returns a root CLI command handler for revenue transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "revenue subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterRevenueCmd(),
+		NewUpdateRevenueCmd(),
+		NewCancelRevenueCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterRevenueCmd returns a CLI command handler for registering a
+// contract for fee distribution
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_ADDRESS NONCES [WITHDRAWER_ADDRESS]",
+		Short: "Register a contract for fee distribution",
+		Long: `Register a contract for fee distribution. NONCES is the comma separated list of
+the nonces of the address derivation path from the deployer to the contract:
+the deployer nonce of the contract deployment transaction, followed by the
+nonces of the factory contracts if the contract was deployed by a factory.
+When the withdrawer address [optional] is omitted, the fees are sent to the
+deployer.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deployer := cliCtx.GetFromAddress()
+
+			contract := args[0]
+			nonces, err := parseNonces(args[1])
+			if err != nil {
+				return err
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				withdrawer, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(contract, deployer, withdrawer, nonces)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateRevenueCmd returns a CLI command handler for updating the withdraw
+// address of a contract for fee distribution
+func NewUpdateRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_ADDRESS WITHDRAWER_ADDRESS",
+		Short: "Update withdrawer address for a contract registered for fee distribution.",
+		Long:  "Update withdrawer address for a contract registered for fee distribution. Only the contract deployer can update the withdrawer address.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deployer := cliCtx.GetFromAddress()
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRevenue(args[0], deployer, withdrawer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelRevenueCmd returns a CLI command handler for canceling a contract
+// for fee distribution
+func NewCancelRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_ADDRESS",
+		Short: "Cancel a contract from fee distribution",
+		Long:  "Cancel a contract from fee distribution. The deployer will no longer receive fees from users interacting with the contract. Only the contract deployer can cancel a contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deployer := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgCancelRevenue(args[0], deployer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseNonces parses the comma separated nonces of the address derivation path
+func parseNonces(arg string) ([]uint64, error) {
+	var nonces []uint64
+	for _, nonce := range strings.Split(arg, ",") {
+		n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nonce %s: %w", nonce, err)
+		}
+		nonces = append(nonces, n)
+	}
+	return nonces, nil
+}
@@ -0,0 +1,43 @@
+package revenue
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/zenanetwork/zena/x/revenue/keeper"
+	"github.com/zenanetwork/zena/x/revenue/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	for _, revenue := range data.Revenues {
+		contract := revenue.GetContractAddr()
+		k.SetRevenue(ctx, revenue)
+		k.SetDeployerMap(ctx, revenue.GetDeployerAddr(), contract)
+
+		if withdrawer := revenue.GetWithdrawerAddr(); len(withdrawer) != 0 {
+			k.SetWithdrawerMap(ctx, withdrawer, contract)
+		}
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the revenue module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:   k.GetParams(ctx),
+		Revenues: k.GetRevenues(ctx),
+	}
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/zenanetwork/zena/x/revenue/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for the revenue keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct for the revenue keeper EVM hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. After each transaction
+// sent to a registered contract, the contract deployer (or, if set, the
+// withdraw address) receives a share of the transaction fees paid by the
+// transaction sender. The fees are only distributed when the registered
+// contract is the top-level target of the transaction, and they are also
+// distributed if the transaction fails, since the sender pays the fees anyway.
+func (h Hooks) PostTxProcessing(
+	ctx sdk.Context,
+	_ common.Address,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	contract := msg.To
+	if contract == nil {
+		return nil
+	}
+
+	// check if the fees are globally enabled
+	params := h.k.GetParams(ctx)
+	if !params.EnableRevenue || !params.DeveloperShares.IsPositive() {
+		return nil
+	}
+
+	revenue, found := h.k.GetRevenue(ctx, *contract)
+	if !found {
+		return nil
+	}
+
+	withdrawer := revenue.GetWithdrawerAddr()
+	if len(withdrawer) == 0 {
+		withdrawer = revenue.GetDeployerAddr()
+	}
+
+	// the fees are paid in the 18 decimals representation of the EVM coin, so
+	// the fractional amounts are handled by the bank keeper of the extended denom
+	txFee := sdkmath.NewIntFromUint64(receipt.GasUsed).Mul(sdkmath.NewIntFromBigInt(msg.GasPrice))
+	developerFee := sdkmath.LegacyNewDecFromInt(txFee).Mul(params.DeveloperShares).TruncateInt()
+	if !developerFee.IsPositive() {
+		return nil
+	}
+
+	fees := sdk.Coins{{Denom: evmtypes.GetEVMCoinExtendedDenom(), Amount: developerFee}}
+
+	// distribute the fees from the fee collector, which holds the fees paid in
+	// the ante handler until the unused gas is refunded. A failed distribution
+	// is skipped so that it doesn't fail the transaction of the sender.
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := h.k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, h.k.feeCollectorName, withdrawer, fees)
+	if err != nil {
+		h.k.Logger(ctx).Error(
+			"failed to distribute developer fees",
+			"fees", fees.String(),
+			"withdrawer", withdrawer.String(),
+			"contract", contract.String(),
+			"error", err,
+		)
+		return nil
+	}
+	writeCache()
+
+	// the base fee part of the developer fees has been distributed, so the fee
+	// market must not burn it: the developer shares take precedence over the burn
+	if baseFee := h.k.evmKeeper.GetBaseFee(ctx); baseFee != nil && baseFee.Sign() > 0 {
+		if msg.GasPrice.Cmp(baseFee) < 0 {
+			baseFee = msg.GasPrice
+		}
+		baseFeePaid := sdkmath.NewIntFromUint64(receipt.GasUsed).Mul(sdkmath.NewIntFromBigInt(baseFee))
+		developerBaseFee := sdkmath.LegacyNewDecFromInt(baseFeePaid).Mul(params.DeveloperShares).TruncateInt()
+		h.k.feeMarketKeeper.DeductTransientBaseFeePaid(ctx, developerBaseFee)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeDistributeDevRevenue,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+				sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, developerFee.String()),
+			),
+		},
+	)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/revenue/types"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestPostTxProcessing(t *testing.T) {
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	otherContract := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	receipt := &ethtypes.Receipt{GasUsed: 21_000}
+
+	newMsg := func(to *common.Address) core.Message {
+		return core.Message{From: sender, To: to, GasPrice: big.NewInt(1_000_000_001)}
+	}
+
+	// 21000 * 1000000001 * 0.5
+	expFee := math.NewInt(10_500_000_010_500)
+
+	testCases := []struct {
+		name       string
+		malleate   func(td testData)
+		msg        core.Message
+		expSent    bool
+		expAddress sdk.AccAddress
+	}{
+		{
+			"no-op - contract creation",
+			func(td testData) { td.registerRevenue(t, nil) },
+			newMsg(nil),
+			false, nil,
+		},
+		{
+			"no-op - contract not registered",
+			func(td testData) { td.registerRevenue(t, nil) },
+			newMsg(&otherContract),
+			false, nil,
+		},
+		{
+			"no-op - revenue disabled",
+			func(td testData) {
+				td.registerRevenue(t, nil)
+
+				params := types.DefaultParams()
+				params.EnableRevenue = false
+				require.NoError(t, td.keeper.SetParams(td.ctx, params))
+			},
+			newMsg(&contract),
+			false, nil,
+		},
+		{
+			"no-op - zero developer shares",
+			func(td testData) {
+				td.registerRevenue(t, nil)
+
+				params := types.DefaultParams()
+				params.DeveloperShares = math.LegacyZeroDec()
+				require.NoError(t, td.keeper.SetParams(td.ctx, params))
+			},
+			newMsg(&contract),
+			false, nil,
+		},
+		{
+			"skip - fee distribution fails",
+			func(td testData) {
+				td.registerRevenue(t, nil)
+				td.bank.sendErr = errors.New("insufficient funds")
+				td.evm.baseFee = big.NewInt(1_000_000_000)
+			},
+			newMsg(&contract),
+			false, nil,
+		},
+		{
+			"pass - fees sent to the deployer",
+			func(td testData) { td.registerRevenue(t, nil) },
+			newMsg(&contract),
+			true, deployer,
+		},
+		{
+			"pass - fees sent to the withdrawer",
+			func(td testData) { td.registerRevenue(t, withdrawer) },
+			newMsg(&contract),
+			true, withdrawer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			tc.malleate(td)
+
+			err := td.keeper.Hooks().PostTxProcessing(td.ctx, sender, tc.msg, receipt)
+			require.NoError(t, err)
+			if !tc.expSent {
+				require.Empty(t, td.bank.sent)
+				// the base fee is only excluded from the burn if the fees are distributed
+				require.True(t, td.feeMarket.deducted.IsZero())
+				return
+			}
+
+			require.Equal(t, []sentCoins{{
+				module:    authtypes.FeeCollectorName,
+				recipient: tc.expAddress,
+				amount:    sdk.Coins{{Denom: evmtypes.GetEVMCoinExtendedDenom(), Amount: expFee}},
+			}}, td.bank.sent)
+		})
+	}
+}
+
+func TestPostTxProcessingDeductsBaseFee(t *testing.T) {
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	receipt := &ethtypes.Receipt{GasUsed: 21_000}
+
+	testCases := []struct {
+		name        string
+		baseFee     *big.Int
+		expDeducted math.Int
+	}{
+		{"no base fee", nil, math.ZeroInt()},
+		{"zero base fee", big.NewInt(0), math.ZeroInt()},
+		// 21000 * 1000000000 * 0.5
+		{"base fee share of the developer fees", big.NewInt(1_000_000_000), math.NewInt(10_500_000_000_000)},
+		// capped at the gas price: 21000 * 1000000001 * 0.5
+		{"base fee above the gas price", big.NewInt(2_000_000_000), math.NewInt(10_500_000_010_500)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			td.registerRevenue(t, nil)
+			td.evm.baseFee = tc.baseFee
+
+			msg := core.Message{From: sender, To: &contract, GasPrice: big.NewInt(1_000_000_001)}
+			require.NoError(t, td.keeper.Hooks().PostTxProcessing(td.ctx, sender, msg, receipt))
+			require.True(t, tc.expDeducted.Equal(td.feeMarket.deducted), "expected %s, got %s", tc.expDeducted, td.feeMarket.deducted)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/zenanetwork/zena/testutil/constants"
+	"github.com/zenanetwork/zena/x/revenue/keeper"
+	"github.com/zenanetwork/zena/x/revenue/types"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	deployer   = sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	withdrawer = sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	blocked    = sdk.AccAddress(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes())
+
+	// contract is deployed by the deployer with nonce 1
+	contract = crypto.CreateAddress(common.BytesToAddress(deployer), 1)
+)
+
+// sentCoins records a transfer of the fake bank keeper.
+type sentCoins struct {
+	module    string
+	recipient sdk.AccAddress
+	amount    sdk.Coins
+}
+
+// fakeBank implements the bank keeper expected by the revenue keeper.
+type fakeBank struct {
+	sent    []sentCoins
+	sendErr error
+}
+
+func (f *fakeBank) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.sent = append(f.sent, sentCoins{senderModule, recipientAddr, amt})
+	return nil
+}
+
+func (f *fakeBank) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(blocked)
+}
+
+// fakeEVM implements the EVM keeper expected by the revenue keeper.
+type fakeEVM struct {
+	accounts  map[common.Address]bool
+	contracts map[common.Address]bool
+	baseFee   *big.Int
+}
+
+func (f *fakeEVM) GetAccountWithoutBalance(_ sdk.Context, addr common.Address) *statedb.Account {
+	if !f.accounts[addr] {
+		return nil
+	}
+	return statedb.NewEmptyAccount()
+}
+
+func (f *fakeEVM) IsContract(_ sdk.Context, addr common.Address) bool {
+	return f.contracts[addr]
+}
+
+func (f *fakeEVM) GetBaseFee(_ sdk.Context) *big.Int {
+	return f.baseFee
+}
+
+// fakeFeeMarket implements the fee market keeper expected by the revenue keeper.
+type fakeFeeMarket struct {
+	deducted math.Int
+}
+
+func (f *fakeFeeMarket) DeductTransientBaseFeePaid(_ sdk.Context, amount math.Int) {
+	f.deducted = f.deducted.Add(amount)
+}
+
+// testData defines necessary fields for testing keeper store methods without
+// full app setup.
+type testData struct {
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	bank      *fakeBank
+	evm       *fakeEVM
+	feeMarket *fakeFeeMarket
+}
+
+func newTestData(t *testing.T) testData {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bank := &fakeBank{}
+	evm := &fakeEVM{
+		accounts:  map[common.Address]bool{common.BytesToAddress(deployer): true},
+		contracts: map[common.Address]bool{contract: true},
+	}
+	feeMarket := &fakeFeeMarket{deducted: math.ZeroInt()}
+	k := keeper.NewKeeper(storeKey, cdc, authority, bank, evm, feeMarket, authtypes.FeeCollectorName)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	// the developer fees are distributed in the extended denom of the EVM coin
+	evmConfigurator := evmtypes.NewEVMConfigurator().
+		WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID])
+	evmConfigurator.ResetTestConfig()
+	require.NoError(t, evmConfigurator.Configure())
+
+	return testData{
+		ctx:       ctx,
+		keeper:    k,
+		bank:      bank,
+		evm:       evm,
+		feeMarket: feeMarket,
+	}
+}
+
+func (td testData) registerRevenue(t *testing.T, withdrawer sdk.AccAddress) {
+	t.Helper()
+
+	_, err := td.keeper.RegisterRevenue(td.ctx, types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{1}))
+	require.NoError(t, err)
+}
+
+func TestRevenueStore(t *testing.T) {
+	td := newTestData(t)
+
+	_, found := td.keeper.GetRevenue(td.ctx, contract)
+	require.False(t, found)
+	require.Empty(t, td.keeper.GetRevenues(td.ctx))
+
+	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	td.keeper.SetRevenue(td.ctx, revenue)
+	td.keeper.SetDeployerMap(td.ctx, deployer, contract)
+	td.keeper.SetWithdrawerMap(td.ctx, withdrawer, contract)
+
+	got, found := td.keeper.GetRevenue(td.ctx, contract)
+	require.True(t, found)
+	require.Equal(t, revenue, got)
+	require.Equal(t, []types.Revenue{revenue}, td.keeper.GetRevenues(td.ctx))
+	require.True(t, td.keeper.IsRevenueRegistered(td.ctx, contract))
+	require.True(t, td.keeper.IsDeployerMapSet(td.ctx, deployer, contract))
+	require.True(t, td.keeper.IsWithdrawerMapSet(td.ctx, withdrawer, contract))
+	require.False(t, td.keeper.IsWithdrawerMapSet(td.ctx, deployer, contract))
+
+	td.keeper.DeleteRevenue(td.ctx, revenue)
+	td.keeper.DeleteDeployerMap(td.ctx, deployer, contract)
+	td.keeper.DeleteWithdrawerMap(td.ctx, withdrawer, contract)
+
+	require.False(t, td.keeper.IsRevenueRegistered(td.ctx, contract))
+	require.False(t, td.keeper.IsDeployerMapSet(td.ctx, deployer, contract))
+	require.False(t, td.keeper.IsWithdrawerMapSet(td.ctx, withdrawer, contract))
+}
+
+func TestQueries(t *testing.T) {
+	td := newTestData(t)
+	td.registerRevenue(t, withdrawer)
+
+	revenuesRes, err := td.keeper.Revenues(td.ctx, &types.QueryRevenuesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Revenue{types.NewRevenue(contract, deployer, withdrawer)}, revenuesRes.Revenues)
+
+	revenueRes, err := td.keeper.Revenue(td.ctx, &types.QueryRevenueRequest{ContractAddress: contract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, deployer.String(), revenueRes.Revenue.DeployerAddress)
+
+	_, err = td.keeper.Revenue(td.ctx, &types.QueryRevenueRequest{ContractAddress: common.Address{}.Hex()})
+	require.ErrorContains(t, err, "invalid format for contract")
+
+	_, err = td.keeper.Revenue(td.ctx, &types.QueryRevenueRequest{ContractAddress: common.BytesToAddress(blocked).Hex()})
+	require.ErrorContains(t, err, "not registered")
+
+	deployerRes, err := td.keeper.DeployerRevenues(td.ctx, &types.QueryDeployerRevenuesRequest{
+		DeployerAddress: deployer.String(),
+		Pagination:      &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{contract.Hex()}, deployerRes.ContractAddresses)
+	require.Equal(t, uint64(1), deployerRes.Pagination.Total)
+
+	withdrawerRes, err := td.keeper.WithdrawerRevenues(td.ctx, &types.QueryWithdrawerRevenuesRequest{
+		WithdrawerAddress: withdrawer.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{contract.Hex()}, withdrawerRes.ContractAddresses)
+
+	withdrawerRes, err = td.keeper.WithdrawerRevenues(td.ctx, &types.QueryWithdrawerRevenuesRequest{
+		WithdrawerAddress: deployer.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, withdrawerRes.ContractAddresses)
+
+	paramsRes, err := td.keeper.Params(td.ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), paramsRes.Params)
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/zenanetwork/zena/x/revenue/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterRevenue registers a contract to receive transaction fees. The
+// deployer proves the ownership of the contract with the nonces of the address
+// derivation path from the deployer to the contract, which supports contracts
+// deployed by factory contracts.
+func (k Keeper) RegisterRevenue(
+	goCtx context.Context,
+	msg *types.MsgRegisterRevenue,
+) (*types.MsgRegisterRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	if k.IsRevenueRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueAlreadyRegistered,
+			"contract is already registered %s", contract,
+		)
+	}
+
+	deployer := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	deployerAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(deployer))
+	if deployerAccount == nil {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueDeployerNotFound,
+			"deployer account not found %s", msg.DeployerAddress,
+		)
+	}
+
+	// the withdrawer is only stored if it differs from the deployer
+	var withdrawer sdk.AccAddress
+	if msg.WithdrawerAddress != "" {
+		withdrawer = sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+		if withdrawer.Equals(deployer) {
+			withdrawer = nil
+		} else if err := k.validateWithdrawer(withdrawer); err != nil {
+			return nil, err
+		}
+	}
+
+	// the contract must be derived from the deployer address and the nonces
+	derivedContract := common.BytesToAddress(deployer)
+
+	// the address derivation is charged as the gas cost of the CREATE opcode
+	// address derivation for each nonce
+	ctx.GasMeter().ConsumeGas(
+		params.AddrDerivationCostCreate*uint64(len(msg.Nonces)),
+		"revenue registration: address derivation CREATE opcode",
+	)
+
+	for _, nonce := range msg.Nonces {
+		derivedContract = crypto.CreateAddress(derivedContract, nonce)
+	}
+
+	if contract != derivedContract {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"not contract deployer or wrong nonce: expected %s instead of %s",
+			derivedContract, msg.ContractAddress,
+		)
+	}
+
+	if !k.evmKeeper.IsContract(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueNoContractDeployed,
+			"no contract code found at address %s", msg.ContractAddress,
+		)
+	}
+
+	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	k.SetRevenue(ctx, revenue)
+	k.SetDeployerMap(ctx, deployer, contract)
+	if len(withdrawer) != 0 {
+		k.SetWithdrawerMap(ctx, withdrawer, contract)
+	}
+
+	k.Logger(ctx).Debug(
+		"registering contract for transaction fees",
+		"contract", msg.ContractAddress,
+		"deployer", msg.DeployerAddress,
+		"withdraw", msg.WithdrawerAddress,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterRevenue,
+			sdk.NewAttribute(types.AttributeKeyDeployerAddress, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, revenue.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// UpdateRevenue updates the withdraw address of a given Revenue. If the given
+// withdraw address is the deployer address, the withdrawer is removed and the
+// fees are sent to the deployer.
+func (k Keeper) UpdateRevenue(
+	goCtx context.Context,
+	msg *types.MsgUpdateRevenue,
+) (*types.MsgUpdateRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	revenue, err := k.getDeployerRevenue(ctx, contract, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the withdrawer is only stored if it differs from the deployer
+	withdrawer := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	if withdrawer.Equals(revenue.GetDeployerAddr()) {
+		withdrawer = nil
+	}
+
+	withdrawerAddress := ""
+	if len(withdrawer) != 0 {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	if withdrawerAddress == revenue.WithdrawerAddress {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"revenue with contract %s already has withdraw address %s",
+			msg.ContractAddress, msg.WithdrawerAddress,
+		)
+	}
+
+	if len(withdrawer) != 0 {
+		if err := k.validateWithdrawer(withdrawer); err != nil {
+			return nil, err
+		}
+		k.SetWithdrawerMap(ctx, withdrawer, contract)
+	}
+
+	// delete the mapping of the previous withdrawer
+	if revenue.WithdrawerAddress != "" {
+		k.DeleteWithdrawerMap(ctx, revenue.GetWithdrawerAddr(), contract)
+	}
+
+	revenue.WithdrawerAddress = withdrawerAddress
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployerAddress, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue deletes the Revenue of a given contract, which stops the
+// distribution of its transaction fees to the developer.
+func (k Keeper) CancelRevenue(
+	goCtx context.Context,
+	msg *types.MsgCancelRevenue,
+) (*types.MsgCancelRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	revenue, err := k.getDeployerRevenue(ctx, contract, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRevenue(ctx, revenue)
+	k.DeleteDeployerMap(ctx, revenue.GetDeployerAddr(), contract)
+
+	// delete entry from withdrawer map if not equal to the deployer
+	if revenue.WithdrawerAddress != "" {
+		k.DeleteWithdrawerMap(ctx, revenue.GetWithdrawerAddr(), contract)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRevenue,
+			sdk.NewAttribute(types.AttributeKeyDeployerAddress, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+		),
+	)
+
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getDeployerRevenue returns the Revenue of the given contract if it was
+// registered by the given deployer.
+func (k Keeper) getDeployerRevenue(ctx sdk.Context, contract common.Address, deployerAddress string) (types.Revenue, error) {
+	revenue, found := k.GetRevenue(ctx, contract)
+	if !found {
+		return types.Revenue{}, errorsmod.Wrapf(
+			types.ErrRevenueContractNotRegistered,
+			"contract %s is not registered", contract,
+		)
+	}
+
+	deployer := sdk.MustAccAddressFromBech32(deployerAddress)
+	if !revenue.GetDeployerAddr().Equals(deployer) {
+		return types.Revenue{}, errorsmod.Wrapf(
+			types.ErrRevenueNotDeployer,
+			"%s is not the contract deployer %s", deployerAddress, revenue.DeployerAddress,
+		)
+	}
+
+	return revenue, nil
+}
+
+// validateWithdrawer checks that the developer fees can be sent to the
+// withdrawer, so that the transactions to the contract don't fail on the fee
+// distribution.
+func (k Keeper) validateWithdrawer(withdrawer sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdrawer) {
+		return errorsmod.Wrapf(
+			types.ErrRevenueInvalidWithdrawer,
+			"%s is not allowed to receive funds", withdrawer,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenanetwork/zena/x/revenue/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestRegisterRevenue(t *testing.T) {
+	// factoryContract is deployed by the contract with nonce 3
+	factoryContract := crypto.CreateAddress(contract, 3)
+
+	testCases := []struct {
+		name     string
+		malleate func(td testData)
+		msg      *types.MsgRegisterRevenue
+		expErr   error
+	}{
+		{
+			"fail - revenue disabled",
+			func(td testData) {
+				params := types.DefaultParams()
+				params.EnableRevenue = false
+				require.NoError(t, td.keeper.SetParams(td.ctx, params))
+			},
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}),
+			types.ErrRevenueDisabled,
+		},
+		{
+			"fail - already registered",
+			func(td testData) { td.registerRevenue(t, nil) },
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}),
+			types.ErrRevenueAlreadyRegistered,
+		},
+		{
+			"fail - deployer not found",
+			func(testData) {},
+			types.NewMsgRegisterRevenue(contract.Hex(), withdrawer, nil, []uint64{1}),
+			types.ErrRevenueDeployerNotFound,
+		},
+		{
+			"fail - blocked withdrawer",
+			func(testData) {},
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, blocked, []uint64{1}),
+			types.ErrRevenueInvalidWithdrawer,
+		},
+		{
+			"fail - wrong nonce",
+			func(testData) {},
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{2}),
+			errortypes.ErrorInvalidSigner,
+		},
+		{
+			"fail - no contract code",
+			func(td testData) { delete(td.evm.contracts, contract) },
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}),
+			types.ErrRevenueNoContractDeployed,
+		},
+		{
+			"pass - without withdrawer",
+			func(testData) {},
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}),
+			nil,
+		},
+		{
+			"pass - deployer as withdrawer",
+			func(testData) {},
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, deployer, []uint64{1}),
+			nil,
+		},
+		{
+			"pass - with withdrawer",
+			func(testData) {},
+			types.NewMsgRegisterRevenue(contract.Hex(), deployer, withdrawer, []uint64{1}),
+			nil,
+		},
+		{
+			"pass - contract deployed by a factory",
+			func(td testData) { td.evm.contracts[factoryContract] = true },
+			types.NewMsgRegisterRevenue(factoryContract.Hex(), deployer, nil, []uint64{1, 3}),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newTestData(t)
+			tc.malleate(td)
+
+			_, err := td.keeper.RegisterRevenue(td.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			contractAddr := common.HexToAddress(tc.msg.ContractAddress)
+			revenue, found := td.keeper.GetRevenue(td.ctx, contractAddr)
+			require.True(t, found)
+			require.Equal(t, tc.msg.DeployerAddress, revenue.DeployerAddress)
+			require.True(t, td.keeper.IsDeployerMapSet(td.ctx, deployer, contractAddr))
+
+			// the deployer is not stored as withdrawer
+			if tc.msg.WithdrawerAddress == "" || tc.msg.WithdrawerAddress == tc.msg.DeployerAddress {
+				require.Empty(t, revenue.WithdrawerAddress)
+				require.False(t, td.keeper.IsWithdrawerMapSet(td.ctx, deployer, contractAddr))
+			} else {
+				require.Equal(t, tc.msg.WithdrawerAddress, revenue.WithdrawerAddress)
+				require.True(t, td.keeper.IsWithdrawerMapSet(td.ctx, withdrawer, contractAddr))
+			}
+		})
+	}
+}
+
+func TestUpdateCancelRevenue(t *testing.T) {
+	td := newTestData(t)
+
+	_, err := td.keeper.UpdateRevenue(td.ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, withdrawer))
+	require.ErrorIs(t, err, types.ErrRevenueContractNotRegistered)
+
+	td.registerRevenue(t, nil)
+
+	_, err = td.keeper.UpdateRevenue(td.ctx, types.NewMsgUpdateRevenue(contract.Hex(), withdrawer, withdrawer))
+	require.ErrorIs(t, err, types.ErrRevenueNotDeployer)
+
+	_, err = td.keeper.UpdateRevenue(td.ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, deployer))
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+
+	_, err = td.keeper.UpdateRevenue(td.ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, blocked))
+	require.ErrorIs(t, err, types.ErrRevenueInvalidWithdrawer)
+
+	_, err = td.keeper.UpdateRevenue(td.ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, withdrawer))
+	require.NoError(t, err)
+
+	revenue, _ := td.keeper.GetRevenue(td.ctx, contract)
+	require.Equal(t, withdrawer.String(), revenue.WithdrawerAddress)
+	require.True(t, td.keeper.IsWithdrawerMapSet(td.ctx, withdrawer, contract))
+
+	// setting the deployer as withdrawer removes the withdrawer
+	_, err = td.keeper.UpdateRevenue(td.ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, deployer))
+	require.NoError(t, err)
+
+	revenue, _ = td.keeper.GetRevenue(td.ctx, contract)
+	require.Empty(t, revenue.WithdrawerAddress)
+	require.False(t, td.keeper.IsWithdrawerMapSet(td.ctx, withdrawer, contract))
+
+	_, err = td.keeper.CancelRevenue(td.ctx, types.NewMsgCancelRevenue(contract.Hex(), withdrawer))
+	require.ErrorIs(t, err, types.ErrRevenueNotDeployer)
+
+	_, err = td.keeper.CancelRevenue(td.ctx, types.NewMsgCancelRevenue(contract.Hex(), deployer))
+	require.NoError(t, err)
+	require.False(t, td.keeper.IsRevenueRegistered(td.ctx, contract))
+	require.False(t, td.keeper.IsDeployerMapSet(td.ctx, deployer, contract))
+
+	_, err = td.keeper.CancelRevenue(td.ctx, types.NewMsgCancelRevenue(contract.Hex(), deployer))
+	require.ErrorIs(t, err, types.ErrRevenueContractNotRegistered)
+}
+
+func TestUpdateParams(t *testing.T) {
+	td := newTestData(t)
+	params := types.NewParams(true, math.LegacyNewDecWithPrec(25, 2), 100)
+
+	_, err := td.keeper.UpdateParams(td.ctx, &types.MsgUpdateParams{Authority: deployer.String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = td.keeper.UpdateParams(td.ctx, &types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    types.NewParams(true, math.LegacyNewDec(2), 100),
+	})
+	require.Error(t, err)
+
+	_, err = td.keeper.UpdateParams(td.ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, td.keeper.GetParams(td.ctx))
+}
+
+func TestRegisterRevenueGas(t *testing.T) {
+	td := newTestData(t)
+	ctx := td.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	_, err := td.keeper.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}))
+	require.NoError(t, err)
+
+	// the gas consumed includes the address derivation cost of the nonce
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), types.DefaultAddrDerivationCostCreate)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRevenueDisabled              = errorsmod.Register(ModuleName, 2, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered     = errorsmod.Register(ModuleName, 3, "revenue already exists for given contract")
+	ErrRevenueNoContractDeployed    = errorsmod.Register(ModuleName, 4, "no contract deployed")
+	ErrRevenueContractNotRegistered = errorsmod.Register(ModuleName, 5, "no revenue registered for contract")
+	ErrRevenueDeployerNotFound      = errorsmod.Register(ModuleName, 6, "no account found for deployer")
+	ErrRevenueNotDeployer           = errorsmod.Register(ModuleName, 7, "deployer does not match the registered deployer")
+	ErrRevenueInvalidWithdrawer     = errorsmod.Register(ModuleName, 8, "invalid withdrawer address")
+	ErrRevenueFundsDistribution     = errorsmod.Register(ModuleName, 9, "failed to distribute developer fees")
+)
@@ -0,0 +1,13 @@
+package types
+
+// revenue events
+const (
+	EventTypeRegisterRevenue      = "register_revenue"
+	EventTypeCancelRevenue        = "cancel_revenue"
+	EventTypeUpdateRevenue        = "update_revenue"
+	EventTypeDistributeDevRevenue = "distribute_dev_revenue"
+
+	AttributeKeyContract          = "contract"
+	AttributeKeyDeployerAddress   = "deployer_address"
+	AttributeKeyWithdrawerAddress = "withdrawer_address"
+)
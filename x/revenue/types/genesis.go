@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, revenues []Revenue) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Revenues: revenues,
+	}
+}
+
+// DefaultGenesisState sets default revenue genesis state with default params
+// and no registered contracts.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:   DefaultParams(),
+		Revenues: []Revenue{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContract := make(map[common.Address]bool)
+	for _, revenue := range gs.Revenues {
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+
+		// only one revenue per contract
+		contract := revenue.GetContractAddr()
+		if seenContract[contract] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", revenue.ContractAddress)
+		}
+		seenContract[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
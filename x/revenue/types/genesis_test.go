@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	contract := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	deployer := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	withdrawer := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	revenue := NewRevenue(contract, deployer, withdrawer)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{
+					revenue,
+					NewRevenue(common.HexToAddress("0x2222222222222222222222222222222222222222"), deployer, nil),
+				},
+			},
+			true,
+		},
+		{
+			"invalid contract",
+			&GenesisState{
+				Params:   DefaultParams(),
+				Revenues: []Revenue{NewRevenue(common.Address{}, deployer, nil)},
+			},
+			false,
+		},
+		{
+			"invalid withdrawer",
+			&GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{
+					{ContractAddress: contract.Hex(), DeployerAddress: deployer.String(), WithdrawerAddress: "withdrawer"},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated contract",
+			&GenesisState{
+				Params:   DefaultParams(),
+				Revenues: []Revenue{revenue, NewRevenue(contract, withdrawer, nil)},
+			},
+			false,
+		},
+		{
+			"invalid params",
+			&GenesisState{
+				Params: NewParams(true, math.LegacyNewDec(2), DefaultAddrDerivationCostCreate),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestValidateParams() {
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"zero shares", NewParams(true, math.LegacyZeroDec(), DefaultAddrDerivationCostCreate), true},
+		{"all shares", NewParams(true, math.LegacyOneDec(), DefaultAddrDerivationCostCreate), true},
+		{"nil shares", Params{EnableRevenue: true}, false},
+		{"negative shares", NewParams(true, math.LegacyNewDec(-1), DefaultAddrDerivationCostCreate), false},
+		{"shares greater than one", NewParams(true, math.LegacyNewDecWithPrec(11, 1), DefaultAddrDerivationCostCreate), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zenanetwork/zena/x/vm/statedb"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected interface needed to distribute the developer
+// fees from the fee collector.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to verify the
+// contract deployers and to read the base fee of the transactions.
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	IsContract(ctx sdk.Context, addr common.Address) bool
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// exclude the base fees distributed to the developers from the base fee burn.
+type FeeMarketKeeper interface {
+	DeductTransientBaseFeePaid(ctx sdk.Context, amount sdkmath.Int)
+}
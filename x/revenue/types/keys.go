@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "revenue"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the revenue persistent store
+const (
+	prefixRevenue = iota + 1
+	prefixDeployer
+	prefixWithdrawer
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRevenue    = []byte{prefixRevenue}
+	KeyPrefixDeployer   = []byte{prefixDeployer}
+	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	ParamsKey           = []byte{prefixParams}
+)
+
+// GetKeyPrefixDeployer returns the KVStore key prefix for the reverse mapping
+// of deployers to their registered contracts
+func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixDeployer, address.MustLengthPrefix(deployerAddress.Bytes())...)
+}
+
+// GetKeyPrefixWithdrawer returns the KVStore key prefix for the reverse mapping
+// of withdrawers to the contracts whose fees they receive
+func GetKeyPrefixWithdrawer(withdrawerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixWithdrawer, address.MustLengthPrefix(withdrawerAddress.Bytes())...)
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/zenanetwork/zena/utils"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNonces is the maximum length of the address derivation path of a
+// registered contract
+const MaxNonces = 20
+
+var (
+	_ sdk.Msg              = &MsgRegisterRevenue{}
+	_ sdk.Msg              = &MsgUpdateRevenue{}
+	_ sdk.Msg              = &MsgCancelRevenue{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgRegisterRevenue{}
+	_ sdk.HasValidateBasic = &MsgUpdateRevenue{}
+	_ sdk.HasValidateBasic = &MsgCancelRevenue{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
+func NewMsgRegisterRevenue(
+	contract string,
+	deployer,
+	withdrawer sdk.AccAddress,
+	nonces []uint64,
+) *MsgRegisterRevenue {
+	withdrawerAddress := ""
+	if withdrawer != nil {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		Nonces:            nonces,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgRegisterRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if err := utils.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	if msg.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+		}
+	}
+
+	if len(msg.Nonces) < 1 {
+		return fmt.Errorf("invalid nonces - empty array")
+	}
+
+	if len(msg.Nonces) > MaxNonces {
+		return fmt.Errorf("invalid nonces - array length must be less than or equal to %d", MaxNonces)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateRevenue creates new instance of MsgUpdateRevenue
+func NewMsgUpdateRevenue(
+	contract string,
+	deployer,
+	withdrawer sdk.AccAddress,
+) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgUpdateRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if err := utils.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// NewMsgCancelRevenue creates new instance of MsgCancelRevenue.
+func NewMsgCancelRevenue(
+	contract string,
+	deployer sdk.AccAddress,
+) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract,
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgCancelRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if err := utils.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
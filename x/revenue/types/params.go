@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultEnableRevenue is true
+	DefaultEnableRevenue = true
+	// DefaultDeveloperShares is 0.5 or 50%
+	DefaultDeveloperShares = math.LegacyNewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the gas cost charged per nonce of the
+	// address derivation path when registering a contract
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	enableRevenue bool,
+	developerShares math.LegacyDec,
+	addrDerivationCostCreate uint64,
+) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default revenue module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableRevenue:            DefaultEnableRevenue,
+		DeveloperShares:          DefaultDeveloperShares,
+		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+	}
+}
+
+// Validate performs basic validation on revenue parameters.
+func (p Params) Validate() error {
+	return validateShares(p.DeveloperShares)
+}
+
+func validateShares(shares math.LegacyDec) error {
+	if shares.IsNil() {
+		return fmt.Errorf("developer shares cannot be nil")
+	}
+
+	if shares.IsNegative() {
+		return fmt.Errorf("developer shares cannot be negative: %s", shares)
+	}
+
+	if shares.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("developer shares cannot be greater than 1: %s", shares)
+	}
+
+	return nil
+}
@@ -45,7 +45,8 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 }
 
 // VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// module parameters. The config generated uses the default JumpTable from the EVM, with the
+// opcode constant gas overrides of the module parameters applied as extra EIPs.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks) vm.Config {
 	noBaseFee := true
 	if types.IsLondon(types.GetEthChainConfig(), ctx.BlockHeight()) {
@@ -56,6 +57,6 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		EnablePreimageRecording: cfg.EnablePreimageRecording,
 		Tracer:                  tracer,
 		NoBaseFee:               noBaseFee,
-		ExtraEips:               cfg.Params.EIPs(),
+		ExtraEips:               append(cfg.Params.EIPs(), cfg.Params.OpcodeGasEIPs()...),
 	}
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -10,6 +11,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -29,7 +31,8 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// consumed in the transaction. If the fees were paid by a fee granter, the leftover gas is refunded to
+// the granter instead and given back to the allowance the fees were deducted from. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
@@ -44,8 +47,18 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		// refund to the fee payer (the sender unless a fee granter paid the fees) from the fee collector module
+		// account, which is the escrow account in charge of collecting tx fees
+		refundee := sdk.AccAddress(msg.From.Bytes())
+		if feePayer := k.GetTransientFeePayer(ctx); !feePayer.Empty() {
+			refundee = feePayer
+
+			fees := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)))}
+			if err := k.restoreFeeAllowance(ctx, feePayer, sdk.AccAddress(msg.From.Bytes()), fees, refundedCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to give back %s to the fee allowance of %s", refundedCoins.String(), feePayer)
+			}
+		}
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundee, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -57,6 +70,78 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// restoreFeeAllowance gives back the fees of the leftover gas to the allowance of the fee granter: the
+// allowance stored before the AnteHandler deducted the fees of the gas limit is charged with the fees
+// of the gas used only. The allowance is left as is if the execution changed it, e.g. if the granter
+// revoked it through the feegrant precompile.
+func (k *Keeper) restoreFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, fees, refund sdk.Coins) error {
+	if k.feegrantKeeper == nil {
+		return nil
+	}
+
+	// the allowance was used for the same message type in the AnteHandler
+	msgs := []sdk.Msg{&types.MsgEthereumTx{}}
+
+	// check that the allowance is the one left by the AnteHandler
+	charged, found, err := k.storedFeeAllowance(ctx, granter, grantee)
+	if err != nil || !found {
+		return err
+	}
+	removed, err := charged.Accept(ctx, fees, msgs)
+	if err != nil {
+		return nil
+	}
+
+	current, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	switch {
+	case removed && err == nil, !removed && err != nil:
+		return nil
+	case !removed:
+		currentBz, err := k.marshalFeeAllowance(granter, grantee, current)
+		if err != nil {
+			return err
+		}
+		chargedBz, err := k.marshalFeeAllowance(granter, grantee, charged)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(currentBz, chargedBz) {
+			return nil
+		}
+	}
+
+	restored, _, err := k.storedFeeAllowance(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+	if _, err := restored.Accept(ctx, fees.Sub(refund...), msgs); err != nil {
+		return err
+	}
+
+	if removed {
+		return k.feegrantKeeper.GrantAllowance(ctx, granter, grantee, restored)
+	}
+	return k.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, restored)
+}
+
+// storedFeeAllowance returns the allowance stored in the AnteHandler before the fees were deducted
+// from it, if it was given by the granter to the grantee.
+func (k *Keeper) storedFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, bool, error) {
+	grant, found, err := k.getTransientFeeAllowance(ctx)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	if grant.Granter != granter.String() || grant.Grantee != grantee.String() {
+		return nil, false, nil
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return nil, false, err
+	}
+	return allowance, true, nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
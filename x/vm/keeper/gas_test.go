@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	vmkeeper "github.com/zenanetwork/zena/x/vm/keeper"
+	vmtypes "github.com/zenanetwork/zena/x/vm/types"
+	"github.com/zenanetwork/zena/x/vm/types/mocks"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// feegrantAccountKeeper adds the methods of the account keeper expected by the feegrant keeper.
+type feegrantAccountKeeper struct {
+	*mocks.AccountKeeper
+}
+
+func (feegrantAccountKeeper) GetModuleAccount(_ context.Context, _ string) sdk.ModuleAccountI {
+	return nil
+}
+
+func TestRefundGasFeeGrant(t *testing.T) {
+	denom := vmtypes.DefaultEVMExtendedDenom
+	// the EVM coin info can be set once per process
+	_ = vmtypes.NewEVMConfigurator().WithEVMCoinInfo(vmtypes.EvmCoinInfo{
+		Denom:         denom,
+		ExtendedDenom: denom,
+		DisplayDenom:  vmtypes.DefaultEVMDisplayDenom,
+		Decimals:      18,
+	}).Configure()
+	require.Equal(t, denom, vmtypes.GetEVMCoinDenom())
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+	}
+
+	granter := sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	grantee := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+
+	// the AnteHandler charges the fees of the gas limit (100), 60 gas are used
+	const (
+		gasLimit    = 100
+		leftoverGas = 40
+	)
+	fees := coins(gasLimit)
+	msg := core.Message{From: common.BytesToAddress(grantee), GasLimit: gasLimit, GasPrice: big.NewInt(1)}
+
+	testCases := []struct {
+		name         string
+		allowance    feegrant.FeeAllowanceI
+		malleate     func(ctx sdk.Context, k feegrantkeeper.Keeper)
+		expAllowance feegrant.FeeAllowanceI
+	}{
+		{
+			name:         "pass - leftover gas given back to the allowance",
+			allowance:    &feegrant.BasicAllowance{SpendLimit: coins(1000)},
+			expAllowance: &feegrant.BasicAllowance{SpendLimit: coins(940)},
+		},
+		{
+			name:         "pass - allowance spent by the gas limit restored",
+			allowance:    &feegrant.BasicAllowance{SpendLimit: coins(100)},
+			expAllowance: &feegrant.BasicAllowance{SpendLimit: coins(40)},
+		},
+		{
+			name: "pass - leftover gas given back to the periodic allowance",
+			allowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: coins(1000)},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(500),
+				PeriodCanSpend:   coins(500),
+				PeriodReset:      time.Unix(1_700_003_600, 0).UTC(),
+			},
+			expAllowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: coins(940)},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(500),
+				PeriodCanSpend:   coins(440),
+				PeriodReset:      time.Unix(1_700_003_600, 0).UTC(),
+			},
+		},
+		{
+			name:      "pass - allowance updated during the execution kept",
+			allowance: &feegrant.BasicAllowance{SpendLimit: coins(1000)},
+			malleate: func(ctx sdk.Context, k feegrantkeeper.Keeper) {
+				require.NoError(t, k.UpdateAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: coins(1)}))
+			},
+			expAllowance: &feegrant.BasicAllowance{SpendLimit: coins(1)},
+		},
+	}
+
+	keys := storetypes.NewKVStoreKeys(vmtypes.StoreKey, feegrant.StoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(vmtypes.TransientKey)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	feegrant.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	accKeeper := mocks.NewAccountKeeper(t)
+	accKeeper.On("GetModuleAddress", vmtypes.ModuleName).Return(sdk.AccAddress("evm"))
+	accKeeper.On("GetAccount", mock.Anything, grantee).Return(authtypes.NewBaseAccountWithAddress(grantee)).Maybe()
+	bankKeeper := mocks.NewBankKeeper(t)
+	bankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, authtypes.FeeCollectorName, granter, coins(leftoverGas)).Return(nil)
+
+	feegrantKeeper := feegrantkeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), feegrantAccountKeeper{accKeeper})
+	vmKeeper := vmkeeper.NewKeeper(
+		encCfg.Codec,
+		keys[vmtypes.StoreKey],
+		tkeys[vmtypes.TransientKey],
+		keys,
+		sdk.AccAddress("foobar"),
+		accKeeper,
+		bankKeeper,
+		mocks.NewStakingKeeper(t),
+		mocks.NewFeeMarketKeeper(t),
+		mocks.NewConsensusParamsKeeper(t),
+		mocks.NewErc20Keeper(t),
+		vmtypes.DefaultEVMChainID,
+		"",
+	).SetFeegrantKeeper(feegrantKeeper)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContextWithKeys(keys, tkeys, nil).
+				WithBlockHeader(cmtproto.Header{Time: time.Unix(1_700_000_000, 0)})
+
+			require.NoError(t, feegrantKeeper.GrantAllowance(ctx, granter, grantee, tc.allowance))
+
+			// fees deducted from the allowance in the AnteHandler
+			require.NoError(t, vmKeeper.SetTransientFeeAllowance(ctx, granter, grantee))
+			require.NoError(t, feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fees, []sdk.Msg{&vmtypes.MsgEthereumTx{}}))
+			vmKeeper.SetTransientFeePayer(ctx, granter)
+
+			if tc.malleate != nil {
+				tc.malleate(ctx, feegrantKeeper)
+			}
+
+			require.NoError(t, vmKeeper.RefundGas(ctx, msg, leftoverGas, denom))
+
+			allowance, err := feegrantKeeper.GetAllowance(ctx, granter, grantee)
+			require.NoError(t, err)
+			require.Equal(t, tc.expAllowance, allowance)
+		})
+	}
+}
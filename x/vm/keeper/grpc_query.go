@@ -21,16 +21,21 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/zenanetwork/zena/ethereum/eip712"
 	rpctypes "github.com/zenanetwork/zena/rpc/types"
 	"github.com/zenanetwork/zena/utils"
 	evmante "github.com/zenanetwork/zena/x/vm/ante"
 	"github.com/zenanetwork/zena/x/vm/statedb"
+	vmtracers "github.com/zenanetwork/zena/x/vm/tracers"
 	"github.com/zenanetwork/zena/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -42,6 +47,14 @@ const (
 	maxTracePredecessors = 10_000
 
 	maxPredecessorGas = uint64(50_000_000)
+
+	// maxAccountRangeLimit is the maximum number of accounts returned by a single AccountRange request.
+	maxAccountRangeLimit = 256
+	// maxAccountRangeStorage is the maximum number of storage entries returned by a single
+	// AccountRange request, to bound the work of dumping the storage of the contracts.
+	maxAccountRangeStorage = 10_000
+	// maxStorageRangeLimit is the maximum number of storage entries returned by a single StorageRange request.
+	maxStorageRangeLimit = 1024
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -186,6 +199,149 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method. The storage
+// entries are ordered by their raw slot key, and the page is capped to
+// maxStorageRangeLimit entries.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 || limit > maxStorageRangeLimit {
+		limit = maxStorageRangeLimit
+	}
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	var storage []types.State
+	pageRes, err := query.Paginate(store, &query.PageRequest{Key: pageReq.Key, Limit: limit}, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method. It iterates the
+// accounts of the auth module with an Ethereum address, ordered by address, so
+// that externally owned accounts are included as well as contracts. The page is
+// capped to maxAccountRangeLimit accounts and, when the storage is included, to
+// maxAccountRangeStorage storage entries.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 || limit > maxAccountRangeLimit {
+		limit = maxAccountRangeLimit
+	}
+
+	authKey, ok := k.storeKeys[authtypes.StoreKey]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "auth store is not available")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(authKey), authtypes.AddressStoreKeyPrefix)
+	iterator := store.Iterator(pageReq.Key, nil)
+	defer iterator.Close()
+
+	var (
+		accounts     []types.DumpAccount
+		nextKey      []byte
+		storageCount int
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		// skip the accounts without an Ethereum address (e.g. interchain accounts)
+		if len(iterator.Key()) != common.AddressLength {
+			continue
+		}
+		if uint64(len(accounts)) == limit {
+			nextKey = iterator.Key()
+			break
+		}
+
+		address := common.BytesToAddress(iterator.Key())
+		account := types.DumpAccount{
+			Address:  address.Hex(),
+			Balance:  "0",
+			CodeHash: common.BytesToHash(types.EmptyCodeHash).Hex(),
+		}
+
+		acct := k.GetAccount(ctx, address)
+		if acct != nil {
+			account.Balance = acct.Balance.String()
+			account.Nonce = acct.Nonce
+			account.CodeHash = common.BytesToHash(acct.CodeHash).Hex()
+		}
+
+		if !req.SkipCode && acct != nil && acct.HasCodeHash() {
+			account.Code = k.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+		}
+
+		if !req.SkipStorage {
+			var storage []types.State
+			k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+				storage = append(storage, types.NewState(key, value))
+				return storageCount+len(storage) <= maxAccountRangeStorage
+			})
+			if storageCount+len(storage) > maxAccountRangeStorage {
+				if len(accounts) == 0 {
+					return nil, status.Errorf(
+						codes.ResourceExhausted,
+						"storage of account %s exceeds %d entries, skip the storage and query it by range instead",
+						address.Hex(), maxAccountRangeStorage,
+					)
+				}
+				// continue on the next page from this account
+				nextKey = iterator.Key()
+				break
+			}
+			storageCount += len(storage)
+			account.Storage = storage
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return &types.QueryAccountRangeResponse{
+		Accounts:   accounts,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -272,6 +428,58 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// SimulateV1 implements eth_simulateV1 rpc api. The calls of the simulated
+// blocks are executed in sequence on top of the requested block, the state
+// changes being discarded at the end of the simulation.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty input")
+	}
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "too many blocks: cannot simulate more than %d blocks", maxSimulateBlocks)
+	}
+	calls := 0
+	for _, block := range opts.BlockStateCalls {
+		calls += len(block.Calls)
+	}
+	if calls > maxSimulateCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "too many calls: cannot simulate more than %d calls", maxSimulateCalls)
+	}
+	if chainID := types.GetEthChainConfig().ChainID; chainID.Cmp(big.NewInt(req.ChainId)) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id; expected %s, got %d", chainID, req.ChainId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the state changes are committed to a cache context so that each call
+	// sees the changes of the previous ones
+	cacheCtx, _ := ctx.CacheContext()
+	blocks, err := k.simulate(cacheCtx, cfg, &opts, req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bz, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Result: bz}, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -805,12 +1013,14 @@ func (k *Keeper) traceTxWithMsg(
 		TxHash:    txConfig.TxHash,
 	}
 
+	// cosmosHooks is set for the tracers reporting the Cosmos operations of the precompiles
+	var cosmosHooks *vmtracers.CosmosHooks
 	if traceConfig.Tracer != "" {
 		var cfg json.RawMessage
 		if traceConfig.TracerJsonConfig != "" {
 			cfg = json.RawMessage(traceConfig.TracerJsonConfig)
 		}
-		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, cfg,
+		if tracer, cosmosHooks, err = vmtracers.New(traceConfig.Tracer, tCtx, cfg,
 			types.GetEthChainConfig()); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
@@ -836,6 +1046,9 @@ func (k *Keeper) traceTxWithMsg(
 
 	// Build EVM execution context
 	ctx = buildTraceCtx(ctx, msg.GasLimit)
+	if cosmosHooks != nil {
+		ctx = vmtracers.WithCosmosHooks(ctx, cosmosHooks)
+	}
 	traceStateDB := statedb.New(ctx, k, txConfig)
 	res, err := k.ApplyMessageWithConfig(ctx, traceStateDB, *msg, tracer.Hooks, commitMessage, false, cfg, txConfig, false, nil)
 	if err != nil {
@@ -874,12 +1087,44 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 }
 
 // Config implements the Query/Config gRPC method
-func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+func (k Keeper) Config(c context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
 	config := types.GetChainConfig()
 	config.Denom = types.GetEVMCoinDenom()
 	config.Decimals = uint64(types.GetEVMCoinDecimals())
 
-	return &types.QueryConfigResponse{Config: config}, nil
+	return &types.QueryConfigResponse{
+		Config:             config,
+		OpcodeGasOverrides: k.GetParams(ctx).OpcodeGasOverrides,
+	}, nil
+}
+
+// EIP712Types implements the Query/EIP712Types gRPC method
+func (k Keeper) EIP712Types(_ context.Context, req *types.QueryEIP712TypesRequest) (*types.QueryEIP712TypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty type URL")
+	}
+
+	eip712Types, msgType, err := eip712.GetProtoEIP712Types(req.TypeUrl)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bz, err := json.Marshal(eip712Types)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEIP712TypesResponse{
+		PrimaryType: eip712.TxPrimaryType,
+		MessageType: msgType,
+		Types:       string(bz),
+	}, nil
 }
 
 // buildTraceCtx builds a context for simulating or tracing transactions by:
@@ -0,0 +1,11 @@
+//go:build test
+// +build test
+
+package keeper
+
+// CleanHooks resets the hooks set on the keeper, so that the tests can replace
+// the hooks set by the app. It is only available in test builds.
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
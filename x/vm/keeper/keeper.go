@@ -23,6 +23,7 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -61,6 +62,9 @@ type Keeper struct {
 	feeMarketWrapper *wrappers.FeeMarketWrapper
 	// optional erc20Keeper interface needed to instantiate erc20 precompiles
 	erc20Keeper types.Erc20Keeper
+	// optional feegrantKeeper used to give back the fees of the leftover gas to the
+	// allowance of the fee granter
+	feegrantKeeper types.FeegrantKeeper
 	// consensusKeeper is used to get consensus params during query contexts.
 	// This is needed as block.gasLimit is expected to be available in eth_call, which is routed through Cosmos SDK's
 	// grpc query router. This query router builds a context WITHOUT consensus params, so we manually supply the context
@@ -396,6 +400,79 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	return result, nil
 }
 
+// SetTransientFeePayer sets the account that paid the fees of the current cosmos tx, called in ante handler.
+// An empty fee payer clears the previous one, so that the leftover gas is refunded to the sender.
+func (k Keeper) SetTransientFeePayer(ctx sdk.Context, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	if feePayer.Empty() {
+		store.Delete(types.KeyPrefixTransientFeePayer)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeePayer, feePayer)
+}
+
+// GetTransientFeePayer returns the account that paid the fees of the current cosmos tx, if it's not the sender.
+func (k Keeper) GetTransientFeePayer(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	return store.Get(types.KeyPrefixTransientFeePayer)
+}
+
+// SetTransientFeeAllowance stores the allowance given by the fee granter to the sender of the current
+// cosmos tx before the fees are deducted from it, called in ante handler. It's used to give back the
+// fees of the leftover gas to the allowance. An empty fee granter clears the previous one.
+func (k Keeper) SetTransientFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	store := ctx.TransientStore(k.transientKey)
+	if granter.Empty() || k.feegrantKeeper == nil {
+		store.Delete(types.KeyPrefixTransientFeeAllowance)
+		return nil
+	}
+
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		// the missing allowance is reported when the fees are deducted from it
+		store.Delete(types.KeyPrefixTransientFeeAllowance)
+		return nil
+	}
+
+	bz, err := k.marshalFeeAllowance(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefixTransientFeeAllowance, bz)
+	return nil
+}
+
+// getTransientFeeAllowance returns the allowance stored by SetTransientFeeAllowance, if any. A new
+// instance is returned on every call, so that it can be updated by the caller.
+func (k Keeper) getTransientFeeAllowance(ctx sdk.Context) (feegrant.Grant, bool, error) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientFeeAllowance)
+	if len(bz) == 0 {
+		return feegrant.Grant{}, false, nil
+	}
+
+	var grant feegrant.Grant
+	if err := k.cdc.Unmarshal(bz, &grant); err != nil {
+		return feegrant.Grant{}, false, err
+	}
+	return grant, true, nil
+}
+
+// SetFeegrantKeeper sets the feegrant keeper used to give back the fees of the leftover gas
+// to the allowance of the fee granter.
+func (k *Keeper) SetFeegrantKeeper(feegrantKeeper types.FeegrantKeeper) *Keeper {
+	k.feegrantKeeper = feegrantKeeper
+	return k
+}
+
+func (k Keeper) marshalFeeAllowance(granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) ([]byte, error) {
+	grant, err := feegrant.NewGrant(granter, grantee, allowance)
+	if err != nil {
+		return nil, err
+	}
+	return k.cdc.Marshal(&grant)
+}
+
 // KVStoreKeys returns KVStore keys injected to keeper
 func (k Keeper) KVStoreKeys() map[string]*storetypes.KVStoreKey {
 	return k.storeKeys
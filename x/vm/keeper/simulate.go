@@ -0,0 +1,537 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+
+	antetypes "github.com/zenanetwork/zena/ante/types"
+	rpctypes "github.com/zenanetwork/zena/rpc/types"
+	"github.com/zenanetwork/zena/utils"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+	"github.com/zenanetwork/zena/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks, including the gaps
+	// filled with empty blocks, that can be simulated in a single request.
+	maxSimulateBlocks = 256
+	// maxSimulateCalls is the maximum number of calls, across all the blocks,
+	// that can be simulated in a single request.
+	maxSimulateCalls = 1000
+	// simTimestampIncrement is the default time increment between simulated blocks.
+	simTimestampIncrement = 12
+)
+
+var (
+	// simTransferTopic is the topic of the ERC-20 Transfer event used for the
+	// synthetic logs of native transfers.
+	simTransferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	// simTransferAddress is the address emitting the synthetic logs of native transfers.
+	simTransferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+)
+
+// simulate runs the calls of the simulated blocks in sequence on top of the
+// block of the context. The state changes of each call are committed to the
+// context, which must be a cache context discarded by the caller. The gas cap
+// is the gas budget of the whole simulation, shared by the calls of all the
+// blocks. A zero gas cap means no limit.
+func (k *Keeper) simulate(ctx sdk.Context, cfg *statedb.EVMConfig, opts *rpctypes.SimOpts, gasCap uint64) ([]map[string]interface{}, error) {
+	base := &ethtypes.Header{
+		Number:   big.NewInt(ctx.BlockHeight()),
+		Time:     uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+		GasLimit: antetypes.BlockGasLimit(ctx),
+	}
+	blocks, err := sanitizeSimChain(base, opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := gasCap
+	if budget == 0 {
+		budget = math.MaxUint64
+	}
+
+	parent := base
+	parentHash := k.GetHashFn(ctx)(base.Number.Uint64())
+	results := make([]map[string]interface{}, len(blocks))
+	for i, block := range blocks {
+		var header *ethtypes.Header
+		header, results[i], err = k.simulateBlock(ctx, cfg, block, parent, parentHash, opts, &budget)
+		if err != nil {
+			return nil, err
+		}
+		parent, parentHash = header, header.Hash()
+	}
+	return results, nil
+}
+
+// sanitizeSimChain sets the number and timestamp of the simulated blocks which
+// don't override them, and fills the gaps between the block numbers with empty
+// blocks.
+func sanitizeSimChain(base *ethtypes.Header, blocks []rpctypes.SimBlock) ([]rpctypes.SimBlock, error) {
+	res := make([]rpctypes.SimBlock, 0, len(blocks))
+	prevNumber := base.Number.Uint64()
+	prevTimestamp := base.Time
+	for _, block := range blocks {
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = new(rpctypes.BlockOverrides)
+		}
+		if block.BlockOverrides.Number == nil {
+			block.BlockOverrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+		}
+		number := block.BlockOverrides.Number.ToInt()
+		if !number.IsUint64() || number.Uint64() <= prevNumber {
+			return nil, fmt.Errorf("block numbers must be in order: %s <= %d", number, prevNumber)
+		}
+		if number.Uint64()-base.Number.Uint64() > maxSimulateBlocks {
+			return nil, fmt.Errorf("too many blocks: cannot simulate more than %d blocks", maxSimulateBlocks)
+		}
+
+		for n := prevNumber + 1; n < number.Uint64(); n++ {
+			timestamp := prevTimestamp + simTimestampIncrement
+			res = append(res, rpctypes.SimBlock{
+				BlockOverrides: &rpctypes.BlockOverrides{
+					Number: (*hexutil.Big)(new(big.Int).SetUint64(n)),
+					Time:   (*hexutil.Uint64)(&timestamp),
+				},
+			})
+			prevTimestamp = timestamp
+		}
+		prevNumber = number.Uint64()
+
+		if block.BlockOverrides.Time == nil {
+			timestamp := prevTimestamp + simTimestampIncrement
+			block.BlockOverrides.Time = (*hexutil.Uint64)(&timestamp)
+		} else if timestamp := uint64(*block.BlockOverrides.Time); timestamp <= prevTimestamp {
+			return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", timestamp, prevTimestamp)
+		}
+		prevTimestamp = uint64(*block.BlockOverrides.Time)
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// validateSimBlockOverrides returns an error if the overrides set a header
+// field which can't be overridden.
+func validateSimBlockOverrides(overrides *rpctypes.BlockOverrides) error {
+	switch {
+	case overrides.PrevRandao != nil:
+		return errors.New("prevRandao block override is not supported")
+	case overrides.BlobBaseFee != nil:
+		return errors.New("blobBaseFee block override is not supported")
+	case overrides.BeaconRoot != nil:
+		return errors.New("beaconRoot block override is not supported")
+	case overrides.Withdrawals != nil:
+		return errors.New("withdrawals block override is not supported")
+	}
+	return nil
+}
+
+// simulateBlock applies the state overrides and runs the calls of a simulated
+// block. The gas used by each call is deducted from the remaining gas budget
+// of the simulation. It returns the header of the block and its RPC
+// representation.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	block rpctypes.SimBlock,
+	parent *ethtypes.Header,
+	parentHash common.Hash,
+	opts *rpctypes.SimOpts,
+	budget *uint64,
+) (*ethtypes.Header, map[string]interface{}, error) {
+	overrides := block.BlockOverrides
+	if err := validateSimBlockOverrides(overrides); err != nil {
+		return nil, nil, err
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   cfg.CoinBase,
+		Difficulty: big.NewInt(0),
+		Number:     overrides.Number.ToInt(),
+		GasLimit:   parent.GasLimit,
+		Time:       uint64(*overrides.Time),
+		MixDigest:  common.MaxHash,
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = min(uint64(*overrides.GasLimit), *budget)
+	}
+	if overrides.FeeRecipient != nil {
+		header.Coinbase = *overrides.FeeRecipient
+	}
+	switch {
+	case overrides.BaseFeePerGas != nil:
+		header.BaseFee = overrides.BaseFeePerGas.ToInt()
+	case cfg.BaseFee == nil:
+		// the base fee is disabled
+	case opts.Validation:
+		header.BaseFee = cfg.BaseFee
+	default:
+		// calls without gas price must succeed when the validation is disabled
+		header.BaseFee = big.NewInt(0)
+	}
+
+	height, err := utils.SafeInt64(header.Number.Uint64())
+	if err != nil {
+		return nil, nil, err
+	}
+	blockTime, err := utils.SafeInt64(header.Time)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = ctx.
+		WithBlockHeight(height).
+		WithBlockTime(time.Unix(blockTime, 0).UTC()).
+		WithBlockGasMeter(storetypes.NewGasMeter(header.GasLimit))
+
+	blockCfg := *cfg
+	blockCfg.CoinBase = header.Coinbase
+	blockCfg.BaseFee = header.BaseFee
+	// the gas limit of the calls defaults to the remaining block gas, so the
+	// minimum gas charged to transactions would exhaust the block
+	blockCfg.FeeMarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+
+	if block.StateOverrides != nil {
+		if err := k.applySimStateOverrides(ctx, *block.StateOverrides); err != nil {
+			return nil, nil, fmt.Errorf("failed to apply state override: %w", err)
+		}
+	}
+
+	var (
+		txs      = make([]*ethtypes.Transaction, len(block.Calls))
+		senders  = make([]common.Address, len(block.Calls))
+		receipts = make([]*ethtypes.Receipt, len(block.Calls))
+		calls    = make([]rpctypes.SimCallResult, len(block.Calls))
+		logIndex uint
+	)
+	for i, args := range block.Calls {
+		if header.GasUsed >= header.GasLimit {
+			return nil, nil, fmt.Errorf("block gas limit reached: %d >= %d", header.GasUsed, header.GasLimit)
+		}
+		if *budget == 0 {
+			return nil, nil, errors.New("simulation gas budget exhausted")
+		}
+		remaining := header.GasLimit - header.GasUsed
+		if args.Gas == nil {
+			args.Gas = (*hexutil.Uint64)(&remaining)
+		}
+		if args.Nonce == nil {
+			nonce := k.GetNonce(ctx, args.GetFrom())
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		}
+		if err := args.CallDefaults(*budget, header.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+			return nil, nil, err
+		}
+		if uint64(*args.Gas) > remaining {
+			return nil, nil, fmt.Errorf("block gas limit reached: %d >= %d", header.GasUsed+uint64(*args.Gas), header.GasLimit)
+		}
+
+		tx := args.ToTransaction(ethtypes.DynamicFeeTxType)
+		txConfig := statedb.NewTxConfig(tx.Hash(), uint(i), logIndex) //nolint:gosec // G115
+		res, logs, err := k.simulateCall(ctx, &blockCfg, args.ToMessage(header.BaseFee, !opts.Validation, !opts.Validation), txConfig, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, log := range logs {
+			log.BlockNumber = header.Number.Uint64()
+			log.TxHash = tx.Hash()
+			log.TxIndex = uint(i) //nolint:gosec // G115
+			log.Index = logIndex
+			logIndex++
+		}
+		header.GasUsed += res.GasUsed
+		*budget -= min(res.GasUsed, *budget)
+
+		calls[i] = newSimCallResult(res, logs)
+		receipts[i] = &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            uint64(calls[i].Status),
+			CumulativeGasUsed: header.GasUsed,
+			Logs:              logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+			TransactionIndex:  uint(i), //nolint:gosec // G115
+		}
+		receipts[i].Bloom = ethtypes.CreateBloom(receipts[i])
+		txs[i] = tx
+		senders[i] = args.GetFrom()
+	}
+
+	simBlock := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	blockHash := simBlock.Hash()
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			log.BlockHash = blockHash
+		}
+	}
+
+	// store the block hash for the BLOCKHASH opcode of the next blocks
+	k.SetHeaderHash(ctx.WithHeaderHash(blockHash.Bytes()))
+
+	return simBlock.Header(), rpctypes.RPCMarshalSimBlock(simBlock, senders, calls, opts.ReturnFullTransactions, types.GetEthChainConfig()), nil
+}
+
+// applySimStateOverrides applies the state overrides of a simulated block and
+// commits them, so they persist across the calls of the simulation. Unlike for
+// eth_call, precompiles can't be moved.
+func (k *Keeper) applySimStateOverrides(ctx sdk.Context, overrides rpctypes.StateOverride) error {
+	for addr, account := range overrides {
+		if account.MovePrecompileTo != nil {
+			return fmt.Errorf("account %s: moving precompiles is not supported", addr.Hex())
+		}
+		if account.State == nil {
+			continue
+		}
+		if account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		// the overridden storage isn't committed by the StateDB, so the current
+		// storage is cleared and the new one is applied as a diff
+		var keys []common.Hash
+		k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+			keys = append(keys, key)
+			return true
+		})
+		for _, key := range keys {
+			k.DeleteState(ctx, addr, key)
+		}
+		account.StateDiff, account.State = account.State, nil
+		overrides[addr] = account
+	}
+
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+	if err := overrides.Apply(stateDB, vm.PrecompiledContracts{}); err != nil {
+		return err
+	}
+	return stateDB.Commit()
+}
+
+// simulateCall executes a simulated call and commits its state changes. In
+// validation mode, the message is checked as a transaction and the sender pays
+// for the gas used. It returns the logs of the call, including the synthetic
+// transfer logs if enabled.
+func (k *Keeper) simulateCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	msg *core.Message,
+	txConfig statedb.TxConfig,
+	opts *rpctypes.SimOpts,
+) (*types.MsgEthereumTxResponse, []*ethtypes.Log, error) {
+	stateDB := statedb.New(ctx, k, txConfig)
+
+	fee := new(big.Int)
+	if opts.Validation {
+		if err := k.validateSimMessage(ctx, msg, cfg.BaseFee); err != nil {
+			return nil, nil, err
+		}
+		fee.Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+		stateDB.SubBalance(msg.From, uint256.MustFromBig(fee), tracing.BalanceDecreaseGasBuy)
+	}
+
+	// the nonce of contract creations is set by the state transition
+	if msg.To != nil {
+		stateDB.SetNonce(msg.From, stateDB.GetNonce(msg.From)+1, tracing.NonceChangeEoACall)
+	}
+
+	var (
+		tracer *simTransferTracer
+		hooks  *tracing.Hooks
+	)
+	if opts.TraceTransfers {
+		tracer = newSimTransferTracer(stateDB)
+		hooks = tracer.Hooks()
+	}
+
+	res, err := k.ApplyMessageWithConfig(ctx, stateDB, *msg, hooks, true, false, cfg, txConfig, false, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if fee.Sign() > 0 {
+		// refund the unused gas
+		refund := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit-res.GasUsed), msg.GasPrice)
+		refundDB := statedb.New(ctx, k, txConfig)
+		refundDB.AddBalance(msg.From, uint256.MustFromBig(refund), tracing.BalanceIncreaseGasReturn)
+		if err := refundDB.Commit(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if tracer != nil {
+		logs = tracer.merge(logs)
+	}
+	return res, logs, nil
+}
+
+// validateSimMessage runs the checks applied to a transaction before its
+// execution, which are skipped by the simulation if the validation is disabled.
+func (k *Keeper) validateSimMessage(ctx sdk.Context, msg *core.Message, baseFee *big.Int) error {
+	stateNonce := k.GetNonce(ctx, msg.From)
+	switch {
+	case msg.Nonce < stateNonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, msg.From.Hex(), msg.Nonce, stateNonce)
+	case msg.Nonce > stateNonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, msg.From.Hex(), msg.Nonce, stateNonce)
+	}
+
+	account := k.GetAccount(ctx, msg.From)
+	if account == nil {
+		account = statedb.NewEmptyAccount()
+	}
+	if code := k.GetCode(ctx, common.BytesToHash(account.CodeHash)); len(code) > 0 {
+		if _, ok := ethtypes.ParseDelegation(code); !ok {
+			return fmt.Errorf("%w: address %s", core.ErrSenderNoEOA, msg.From.Hex())
+		}
+	}
+
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From.Hex(), msg.GasFeeCap, baseFee)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	cost.Add(cost, msg.Value)
+	if balance := account.Balance.ToBig(); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From.Hex(), balance, cost)
+	}
+	return nil
+}
+
+// newSimCallResult returns the RPC result of a simulated call.
+func newSimCallResult(res *types.MsgEthereumTxResponse, logs []*ethtypes.Log) rpctypes.SimCallResult {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	result := rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		MaxUsedGas:  hexutil.Uint64(res.MaxUsedGas),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if !res.Failed() {
+		return result
+	}
+
+	result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := types.NewExecErrorWithReason(res.Ret)
+		result.Error = &rpctypes.SimCallError{
+			Message: revertErr.Error(),
+			Code:    revertErr.ErrorCode(),
+			Data:    hexutil.Encode(res.Ret),
+		}
+	} else {
+		result.Error = &rpctypes.SimCallError{
+			Message: res.VmError,
+			Code:    rpctypes.SimErrCodeVMError,
+		}
+	}
+	return result
+}
+
+// simTransferTracer records the native value transfers of a simulated call as
+// synthetic ERC-20 Transfer logs.
+type simTransferTracer struct {
+	stateDB *statedb.StateDB
+	// frames holds the transfers of each call frame. The transfers of the
+	// reverted frames are dropped.
+	frames [][]simTransfer
+}
+
+// simTransfer is a synthetic transfer log with the number of logs emitted
+// before it within the transaction.
+type simTransfer struct {
+	position int
+	log      *ethtypes.Log
+}
+
+func newSimTransferTracer(stateDB *statedb.StateDB) *simTransferTracer {
+	return &simTransferTracer{stateDB: stateDB}
+}
+
+// Hooks returns the tracing hooks of the tracer.
+func (t *simTransferTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: func(*tracing.VMContext, *ethtypes.Transaction, common.Address) {},
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *simTransferTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() <= 0 {
+		return
+	}
+
+	last := len(t.frames) - 1
+	t.frames[last] = append(t.frames[last], simTransfer{
+		position: len(t.stateDB.Logs()),
+		log: &ethtypes.Log{
+			Address: simTransferAddress,
+			Topics: []common.Hash{
+				simTransferTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *simTransferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	last := len(t.frames) - 1
+	if last < 0 {
+		return
+	}
+
+	transfers := t.frames[last]
+	if reverted {
+		transfers = nil
+	}
+	if last == 0 {
+		t.frames[0] = transfers
+		return
+	}
+	t.frames = t.frames[:last]
+	t.frames[last-1] = append(t.frames[last-1], transfers...)
+}
+
+// merge inserts the transfer logs into the logs of the call in execution order.
+func (t *simTransferTracer) merge(logs []*ethtypes.Log) []*ethtypes.Log {
+	if len(t.frames) == 0 {
+		return logs
+	}
+
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.frames[0]))
+	i := 0
+	for _, transfer := range t.frames[0] {
+		for ; i < transfer.position && i < len(logs); i++ {
+			merged = append(merged, logs[i])
+		}
+		merged = append(merged, transfer.log)
+	}
+	return append(merged, logs[i:]...)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/zenanetwork/zena/rpc/types"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+	"github.com/zenanetwork/zena/x/vm/types/mocks"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSanitizeSimChain(t *testing.T) {
+	base := &ethtypes.Header{Number: big.NewInt(10), Time: 1000}
+	number := func(n int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(n)) }
+	timestamp := func(t uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&t) }
+
+	testCases := []struct {
+		name       string
+		blocks     []rpctypes.SimBlock
+		expNumbers []uint64
+		expTimes   []uint64
+		expErr     string
+	}{
+		{
+			name:       "default number and time",
+			blocks:     []rpctypes.SimBlock{{}, {}},
+			expNumbers: []uint64{11, 12},
+			expTimes:   []uint64{1012, 1024},
+		},
+		{
+			name: "gap filled with empty blocks",
+			blocks: []rpctypes.SimBlock{
+				{BlockOverrides: &rpctypes.BlockOverrides{Number: number(13)}},
+			},
+			expNumbers: []uint64{11, 12, 13},
+			expTimes:   []uint64{1012, 1024, 1036},
+		},
+		{
+			name: "overridden time",
+			blocks: []rpctypes.SimBlock{
+				{BlockOverrides: &rpctypes.BlockOverrides{Time: timestamp(2000)}},
+				{},
+			},
+			expNumbers: []uint64{11, 12},
+			expTimes:   []uint64{2000, 2012},
+		},
+		{
+			name: "numbers out of order",
+			blocks: []rpctypes.SimBlock{
+				{BlockOverrides: &rpctypes.BlockOverrides{Number: number(12)}},
+				{BlockOverrides: &rpctypes.BlockOverrides{Number: number(12)}},
+			},
+			expErr: "block numbers must be in order",
+		},
+		{
+			name: "number before base block",
+			blocks: []rpctypes.SimBlock{
+				{BlockOverrides: &rpctypes.BlockOverrides{Number: number(5)}},
+			},
+			expErr: "block numbers must be in order",
+		},
+		{
+			name: "timestamps out of order",
+			blocks: []rpctypes.SimBlock{
+				{BlockOverrides: &rpctypes.BlockOverrides{Time: timestamp(1000)}},
+			},
+			expErr: "block timestamps must be in order",
+		},
+		{
+			name: "too many blocks",
+			blocks: []rpctypes.SimBlock{
+				{BlockOverrides: &rpctypes.BlockOverrides{Number: number(10 + maxSimulateBlocks + 1)}},
+			},
+			expErr: "too many blocks",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, err := sanitizeSimChain(base, tc.blocks)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, blocks, len(tc.expNumbers))
+			for i, block := range blocks {
+				require.Equal(t, tc.expNumbers[i], block.BlockOverrides.Number.ToInt().Uint64())
+				require.Equal(t, tc.expTimes[i], uint64(*block.BlockOverrides.Time))
+			}
+		})
+	}
+}
+
+func TestSimTransferTracer(t *testing.T) {
+	var (
+		sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		recipient = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	)
+
+	stateDB := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), statedb.NewEmptyTxConfig())
+	tracer := newSimTransferTracer(stateDB)
+	hooks := tracer.Hooks()
+
+	// sender calls the contract with value, which emits a log, transfers value
+	// to the recipient and makes a reverted call with value
+	hooks.OnEnter(0, byte(vm.CALL), sender, contract, nil, 100_000, big.NewInt(100))
+	stateDB.AddLog(&ethtypes.Log{Address: contract})
+	hooks.OnEnter(1, byte(vm.CALL), contract, recipient, nil, 50_000, big.NewInt(10))
+	hooks.OnExit(1, nil, 0, nil, false)
+	hooks.OnEnter(1, byte(vm.CALL), contract, recipient, nil, 50_000, big.NewInt(20))
+	hooks.OnExit(1, nil, 0, vm.ErrExecutionReverted, true)
+	hooks.OnEnter(1, byte(vm.DELEGATECALL), contract, recipient, nil, 50_000, big.NewInt(30))
+	hooks.OnExit(1, nil, 0, nil, false)
+	hooks.OnExit(0, nil, 0, nil, false)
+
+	logs := tracer.merge(stateDB.Logs())
+	require.Len(t, logs, 3)
+
+	requireTransfer := func(log *ethtypes.Log, from, to common.Address, value int64) {
+		require.Equal(t, simTransferAddress, log.Address)
+		require.Equal(t, []common.Hash{simTransferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())}, log.Topics)
+		require.Equal(t, big.NewInt(value), new(big.Int).SetBytes(log.Data))
+	}
+	requireTransfer(logs[0], sender, contract, 100)
+	require.Equal(t, contract, logs[1].Address)
+	requireTransfer(logs[2], contract, recipient, 10)
+
+	// the transfers of a reverted transaction are dropped
+	tracer = newSimTransferTracer(statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), statedb.NewEmptyTxConfig()))
+	hooks = tracer.Hooks()
+	hooks.OnEnter(0, byte(vm.CALL), sender, contract, nil, 100_000, big.NewInt(100))
+	hooks.OnExit(0, nil, 0, vm.ErrExecutionReverted, true)
+	require.Empty(t, tracer.merge(nil))
+}
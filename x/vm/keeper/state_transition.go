@@ -260,6 +260,18 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to extract sender address from ethereum transaction")
 	}
 
+	// track the base fee portion of the fees paid for the gas used, so that the
+	// fee market can burn it at the end of the block. This is done before the
+	// hooks, which can deduct the base fees they distribute from the tracked amount.
+	if cfg.BaseFee != nil && msg.GasPrice != nil {
+		baseFee := cfg.BaseFee
+		if msg.GasPrice.Cmp(baseFee) < 0 {
+			baseFee = msg.GasPrice
+		}
+		baseFeePaid := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(res.GasUsed))
+		k.feeMarketWrapper.AddTransientBaseFeePaid(ctx, math.NewIntFromBigInt(baseFeePaid))
+	}
+
 	eventsLen := len(tmpCtx.EventManager().Events())
 
 	// Only call PostTxProcessing if there are hooks set, to avoid calling commitFn unnecessarily
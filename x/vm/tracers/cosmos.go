@@ -0,0 +1,329 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // register the native tracers wrapped below
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CosmosCallTracer is the name of the call tracer that includes the Cosmos
+	// operations performed by the precompiles as child frames of their calls.
+	CosmosCallTracer = "cosmosCallTracer"
+
+	callTracer     = "callTracer"
+	prestateTracer = "prestateTracer"
+)
+
+// Cosmos frame types
+const (
+	FrameTypeCosmosMsg           = "COSMOS_MSG"
+	FrameTypeCosmosEvent         = "COSMOS_EVENT"
+	FrameTypeCosmosBalanceChange = "COSMOS_BALANCE_CHANGE"
+	FrameTypeCosmosStoreGas      = "COSMOS_STORE_GAS"
+)
+
+// cosmosConfig defines the tracer configuration fields used to enable the
+// Cosmos frames on the native tracers.
+type cosmosConfig struct {
+	WithCosmos bool `json:"withCosmos"`
+}
+
+// CosmosFrame is a Cosmos operation performed by a precompile.
+type CosmosFrame struct {
+	Type       string           `json:"type"`
+	MsgType    string           `json:"msgType,omitempty"`
+	Msg        json.RawMessage  `json:"msg,omitempty"`
+	EventType  string           `json:"eventType,omitempty"`
+	Attributes []EventAttribute `json:"attributes,omitempty"`
+	Address    *common.Address  `json:"address,omitempty"`
+	Value      *hexutil.Big     `json:"value,omitempty"`
+	GasUsed    *hexutil.Uint64  `json:"gasUsed,omitempty"`
+}
+
+// EventAttribute is an attribute of an SDK event.
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// PrecompileFrame is a precompile call with the Cosmos operations it
+// performed. It's returned by the prestate tracer with Cosmos frames.
+type PrecompileFrame struct {
+	Type  string         `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Calls []CosmosFrame  `json:"calls"`
+}
+
+// prestateResult is the result of the prestate tracer with Cosmos frames.
+type prestateResult struct {
+	State  json.RawMessage   `json:"state"`
+	Cosmos []PrecompileFrame `json:"cosmos"`
+}
+
+// callRecord mirrors a call frame of the EVM execution to attach the Cosmos
+// frames to it.
+type callRecord struct {
+	typ    vm.OpCode
+	from   common.Address
+	to     common.Address
+	calls  []*callRecord
+	cosmos []CosmosFrame
+}
+
+// cosmosTracer wraps a native tracer and records the Cosmos operations
+// performed by the precompiles through the Cosmos hooks.
+type cosmosTracer struct {
+	inner      *tracers.Tracer
+	isPrestate bool
+
+	root  *callRecord
+	stack []*callRecord
+}
+
+// New creates the tracer with the given name. The Cosmos call tracer, as well
+// as the call and prestate tracers configured with `withCosmos`, return the
+// Cosmos hooks that must be set on the context of the traced execution.
+// Other tracers are created from the geth default directory and return nil
+// hooks.
+func New(name string, ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, *CosmosHooks, error) {
+	switch name {
+	case CosmosCallTracer:
+		return newCosmosTracer(callTracer, ctx, cfg, chainConfig)
+	case callTracer, prestateTracer:
+		var config cosmosConfig
+		if len(cfg) > 0 {
+			if err := json.Unmarshal(cfg, &config); err != nil {
+				return nil, nil, err
+			}
+		}
+		if config.WithCosmos {
+			return newCosmosTracer(name, ctx, cfg, chainConfig)
+		}
+	}
+
+	tracer, err := tracers.DefaultDirectory.New(name, ctx, cfg, chainConfig)
+	return tracer, nil, err
+}
+
+func newCosmosTracer(name string, ctx *tracers.Context, cfg json.RawMessage, chainConfig *params.ChainConfig) (*tracers.Tracer, *CosmosHooks, error) {
+	inner, err := tracers.DefaultDirectory.New(name, ctx, cfg, chainConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := &cosmosTracer{
+		inner:      inner,
+		isPrestate: name == prestateTracer,
+	}
+
+	hooks := *inner.Hooks
+	hooks.OnEnter = t.OnEnter
+	hooks.OnExit = t.OnExit
+
+	return &tracers.Tracer{
+		Hooks:     &hooks,
+		GetResult: t.GetResult,
+		Stop:      inner.Stop,
+	}, &CosmosHooks{
+		OnCosmosMsg:           t.OnCosmosMsg,
+		OnCosmosEvent:         t.OnCosmosEvent,
+		OnCosmosBalanceChange: t.OnCosmosBalanceChange,
+		OnCosmosStoreGas:      t.OnCosmosStoreGas,
+	}, nil
+}
+
+// OnEnter records the call frame and forwards the call to the wrapped tracer.
+func (t *cosmosTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	call := &callRecord{typ: vm.OpCode(typ), from: from, to: to}
+	if depth == 0 || len(t.stack) == 0 {
+		t.root = call
+		t.stack = []*callRecord{call}
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.calls = append(parent.calls, call)
+		t.stack = append(t.stack, call)
+	}
+
+	if t.inner.OnEnter != nil {
+		t.inner.OnEnter(depth, typ, from, to, input, gas, value)
+	}
+}
+
+// OnExit pops the call frame and forwards the call to the wrapped tracer.
+func (t *cosmosTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if depth > 0 && len(t.stack) > 1 {
+		t.stack = t.stack[:len(t.stack)-1]
+	}
+
+	if t.inner.OnExit != nil {
+		t.inner.OnExit(depth, output, gasUsed, err, reverted)
+	}
+}
+
+// OnCosmosMsg records a Cosmos message executed by the current precompile call.
+func (t *cosmosTracer) OnCosmosMsg(msg sdk.Msg) {
+	frame := CosmosFrame{
+		Type:    FrameTypeCosmosMsg,
+		MsgType: sdk.MsgTypeURL(msg),
+	}
+	// the message is still recorded by type if it can't be encoded
+	if bz, err := codec.ProtoMarshalJSON(msg, nil); err == nil {
+		frame.Msg = bz
+	}
+	t.addFrame(frame)
+}
+
+// OnCosmosEvent records an SDK event emitted by the current precompile call.
+func (t *cosmosTracer) OnCosmosEvent(event sdk.Event) {
+	attributes := make([]EventAttribute, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		attributes[i] = EventAttribute{Key: attr.Key, Value: attr.Value}
+	}
+	t.addFrame(CosmosFrame{
+		Type:       FrameTypeCosmosEvent,
+		EventType:  event.Type,
+		Attributes: attributes,
+	})
+}
+
+// OnCosmosBalanceChange records a balance change applied by the current
+// precompile call. Balance decreases have a negative value.
+func (t *cosmosTracer) OnCosmosBalanceChange(addr common.Address, amount *uint256.Int, isAdd bool) {
+	value := amount.ToBig()
+	if !isAdd {
+		value.Neg(value)
+	}
+	t.addFrame(CosmosFrame{
+		Type:    FrameTypeCosmosBalanceChange,
+		Address: &addr,
+		Value:   (*hexutil.Big)(value),
+	})
+}
+
+// OnCosmosStoreGas records the store gas consumed by the current precompile call.
+func (t *cosmosTracer) OnCosmosStoreGas(gasUsed uint64) {
+	t.addFrame(CosmosFrame{
+		Type:    FrameTypeCosmosStoreGas,
+		GasUsed: (*hexutil.Uint64)(&gasUsed),
+	})
+}
+
+func (t *cosmosTracer) addFrame(frame CosmosFrame) {
+	if len(t.stack) == 0 {
+		return
+	}
+	call := t.stack[len(t.stack)-1]
+	call.cosmos = append(call.cosmos, frame)
+}
+
+// GetResult returns the result of the wrapped tracer including the Cosmos frames.
+func (t *cosmosTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.inner.GetResult()
+	if res == nil || t.root == nil {
+		return res, err
+	}
+
+	if t.isPrestate {
+		bz, marshalErr := json.Marshal(prestateResult{
+			State:  res,
+			Cosmos: t.root.precompileFrames([]PrecompileFrame{}),
+		})
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		return bz, err
+	}
+
+	bz, injectErr := t.root.injectFrames(res)
+	if injectErr != nil {
+		return nil, injectErr
+	}
+	return bz, err
+}
+
+// hasCosmosFrames returns true if the call or one of its sub calls has Cosmos frames.
+func (c *callRecord) hasCosmosFrames() bool {
+	if len(c.cosmos) > 0 {
+		return true
+	}
+	for _, call := range c.calls {
+		if call.hasCosmosFrames() {
+			return true
+		}
+	}
+	return false
+}
+
+// injectFrames appends the Cosmos frames of the call and its sub calls to the
+// `calls` field of the matching frames of the call tracer result.
+func (c *callRecord) injectFrames(frame json.RawMessage) (json.RawMessage, error) {
+	if !c.hasCosmosFrames() {
+		return frame, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(frame, &fields); err != nil {
+		return nil, err
+	}
+
+	var calls []json.RawMessage
+	if bz, ok := fields["calls"]; ok {
+		if err := json.Unmarshal(bz, &calls); err != nil {
+			return nil, err
+		}
+	}
+
+	// sub calls are missing if the tracer is configured with onlyTopCall
+	for i := 0; i < len(c.calls) && i < len(calls); i++ {
+		var err error
+		if calls[i], err = c.calls[i].injectFrames(calls[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, cosmosFrame := range c.cosmos {
+		bz, err := json.Marshal(cosmosFrame)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, bz)
+	}
+
+	if len(calls) > 0 {
+		bz, err := json.Marshal(calls)
+		if err != nil {
+			return nil, err
+		}
+		fields["calls"] = bz
+	}
+
+	return json.Marshal(fields)
+}
+
+// precompileFrames returns the calls with Cosmos frames in execution order.
+func (c *callRecord) precompileFrames(frames []PrecompileFrame) []PrecompileFrame {
+	if len(c.cosmos) > 0 {
+		frames = append(frames, PrecompileFrame{
+			Type:  c.typ.String(),
+			From:  c.from,
+			To:    c.to,
+			Calls: c.cosmos,
+		})
+	}
+	for _, call := range c.calls {
+		frames = call.precompileFrames(frames)
+	}
+	return frames
+}
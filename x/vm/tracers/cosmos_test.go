@@ -0,0 +1,200 @@
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	sender     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	precompile = common.HexToAddress("0x0000000000000000000000000000000000000800")
+)
+
+// testFrame is the subset of the call tracer frame fields checked by the tests.
+type testFrame struct {
+	Type       string           `json:"type"`
+	To         string           `json:"to"`
+	MsgType    string           `json:"msgType"`
+	Msg        json.RawMessage  `json:"msg"`
+	EventType  string           `json:"eventType"`
+	Attributes []EventAttribute `json:"attributes"`
+	Address    string           `json:"address"`
+	Value      string           `json:"value"`
+	GasUsed    string           `json:"gasUsed"`
+	Calls      []testFrame      `json:"calls"`
+}
+
+// traceCall simulates a transaction calling a contract which calls a
+// precompile performing Cosmos operations.
+func traceCall(tracer *tracers.Tracer, hooks *CosmosHooks) {
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{To: &contract, Gas: 100_000})
+	if tracer.OnTxStart != nil {
+		tracer.OnTxStart(&tracing.VMContext{}, tx, sender)
+	}
+	tracer.OnEnter(0, byte(vm.CALL), sender, contract, nil, 100_000, big.NewInt(0))
+	tracer.OnEnter(1, byte(vm.CALL), contract, precompile, nil, 50_000, big.NewInt(0))
+
+	hooks.OnCosmosMsg(&banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(contract.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(sender.Bytes()).String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("azena", math.NewInt(10))),
+	})
+	hooks.OnCosmosEvent(sdk.NewEvent(banktypes.EventTypeTransfer, sdk.NewAttribute(banktypes.AttributeKeyRecipient, "recipient")))
+	hooks.OnCosmosBalanceChange(contract, uint256.NewInt(10), false)
+	hooks.OnCosmosBalanceChange(sender, uint256.NewInt(10), true)
+	hooks.OnCosmosStoreGas(1_000)
+
+	tracer.OnExit(1, nil, 20_000, nil, false)
+	tracer.OnExit(0, nil, 40_000, nil, false)
+	if tracer.OnTxEnd != nil {
+		tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: 40_000}, nil)
+	}
+}
+
+func requireCosmosFrames(t *testing.T, frames []testFrame) {
+	t.Helper()
+
+	require.Len(t, frames, 5)
+
+	require.Equal(t, FrameTypeCosmosMsg, frames[0].Type)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", frames[0].MsgType)
+	require.Contains(t, string(frames[0].Msg), "from_address")
+
+	require.Equal(t, FrameTypeCosmosEvent, frames[1].Type)
+	require.Equal(t, banktypes.EventTypeTransfer, frames[1].EventType)
+	require.Equal(t, []EventAttribute{{Key: banktypes.AttributeKeyRecipient, Value: "recipient"}}, frames[1].Attributes)
+
+	require.Equal(t, FrameTypeCosmosBalanceChange, frames[2].Type)
+	require.Equal(t, contract.Hex(), common.HexToAddress(frames[2].Address).Hex())
+	require.Equal(t, "-0xa", frames[2].Value)
+
+	require.Equal(t, FrameTypeCosmosBalanceChange, frames[3].Type)
+	require.Equal(t, sender.Hex(), common.HexToAddress(frames[3].Address).Hex())
+	require.Equal(t, "0xa", frames[3].Value)
+
+	require.Equal(t, FrameTypeCosmosStoreGas, frames[4].Type)
+	require.Equal(t, "0x3e8", frames[4].GasUsed)
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tracer   string
+		cfg      json.RawMessage
+		expHooks bool
+		expError bool
+	}{
+		{"cosmos call tracer", CosmosCallTracer, nil, true, false},
+		{"call tracer", callTracer, nil, false, false},
+		{"call tracer with cosmos", callTracer, json.RawMessage(`{"withCosmos":true}`), true, false},
+		{"prestate tracer with cosmos", prestateTracer, json.RawMessage(`{"withCosmos":true,"diffMode":false}`), true, false},
+		{"4byte tracer ignores cosmos", "4byteTracer", json.RawMessage(`{"withCosmos":true}`), false, false},
+		{"invalid config", callTracer, json.RawMessage(`{"withCosmos":1}`), false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracer, hooks, err := New(tc.tracer, &tracers.Context{}, tc.cfg, params.TestChainConfig)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, tracer)
+			require.Equal(t, tc.expHooks, hooks != nil)
+		})
+	}
+}
+
+func TestCosmosCallTracer(t *testing.T) {
+	tracer, hooks, err := New(CosmosCallTracer, &tracers.Context{}, nil, params.TestChainConfig)
+	require.NoError(t, err)
+
+	traceCall(tracer, hooks)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var root testFrame
+	require.NoError(t, json.Unmarshal(res, &root))
+	require.Equal(t, "CALL", root.Type)
+	require.Len(t, root.Calls, 1)
+
+	precompileFrame := root.Calls[0]
+	require.Equal(t, "CALL", precompileFrame.Type)
+	require.Equal(t, precompile.Hex(), common.HexToAddress(precompileFrame.To).Hex())
+	requireCosmosFrames(t, precompileFrame.Calls)
+}
+
+func TestCallTracerOnlyTopCall(t *testing.T) {
+	tracer, hooks, err := New(callTracer, &tracers.Context{}, json.RawMessage(`{"withCosmos":true,"onlyTopCall":true}`), params.TestChainConfig)
+	require.NoError(t, err)
+
+	traceCall(tracer, hooks)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	// the precompile frame isn't traced so its Cosmos frames are dropped
+	var root testFrame
+	require.NoError(t, json.Unmarshal(res, &root))
+	require.Empty(t, root.Calls)
+}
+
+func TestPrestateTracerWithCosmos(t *testing.T) {
+	tracer, hooks, err := New(prestateTracer, &tracers.Context{}, json.RawMessage(`{"withCosmos":true}`), params.TestChainConfig)
+	require.NoError(t, err)
+
+	// the prestate tracer requires a state to look up the accounts on tx start
+	tracer.OnTxStart = nil
+	traceCall(tracer, hooks)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var out struct {
+		State  json.RawMessage `json:"state"`
+		Cosmos []struct {
+			Type  string      `json:"type"`
+			From  string      `json:"from"`
+			To    string      `json:"to"`
+			Calls []testFrame `json:"calls"`
+		} `json:"cosmos"`
+	}
+	require.NoError(t, json.Unmarshal(res, &out))
+	require.JSONEq(t, `{}`, string(out.State))
+	require.Len(t, out.Cosmos, 1)
+	require.Equal(t, "CALL", out.Cosmos[0].Type)
+	require.Equal(t, contract.Hex(), common.HexToAddress(out.Cosmos[0].From).Hex())
+	require.Equal(t, precompile.Hex(), common.HexToAddress(out.Cosmos[0].To).Hex())
+	requireCosmosFrames(t, out.Cosmos[0].Calls)
+}
+
+func TestCosmosHooksFromContext(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	require.Nil(t, CosmosHooksFromContext(ctx))
+
+	hooks := &CosmosHooks{}
+	ctx = WithCosmosHooks(ctx, hooks)
+	require.Equal(t, hooks, CosmosHooksFromContext(ctx))
+	// the hooks are kept on derived contexts
+	require.Equal(t, hooks, CosmosHooksFromContext(ctx.WithBlockHeight(1)))
+}
@@ -0,0 +1,38 @@
+package tracers
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type cosmosHooksKey struct{}
+
+// CosmosHooks defines the hooks called by the stateful precompiles to report
+// the Cosmos SDK operations performed within a traced EVM call. All hooks are
+// optional.
+type CosmosHooks struct {
+	// OnCosmosMsg is called before a Cosmos message is executed by a precompile.
+	OnCosmosMsg func(msg sdk.Msg)
+	// OnCosmosEvent is called for each SDK event emitted by a precompile.
+	OnCosmosEvent func(event sdk.Event)
+	// OnCosmosBalanceChange is called for each balance change applied to the
+	// EVM state through the precompile balance handler.
+	OnCosmosBalanceChange func(addr common.Address, amount *uint256.Int, isAdd bool)
+	// OnCosmosStoreGas is called with the gas consumed by the Cosmos stores
+	// during a precompile call.
+	OnCosmosStoreGas func(gasUsed uint64)
+}
+
+// WithCosmosHooks returns a copy of the context carrying the Cosmos hooks.
+func WithCosmosHooks(ctx sdk.Context, hooks *CosmosHooks) sdk.Context {
+	return ctx.WithValue(cosmosHooksKey{}, hooks)
+}
+
+// CosmosHooksFromContext returns the Cosmos hooks of the context, or nil if
+// the context isn't traced.
+func CosmosHooksFromContext(ctx sdk.Context) *CosmosHooks {
+	hooks, _ := ctx.Value(cosmosHooksKey{}).(*CosmosHooks)
+	return hooks
+}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/vm"
 	geth "github.com/ethereum/go-ethereum/params"
+
+	"github.com/zenanetwork/zena/eips"
 )
 
 // Configure applies the changes to the virtual machine configuration.
@@ -35,6 +37,11 @@ func (ec *EVMConfigurator) Configure() error {
 		return err
 	}
 
+	// register the activators applying the opcode gas overrides of the params
+	if err := vm.ExtendActivators(eips.OpcodeGasActivators()); err != nil {
+		return err
+	}
+
 	// After applying modifiers the configurator is sealed. This way, it is not possible
 	// to call the configure method twice.
 	ec.sealed = true
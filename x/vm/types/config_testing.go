@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/core/vm"
 	geth "github.com/ethereum/go-ethereum/params"
+
+	"github.com/zenanetwork/zena/eips"
 )
 
 // testChainConfig is the chain configuration used in the EVM to defined which
@@ -38,6 +40,11 @@ func (ec *EVMConfigurator) Configure() error {
 		return err
 	}
 
+	// register the activators applying the opcode gas overrides of the params
+	if err := vm.ExtendActivators(eips.OpcodeGasActivators()); err != nil {
+		return err
+	}
+
 	// After applying modifications, the configurator is sealed. This way, it is not possible
 	// to call the configure method twice.
 	ec.sealed = true
@@ -73,6 +73,12 @@ type Params struct {
 	ActiveStaticPrecompiles []string              `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64                `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
 	ExtendedDenomOptions    *ExtendedDenomOptions `protobuf:"bytes,11,opt,name=extended_denom_options,json=extendedDenomOptions,proto3" json:"extended_denom_options,omitempty"`
+	// msg_filter defines the Cosmos message types that are blocked or charged
+	// a higher minimum gas price by the ante handler
+	MsgFilter MsgFilter `protobuf:"bytes,12,opt,name=msg_filter,json=msgFilter,proto3" json:"msg_filter"`
+	// opcode_gas_overrides defines the constant gas of the opcodes that replaces
+	// the default value of the EVM jump table
+	OpcodeGasOverrides []OpcodeGasOverride `protobuf:"bytes,13,rep,name=opcode_gas_overrides,json=opcodeGasOverrides,proto3" json:"opcode_gas_overrides"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -157,6 +163,77 @@ func (m *Params) GetExtendedDenomOptions() *ExtendedDenomOptions {
 	return nil
 }
 
+func (m *Params) GetMsgFilter() MsgFilter {
+	if m != nil {
+		return m.MsgFilter
+	}
+	return MsgFilter{}
+}
+
+func (m *Params) GetOpcodeGasOverrides() []OpcodeGasOverride {
+	if m != nil {
+		return m.OpcodeGasOverrides
+	}
+	return nil
+}
+
+// OpcodeGasOverride defines the constant gas charged for an EVM opcode. The
+// value must be within a bounded multiplier range of the default constant gas
+// of the opcode in the active fork.
+type OpcodeGasOverride struct {
+	// opcode is the name of the opcode (e.g. SSTORE)
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// constant_gas is the constant gas charged for the opcode
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+}
+
+func (m *OpcodeGasOverride) Reset()         { *m = OpcodeGasOverride{} }
+func (m *OpcodeGasOverride) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGasOverride) ProtoMessage()    {}
+func (*OpcodeGasOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *OpcodeGasOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpcodeGasOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpcodeGasOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpcodeGasOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGasOverride.Merge(m, src)
+}
+func (m *OpcodeGasOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpcodeGasOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGasOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGasOverride proto.InternalMessageInfo
+
+func (m *OpcodeGasOverride) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpcodeGasOverride) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
 type ExtendedDenomOptions struct {
 	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
 }
@@ -165,7 +242,7 @@ func (m *ExtendedDenomOptions) Reset()         { *m = ExtendedDenomOptions{} }
 func (m *ExtendedDenomOptions) String() string { return proto.CompactTextString(m) }
 func (*ExtendedDenomOptions) ProtoMessage()    {}
 func (*ExtendedDenomOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
 }
 func (m *ExtendedDenomOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,6 +278,125 @@ func (m *ExtendedDenomOptions) GetExtendedDenom() string {
 	return ""
 }
 
+// MsgFilter defines the Cosmos message filters enforced by the ante handler.
+// The filters are read from the state on every transaction, so they can be
+// updated through governance without a binary upgrade.
+type MsgFilter struct {
+	// blocked_msg_type_urls defines the message type URLs that are rejected when
+	// included in a Cosmos transaction
+	BlockedMsgTypeURLs []string `protobuf:"bytes,1,rep,name=blocked_msg_type_urls,json=blockedMsgTypeUrls,proto3" json:"blocked_msg_type_urls,omitempty"`
+	// blocked_authz_msg_type_urls defines the message type URLs that cannot be
+	// granted or executed within an authz.MsgExec
+	BlockedAuthzMsgTypeURLs []string `protobuf:"bytes,2,rep,name=blocked_authz_msg_type_urls,json=blockedAuthzMsgTypeUrls,proto3" json:"blocked_authz_msg_type_urls,omitempty"`
+	// min_gas_price_overrides defines the minimum gas prices required for
+	// Cosmos transactions containing the given message types
+	MinGasPriceOverrides []MinGasPriceOverride `protobuf:"bytes,3,rep,name=min_gas_price_overrides,json=minGasPriceOverrides,proto3" json:"min_gas_price_overrides"`
+}
+
+func (m *MsgFilter) Reset()         { *m = MsgFilter{} }
+func (m *MsgFilter) String() string { return proto.CompactTextString(m) }
+func (*MsgFilter) ProtoMessage()    {}
+func (*MsgFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
+}
+func (m *MsgFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFilter.Merge(m, src)
+}
+func (m *MsgFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFilter proto.InternalMessageInfo
+
+func (m *MsgFilter) GetBlockedMsgTypeURLs() []string {
+	if m != nil {
+		return m.BlockedMsgTypeURLs
+	}
+	return nil
+}
+
+func (m *MsgFilter) GetBlockedAuthzMsgTypeURLs() []string {
+	if m != nil {
+		return m.BlockedAuthzMsgTypeURLs
+	}
+	return nil
+}
+
+func (m *MsgFilter) GetMinGasPriceOverrides() []MinGasPriceOverride {
+	if m != nil {
+		return m.MinGasPriceOverrides
+	}
+	return nil
+}
+
+// MinGasPriceOverride defines the minimum gas price required for Cosmos
+// transactions that contain a message with the given type URL
+type MinGasPriceOverride struct {
+	// msg_type_url is the type URL of the message
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_gas_price is the minimum gas price, in the EVM denom, required for
+	// transactions containing the message
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *MinGasPriceOverride) Reset()         { *m = MinGasPriceOverride{} }
+func (m *MinGasPriceOverride) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceOverride) ProtoMessage()    {}
+func (*MinGasPriceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
+}
+func (m *MinGasPriceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceOverride.Merge(m, src)
+}
+func (m *MinGasPriceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceOverride proto.InternalMessageInfo
+
+func (m *MinGasPriceOverride) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -214,7 +410,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +471,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +581,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +650,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +707,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +785,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +907,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +948,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +1008,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1129,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{11}
+	return fileDescriptor_d1129b8db63d55c7, []int{14}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1190,7 @@ func (m *EvmCoinInfo) Reset()         { *m = EvmCoinInfo{} }
 func (m *EvmCoinInfo) String() string { return proto.CompactTextString(m) }
 func (*EvmCoinInfo) ProtoMessage()    {}
 func (*EvmCoinInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{12}
+	return fileDescriptor_d1129b8db63d55c7, []int{15}
 }
 func (m *EvmCoinInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1250,10 @@ func (m *EvmCoinInfo) GetDecimals() uint32 {
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*OpcodeGasOverride)(nil), "cosmos.evm.vm.v1.OpcodeGasOverride")
 	proto.RegisterType((*ExtendedDenomOptions)(nil), "cosmos.evm.vm.v1.ExtendedDenomOptions")
+	proto.RegisterType((*MsgFilter)(nil), "cosmos.evm.vm.v1.MsgFilter")
+	proto.RegisterType((*MinGasPriceOverride)(nil), "cosmos.evm.vm.v1.MinGasPriceOverride")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "cosmos.evm.vm.v1.ChainConfig")
@@ -1071,139 +1270,157 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2111 bytes of a gzipped FileDescriptorProto
+	// 2385 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x4d,
-	0x5b, 0xa8, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0x98, 0x56, 0xaa, 0x6c,
-	0x0b, 0x43, 0x35, 0x46, 0x8a, 0x14, 0x8b, 0xe1, 0xee, 0x78, 0xb9, 0xd1, 0xee, 0x0e, 0xb1, 0xb3,
-	0xa4, 0xc5, 0xfe, 0x81, 0x06, 0xee, 0x25, 0xed, 0xdd, 0x40, 0x80, 0x5e, 0x72, 0xcc, 0x4f, 0xe8,
-	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c, 0xf0,
-	0x5b, 0x61, 0x15, 0x40, 0xb0, 0xe7, 0x79, 0x3f, 0x9e, 0x67, 0x3e, 0xde, 0xdd, 0x79, 0x97, 0xa0,
-	0xe2, 0x50, 0x16, 0x52, 0xb6, 0x4d, 0x3a, 0xe1, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x56, 0x4c,
-	0x13, 0x0a, 0x0d, 0xe9, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0xb9, 0x85, 0x43, 0x3f, 0xa2, 0xdb,
-	0xe2, 0x5f, 0x19, 0x54, 0x29, 0x79, 0xd4, 0xa3, 0x62, 0xb8, 0xcd, 0x47, 0xd2, 0x6a, 0xfd, 0x5d,
-	0x03, 0x8b, 0xa7, 0x38, 0xc6, 0x21, 0x83, 0x3b, 0x20, 0x47, 0x3a, 0xa1, 0xed, 0x92, 0x88, 0x86,
-	0xe5, 0xcc, 0x46, 0x66, 0x33, 0x57, 0x2d, 0x5d, 0xa5, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xc4, 0x1a,
-	0xb8, 0x2c, 0xa4, 0x93, 0x4e, 0x78, 0xc8, 0x87, 0x70, 0x1f, 0x00, 0x72, 0x91, 0xc4, 0xd8, 0x26,
-	0x7e, 0x8b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0xf5, 0x52, 0x33, 0x57, 0xe3, 0xd6, 0xda,
-	0xd1, 0x29, 0xbb, 0x4a, 0xcd, 0x5b, 0x8a, 0x60, 0x10, 0x68, 0xa1, 0x9c, 0x00, 0x35, 0xbf, 0xc5,
-	0xe0, 0x2e, 0x28, 0x70, 0x6a, 0xa7, 0x89, 0xa3, 0x88, 0x04, 0xac, 0xbc, 0xb4, 0x91, 0xdd, 0xcc,
-	0x55, 0x57, 0x7a, 0xa9, 0x99, 0xaf, 0x7d, 0xfc, 0xf4, 0x40, 0x99, 0x51, 0x9e, 0x74, 0xc2, 0x3e,
-	0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x51, 0x12, 0xd3, 0xa0, 0xac, 0x6f,
-	0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xe4, 0x46, 0x6c, 0xed, 0x8b, 0xb8, 0x03, 0x19, 0x56, 0xbd,
-	0xfd, 0x4d, 0x6a, 0xce, 0xf5, 0x52, 0x73, 0x79, 0xcc, 0x8c, 0x96, 0xf1, 0x28, 0x84, 0x4f, 0xc0,
-	0x5d, 0xec, 0x24, 0x7e, 0x87, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0xad, 0x98, 0x38, 0x34, 0x6c,
-	0xf9, 0x01, 0x61, 0xe5, 0x1c, 0x9f, 0x1f, 0xba, 0x23, 0x03, 0xea, 0xc2, 0x7f, 0x3a, 0x74, 0xc3,
-	0x87, 0xa0, 0xd4, 0xf4, 0x59, 0x42, 0xe3, 0xae, 0xcd, 0x48, 0xdc, 0x21, 0xf6, 0x2b, 0x3f, 0x72,
-	0xe9, 0xab, 0x32, 0xd8, 0xc8, 0x6c, 0x6a, 0x08, 0x2a, 0x5f, 0x9d, 0xbb, 0x5e, 0x08, 0x0f, 0xfc,
-	0x14, 0xac, 0x91, 0x8b, 0x84, 0x44, 0x2e, 0x71, 0xe5, 0x06, 0xdb, 0xb4, 0x95, 0xf8, 0x34, 0x62,
-	0xe5, 0xbc, 0x58, 0xd4, 0x4f, 0xa7, 0x17, 0x55, 0x53, 0xf1, 0xe2, 0x10, 0x9e, 0xcb, 0x68, 0x54,
-	0x22, 0xd7, 0x58, 0x9f, 0xdc, 0x7b, 0xfd, 0xdd, 0xd7, 0x0f, 0xd6, 0x46, 0x6a, 0xe7, 0x82, 0x57,
-	0x8f, 0x3c, 0xf1, 0x63, 0x4d, 0x9f, 0x37, 0xb2, 0xc7, 0x9a, 0x9e, 0x35, 0xb4, 0x63, 0x4d, 0x5f,
-	0x30, 0x16, 0x8f, 0x35, 0x7d, 0xd1, 0x58, 0xb2, 0x3e, 0x04, 0xa5, 0xeb, 0x24, 0xe0, 0x4f, 0x40,
-	0x71, 0x7c, 0xaa, 0xb2, 0x4c, 0xd0, 0xf2, 0x98, 0xb4, 0xf5, 0xb7, 0x0c, 0x18, 0xdf, 0x60, 0xb8,
-	0x0f, 0x16, 0x9d, 0x98, 0xe0, 0x84, 0x88, 0x84, 0xfc, 0xee, 0xbb, 0xff, 0xe7, 0xa0, 0xce, 0xba,
-	0x2d, 0x52, 0xd5, 0xf8, 0x61, 0x21, 0x95, 0x08, 0x3f, 0x04, 0x9a, 0x83, 0x83, 0xa0, 0x3c, 0xff,
-	0x43, 0x09, 0x44, 0x9a, 0xf5, 0x9f, 0x0c, 0xb8, 0x35, 0x15, 0x01, 0x1d, 0x90, 0x57, 0x85, 0x94,
-	0x74, 0x5b, 0x72, 0x72, 0xc5, 0xdd, 0xfb, 0xdf, 0xc7, 0x2d, 0x48, 0x7f, 0xdc, 0x4b, 0x4d, 0x30,
-	0xc4, 0x57, 0xa9, 0x09, 0x65, 0x7d, 0x8f, 0x10, 0x59, 0x08, 0xe0, 0x41, 0x04, 0x74, 0xc0, 0xea,
-	0x78, 0xb5, 0xda, 0x81, 0xcf, 0x92, 0xf2, 0xbc, 0x28, 0xf4, 0x47, 0xbd, 0xd4, 0x1c, 0x9f, 0xd8,
-	0x89, 0xcf, 0x92, 0xab, 0xd4, 0xac, 0x8c, 0xb1, 0x8e, 0x66, 0x5a, 0xe8, 0x16, 0x9e, 0x4c, 0xb0,
-	0xbe, 0x32, 0x40, 0xfe, 0xa0, 0x89, 0xfd, 0xe8, 0x80, 0x46, 0x2f, 0x7d, 0x0f, 0x7e, 0x0a, 0x56,
-	0x9a, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x1b, 0x01, 0x75, 0xce, 0xd5, 0x23, 0xfd, 0xe8, 0xdf,
-	0xa9, 0x79, 0x5b, 0x2e, 0x90, 0xb9, 0xe7, 0x5b, 0x3e, 0xdd, 0x0e, 0x71, 0xd2, 0xdc, 0x3a, 0x8a,
-	0xb8, 0xe8, 0x9a, 0x14, 0x9d, 0xc8, 0xb4, 0x50, 0x71, 0x60, 0xa9, 0x72, 0x03, 0x6c, 0x82, 0xa2,
-	0x8b, 0xa9, 0xfd, 0x92, 0xc6, 0xe7, 0x8a, 0x7c, 0x5e, 0x90, 0x57, 0xbf, 0x97, 0xbc, 0x97, 0x9a,
-	0x85, 0xc3, 0xfd, 0xe7, 0x1f, 0xd1, 0xf8, 0x5c, 0x50, 0x5c, 0xa5, 0xe6, 0x6d, 0x29, 0x36, 0x4e,
-	0x64, 0xa1, 0x82, 0x8b, 0xe9, 0x20, 0x0c, 0xbe, 0x00, 0xc6, 0x20, 0x80, 0xb5, 0x5b, 0x2d, 0x1a,
-	0x27, 0xe5, 0xec, 0x46, 0x66, 0x53, 0xaf, 0xfe, 0xbc, 0x97, 0x9a, 0x45, 0x45, 0x59, 0x97, 0x9e,
-	0xab, 0xd4, 0xbc, 0x33, 0x41, 0xaa, 0x72, 0x2c, 0x54, 0x54, 0xb4, 0x2a, 0x14, 0x36, 0x40, 0x81,
-	0xf8, 0xad, 0x9d, 0xbd, 0x87, 0x6a, 0x01, 0x9a, 0x58, 0xc0, 0x6f, 0x66, 0x2d, 0x20, 0x5f, 0x3b,
-	0x3a, 0xdd, 0xd9, 0x7b, 0xd8, 0x9f, 0xff, 0xaa, 0x7a, 0xaf, 0x8d, 0xb0, 0x58, 0x28, 0x2f, 0xa1,
-	0x9c, 0x7c, 0x5f, 0x63, 0x4f, 0x69, 0x2c, 0xde, 0x54, 0x63, 0xef, 0x3a, 0x8d, 0xbd, 0x71, 0x8d,
-	0xbd, 0x71, 0x8d, 0xc7, 0x4a, 0x63, 0xe9, 0xa6, 0x1a, 0x8f, 0xaf, 0xd3, 0x78, 0x3c, 0xae, 0x21,
-	0x63, 0x78, 0x31, 0x35, 0xba, 0x7f, 0xc6, 0x51, 0xe2, 0xb7, 0x43, 0x25, 0xa3, 0xdf, 0xb8, 0x98,
-	0x26, 0x32, 0x2d, 0x54, 0x1c, 0x58, 0x24, 0xfb, 0x39, 0x28, 0x39, 0x34, 0x62, 0x09, 0xb7, 0x45,
-	0xb4, 0x15, 0x10, 0x25, 0x91, 0x13, 0x12, 0x8f, 0x67, 0x49, 0xdc, 0x93, 0x12, 0xd7, 0xa5, 0x5b,
-	0x68, 0x75, 0xdc, 0x2c, 0xc5, 0x6c, 0x60, 0xb4, 0x48, 0x42, 0x62, 0xd6, 0x68, 0xc7, 0x9e, 0x12,
-	0x02, 0x42, 0xe8, 0xfd, 0x59, 0x42, 0xaa, 0xac, 0x26, 0x53, 0x2d, 0xb4, 0x32, 0x34, 0x49, 0x81,
-	0x4f, 0x40, 0xd1, 0xe7, 0xaa, 0x8d, 0x76, 0xa0, 0xe8, 0xf3, 0x82, 0x7e, 0x77, 0x16, 0xbd, 0x7a,
-	0x14, 0xc6, 0x13, 0x2d, 0xb4, 0xdc, 0x37, 0x48, 0x6a, 0x17, 0xc0, 0xb0, 0xed, 0xc7, 0xb6, 0x17,
-	0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x5f, 0xcc, 0xa2, 0xbf, 0x2b, 0xe9, 0xa7, 0x93,
-	0x2d, 0x64, 0x70, 0xe3, 0x6f, 0xa5, 0x4d, 0xaa, 0xd4, 0x41, 0xa1, 0x41, 0xe2, 0xc0, 0x8f, 0x14,
-	0xff, 0xb2, 0xe0, 0x7f, 0x38, 0x8b, 0x5f, 0x55, 0xd0, 0x68, 0x9a, 0x85, 0xf2, 0x12, 0x0e, 0x48,
-	0x03, 0x1a, 0xb9, 0xb4, 0x4f, 0x7a, 0xeb, 0xc6, 0xa4, 0xa3, 0x69, 0x16, 0xca, 0x4b, 0x28, 0x49,
-	0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0xd8, 0x10, 0x28, 0xb8, 0x7f, 0x39, 0x8b, 0xbb, 0xff,
-	0x72, 0x9d, 0xce, 0xe6, 0x2f, 0x57, 0x6e, 0x1d, 0xdb, 0x12, 0x17, 0x40, 0x2f, 0xc6, 0xdd, 0x09,
-	0x9d, 0xd2, 0x8d, 0x37, 0x7e, 0x3a, 0xd9, 0x42, 0x06, 0x37, 0x8e, 0xa9, 0x7c, 0x06, 0x4a, 0x21,
-	0x89, 0x3d, 0x62, 0x47, 0x24, 0x61, 0xad, 0xc0, 0x4f, 0x94, 0xce, 0xed, 0x1b, 0x3f, 0x07, 0xd7,
-	0xa5, 0x5b, 0x08, 0x0a, 0xf3, 0x33, 0x65, 0x95, 0x5a, 0x77, 0x81, 0xee, 0xf0, 0xdb, 0xc2, 0xf6,
-	0xdd, 0x72, 0x59, 0xb4, 0x26, 0x4b, 0x02, 0x1f, 0xb9, 0xb0, 0x04, 0x16, 0xe4, 0xdd, 0x7e, 0x57,
-	0xdc, 0xed, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x12, 0x06,
-	0x18, 0x7e, 0x0c, 0x96, 0x59, 0x13, 0x47, 0x5e, 0x13, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x4f,
-	0xcc, 0x78, 0x67, 0xd6, 0x8c, 0x4b, 0x72, 0xc6, 0x63, 0x79, 0x16, 0x2a, 0xf4, 0xf1, 0x99, 0x1f,
-	0x12, 0x78, 0x0a, 0xf2, 0x0e, 0x8e, 0x9c, 0x76, 0x24, 0x59, 0xef, 0x0b, 0xd6, 0xed, 0x59, 0xac,
-	0xea, 0x2a, 0x1e, 0xc9, 0xb2, 0x10, 0x90, 0xa8, 0xcf, 0xd8, 0x8a, 0xb1, 0xd7, 0x26, 0x92, 0xf1,
-	0x9d, 0x1b, 0x33, 0x8e, 0x64, 0x59, 0x08, 0x48, 0xd4, 0x67, 0xec, 0x90, 0xf8, 0x3c, 0x50, 0x8c,
-	0xeb, 0x37, 0x66, 0x1c, 0xc9, 0xb2, 0x10, 0x90, 0x48, 0x30, 0x3e, 0x05, 0x80, 0x32, 0x7c, 0x8e,
-	0x25, 0xa1, 0x29, 0x08, 0xb7, 0x66, 0x11, 0xaa, 0xfe, 0x7a, 0x98, 0x64, 0xa1, 0x9c, 0x00, 0x9c,
-	0x6e, 0xd0, 0xd7, 0xad, 0x19, 0x77, 0x8e, 0x35, 0xfd, 0x8e, 0x51, 0xb6, 0xb6, 0xc1, 0x02, 0xef,
-	0x5b, 0x09, 0x34, 0x40, 0xf6, 0x9c, 0x74, 0x55, 0x0f, 0xc7, 0x87, 0xfc, 0xec, 0x3b, 0x38, 0x68,
-	0x13, 0x79, 0x9d, 0x23, 0x09, 0xac, 0x53, 0xb0, 0x72, 0x16, 0xe3, 0x88, 0xf1, 0x9e, 0x97, 0x46,
-	0x27, 0xd4, 0x63, 0x10, 0x02, 0xad, 0x89, 0x59, 0x53, 0xe5, 0x8a, 0x31, 0xfc, 0x19, 0xd0, 0x02,
-	0xea, 0x31, 0xd1, 0xd8, 0xe4, 0x77, 0x6f, 0x4f, 0x77, 0x51, 0x27, 0xd4, 0x43, 0x22, 0xc4, 0xfa,
-	0x4b, 0x16, 0x64, 0x4f, 0xa8, 0x07, 0xcb, 0x60, 0x09, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x7d,
-	0x08, 0xd7, 0xc0, 0x62, 0x42, 0x5b, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04,
-	0x8b, 0x1e, 0xa0, 0x80, 0xc4, 0x98, 0x7f, 0x42, 0x88, 0x52, 0xb7, 0xa3, 0x76, 0xd8, 0x20, 0xb1,
-	0xb8, 0xca, 0xb5, 0xea, 0xca, 0x65, 0x6a, 0xe6, 0x85, 0xfd, 0x99, 0x30, 0xa3, 0x51, 0x00, 0xdf,
-	0x03, 0x4b, 0xc9, 0x85, 0x2d, 0xd6, 0xb0, 0x20, 0xb6, 0x78, 0xf5, 0x32, 0x35, 0x57, 0x92, 0xe1,
-	0x32, 0x7f, 0x87, 0x59, 0x13, 0x2d, 0x26, 0x17, 0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xe4, 0xc2, 0xf6,
-	0x23, 0x97, 0x5c, 0x88, 0x4b, 0x5c, 0xab, 0x96, 0x2e, 0x53, 0xd3, 0x18, 0x09, 0x3f, 0xe2, 0x3e,
-	0xb4, 0x94, 0x5c, 0x88, 0x01, 0x7c, 0x0f, 0x00, 0x39, 0x25, 0xa1, 0x20, 0xef, 0xe4, 0xe5, 0xcb,
-	0xd4, 0xcc, 0x09, 0xab, 0xe0, 0x1e, 0x0e, 0xa1, 0x05, 0x16, 0x24, 0xb7, 0x2e, 0xb8, 0x0b, 0x97,
-	0xa9, 0xa9, 0x07, 0xd4, 0x93, 0x9c, 0xd2, 0xc5, 0xb7, 0x2a, 0x26, 0x21, 0xed, 0x10, 0x57, 0x5c,
-	0x8c, 0x3a, 0xea, 0x43, 0xf8, 0x01, 0x58, 0x91, 0x5a, 0xfc, 0xec, 0x59, 0x82, 0xc3, 0x96, 0xfc,
-	0xda, 0xa8, 0xc2, 0xcb, 0xd4, 0x2c, 0x0a, 0xd7, 0x59, 0xdf, 0x83, 0x26, 0xb0, 0xf5, 0xc5, 0x3c,
-	0xd0, 0xcf, 0x2e, 0x10, 0x61, 0xed, 0x20, 0x81, 0x1f, 0x01, 0x43, 0x34, 0x9a, 0xd8, 0x49, 0xec,
-	0xb1, 0x73, 0xa9, 0xde, 0x1b, 0xde, 0x81, 0x93, 0x11, 0x16, 0x5a, 0xe9, 0x9b, 0xf6, 0xd5, 0xe1,
-	0x95, 0xc0, 0x42, 0x23, 0xa0, 0x34, 0x14, 0x65, 0x54, 0x40, 0x12, 0xc0, 0x17, 0x62, 0xcb, 0x45,
-	0x89, 0x64, 0x45, 0x13, 0xff, 0xa3, 0xe9, 0x12, 0x99, 0xa8, 0xb3, 0xea, 0x3d, 0xde, 0xc2, 0x5f,
-	0xa5, 0x66, 0x51, 0x6a, 0xab, 0x7c, 0xeb, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0xe1, 0xa7, 0x23, 0x8a,
-	0xd1, 0x00, 0xd9, 0x98, 0x24, 0xe2, 0xd8, 0x0b, 0x88, 0x0f, 0xf9, 0xdb, 0x2a, 0x26, 0x1d, 0x12,
-	0x27, 0xc4, 0x15, 0xc7, 0xab, 0xa3, 0x01, 0xe6, 0xaf, 0x3e, 0x0f, 0x33, 0xbb, 0xcd, 0x88, 0x2b,
-	0xcf, 0x12, 0x2d, 0x79, 0x98, 0xfd, 0x81, 0x11, 0xf7, 0x89, 0xf6, 0xf9, 0x97, 0xe6, 0x9c, 0x85,
-	0x41, 0x5e, 0xf5, 0xf7, 0xed, 0x56, 0x40, 0x66, 0xd4, 0xe8, 0x2e, 0x28, 0xf0, 0xaf, 0x39, 0xec,
-	0x11, 0xfb, 0x9c, 0x74, 0x55, 0xa5, 0xca, 0xba, 0x53, 0xf6, 0xdf, 0x93, 0x2e, 0x43, 0xa3, 0x40,
-	0x49, 0x7c, 0xa9, 0x81, 0xfc, 0x59, 0x8c, 0x1d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x1c, 0xc6, 0x4a,
-	0x42, 0x21, 0xae, 0xcd, 0x0f, 0x95, 0xb6, 0x13, 0xf5, 0x44, 0xf6, 0x21, 0xcf, 0x88, 0x09, 0xb9,
-	0x20, 0x8e, 0xd8, 0x4b, 0x0d, 0x29, 0x04, 0xf7, 0xc0, 0xb2, 0xeb, 0x33, 0xdc, 0x08, 0xc4, 0xc7,
-	0xab, 0x73, 0x2e, 0x97, 0x5f, 0x35, 0x2e, 0x53, 0xb3, 0xa0, 0x1c, 0x75, 0x6e, 0x47, 0x63, 0x88,
-	0xd7, 0xd0, 0x30, 0x4d, 0xcc, 0x56, 0xec, 0x8d, 0x2e, 0x6b, 0x68, 0x10, 0x2a, 0x3c, 0x68, 0x02,
-	0xcb, 0x1b, 0xa3, 0xd1, 0xf6, 0x44, 0xf9, 0xea, 0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44,
-	0xb9, 0x2e, 0x20, 0x09, 0xe0, 0x07, 0x20, 0x47, 0x3b, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0x51, 0xa6,
-	0xf9, 0xdd, 0x77, 0xa6, 0xcb, 0x60, 0xe4, 0x4b, 0x06, 0x0d, 0xe3, 0xf9, 0xe2, 0x48, 0x24, 0x26,
-	0x19, 0x92, 0x90, 0xc6, 0x5d, 0xd1, 0x5a, 0xa9, 0xc5, 0x49, 0xc7, 0x53, 0x61, 0x47, 0x63, 0x08,
-	0x56, 0x01, 0x54, 0x69, 0x31, 0x49, 0xda, 0x71, 0x64, 0x8b, 0x37, 0x48, 0x41, 0xe4, 0x8a, 0xe7,
-	0x58, 0x7a, 0x91, 0x70, 0x1e, 0xe2, 0x04, 0xa3, 0x29, 0x0b, 0xfc, 0x35, 0x80, 0xf2, 0x4c, 0xec,
-	0xcf, 0x18, 0x8d, 0xf8, 0xf7, 0xd8, 0x4b, 0xdf, 0x53, 0xbd, 0x91, 0xd0, 0x97, 0x5e, 0x35, 0x67,
-	0x43, 0xa2, 0x63, 0x46, 0xd5, 0x2a, 0x8e, 0x35, 0x5d, 0x33, 0x16, 0x8e, 0x35, 0x7d, 0xc9, 0xd0,
-	0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfb, 0x78, 0x64, 0x7a, 0xd6, 0x33, 0x00, 0x4e, 0x63, 0xe2,
-	0xf3, 0x0e, 0x36, 0x08, 0xf8, 0x6b, 0x2f, 0xc2, 0x21, 0xe9, 0xbf, 0x6f, 0xf9, 0x78, 0xb4, 0x30,
-	0xe7, 0xc7, 0x0b, 0x13, 0x02, 0xcd, 0xa1, 0x2e, 0x11, 0xa5, 0x91, 0x43, 0x62, 0x6c, 0xfd, 0x35,
-	0x03, 0xf2, 0xb5, 0x4e, 0x78, 0x40, 0xfd, 0xe8, 0x28, 0x7a, 0x49, 0x87, 0xd7, 0x7c, 0x66, 0xf4,
-	0x9a, 0x9f, 0xfe, 0xc2, 0x9f, 0xbf, 0xe6, 0x0b, 0x1f, 0xbe, 0x2b, 0xaa, 0xac, 0x15, 0xe0, 0xae,
-	0x8a, 0x92, 0x4a, 0x05, 0x65, 0x3c, 0x9c, 0x6a, 0x19, 0xf8, 0xb3, 0xb9, 0x3c, 0x6c, 0x19, 0x1e,
-	0xfc, 0x33, 0x03, 0x46, 0x3e, 0xa2, 0xe1, 0xaf, 0x40, 0x65, 0xff, 0xe0, 0xa0, 0x56, 0xaf, 0xdb,
-	0x67, 0x9f, 0x9c, 0xd6, 0xec, 0xd3, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0x76, 0x52,
-	0xab, 0xd7, 0x8d, 0xb9, 0xca, 0xfd, 0xd7, 0x6f, 0x36, 0xca, 0xc3, 0xf8, 0x53, 0x12, 0x87, 0x3e,
-	0x63, 0x3e, 0x8d, 0x02, 0xbe, 0xdc, 0xf7, 0xc1, 0xda, 0x68, 0x36, 0xaa, 0xd5, 0xcf, 0xd0, 0xd1,
-	0xc1, 0x59, 0xed, 0xd0, 0xc8, 0x54, 0xca, 0xaf, 0xdf, 0x6c, 0x94, 0x86, 0x99, 0x88, 0xb0, 0x24,
-	0xf6, 0x1d, 0xfe, 0x1e, 0x78, 0x0c, 0xca, 0xd7, 0x6b, 0xd6, 0x0e, 0x8d, 0xf9, 0x4a, 0xe5, 0xf5,
-	0x9b, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5b, 0xd1, 0x3e, 0xff, 0xc7, 0xfa, 0x5c, 0xf5, 0xc9, 0x37,
-	0xbd, 0xf5, 0xcc, 0xb7, 0xbd, 0xf5, 0xcc, 0x7f, 0x7b, 0xeb, 0x99, 0x2f, 0xde, 0xae, 0xcf, 0x7d,
-	0xfb, 0x76, 0x7d, 0xee, 0x5f, 0x6f, 0xd7, 0xe7, 0xfe, 0xb8, 0xe1, 0xf9, 0x49, 0xb3, 0xdd, 0xd8,
-	0x72, 0x68, 0xb8, 0x3d, 0xf9, 0xcb, 0x4b, 0xd2, 0x6d, 0x11, 0xd6, 0x58, 0x14, 0x3f, 0xbe, 0x3d,
-	0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x59, 0x0f, 0x70, 0xd5, 0x13, 0x00, 0x00,
+	0x19, 0x16, 0x45, 0x4a, 0x22, 0x87, 0x14, 0xb5, 0x1a, 0x51, 0x12, 0x4d, 0x25, 0x5a, 0x65, 0xd3,
+	0x04, 0x6a, 0x90, 0x4a, 0x96, 0x12, 0xb5, 0x86, 0xd3, 0xb4, 0x11, 0x25, 0xda, 0x95, 0x2a, 0xd9,
+	0xc2, 0x50, 0x89, 0xe1, 0x36, 0xc5, 0x62, 0xb8, 0x3b, 0x5e, 0x6e, 0xb4, 0xbb, 0x43, 0xec, 0x2c,
+	0x69, 0xd1, 0x7f, 0xa0, 0x81, 0x7b, 0x49, 0x0f, 0x39, 0x1a, 0x08, 0xd0, 0x4b, 0x8e, 0xe9, 0x3f,
+	0xe8, 0x31, 0xc7, 0x1c, 0x8b, 0x00, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c,
+	0xf0, 0x9b, 0x11, 0x54, 0x40, 0xb0, 0xe7, 0xfd, 0x7a, 0x9e, 0x77, 0x66, 0x5e, 0xce, 0xbc, 0xb3,
+	0xa0, 0x64, 0x51, 0xe6, 0x53, 0xb6, 0x4d, 0x5a, 0xfe, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x46,
+	0x48, 0x23, 0x0a, 0x35, 0x69, 0xdb, 0xe2, 0x1a, 0xfe, 0xb7, 0x53, 0x5a, 0xc4, 0xbe, 0x1b, 0xd0,
+	0x6d, 0xf1, 0xaf, 0x74, 0x2a, 0x15, 0x1c, 0xea, 0x50, 0x31, 0xdc, 0xe6, 0x23, 0xa9, 0x35, 0xfe,
+	0x39, 0x03, 0x66, 0xcf, 0x70, 0x88, 0x7d, 0x06, 0x77, 0x40, 0x86, 0xb4, 0x7c, 0xd3, 0x26, 0x01,
+	0xf5, 0x8b, 0x89, 0x8d, 0xc4, 0x66, 0xa6, 0x5c, 0xb8, 0x8e, 0x75, 0xad, 0x8d, 0x7d, 0xef, 0xbe,
+	0xd1, 0x33, 0x19, 0x28, 0x4d, 0x5a, 0xfe, 0x21, 0x1f, 0xc2, 0x7d, 0x00, 0xc8, 0x65, 0x14, 0x62,
+	0x93, 0xb8, 0x0d, 0x56, 0x4c, 0x6d, 0x24, 0x37, 0x93, 0x65, 0xa3, 0x13, 0xeb, 0x99, 0x0a, 0xd7,
+	0x56, 0x8e, 0xce, 0xd8, 0x75, 0xac, 0x2f, 0x2a, 0x80, 0x9e, 0xa3, 0x81, 0x32, 0x42, 0xa8, 0xb8,
+	0x0d, 0x06, 0x77, 0x41, 0x8e, 0x43, 0x5b, 0x75, 0x1c, 0x04, 0xc4, 0x63, 0xc5, 0xb9, 0x8d, 0xe4,
+	0x66, 0xa6, 0xbc, 0xd0, 0x89, 0xf5, 0x6c, 0xe5, 0xb3, 0xd3, 0x03, 0xa5, 0x46, 0x59, 0xd2, 0xf2,
+	0xbb, 0x02, 0xfc, 0x0b, 0xc8, 0x63, 0xcb, 0x22, 0x8c, 0x99, 0x16, 0x0d, 0xa2, 0x90, 0x7a, 0xc5,
+	0xf4, 0x46, 0x62, 0x33, 0xbb, 0xab, 0x6f, 0x8d, 0x2e, 0xc4, 0xd6, 0xbe, 0xf0, 0x3b, 0x90, 0x6e,
+	0xe5, 0xe5, 0xef, 0x63, 0x7d, 0xaa, 0x13, 0xeb, 0xf3, 0x43, 0x6a, 0x34, 0x8f, 0x07, 0x45, 0x78,
+	0x1f, 0xdc, 0xc1, 0x56, 0xe4, 0xb6, 0x88, 0xc9, 0x22, 0x1c, 0xb9, 0x96, 0xd9, 0x08, 0x89, 0x45,
+	0xfd, 0x86, 0xeb, 0x11, 0x56, 0xcc, 0xf0, 0xfc, 0xd0, 0xaa, 0x74, 0xa8, 0x0a, 0xfb, 0x59, 0xdf,
+	0x0c, 0xef, 0x82, 0x42, 0xdd, 0x65, 0x11, 0x0d, 0xdb, 0x26, 0x23, 0x61, 0x8b, 0x98, 0xcf, 0xdd,
+	0xc0, 0xa6, 0xcf, 0x8b, 0x60, 0x23, 0xb1, 0x99, 0x42, 0x50, 0xd9, 0xaa, 0xdc, 0xf4, 0x44, 0x58,
+	0xe0, 0xe7, 0x60, 0x85, 0x5c, 0x46, 0x24, 0xb0, 0x89, 0x2d, 0x17, 0xd8, 0xa4, 0x8d, 0xc8, 0xa5,
+	0x01, 0x2b, 0x66, 0xc5, 0xa4, 0xde, 0x1d, 0x9f, 0x54, 0x45, 0xf9, 0x8b, 0x4d, 0x78, 0x2c, 0xbd,
+	0x51, 0x81, 0x4c, 0xd0, 0xc2, 0x4f, 0x00, 0xf0, 0x99, 0x63, 0x3e, 0x73, 0xbd, 0x88, 0x84, 0xc5,
+	0x9c, 0x40, 0x5c, 0x1b, 0x47, 0x3c, 0x65, 0xce, 0x03, 0xe1, 0x52, 0x4e, 0xf1, 0x25, 0x42, 0x19,
+	0xbf, 0xab, 0x80, 0x7f, 0x06, 0x05, 0xda, 0xb0, 0xa8, 0x4d, 0x4c, 0x07, 0x33, 0x93, 0xb6, 0x48,
+	0x18, 0xba, 0x36, 0x61, 0xc5, 0xf9, 0x8d, 0xe4, 0x66, 0x76, 0xf7, 0xed, 0x71, 0xac, 0xc7, 0xc2,
+	0xfb, 0x21, 0x66, 0x8f, 0x95, 0xaf, 0xc2, 0x84, 0x74, 0xd4, 0xc0, 0xee, 0xaf, 0xbd, 0xfc, 0xe9,
+	0xbb, 0xf7, 0x56, 0x06, 0x4a, 0xfb, 0x92, 0x17, 0xb7, 0x2c, 0xc8, 0xe3, 0x54, 0x7a, 0x5a, 0x4b,
+	0x1e, 0xa7, 0xd2, 0x49, 0x2d, 0x75, 0x9c, 0x4a, 0xcf, 0x68, 0xb3, 0xc7, 0xa9, 0xf4, 0xac, 0x36,
+	0x67, 0x3c, 0x02, 0x8b, 0x63, 0x1c, 0x70, 0x05, 0xcc, 0x4a, 0x7c, 0x59, 0xba, 0x48, 0x49, 0xf0,
+	0x2d, 0x90, 0xb3, 0x68, 0xc0, 0x22, 0x1c, 0x44, 0x7c, 0x02, 0xc5, 0x69, 0xb1, 0x11, 0xd9, 0xae,
+	0xee, 0x21, 0x66, 0xc6, 0xc7, 0xa0, 0x30, 0x69, 0x45, 0xe1, 0x3b, 0x20, 0x3f, 0xbc, 0x33, 0x0a,
+	0x7a, 0x7e, 0x68, 0xa5, 0x8d, 0xaf, 0xa7, 0x41, 0xa6, 0xb7, 0x7e, 0xf0, 0x08, 0x2c, 0xd7, 0x3c,
+	0x6a, 0x5d, 0x10, 0xdb, 0xe4, 0x0b, 0x1f, 0xb5, 0x1b, 0xc4, 0x6c, 0x86, 0x1e, 0x2b, 0x26, 0x44,
+	0x61, 0xaf, 0x74, 0x62, 0x1d, 0x96, 0xa5, 0xc3, 0x29, 0x73, 0xce, 0xdb, 0x0d, 0xf2, 0x29, 0x3a,
+	0x61, 0x08, 0xd6, 0x86, 0x75, 0xa1, 0xc7, 0xe0, 0x53, 0xb0, 0xd6, 0x85, 0xc2, 0xcd, 0xa8, 0xfe,
+	0x62, 0x04, 0x70, 0x5a, 0x00, 0xae, 0x75, 0x62, 0x7d, 0x55, 0x01, 0xee, 0x73, 0xaf, 0x41, 0xd4,
+	0xd5, 0xda, 0x04, 0x03, 0x87, 0xae, 0x81, 0x55, 0xdf, 0x0d, 0xc4, 0x8e, 0x36, 0x42, 0xd7, 0x22,
+	0x03, 0xfb, 0x9a, 0x14, 0xfb, 0xfa, 0xce, 0x84, 0x1a, 0x71, 0x83, 0x87, 0x98, 0x9d, 0x71, 0xf7,
+	0x91, 0x9d, 0x2d, 0xf8, 0xe3, 0x26, 0x66, 0x7c, 0x9d, 0x00, 0x4b, 0x13, 0x62, 0xe0, 0x5d, 0x90,
+	0x1b, 0x9c, 0x88, 0x3a, 0x6a, 0xf2, 0x9d, 0x58, 0x07, 0xfd, 0xdc, 0x11, 0x2f, 0x5b, 0x95, 0x2e,
+	0x3c, 0x01, 0xf3, 0x43, 0xd9, 0x8a, 0x4d, 0xcc, 0x94, 0x37, 0x39, 0xf9, 0x8f, 0xb1, 0xbe, 0x26,
+	0x53, 0x65, 0xf6, 0xc5, 0x96, 0x4b, 0xb7, 0x7d, 0x1c, 0xd5, 0xb7, 0x4e, 0x88, 0x83, 0xad, 0xf6,
+	0x21, 0xb1, 0xbe, 0xfd, 0xe9, 0xbb, 0xf7, 0x12, 0x28, 0x3b, 0x90, 0xa0, 0xf1, 0xf7, 0x04, 0x18,
+	0xfe, 0xfd, 0xc3, 0x7d, 0x30, 0x6b, 0x85, 0x04, 0x47, 0xb2, 0x76, 0x26, 0x16, 0xf5, 0x50, 0x00,
+	0xcf, 0x4b, 0x4d, 0x5d, 0x05, 0xc2, 0x8f, 0x41, 0xca, 0xc2, 0x9e, 0x27, 0x32, 0xfb, 0xbf, 0x00,
+	0x44, 0x98, 0xf1, 0x9f, 0x04, 0x58, 0x1c, 0xf3, 0x80, 0x16, 0xc8, 0xaa, 0x73, 0x8e, 0x2f, 0x96,
+	0x48, 0x2e, 0xbf, 0xfb, 0xc6, 0xcf, 0x61, 0x0b, 0xd0, 0x5f, 0xf0, 0x65, 0xec, 0xcb, 0xd7, 0xb1,
+	0x0e, 0xe5, 0xf1, 0x3b, 0x00, 0x64, 0x20, 0x80, 0x7b, 0x1e, 0xd0, 0x02, 0x4b, 0xc3, 0x87, 0xa9,
+	0xe9, 0xb9, 0x2c, 0x52, 0xd5, 0xf5, 0x41, 0x27, 0xd6, 0x87, 0x13, 0x3b, 0x71, 0x59, 0x74, 0x1d,
+	0xeb, 0xa5, 0x21, 0xd4, 0xc1, 0x48, 0x03, 0x2d, 0xe2, 0xd1, 0x00, 0xe3, 0x5b, 0x0d, 0x64, 0x0f,
+	0xea, 0xd8, 0x0d, 0x0e, 0x68, 0xf0, 0xcc, 0x75, 0xe0, 0xe7, 0x60, 0xa1, 0x4e, 0x7d, 0xc2, 0x22,
+	0x82, 0x6d, 0x53, 0x14, 0xa9, 0x2a, 0x83, 0x0f, 0x7e, 0x8c, 0xf5, 0xe5, 0xf1, 0xfd, 0x3c, 0x0a,
+	0x38, 0xe9, 0x8a, 0x24, 0x1d, 0x89, 0x34, 0x50, 0xbe, 0xa7, 0x11, 0x3f, 0x04, 0x58, 0x07, 0x79,
+	0x1b, 0x53, 0xf3, 0x19, 0x0d, 0x2f, 0x14, 0xb8, 0x2c, 0x98, 0xf2, 0xcf, 0x82, 0x77, 0x62, 0x3d,
+	0x77, 0xb8, 0xff, 0xf8, 0x01, 0x0d, 0x2f, 0x04, 0xc4, 0x75, 0xac, 0x2f, 0x4b, 0xb2, 0x61, 0x20,
+	0x03, 0xe5, 0x6c, 0x4c, 0x7b, 0x6e, 0xf0, 0x09, 0xd0, 0x7a, 0x0e, 0xac, 0xd9, 0x68, 0xd0, 0x30,
+	0x2a, 0x26, 0x37, 0x12, 0x9b, 0xe9, 0xf2, 0xaf, 0x3a, 0xb1, 0x9e, 0x57, 0x90, 0x55, 0x69, 0xb9,
+	0x8e, 0xf5, 0xd5, 0x11, 0x50, 0x15, 0x63, 0xa0, 0xbc, 0x82, 0x55, 0xae, 0xb0, 0x06, 0x72, 0xc4,
+	0x6d, 0xec, 0xec, 0xdd, 0x55, 0x13, 0x48, 0x89, 0x09, 0xfc, 0xfe, 0xa6, 0x09, 0x64, 0x2b, 0x47,
+	0x67, 0x3b, 0x7b, 0x77, 0xbb, 0xf9, 0x2f, 0xa9, 0x6b, 0x77, 0x00, 0xc5, 0x40, 0x59, 0x29, 0xca,
+	0xe4, 0xbb, 0x1c, 0x7b, 0x8a, 0x63, 0xf6, 0xb6, 0x1c, 0x7b, 0x93, 0x38, 0xf6, 0x86, 0x39, 0xf6,
+	0x86, 0x39, 0xee, 0x29, 0x8e, 0xb9, 0xdb, 0x72, 0xdc, 0x9b, 0xc4, 0x71, 0x6f, 0x98, 0x43, 0xfa,
+	0xf0, 0x62, 0xaa, 0xb5, 0x5f, 0xe0, 0x20, 0x72, 0x9b, 0xbe, 0xa2, 0x49, 0xdf, 0xba, 0x98, 0x46,
+	0x22, 0x0d, 0x94, 0xef, 0x69, 0x24, 0xfa, 0x05, 0x28, 0x74, 0x2f, 0x0b, 0x37, 0xa0, 0x0d, 0x8f,
+	0x28, 0x8a, 0x8c, 0xa0, 0xb8, 0x77, 0x13, 0xc5, 0x9a, 0xa4, 0x98, 0x14, 0x6e, 0xa0, 0xa5, 0x61,
+	0xb5, 0x24, 0x33, 0x81, 0xd6, 0x20, 0x11, 0x09, 0x59, 0xad, 0x19, 0x3a, 0x8a, 0x08, 0x08, 0xa2,
+	0x0f, 0x6f, 0x22, 0x52, 0x65, 0x35, 0x1a, 0x6a, 0xa0, 0x85, 0xbe, 0x4a, 0x12, 0x3c, 0x05, 0x79,
+	0x97, 0xb3, 0xd6, 0x9a, 0x9e, 0x82, 0xcf, 0x0a, 0xf8, 0xdd, 0x9b, 0xe0, 0xd5, 0x4f, 0x61, 0x38,
+	0xd0, 0x40, 0xf3, 0x5d, 0x85, 0x84, 0xb6, 0x01, 0xf4, 0x9b, 0x6e, 0x68, 0x3a, 0x1e, 0xb6, 0x5c,
+	0x12, 0x2a, 0xf8, 0x9c, 0x80, 0xff, 0xf5, 0x4d, 0xf0, 0x77, 0x24, 0xfc, 0x78, 0xb0, 0x81, 0x34,
+	0xae, 0x7c, 0x28, 0x75, 0x92, 0xa5, 0x0a, 0x72, 0x35, 0x12, 0x7a, 0x6e, 0xa0, 0xf0, 0xe7, 0x05,
+	0xfe, 0xdd, 0x9b, 0xf0, 0x55, 0x05, 0x0d, 0x86, 0x19, 0x28, 0x2b, 0xc5, 0x1e, 0xa8, 0x47, 0x03,
+	0x9b, 0x76, 0x41, 0x17, 0x6f, 0x0d, 0x3a, 0x18, 0x66, 0xa0, 0xac, 0x14, 0x25, 0xa8, 0x03, 0x96,
+	0x70, 0x18, 0xd2, 0xe7, 0x23, 0x0b, 0x02, 0x05, 0xf6, 0x6f, 0x6e, 0xc2, 0xee, 0x1e, 0xae, 0xe3,
+	0xd1, 0xfc, 0x70, 0xe5, 0xda, 0xa1, 0x25, 0xb1, 0x01, 0x74, 0x42, 0xdc, 0x1e, 0xe1, 0x29, 0xdc,
+	0x7a, 0xe1, 0xc7, 0x83, 0x0d, 0xa4, 0x71, 0xe5, 0x10, 0xcb, 0x17, 0xa0, 0xe0, 0x93, 0xd0, 0x21,
+	0x66, 0x40, 0x22, 0xd6, 0xf0, 0xdc, 0x48, 0xf1, 0x2c, 0xdf, 0xfa, 0x77, 0x30, 0x29, 0xdc, 0x40,
+	0x50, 0xa8, 0x1f, 0x29, 0xad, 0xe4, 0xba, 0x03, 0xd2, 0x16, 0xbf, 0x2d, 0x4c, 0xd7, 0x2e, 0x16,
+	0x45, 0xc3, 0x36, 0x27, 0xe4, 0x23, 0x1b, 0x16, 0xc0, 0x8c, 0xec, 0xc5, 0xee, 0x88, 0x5e, 0x4c,
+	0x0a, 0xb0, 0x04, 0xd2, 0x36, 0xb1, 0x5c, 0x1f, 0x7b, 0xac, 0x58, 0x12, 0x01, 0x3d, 0x19, 0x7e,
+	0x06, 0xe6, 0x59, 0x1d, 0x07, 0x4e, 0x1d, 0xbb, 0x66, 0xe4, 0xfa, 0xa4, 0xb8, 0x26, 0x32, 0xde,
+	0xb9, 0x29, 0xe3, 0x82, 0xcc, 0x78, 0x28, 0xce, 0x40, 0xb9, 0xae, 0x7c, 0xee, 0xfa, 0x04, 0x9e,
+	0x81, 0xac, 0x85, 0x03, 0xab, 0x19, 0x48, 0xd4, 0x37, 0x04, 0xea, 0xf6, 0x4d, 0xa8, 0xea, 0x2a,
+	0x1e, 0x88, 0x32, 0x10, 0x90, 0x52, 0x17, 0xb1, 0x11, 0x62, 0xa7, 0x49, 0x24, 0xe2, 0x9b, 0xb7,
+	0x46, 0x1c, 0x88, 0x32, 0x10, 0x90, 0x52, 0x17, 0xb1, 0x45, 0xc2, 0x0b, 0x4f, 0x21, 0xae, 0xdf,
+	0x1a, 0x71, 0x20, 0xca, 0x40, 0x40, 0x4a, 0x02, 0xf1, 0x14, 0x00, 0xca, 0xf0, 0x05, 0x96, 0x80,
+	0xba, 0x00, 0xdc, 0xba, 0x09, 0x50, 0x3d, 0xff, 0xfa, 0x41, 0x06, 0xca, 0x08, 0x81, 0xc3, 0xf5,
+	0xfa, 0xfa, 0x15, 0x6d, 0xf5, 0x38, 0x95, 0x5e, 0xd5, 0x8a, 0xc6, 0x36, 0x98, 0xe1, 0xcf, 0x2a,
+	0x02, 0x35, 0x90, 0xbc, 0x20, 0x6d, 0xd5, 0x73, 0xf3, 0x21, 0xdf, 0xfb, 0x16, 0xf6, 0x9a, 0xaa,
+	0xff, 0x43, 0x52, 0x30, 0xce, 0xc0, 0xc2, 0x79, 0x88, 0x03, 0xc6, 0x9f, 0x64, 0x34, 0x38, 0xa1,
+	0x0e, 0x83, 0x10, 0xa4, 0xea, 0x98, 0xd5, 0x55, 0xac, 0x18, 0xc3, 0x5f, 0x82, 0x94, 0x47, 0x1d,
+	0xd9, 0x36, 0x67, 0x77, 0x97, 0xc7, 0xbb, 0xa8, 0x13, 0xea, 0x20, 0xe1, 0x62, 0xfc, 0x35, 0x09,
+	0x92, 0x27, 0xd4, 0x81, 0x45, 0x30, 0x87, 0x6d, 0x3b, 0x24, 0x8c, 0x29, 0xa4, 0xae, 0xc8, 0x5f,
+	0x1b, 0x11, 0x6d, 0xb8, 0x96, 0xea, 0xc2, 0x91, 0x92, 0x38, 0xb1, 0x8d, 0x23, 0x2c, 0x7a, 0x80,
+	0x1c, 0x12, 0x63, 0xfe, 0xc2, 0x15, 0xa5, 0x6e, 0x06, 0x4d, 0xbf, 0x46, 0x42, 0x71, 0x95, 0xa7,
+	0xca, 0x0b, 0x57, 0xb1, 0x9e, 0x15, 0xfa, 0x47, 0x42, 0x8d, 0x06, 0x05, 0xf8, 0x3e, 0x98, 0x8b,
+	0x2e, 0x4d, 0x31, 0x87, 0x19, 0xb1, 0xc4, 0x4b, 0x57, 0xb1, 0xbe, 0x10, 0xf5, 0xa7, 0xf9, 0x07,
+	0xcc, 0xea, 0x68, 0x36, 0xba, 0xe4, 0xff, 0xc3, 0x6d, 0x90, 0x8e, 0x2e, 0x4d, 0x37, 0xb0, 0xc9,
+	0xa5, 0xb8, 0xc4, 0x53, 0xe5, 0xc2, 0x55, 0xac, 0x6b, 0x03, 0xee, 0x47, 0xdc, 0x86, 0xe6, 0xa2,
+	0x4b, 0x31, 0x80, 0xef, 0x03, 0x20, 0x53, 0x12, 0x0c, 0xf2, 0x4e, 0x9e, 0xbf, 0x8a, 0xf5, 0x8c,
+	0xd0, 0x0a, 0xec, 0xfe, 0x10, 0x1a, 0x60, 0x46, 0x62, 0xa7, 0x05, 0x76, 0xee, 0x2a, 0xd6, 0xd3,
+	0x1e, 0x75, 0x24, 0xa6, 0x34, 0xf1, 0xa5, 0x0a, 0x89, 0x4f, 0x5b, 0xc4, 0x16, 0x17, 0x63, 0x1a,
+	0x75, 0x45, 0xf8, 0x11, 0x58, 0x90, 0x5c, 0x7c, 0xef, 0x59, 0x84, 0xfd, 0x86, 0x7c, 0x0c, 0x97,
+	0xe1, 0x55, 0xac, 0xe7, 0x85, 0xe9, 0xbc, 0x6b, 0x41, 0x23, 0xb2, 0xf1, 0xd5, 0x34, 0x48, 0x9f,
+	0x5f, 0x22, 0xc2, 0x9a, 0x5e, 0x04, 0x1f, 0x00, 0x4d, 0x34, 0x9a, 0xd8, 0x8a, 0xcc, 0xa1, 0x7d,
+	0x29, 0xaf, 0xf5, 0xef, 0xc0, 0x51, 0x0f, 0x03, 0x2d, 0x74, 0x55, 0xfb, 0x6a, 0xf3, 0x0a, 0x60,
+	0xa6, 0xe6, 0x51, 0xea, 0x8b, 0x32, 0xca, 0x21, 0x29, 0xc0, 0x27, 0x62, 0xc9, 0x45, 0x89, 0x24,
+	0x45, 0x13, 0xff, 0xd6, 0x78, 0x89, 0x8c, 0xd4, 0x59, 0x79, 0x8d, 0xb7, 0xf0, 0xd7, 0xb1, 0x9e,
+	0x97, 0xdc, 0x2a, 0xde, 0x90, 0x8f, 0x8e, 0xd9, 0xe8, 0x52, 0x14, 0xa3, 0x06, 0x92, 0x21, 0x89,
+	0xc4, 0xb6, 0xe7, 0x10, 0x1f, 0xf2, 0xd3, 0x2a, 0x24, 0x2d, 0x12, 0x46, 0xc4, 0x16, 0xdb, 0x9b,
+	0x46, 0x3d, 0x99, 0x1f, 0x7d, 0xfc, 0x9d, 0xd3, 0x64, 0xc4, 0x96, 0x7b, 0x89, 0xe6, 0x1c, 0xcc,
+	0x3e, 0x65, 0xc4, 0xbe, 0x9f, 0xfa, 0xf2, 0x1b, 0x7d, 0xca, 0xc0, 0x20, 0xab, 0xfa, 0xfb, 0x66,
+	0xc3, 0x23, 0x37, 0xd4, 0xe8, 0x2e, 0xc8, 0xb1, 0x88, 0x86, 0xd8, 0x21, 0xe6, 0x05, 0x69, 0x77,
+	0xdf, 0x8b, 0xa2, 0xee, 0x94, 0xfe, 0x8f, 0xa4, 0xcd, 0xd0, 0xa0, 0xa0, 0x28, 0xbe, 0x49, 0x81,
+	0xec, 0x79, 0x88, 0x2d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x5c, 0x0c, 0xbb, 0x6f, 0x6b, 0x29, 0x71,
+	0x6e, 0xbe, 0xa9, 0xb4, 0x19, 0xa9, 0x5f, 0x64, 0x57, 0xe4, 0x11, 0x21, 0x21, 0x97, 0xc4, 0x12,
+	0x6b, 0x99, 0x42, 0x4a, 0x82, 0x7b, 0x60, 0xde, 0x76, 0x19, 0xae, 0x79, 0xe2, 0xdb, 0x8a, 0x75,
+	0x21, 0xa7, 0x5f, 0xd6, 0xae, 0x62, 0x3d, 0xa7, 0x0c, 0x55, 0xae, 0x47, 0x43, 0x12, 0xaf, 0xa1,
+	0x7e, 0x98, 0xc8, 0x56, 0xac, 0x4d, 0x5a, 0xd6, 0x50, 0xcf, 0x55, 0x58, 0xd0, 0x88, 0x2c, 0x6f,
+	0x8c, 0x5a, 0xd3, 0x11, 0xe5, 0x9b, 0x46, 0x52, 0xe0, 0x5a, 0xcf, 0xf5, 0xdd, 0x48, 0x94, 0xeb,
+	0x0c, 0x92, 0x02, 0xfc, 0x08, 0x64, 0xfa, 0x2f, 0x61, 0x20, 0xca, 0xe0, 0xcd, 0xf1, 0x32, 0x18,
+	0x78, 0xc9, 0xa0, 0xbe, 0x3f, 0x9f, 0x1c, 0x09, 0x44, 0x92, 0x3e, 0xf1, 0x69, 0xd8, 0x16, 0xad,
+	0x95, 0x9a, 0x9c, 0x34, 0x9c, 0x0a, 0x3d, 0x1a, 0x92, 0x60, 0x19, 0x40, 0x15, 0x16, 0x92, 0xa8,
+	0x19, 0x06, 0xa6, 0x38, 0x41, 0x72, 0x22, 0x56, 0xfc, 0x8e, 0xa5, 0x15, 0x09, 0xe3, 0x21, 0x8e,
+	0x30, 0x1a, 0xd3, 0xc0, 0xdf, 0x01, 0x28, 0xf7, 0xc4, 0xfc, 0x82, 0xd1, 0x80, 0xbf, 0xc7, 0x9e,
+	0xb9, 0x8e, 0xea, 0x8d, 0x04, 0xbf, 0xb4, 0xaa, 0x9c, 0x35, 0x29, 0x1d, 0x33, 0xaa, 0x66, 0x71,
+	0x9c, 0x4a, 0xa7, 0xb4, 0x99, 0xe3, 0x54, 0x7a, 0x4e, 0x4b, 0xf7, 0xd6, 0x4f, 0xcd, 0x02, 0x2d,
+	0x75, 0xe5, 0x81, 0xf4, 0x8c, 0x47, 0x00, 0x9c, 0x85, 0xc4, 0xe5, 0x1d, 0xac, 0xe7, 0xf1, 0x63,
+	0x2f, 0xc0, 0x7e, 0xf7, 0xd3, 0x8b, 0x18, 0x0f, 0x16, 0xe6, 0xf4, 0x70, 0x61, 0x42, 0x90, 0x12,
+	0x1f, 0x6a, 0x92, 0xd2, 0x9b, 0x8f, 0x8d, 0xbf, 0x25, 0x40, 0xb6, 0xd2, 0xf2, 0x0f, 0xa8, 0x1b,
+	0x1c, 0x05, 0xcf, 0x68, 0xff, 0x9a, 0x4f, 0x0c, 0x5e, 0xf3, 0xe3, 0x5f, 0x64, 0xa6, 0x27, 0x7c,
+	0x91, 0x81, 0x6f, 0x8b, 0x2a, 0x6b, 0x78, 0xb8, 0xad, 0xbc, 0x24, 0x53, 0x4e, 0x29, 0x0f, 0xc7,
+	0x5a, 0x06, 0xfe, 0xdb, 0x9c, 0xef, 0xb7, 0x0c, 0xef, 0xfd, 0x2b, 0x01, 0x06, 0x1e, 0xd1, 0xf0,
+	0xb7, 0xa0, 0xb4, 0x7f, 0x70, 0x50, 0xa9, 0x56, 0xcd, 0xf3, 0xa7, 0x67, 0x15, 0xf3, 0xac, 0x82,
+	0x4e, 0x8f, 0xaa, 0xd5, 0xa3, 0xc7, 0x8f, 0x4e, 0x2a, 0xd5, 0xaa, 0x36, 0x55, 0x7a, 0xe3, 0xe5,
+	0xab, 0x8d, 0x62, 0xdf, 0xff, 0x8c, 0x84, 0xbe, 0xcb, 0x98, 0x4b, 0x03, 0x8f, 0x4f, 0xf7, 0x43,
+	0xb0, 0x32, 0x18, 0x8d, 0x2a, 0xd5, 0x73, 0x74, 0x74, 0x70, 0x5e, 0x39, 0xd4, 0x12, 0xa5, 0xe2,
+	0xcb, 0x57, 0x1b, 0x85, 0x7e, 0x24, 0x22, 0x2c, 0x0a, 0x5d, 0x8b, 0x9f, 0x03, 0xf7, 0x40, 0x71,
+	0x32, 0x67, 0xe5, 0x50, 0x9b, 0x2e, 0x95, 0x5e, 0xbe, 0xda, 0x58, 0x99, 0xc4, 0x48, 0xec, 0x52,
+	0xea, 0xcb, 0x7f, 0xac, 0x4f, 0x95, 0x3f, 0xf9, 0xbe, 0xb3, 0x9e, 0xf8, 0xa1, 0xb3, 0x9e, 0xf8,
+	0x6f, 0x67, 0x3d, 0xf1, 0xd5, 0xeb, 0xf5, 0xa9, 0x1f, 0x5e, 0xaf, 0x4f, 0xfd, 0xfb, 0xf5, 0xfa,
+	0xd4, 0x9f, 0xde, 0x75, 0xdc, 0xa8, 0xde, 0xac, 0x6d, 0x59, 0xd4, 0xdf, 0x7e, 0x41, 0x02, 0x1c,
+	0x90, 0xe8, 0x39, 0x0d, 0x2f, 0xc4, 0x58, 0x7e, 0x7f, 0x8b, 0xda, 0x0d, 0xc2, 0x6a, 0xb3, 0xe2,
+	0x0b, 0xf1, 0x07, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x24, 0xda, 0x5c, 0x6a, 0x7a, 0x16, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1226,6 +1443,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OpcodeGasOverrides) > 0 {
+		for iNdEx := len(m.OpcodeGasOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpcodeGasOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.MsgFilter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if m.ExtendedDenomOptions != nil {
 		{
 			size, err := m.ExtendedDenomOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -1272,21 +1513,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.ExtraEIPs)*10)
+		var j4 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintEvm(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvm(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1300,6 +1541,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpcodeGasOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGasOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGasOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConstantGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ConstantGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtendedDenomOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1330,6 +1606,101 @@ func (m *ExtendedDenomOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinGasPriceOverrides) > 0 {
+		for iNdEx := len(m.MinGasPriceOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPriceOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockedAuthzMsgTypeURLs) > 0 {
+		for iNdEx := len(m.BlockedAuthzMsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAuthzMsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.BlockedAuthzMsgTypeURLs[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedAuthzMsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlockedMsgTypeURLs) > 0 {
+		for iNdEx := len(m.BlockedMsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedMsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.BlockedMsgTypeURLs[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedMsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinGasPriceOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2261,43 +2632,109 @@ func (m *Params) Size() (n int) {
 		l = m.ExtendedDenomOptions.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	l = m.MsgFilter.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.OpcodeGasOverrides) > 0 {
+		for _, e := range m.OpcodeGasOverrides {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ExtendedDenomOptions) Size() (n int) {
+func (m *OpcodeGasOverride) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ExtendedDenom)
+	l = len(m.Opcode)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovEvm(uint64(m.ConstantGas))
+	}
 	return n
 }
 
-func (m *AccessControl) Size() (n int) {
+func (m *ExtendedDenomOptions) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Create.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	l = m.Call.Size()
-	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
-func (m *AccessControlType) Size() (n int) {
+func (m *MsgFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AccessType != 0 {
-		n += 1 + sovEvm(uint64(m.AccessType))
+	if len(m.BlockedMsgTypeURLs) > 0 {
+		for _, s := range m.BlockedMsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BlockedAuthzMsgTypeURLs) > 0 {
+		for _, s := range m.BlockedAuthzMsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.MinGasPriceOverrides) > 0 {
+		for _, e := range m.MinGasPriceOverrides {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinGasPriceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControlType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
 	}
 	if len(m.AccessControlList) > 0 {
 		for _, s := range m.AccessControlList {
@@ -2926,6 +3363,174 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpcodeGasOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpcodeGasOverrides = append(m.OpcodeGasOverrides, OpcodeGasOverride{})
+			if err := m.OpcodeGasOverrides[len(m.OpcodeGasOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpcodeGasOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGasOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGasOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3029,6 +3634,270 @@ func (m *ExtendedDenomOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedMsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedMsgTypeURLs = append(m.BlockedMsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAuthzMsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAuthzMsgTypeURLs = append(m.BlockedAuthzMsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPriceOverrides = append(m.MinGasPriceOverrides, MinGasPriceOverride{})
+			if err := m.MinGasPriceOverrides[len(m.MinGasPriceOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AccessControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
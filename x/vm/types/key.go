@@ -36,6 +36,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
+	prefixTransientFeeAllowance
 )
 
 // KVStore key prefixes
@@ -49,10 +51,12 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom        = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex      = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize      = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed      = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer     = []byte{prefixTransientFeePayer}
+	KeyPrefixTransientFeeAllowance = []byte{prefixTransientFeeAllowance}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
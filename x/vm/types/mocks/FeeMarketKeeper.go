@@ -16,6 +16,11 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AddTransientBaseFeePaid provides a mock function with given fields: ctx, amount
+func (_m *FeeMarketKeeper) AddTransientBaseFeePaid(ctx types.Context, amount math.Int) {
+	_m.Called(ctx, amount)
+}
+
 // CalculateBaseFee provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	ret := _m.Called(ctx)
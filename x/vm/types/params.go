@@ -4,16 +4,22 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
+	"github.com/zenanetwork/zena/eips"
 	"github.com/zenanetwork/zena/utils"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 var (
@@ -109,6 +115,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.MsgFilter.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateOpcodeGasOverrides(p.OpcodeGasOverrides); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -121,6 +135,37 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// OpcodeGasEIPs returns the extra EIPs that apply the opcode constant gas
+// overrides to the jump table.
+func (p Params) OpcodeGasEIPs() []int {
+	var opcodeGasEIPs []int
+	for _, override := range p.OpcodeGasOverrides {
+		opcodeGasEIPs = append(opcodeGasEIPs, eips.OpcodeGasEIPs(vm.StringToOp(override.Opcode), override.ConstantGas)...)
+	}
+	return opcodeGasEIPs
+}
+
+// ValidateOpcodeGasOverrides checks that the overridden opcodes have constant
+// gas in the jump table of the fork defined by the rules, and that their
+// overridden constant gas is within the bounded multiplier range of it.
+func (p Params) ValidateOpcodeGasOverrides(rules params.Rules) error {
+	if len(p.OpcodeGasOverrides) == 0 {
+		return nil
+	}
+
+	jt, err := vm.LookupInstructionSet(rules)
+	if err != nil {
+		return err
+	}
+
+	for _, override := range p.OpcodeGasOverrides {
+		if err := eips.ValidateOpcodeGasOverride(&jt, vm.StringToOp(override.Opcode), override.ConstantGas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -151,6 +196,67 @@ func (act AccessControlType) Validate() error {
 	return validateAllowlistAddresses(act.AccessControlList)
 }
 
+// unblockableMsgTypeURLs returns the msgs that cannot be blocked by the msg filter,
+// since governance needs them to submit, vote and update the filter itself.
+func unblockableMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVote{}),
+		sdk.MsgTypeURL(&MsgUpdateParams{}),
+	}
+}
+
+// Validate performs basic validation on the message filters.
+func (mf MsgFilter) Validate() error {
+	if err := validateMsgTypeURLs(mf.BlockedMsgTypeURLs); err != nil {
+		return fmt.Errorf("invalid blocked msg type urls: %w", err)
+	}
+
+	unblockable := unblockableMsgTypeURLs()
+	for _, typeURL := range mf.BlockedMsgTypeURLs {
+		if slices.Contains(unblockable, typeURL) {
+			return fmt.Errorf("msg type url %s cannot be blocked", typeURL)
+		}
+	}
+
+	if err := validateMsgTypeURLs(mf.BlockedAuthzMsgTypeURLs); err != nil {
+		return fmt.Errorf("invalid blocked authz msg type urls: %w", err)
+	}
+
+	typeURLs := make([]string, len(mf.MinGasPriceOverrides))
+	for i, override := range mf.MinGasPriceOverrides {
+		if override.MinGasPrice.IsNil() || override.MinGasPrice.IsNegative() {
+			return fmt.Errorf("invalid min gas price override for %s: %s", override.MsgTypeURL, override.MinGasPrice)
+		}
+		typeURLs[i] = override.MsgTypeURL
+	}
+
+	if err := validateMsgTypeURLs(typeURLs); err != nil {
+		return fmt.Errorf("invalid min gas price overrides: %w", err)
+	}
+
+	return nil
+}
+
+// validateMsgTypeURLs checks that the message type URLs are well formed and unique.
+func validateMsgTypeURLs(typeURLs []string) error {
+	seen := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if len(typeURL) < 2 || !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid msg type url %q, expected format /<proto message name>", typeURL)
+		}
+
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate msg type url %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateAccessType(i interface{}) error {
 	accessType, ok := i.(AccessType)
 	if !ok {
@@ -180,16 +286,20 @@ func validateAllowlistAddresses(i interface{}) error {
 }
 
 func validateEIPs(i interface{}) error {
-	eips, ok := i.([]int64)
+	extraEIPs, ok := i.([]int64)
 	if !ok {
 		return fmt.Errorf("invalid EIP slice type: %T", i)
 	}
 
 	uniqueEIPs := make(map[int64]struct{})
 
-	for _, eip := range eips {
+	for _, eip := range extraEIPs {
+		if eips.IsOpcodeGasEIP(int(eip)) {
+			return fmt.Errorf("EIP %d is reserved for the opcode gas overrides", eip)
+		}
+
 		if !vm.ValidEip(int(eip)) {
-			return fmt.Errorf("EIP %d is not activateable, valid EIPs are: %s", eip, vm.ActivateableEips())
+			return fmt.Errorf("EIP %d is not activateable, valid EIPs are: %s", eip, eips.ActivateableEIPs())
 		}
 
 		if _, ok := uniqueEIPs[eip]; ok {
@@ -202,6 +312,30 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// validateOpcodeGasOverrides checks that the overridden opcodes are unique and
+// that their constant gas is positive. The bounds of the constant gas depend on
+// the active fork and are checked by Params.ValidateOpcodeGasOverrides.
+func validateOpcodeGasOverrides(overrides []OpcodeGasOverride) error {
+	seenOpcodes := make(map[vm.OpCode]struct{}, len(overrides))
+	for _, override := range overrides {
+		op := vm.StringToOp(override.Opcode)
+		if op.String() != override.Opcode {
+			return fmt.Errorf("invalid opcode %q", override.Opcode)
+		}
+
+		if _, ok := seenOpcodes[op]; ok {
+			return fmt.Errorf("duplicate opcode gas override %s", override.Opcode)
+		}
+		seenOpcodes[op] = struct{}{}
+
+		if override.ConstantGas == 0 {
+			return fmt.Errorf("constant gas of opcode %s must be positive", override.Opcode)
+		}
+	}
+
+	return nil
+}
+
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
 func ValidatePrecompiles(i interface{}) error {
 	precompiles, ok := i.([]string)
@@ -5,6 +5,8 @@ import (
 
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -49,6 +51,106 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid msg filter",
+			params: Params{
+				MsgFilter: MsgFilter{
+					BlockedMsgTypeURLs:      []string{"/cosmos.bank.v1beta1.MsgSend"},
+					BlockedAuthzMsgTypeURLs: []string{"/cosmos.staking.v1beta1.MsgUndelegate"},
+					MinGasPriceOverrides: []MinGasPriceOverride{
+						{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: math.LegacyNewDec(10)},
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid blocked msg type url",
+			params: Params{
+				MsgFilter: MsgFilter{BlockedMsgTypeURLs: []string{"cosmos.bank.v1beta1.MsgSend"}},
+			},
+			errContains: "invalid blocked msg type urls",
+		},
+		{
+			name: "blocked gov vote",
+			params: Params{
+				MsgFilter: MsgFilter{BlockedMsgTypeURLs: []string{"/cosmos.gov.v1.MsgVote"}},
+			},
+			errContains: "msg type url /cosmos.gov.v1.MsgVote cannot be blocked",
+		},
+		{
+			name: "blocked evm params update",
+			params: Params{
+				MsgFilter: MsgFilter{BlockedMsgTypeURLs: []string{"/cosmos.evm.vm.v1.MsgUpdateParams"}},
+			},
+			errContains: "cannot be blocked",
+		},
+		{
+			name: "duplicate blocked authz msg type url",
+			params: Params{
+				MsgFilter: MsgFilter{BlockedAuthzMsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}},
+			},
+			errContains: "duplicate msg type url",
+		},
+		{
+			name: "negative min gas price override",
+			params: Params{
+				MsgFilter: MsgFilter{MinGasPriceOverrides: []MinGasPriceOverride{
+					{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: math.LegacyNewDec(-1)},
+				}},
+			},
+			errContains: "invalid min gas price override",
+		},
+		{
+			name: "nil min gas price override",
+			params: Params{
+				MsgFilter: MsgFilter{MinGasPriceOverrides: []MinGasPriceOverride{
+					{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend"},
+				}},
+			},
+			errContains: "invalid min gas price override",
+		},
+		{
+			name: "valid opcode gas overrides",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{
+					{Opcode: "CREATE", ConstantGas: 320000},
+					{Opcode: "ADD", ConstantGas: 1},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid opcode gas override name",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{Opcode: "FOO", ConstantGas: 10}},
+			},
+			errContains: "invalid opcode",
+		},
+		{
+			name: "duplicate opcode gas override",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{
+					{Opcode: "ADD", ConstantGas: 10},
+					{Opcode: "ADD", ConstantGas: 20},
+				},
+			},
+			errContains: "duplicate opcode gas override",
+		},
+		{
+			name: "opcode gas override with zero constant gas",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{Opcode: "ADD", ConstantGas: 0}},
+			},
+			errContains: "must be positive",
+		},
+		{
+			name: "extra EIP reserved for the opcode gas overrides",
+			params: Params{
+				ExtraEIPs: []int64{1 << 24},
+			},
+			errContains: "reserved for the opcode gas overrides",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -75,6 +177,60 @@ func TestParamsValidate(t *testing.T) {
 	}
 }
 
+func TestParamsValidateOpcodeGasOverrides(t *testing.T) {
+	testCases := []struct {
+		name        string
+		overrides   []OpcodeGasOverride
+		rules       ethparams.Rules
+		errContains string
+	}{
+		{
+			name: "valid",
+			overrides: []OpcodeGasOverride{
+				{Opcode: "CREATE", ConstantGas: 320000},
+				{Opcode: "PUSH0", ConstantGas: 1},
+			},
+			rules: ethparams.Rules{IsPrague: true},
+		},
+		{
+			name:        "opcode without constant gas",
+			overrides:   []OpcodeGasOverride{{Opcode: "STOP", ConstantGas: 10}},
+			rules:       ethparams.Rules{IsPrague: true},
+			errContains: "has no constant gas",
+		},
+		{
+			name:        "opcode not defined in the active fork",
+			overrides:   []OpcodeGasOverride{{Opcode: "PUSH0", ConstantGas: 1}},
+			rules:       ethparams.Rules{IsLondon: true},
+			errContains: "has no constant gas",
+		},
+		{
+			name:        "above the multiplier range",
+			overrides:   []OpcodeGasOverride{{Opcode: "ADD", ConstantGas: 31}},
+			rules:       ethparams.Rules{IsPrague: true},
+			errContains: "out of the range [1, 30]",
+		},
+		{
+			name:        "below the multiplier range",
+			overrides:   []OpcodeGasOverride{{Opcode: "CREATE", ConstantGas: 3199}},
+			rules:       ethparams.Rules{IsPrague: true},
+			errContains: "out of the range [3200, 320000]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := Params{OpcodeGasOverrides: tc.overrides}
+			err := params.ValidateOpcodeGasOverrides(tc.rules)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
 	params := NewParams(extraEips, nil, nil, DefaultAccessControl)
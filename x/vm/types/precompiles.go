@@ -6,13 +6,17 @@ const (
 )
 
 const (
-	StakingPrecompileAddress      = "0x0000000000000000000000000000000000000800"
-	DistributionPrecompileAddress = "0x0000000000000000000000000000000000000801"
-	ICS20PrecompileAddress        = "0x0000000000000000000000000000000000000802"
-	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
-	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
-	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
-	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	StakingPrecompileAddress       = "0x0000000000000000000000000000000000000800"
+	DistributionPrecompileAddress  = "0x0000000000000000000000000000000000000801"
+	ICS20PrecompileAddress         = "0x0000000000000000000000000000000000000802"
+	VestingPrecompileAddress       = "0x0000000000000000000000000000000000000803"
+	BankPrecompileAddress          = "0x0000000000000000000000000000000000000804"
+	GovPrecompileAddress           = "0x0000000000000000000000000000000000000805"
+	SlashingPrecompileAddress      = "0x0000000000000000000000000000000000000806"
+	AuthzPrecompileAddress         = "0x0000000000000000000000000000000000000807"
+	FeegrantPrecompileAddress      = "0x0000000000000000000000000000000000000808"
+	ICAControllerPrecompileAddress = "0x0000000000000000000000000000000000000809"
+	RevenuePrecompileAddress       = "0x000000000000000000000000000000000000080a"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -29,4 +33,8 @@ var AvailableStaticPrecompiles = []string{
 	BankPrecompileAddress,
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
+	ICAControllerPrecompileAddress,
+	RevenuePrecompileAddress,
 }
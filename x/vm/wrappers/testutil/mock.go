@@ -516,6 +516,18 @@ func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddTransientBaseFeePaid mocks base method.
+func (m *MockFeeMarketKeeper) AddTransientBaseFeePaid(ctx types.Context, amount math.Int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTransientBaseFeePaid", ctx, amount)
+}
+
+// AddTransientBaseFeePaid indicates an expected call of AddTransientBaseFeePaid.
+func (mr *MockFeeMarketKeeperMockRecorder) AddTransientBaseFeePaid(ctx, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransientBaseFeePaid", reflect.TypeOf((*MockFeeMarketKeeper)(nil).AddTransientBaseFeePaid), ctx, amount)
+}
+
 // CalculateBaseFee mocks base method.
 func (m *MockFeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	m.ctrl.T.Helper()
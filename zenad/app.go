@@ -28,6 +28,9 @@ import (
 	precompiletypes "github.com/zenanetwork/zena/precompiles/types"
 	srvflags "github.com/zenanetwork/zena/server/flags"
 	"github.com/zenanetwork/zena/utils"
+	"github.com/zenanetwork/zena/x/clawback"
+	clawbackkeeper "github.com/zenanetwork/zena/x/clawback/keeper"
+	clawbacktypes "github.com/zenanetwork/zena/x/clawback/types"
 	"github.com/zenanetwork/zena/x/erc20"
 	erc20keeper "github.com/zenanetwork/zena/x/erc20/keeper"
 	erc20types "github.com/zenanetwork/zena/x/erc20/types"
@@ -40,11 +43,24 @@ import (
 	"github.com/zenanetwork/zena/x/precisebank"
 	precisebankkeeper "github.com/zenanetwork/zena/x/precisebank/keeper"
 	precisebanktypes "github.com/zenanetwork/zena/x/precisebank/types"
+	"github.com/zenanetwork/zena/x/ratelimit"
+	ratelimitkeeper "github.com/zenanetwork/zena/x/ratelimit/keeper"
+	ratelimittypes "github.com/zenanetwork/zena/x/ratelimit/types"
+	ratelimitv2 "github.com/zenanetwork/zena/x/ratelimit/v2"
+	"github.com/zenanetwork/zena/x/revenue"
+	revenuekeeper "github.com/zenanetwork/zena/x/revenue/keeper"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
 	"github.com/zenanetwork/zena/x/vm"
 	evmkeeper "github.com/zenanetwork/zena/x/vm/keeper"
 	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
+	ibccallbacksv2 "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/v2"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	transferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -181,15 +197,18 @@ type ZENAD struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
+	RateLimitKeeper     ratelimitkeeper.Keeper
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
 	EVMKeeper         *evmkeeper.Keeper
 	Erc20Keeper       erc20keeper.Keeper
 	PreciseBankKeeper precisebankkeeper.Keeper
+	RevenueKeeper     revenuekeeper.Keeper
 	EVMMempool        *evmmempool.ExperimentalEVMMempool
 
 	// the module manager
@@ -239,9 +258,10 @@ func NewExampleApp(
 		govtypes.StoreKey, consensusparamtypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, ratelimittypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
+		revenuetypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -318,7 +338,8 @@ func NewExampleApp(
 		appCodec,
 		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
 		app.AccountKeeper,
-		app.BankKeeper,
+		// reject the delegations of unvested clawback coins with an error
+		clawbackkeeper.NewStakingBankKeeper(app.BankKeeper, app.AccountKeeper),
 		authAddr,
 		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
@@ -352,7 +373,8 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper) // needed to check blocked addresses when granting to new accounts
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -365,7 +387,7 @@ func NewExampleApp(
 		appCodec,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
-	)
+	).SetBankKeeper(app.BankKeeper) // needed to check blocked addresses when granting to new accounts
 
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
@@ -421,11 +443,6 @@ func NewExampleApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	// Cosmos EVM keepers
-	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
-		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		keys[feemarkettypes.StoreKey],
-		tkeys[feemarkettypes.TransientKey],
-	)
 
 	// Set up PreciseBank keeper
 	//
@@ -435,6 +452,17 @@ func NewExampleApp(
 		keys[precisebanktypes.StoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+	app.PreciseBankKeeper.SetAssertInvariants(cast.ToBool(appOpts.Get(srvflags.PreciseBankAssertInvariants)))
+
+	// NOTE: the base fees are burned with the PreciseBank keeper, so that the
+	// fractional amounts of the extended EVM coin are burned too.
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		keys[feemarkettypes.StoreKey],
+		tkeys[feemarkettypes.TransientKey],
+		app.PreciseBankKeeper,
 	)
 
 	// Set up EVM keeper
@@ -455,6 +483,7 @@ func NewExampleApp(
 		tracer,
 	).WithStaticPrecompiles(
 		precompiletypes.DefaultStaticPrecompiles(
+			app.AccountKeeper,
 			*app.StakingKeeper,
 			app.DistrKeeper,
 			app.PreciseBankKeeper,
@@ -463,10 +492,34 @@ func NewExampleApp(
 			app.IBCKeeper.ChannelKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
+			&app.ICAControllerKeeper,
+			&app.RevenueKeeper,
 			appCodec,
 		),
 	)
 
+	// give back the fees of the leftover gas of fee-granted EVM txs to the allowance of the granter
+	app.EVMKeeper.SetFeegrantKeeper(app.FeeGrantKeeper)
+
+	app.RevenueKeeper = revenuekeeper.NewKeeper(
+		keys[revenuetypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.PreciseBankKeeper,
+		app.EVMKeeper,
+		app.FeeMarketKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	// distribute a share of the transaction fees of the registered contracts to their developers
+	app.EVMKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(
+			app.RevenueKeeper.Hooks(),
+		),
+	)
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
 		appCodec,
@@ -478,13 +531,24 @@ func NewExampleApp(
 		&app.TransferKeeper,
 	)
 
+	// instantiate the rate limit keeper, which wraps the channel keeper to account the
+	// outflow of the IBC v1 transfers, including the ones sent by the ICS20 precompile
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, // ics4Wrapper
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientV2Keeper,
+	)
+
 	// instantiate IBC transfer keeper using official ibc-go transfer keeper (v0.6.0)
 	// ERC20 conversions via IBC are now only handled through the ICS20 precompile
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]),
-		nil, // legacySubspace (not needed)
-		app.IBCKeeper.ChannelKeeper, // ics4Wrapper
+		nil,                 // legacySubspace (not needed)
+		app.RateLimitKeeper, // ics4Wrapper
 		app.IBCKeeper.ChannelKeeper, // channelKeeper
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
@@ -493,19 +557,39 @@ func NewExampleApp(
 	)
 	app.TransferKeeper.SetAddressCodec(evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()))
 
+	// instantiate the ICS27 interchain accounts controller keeper, used by the ICA controller precompile.
+	// NOTE: the ICS4Wrapper is replaced by the callbacks middleware once the controller stack is created
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		nil, // legacySubspace (not needed)
+		app.IBCKeeper.ChannelKeeper, // ics4Wrapper
+		app.IBCKeeper.ChannelKeeper, // channelKeeper
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
 			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- Rate Limit Middleware
 			- ERC-20 Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket ->  erc20.SendPacket -> callbacks.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> callbacks.OnRecvPacket -> ratelimit.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+
+		The IBC v2 transfer stack, routed by client ID instead of channel, uses the same layout. The
+		outflow of IBC v2 transfers is accounted in ratelimit.OnSendPacket instead of the ICS4Wrapper.
+
+		The ICA controller stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ICA Controller
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -514,6 +598,7 @@ func NewExampleApp(
 	transferStack = ibctransfer.NewIBCModule(app.TransferKeeper)
 	maxCallbackGas := uint64(1_000_000)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	app.CallbackKeeper = ibccallbackskeeper.NewKeeper(
 		app.AccountKeeper,
 		app.EVMKeeper,
@@ -524,10 +609,18 @@ func NewExampleApp(
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = ibctransferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
+	transferStackV2 = ratelimitv2.NewIBCMiddleware(transferStackV2, app.RateLimitKeeper)
+	transferStackV2 = ibccallbacksv2.NewIBCMiddleware(transferStackV2, app.IBCKeeper.ChannelKeeperV2, app.CallbackKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	app.ICAControllerKeeper.WithICS4Wrapper(icaControllerStack.(porttypes.ICS4Wrapper))
+
+	// Create static IBC router, add transfer and ICA controller routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -568,11 +661,15 @@ func NewExampleApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, nil),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.BankKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper),
 		precisebank.NewAppModule(app.PreciseBankKeeper, app.BankKeeper, app.AccountKeeper),
+		revenue.NewAppModule(app.RevenueKeeper),
+		clawback.NewAppModule(clawbackkeeper.NewKeeper(app.AccountKeeper, app.BankKeeper)),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -608,7 +705,7 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName, ratelimittypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
 		erc20types.ModuleName, feemarkettypes.ModuleName,
@@ -621,7 +718,9 @@ func NewExampleApp(
 		authz.ModuleName, feegrant.ModuleName,
 		consensusparamtypes.ModuleName,
 		precisebanktypes.ModuleName,
+		revenuetypes.ModuleName,
 		vestingtypes.ModuleName,
+		clawbacktypes.ModuleName,
 	)
 
 	// NOTE: the feemarket module should go last in order of end blockers that are actually doing something,
@@ -634,13 +733,15 @@ func NewExampleApp(
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName, ratelimittypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, consensusparamtypes.ModuleName,
 		precisebanktypes.ModuleName,
+		revenuetypes.ModuleName,
 		vestingtypes.ModuleName,
+		clawbacktypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -660,10 +761,12 @@ func NewExampleApp(
 		feemarkettypes.ModuleName,
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
+		revenuetypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName, ratelimittypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		clawbacktypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -1048,6 +1151,10 @@ func (app *ZENAD) GetPreciseBankKeeper() *precisebankkeeper.Keeper {
 	return &app.PreciseBankKeeper
 }
 
+func (app *ZENAD) GetRevenueKeeper() *revenuekeeper.Keeper {
+	return &app.RevenueKeeper
+}
+
 func (app *ZENAD) GetCallbackKeeper() ibccallbackskeeper.ContractKeeper {
 	return app.CallbackKeeper
 }
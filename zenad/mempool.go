@@ -61,10 +61,16 @@ func (app *ZENAD) configureEVMMempool(appOpts servertypes.AppOptions, logger log
 // createMempoolConfig creates a new EVMMempoolConfig with the default configuration
 // and overrides it with values from appOpts if they exist and are non-zero.
 func (app *ZENAD) createMempoolConfig(appOpts servertypes.AppOptions, logger log.Logger) (*evmmempool.EVMMempoolConfig, error) {
+	cosmosTxPolicy := evmconfig.GetCosmosTxPolicy(appOpts, logger)
+	if err := cosmosTxPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cosmos tx policy: %w", err)
+	}
+
 	return &evmmempool.EVMMempoolConfig{
 		AnteHandler:      app.GetAnteHandler(),
 		LegacyPoolConfig: evmconfig.GetLegacyPoolConfig(appOpts, logger),
 		BlockGasLimit:    evmconfig.GetBlockGasLimit(appOpts, logger),
 		MinTip:           evmconfig.GetMinTip(appOpts, logger),
+		CosmosTxPolicy:   cosmosTxPolicy,
 	}, nil
 }
@@ -0,0 +1,259 @@
+// This test suite validates that ExampleChain (an EVM-based chain) can act as
+// an interchain accounts controller through the ICA controller precompile.
+// Since the test chains don't run the ICA host submodule, the channel handshake
+// is completed on the controller chain only.
+package ibc
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zenanetwork/zena/zenad"
+	"github.com/zenanetwork/zena/zenad/tests/integration"
+	"github.com/zenanetwork/zena/precompiles/icacontroller"
+	evmibctesting "github.com/zenanetwork/zena/testutil/ibc"
+	evmante "github.com/zenanetwork/zena/x/vm/ante"
+	"github.com/zenanetwork/zena/x/vm/statedb"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// hostAccountAddress is the interchain account address set on the controller
+// chain when the channel handshake is completed.
+const hostAccountAddress = "cosmos1hostaccount"
+
+type ICAControllerPrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *icacontroller.Precompile
+	chainB           *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+}
+
+func (suite *ICAControllerPrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	suite.chainAPrecompile = icacontroller.NewPrecompile(
+		&evmAppA.ICAControllerKeeper,
+		evmAppA.BankKeeper,
+		evmAppA.AppCodec(),
+		evmAppA.AccountKeeper.AddressCodec(),
+	)
+
+	suite.path = evmibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupConnections()
+}
+
+func TestICAControllerPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAControllerPrecompileTestSuite))
+}
+
+func (suite *ICAControllerPrecompileTestSuite) TestRegisterInterchainAccount() {
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+	portID, err := icatypes.NewControllerPortID(senderAccount.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	channelID := suite.registerInterchainAccount(senderIdx)
+
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	ctx := suite.chainA.GetContext()
+	channel, found := evmAppA.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.INIT, channel.State)
+	suite.Require().Equal(channeltypes.UNORDERED, channel.Ordering)
+	suite.Require().Equal(icatypes.HostPortID, channel.Counterparty.PortId)
+	suite.Require().Equal([]string{suite.path.EndpointA.ConnectionID}, channel.ConnectionHops)
+
+	// the account is not available until the handshake is completed
+	suite.Require().Equal("", suite.queryInterchainAccount(owner))
+
+	// registering on behalf of another owner fails
+	otherOwner := common.BytesToAddress(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().Bytes())
+	data, err := suite.chainAPrecompile.Pack(icacontroller.RegisterInterchainAccountMethod, otherOwner, suite.path.EndpointA.ConnectionID, "")
+	suite.Require().NoError(err)
+	_, _, _, err = suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().Error(err)
+
+	suite.openChannel(portID, channelID, icatypes.EncodingProtobuf)
+	suite.Require().Equal(hostAccountAddress, suite.queryInterchainAccount(owner))
+
+	// registering again once the channel is open fails
+	data, err = suite.chainAPrecompile.Pack(icacontroller.RegisterInterchainAccountMethod, owner, suite.path.EndpointA.ConnectionID, "")
+	suite.Require().NoError(err)
+	_, _, _, err = suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().Error(err)
+}
+
+func (suite *ICAControllerPrecompileTestSuite) TestSendTx() {
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: hostAccountAddress,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100))),
+	}
+
+	testCases := []struct {
+		name     string
+		encoding string
+	}{
+		{"protobuf encoded channel", icatypes.EncodingProtobuf},
+		{"proto3 JSON encoded channel", icatypes.EncodingProto3JSON},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			senderIdx := 1
+			senderAccount := suite.chainA.SenderAccounts[senderIdx]
+			owner := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+			portID, err := icatypes.NewControllerPortID(senderAccount.SenderAccount.GetAddress().String())
+			suite.Require().NoError(err)
+
+			evmAppA := suite.chainA.App.(*zenad.ZENAD)
+			bankMsgAny, err := codectypes.NewAnyWithValue(bankMsg)
+			suite.Require().NoError(err)
+			msgs := []icacontroller.CosmosMsg{{TypeURL: bankMsgAny.TypeUrl, Value: bankMsgAny.Value}}
+			memo := fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, owner.Hex())
+			relativeTimeout := uint64(time.Hour.Nanoseconds())
+
+			// sending before the account is registered fails
+			data, err := suite.chainAPrecompile.Pack(icacontroller.SendTxMethod, owner, suite.path.EndpointA.ConnectionID, msgs, memo, relativeTimeout)
+			suite.Require().NoError(err)
+			_, _, _, err = suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+			suite.Require().Error(err)
+
+			channelID := suite.registerInterchainAccount(senderIdx)
+			suite.openChannel(portID, channelID, tc.encoding)
+
+			res, _, ethRes, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+			suite.Require().NoError(err)
+
+			out, err := suite.chainAPrecompile.Unpack(icacontroller.SendTxMethod, ethRes.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), out[0])
+
+			packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+			suite.Require().Equal(portID, packet.SourcePort)
+			suite.Require().Equal(channelID, packet.SourceChannel)
+			suite.Require().Equal(uint64(1), packet.Sequence)
+
+			var packetData icatypes.InterchainAccountPacketData
+			suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON(packet.Data, &packetData))
+			suite.Require().Equal(icatypes.EXECUTE_TX, packetData.Type)
+			suite.Require().Equal(memo, packetData.Memo)
+
+			txMsgs, err := icatypes.DeserializeCosmosTx(evmAppA.AppCodec(), packetData.Data, tc.encoding)
+			suite.Require().NoError(err)
+			suite.Require().Len(txMsgs, 1)
+			suite.Require().Equal(bankMsg.String(), txMsgs[0].(*banktypes.MsgSend).String())
+
+			// sending on behalf of another owner fails
+			otherOwner := common.BytesToAddress(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().Bytes())
+			data, err = suite.chainAPrecompile.Pack(icacontroller.SendTxMethod, otherOwner, suite.path.EndpointA.ConnectionID, msgs, memo, relativeTimeout)
+			suite.Require().NoError(err)
+			_, _, _, err = suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+// registerInterchainAccount registers an interchain account for the sender
+// through the precompile and returns the identifier of the channel being opened.
+func (suite *ICAControllerPrecompileTestSuite) registerInterchainAccount(senderIdx int) string {
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+
+	data, err := suite.chainAPrecompile.Pack(icacontroller.RegisterInterchainAccountMethod, owner, suite.path.EndpointA.ConnectionID, "")
+	suite.Require().NoError(err)
+
+	_, _, ethRes, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(ethRes.Logs, 1)
+
+	out, err := suite.chainAPrecompile.Unpack(icacontroller.RegisterInterchainAccountMethod, ethRes.Ret)
+	suite.Require().NoError(err)
+	channelID, ok := out[0].(string)
+	suite.Require().True(ok)
+	suite.Require().True(channeltypes.IsValidChannelID(channelID))
+
+	return channelID
+}
+
+// openChannel completes the channel handshake on the controller chain, as the
+// ICA controller OnChanOpenAck callback would do, using the given encoding.
+func (suite *ICAControllerPrecompileTestSuite) openChannel(portID, channelID, encoding string) {
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	ctx := suite.chainA.GetContext()
+
+	channel, found := evmAppA.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	suite.Require().True(found)
+
+	metadata := icatypes.NewMetadata(
+		icatypes.Version,
+		suite.path.EndpointA.ConnectionID,
+		suite.path.EndpointB.ConnectionID,
+		hostAccountAddress,
+		encoding,
+		icatypes.TxTypeSDKMultiMsg,
+	)
+	channel.State = channeltypes.OPEN
+	channel.Counterparty.ChannelId = "channel-0"
+	channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+	evmAppA.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+
+	evmAppA.ICAControllerKeeper.SetActiveChannelID(ctx, suite.path.EndpointA.ConnectionID, portID, channelID)
+	evmAppA.ICAControllerKeeper.SetInterchainAccountAddress(ctx, suite.path.EndpointA.ConnectionID, portID, hostAccountAddress)
+	suite.coordinator.CommitBlock(suite.chainA)
+}
+
+// queryInterchainAccount returns the interchain account address of the owner
+// through the precompile.
+func (suite *ICAControllerPrecompileTestSuite) queryInterchainAccount(owner common.Address) string {
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	ctx := evmante.BuildEvmExecutionCtx(suite.chainA.GetContext())
+	stateDB := statedb.New(ctx, evmAppA.EVMKeeper, statedb.NewEmptyTxConfig())
+
+	res, err := evmAppA.EVMKeeper.CallEVM(
+		ctx,
+		stateDB,
+		suite.chainAPrecompile.ABI,
+		owner,
+		suite.chainAPrecompile.Address(),
+		false,
+		false,
+		nil,
+		icacontroller.InterchainAccountMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+	)
+	suite.Require().NoError(err)
+
+	out, err := suite.chainAPrecompile.Unpack(icacontroller.InterchainAccountMethod, res.Ret)
+	suite.Require().NoError(err)
+	address, ok := out[0].(string)
+	suite.Require().True(ok)
+
+	return address
+}
@@ -0,0 +1,262 @@
+// This test suite validates that the IBC transfers of ExampleChain (an EVM-based
+// chain) are bounded by the rate limits of the ratelimit module, including the
+// transfers sent through the ICS20 precompile and over IBC v2 clients.
+package ibc
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zenanetwork/zena/zenad"
+	"github.com/zenanetwork/zena/zenad/tests/integration"
+	"github.com/zenanetwork/zena/precompiles/ics20"
+	evmibctesting "github.com/zenanetwork/zena/testutil/ibc"
+	ratelimittypes "github.com/zenanetwork/zena/x/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ics20.Precompile
+	chainB           *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	suite.chainAPrecompile = ics20.NewPrecompile(
+		evmAppA.BankKeeper,
+		*evmAppA.StakingKeeper,
+		evmAppA.TransferKeeper,
+		evmAppA.IBCKeeper.ChannelKeeper,
+		&evmAppA.Erc20Keeper,
+	)
+
+	// NOTE:
+	// path.EndpointA = endpoint on chainA
+	// path.EndpointB = endpoint on chainB
+	suite.path = evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+// addRateLimit adds a rate limit on chainA through the governance message.
+func (suite *RateLimitTestSuite) addRateLimit(denom, channelOrClientID string, maxSend, maxRecv sdkmath.Int) {
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	_, err := evmAppA.RateLimitKeeper.AddRateLimit(suite.chainA.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Path:      ratelimittypes.NewPath(denom, channelOrClientID),
+		Quota:     ratelimittypes.NewQuota(maxSend, maxRecv, time.Hour),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitTestSuite) TestPrecompileTransferExceedsSendQuota() {
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	denom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	quota := sdkmath.NewInt(1_000)
+	suite.addRateLimit(denom, suite.path.EndpointA.ChannelID, quota, sdkmath.ZeroInt())
+
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	senderAddr := senderAccount.SenderAccount.GetAddress()
+
+	transfer := func(amount sdkmath.Int) error {
+		data, err := suite.chainAPrecompile.Pack("transfer",
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			denom,
+			amount.BigInt(),
+			common.BytesToAddress(senderAddr.Bytes()),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(1, 110),
+			uint64(0),
+			"",
+		)
+		suite.Require().NoError(err)
+
+		res, _, ethRes, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+		if err != nil {
+			reason, unpackErr := abi.UnpackRevert(ethRes.Ret)
+			suite.Require().NoError(unpackErr)
+			return errors.New(reason)
+		}
+		packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+		return suite.path.RelayPacket(packet)
+	}
+
+	// transfer the whole quota
+	err = transfer(quota)
+	suite.Require().NoError(err)
+
+	rateLimit, found := evmAppA.RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), denom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(quota.String(), rateLimit.Flow.Outflow.String())
+	suite.Require().Empty(evmAppA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()), "acknowledged packet should not be pending")
+
+	// any further transfer reverts and the tokens are not escrowed
+	balance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, denom)
+	err = transfer(sdkmath.OneInt())
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), ratelimittypes.ErrQuotaExceeded.Error())
+
+	afterBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, denom)
+	suite.Require().True(afterBalance.Amount.LTE(balance.Amount), "only the fees should be paid")
+	escrow := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), transfertypes.GetEscrowAddress(transfertypes.PortID, suite.path.EndpointA.ChannelID), denom)
+	suite.Require().Equal(quota.String(), escrow.Amount.String())
+
+	// the quota is available again once the bucket of the transfer left the rolling window
+	suite.coordinator.IncrementTimeBy(time.Hour + time.Hour/ratelimittypes.BucketsPerWindow)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	err = transfer(quota)
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitTestSuite) TestRecvPacketExceedsRecvQuota() {
+	evmAppB := suite.chainB.App.(*zenad.ZENAD)
+	denom, err := evmAppB.StakingKeeper.BondDenom(suite.chainB.GetContext())
+	suite.Require().NoError(err)
+
+	// the voucher of chainB's bond denom on chainA
+	voucher := transfertypes.NewDenom(denom, transfertypes.NewHop(transfertypes.PortID, suite.path.EndpointA.ChannelID))
+	quota := sdkmath.NewInt(1_000)
+	suite.addRateLimit(voucher.IBCDenom(), suite.path.EndpointA.ChannelID, sdkmath.ZeroInt(), quota)
+
+	sender := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccount.GetAddress()
+
+	transfer := func(amount sdkmath.Int) channeltypes.Acknowledgement {
+		msg := transfertypes.NewMsgTransfer(
+			suite.path.EndpointB.ChannelConfig.PortID,
+			suite.path.EndpointB.ChannelID,
+			sdk.NewCoin(denom, amount),
+			sender.String(),
+			receiver.String(),
+			clienttypes.NewHeight(1, 110), 0, "",
+		)
+		res, err := suite.chainB.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+		_, ackBz, err := suite.path.RelayPacketWithResults(packet)
+		suite.Require().NoError(err)
+
+		var ack channeltypes.Acknowledgement
+		suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+		return ack
+	}
+
+	ack := transfer(sdkmath.NewInt(600))
+	suite.Require().True(ack.Success())
+
+	// the second transfer exceeds the quota, so it's refunded on chainB
+	escrowB := transfertypes.GetEscrowAddress(transfertypes.PortID, suite.path.EndpointB.ChannelID)
+	ack = transfer(sdkmath.NewInt(600))
+	suite.Require().False(ack.Success())
+	suite.Require().Equal("600", evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), escrowB, denom).Amount.String())
+
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	received := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, voucher.IBCDenom())
+	suite.Require().Equal("600", received.Amount.String())
+
+	rateLimit, found := evmAppA.RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), voucher.IBCDenom(), suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal("600", rateLimit.Flow.Inflow.String())
+}
+
+func TestRateLimitV2TestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitV2TestSuite))
+}
+
+type RateLimitV2TestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *evmibctesting.TestChain
+	chainB *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+}
+
+func (suite *RateLimitV2TestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	suite.path = evmibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+}
+
+func (suite *RateLimitV2TestSuite) TestTransferExceedsSendQuota() {
+	evmAppA := suite.chainA.App.(*zenad.ZENAD)
+	ctx := suite.chainA.GetContext()
+	denom, err := evmAppA.StakingKeeper.BondDenom(ctx)
+	suite.Require().NoError(err)
+
+	clientID := suite.path.EndpointA.ClientID
+	quota := sdkmath.NewInt(1_000)
+	_, err = evmAppA.RateLimitKeeper.AddRateLimit(ctx, &ratelimittypes.MsgAddRateLimit{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Path:      ratelimittypes.NewPath(denom, clientID),
+		Quota:     ratelimittypes.NewQuota(quota, sdkmath.ZeroInt(), time.Hour),
+	})
+	suite.Require().NoError(err)
+
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+	transfer := func(amount sdkmath.Int) error {
+		msg := transfertypes.NewMsgTransfer(
+			transfertypes.PortID,
+			clientID,
+			sdk.NewCoin(denom, amount),
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			clienttypes.ZeroHeight(), timeoutTimestamp, "",
+		)
+		_, err := suite.chainA.SendMsgs(msg)
+		return err
+	}
+
+	suite.Require().NoError(transfer(quota))
+
+	rateLimit, found := evmAppA.RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), denom, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(quota.String(), rateLimit.Flow.Outflow.String())
+	suite.Require().Len(evmAppA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()), 1)
+
+	err = transfer(sdkmath.OneInt())
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), ratelimittypes.ErrQuotaExceeded.Error())
+}
@@ -21,6 +21,7 @@ import (
 	evmibctesting "github.com/zenanetwork/zena/testutil/ibc"
 	evmante "github.com/zenanetwork/zena/x/vm/ante"
 	"github.com/zenanetwork/zena/x/vm/statedb"
+	evmtypes "github.com/zenanetwork/zena/x/vm/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 
@@ -383,6 +384,98 @@ func (suite *ICS20TransferV2TestSuite) TestHandleMsgTransfer() {
 	}
 }
 
+// TestHandleMsgTransferOverload sends from chainA to chainB through the ics20 precompile
+// transfer overload that addresses the v2 client and sets the payload encoding.
+func (suite *ICS20TransferV2TestSuite) TestHandleMsgTransferOverload() {
+	testCases := []struct {
+		name          string
+		channelClient bool
+		encoding      string
+		errMsg        string
+	}{
+		{"default encoding", false, "", ""},
+		{"json encoding", false, transfertypes.EncodingJSON, ""},
+		{"protobuf encoding", false, transfertypes.EncodingProtobuf, ""},
+		{"abi encoding", false, transfertypes.EncodingABI, ""},
+		{"unsupported encoding", false, "application/xml", "invalid encoding"},
+		{"source client is a v1 channel id", true, "", "invalid source client"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pathAToB := evmibctesting.NewPath(suite.chainA, suite.chainB)
+			pathAToB.SetupV2()
+			traceAToB := transfertypes.NewHop(transfertypes.PortID, pathAToB.EndpointB.ClientID)
+
+			senderIdx := 1
+			senderAccount := suite.chainA.SenderAccounts[senderIdx]
+			senderAddr := senderAccount.SenderAccount.GetAddress()
+
+			evmAppA := suite.chainA.App.(*zenad.ZENAD)
+			denom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+			senderBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, denom)
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
+			originalCoin := sdk.NewCoin(denom, evmibctesting.DefaultCoinAmount)
+
+			sourceClient := pathAToB.EndpointA.ClientID
+			if tc.channelClient {
+				sourceClient = "channel-0"
+			}
+
+			data, err := suite.chainAPrecompile.Pack(ics20.TransferV2Method,
+				sourceClient,
+				tc.encoding,
+				originalCoin.Denom,
+				originalCoin.Amount.BigInt(),
+				common.BytesToAddress(senderAddr.Bytes()),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				timeoutTimestamp,
+				"",
+			)
+			suite.Require().NoError(err)
+
+			res, _, evmRes, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+				suite.Require().Contains(evmtypes.NewExecErrorWithReason(evmRes.Ret).Error(), tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			packets, err := pathAToB.EndpointA.ParseV2PacketFromEvent(res.Events)
+			suite.Require().NoError(err)
+			suite.Require().Len(packets, 1)
+
+			expEncoding := tc.encoding
+			if expEncoding == "" {
+				expEncoding = transfertypes.EncodingJSON
+			}
+			suite.Require().Equal(expEncoding, packets[0].Payloads[0].Encoding)
+
+			afterSenderBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, denom)
+			suite.Require().Equal(
+				senderBalance.Amount.Sub(originalCoin.Amount).String(),
+				afterSenderBalance.Amount.String(),
+			)
+
+			err = pathAToB.RelayPacketV2(packets[0])
+			suite.Require().NoError(err)
+
+			evmAppB := suite.chainB.App.(*zenad.ZENAD)
+			chainBDenom := transfertypes.NewDenom(originalCoin.Denom, traceAToB)
+			chainBBalance := evmAppB.BankKeeper.GetBalance(
+				suite.chainB.GetContext(),
+				suite.chainB.SenderAccount.GetAddress(),
+				chainBDenom.IBCDenom(),
+			)
+			suite.Require().Equal(sdk.NewCoin(chainBDenom.IBCDenom(), originalCoin.Amount), chainBBalance)
+		})
+	}
+}
+
 func TestICS20TransferV2TestSuite(t *testing.T) {
 	suite.Run(t, new(ICS20TransferV2TestSuite))
 }
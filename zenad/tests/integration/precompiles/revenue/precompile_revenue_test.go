@@ -0,0 +1,19 @@
+package revenue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zenanetwork/zena/zenad/tests/integration"
+	"github.com/zenanetwork/zena/tests/integration/precompiles/revenue"
+)
+
+func TestRevenuePrecompileTestSuite(t *testing.T) {
+	s := revenue.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
+
+func TestRevenuePrecompileIntegrationTestSuite(t *testing.T) {
+	revenue.TestPrecompileIntegrationTestSuite(t, integration.CreateEvmd)
+}
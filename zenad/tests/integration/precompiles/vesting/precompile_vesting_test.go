@@ -0,0 +1,19 @@
+package vesting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zenanetwork/zena/zenad/tests/integration"
+	"github.com/zenanetwork/zena/tests/integration/precompiles/vesting"
+)
+
+func TestVestingPrecompileTestSuite(t *testing.T) {
+	s := vesting.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
+
+func TestVestingPrecompileIntegrationTestSuite(t *testing.T) {
+	vesting.TestPrecompileIntegrationTestSuite(t, integration.CreateEvmd)
+}
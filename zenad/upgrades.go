@@ -4,7 +4,10 @@ import (
 	"context"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ratelimittypes "github.com/zenanetwork/zena/x/ratelimit/types"
+	revenuetypes "github.com/zenanetwork/zena/x/revenue/types"
 	"github.com/zenanetwork/zena/x/vm/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -75,7 +78,7 @@ func (app ZENAD) RegisterUpgradeHandlers() {
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{},
+			Added: []string{icacontrollertypes.StoreKey, ratelimittypes.StoreKey, revenuetypes.StoreKey},
 		}
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))